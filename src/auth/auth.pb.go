@@ -52,6 +52,10 @@ const (
 	Permission_CLUSTER_AUTH_DELETE_EXPIRED_TOKENS         Permission = 140
 	Permission_CLUSTER_AUTH_REVOKE_USER_TOKENS            Permission = 142
 	Permission_CLUSTER_AUTH_ROTATE_ROOT_TOKEN             Permission = 147
+	Permission_CLUSTER_AUTH_LIST_TOKENS                   Permission = 148
+	Permission_CLUSTER_AUTH_REVOKE_TOKEN                  Permission = 149
+	Permission_CLUSTER_ADMIN_MIGRATION_STATUS             Permission = 150
+	Permission_CLUSTER_ADMIN_VERIFY_REPLICA               Permission = 154
 	Permission_CLUSTER_ENTERPRISE_ACTIVATE                Permission = 114
 	Permission_CLUSTER_ENTERPRISE_HEARTBEAT               Permission = 115
 	Permission_CLUSTER_ENTERPRISE_GET_CODE                Permission = 116
@@ -68,6 +72,9 @@ const (
 	Permission_CLUSTER_IDENTITY_LIST_OIDC_CLIENTS         Permission = 127
 	Permission_CLUSTER_IDENTITY_GET_OIDC_CLIENT           Permission = 128
 	Permission_CLUSTER_IDENTITY_DELETE_OIDC_CLIENT        Permission = 129
+	Permission_CLUSTER_IDENTITY_LIST_USERS                Permission = 151
+	Permission_CLUSTER_IDENTITY_GET_USER                  Permission = 152
+	Permission_CLUSTER_IDENTITY_DELETE_USER               Permission = 153
 	Permission_CLUSTER_DEBUG_DUMP                         Permission = 131
 	Permission_CLUSTER_LICENSE_ACTIVATE                   Permission = 132
 	Permission_CLUSTER_LICENSE_GET_CODE                   Permission = 133
@@ -96,6 +103,8 @@ const (
 	Permission_REPO_ADD_PIPELINE_READER    Permission = 212
 	Permission_REPO_REMOVE_PIPELINE_READER Permission = 213
 	Permission_REPO_ADD_PIPELINE_WRITER    Permission = 214
+	Permission_REPO_CREATE_TAG             Permission = 215
+	Permission_REPO_DELETE_TAG             Permission = 216
 	Permission_PIPELINE_LIST_JOB           Permission = 301
 )
 
@@ -117,6 +126,10 @@ var Permission_name = map[int32]string{
 	140: "CLUSTER_AUTH_DELETE_EXPIRED_TOKENS",
 	142: "CLUSTER_AUTH_REVOKE_USER_TOKENS",
 	147: "CLUSTER_AUTH_ROTATE_ROOT_TOKEN",
+	148: "CLUSTER_AUTH_LIST_TOKENS",
+	149: "CLUSTER_AUTH_REVOKE_TOKEN",
+	150: "CLUSTER_ADMIN_MIGRATION_STATUS",
+	154: "CLUSTER_ADMIN_VERIFY_REPLICA",
 	114: "CLUSTER_ENTERPRISE_ACTIVATE",
 	115: "CLUSTER_ENTERPRISE_HEARTBEAT",
 	116: "CLUSTER_ENTERPRISE_GET_CODE",
@@ -133,6 +146,9 @@ var Permission_name = map[int32]string{
 	127: "CLUSTER_IDENTITY_LIST_OIDC_CLIENTS",
 	128: "CLUSTER_IDENTITY_GET_OIDC_CLIENT",
 	129: "CLUSTER_IDENTITY_DELETE_OIDC_CLIENT",
+	151: "CLUSTER_IDENTITY_LIST_USERS",
+	152: "CLUSTER_IDENTITY_GET_USER",
+	153: "CLUSTER_IDENTITY_DELETE_USER",
 	131: "CLUSTER_DEBUG_DUMP",
 	132: "CLUSTER_LICENSE_ACTIVATE",
 	133: "CLUSTER_LICENSE_GET_CODE",
@@ -160,6 +176,8 @@ var Permission_name = map[int32]string{
 	212: "REPO_ADD_PIPELINE_READER",
 	213: "REPO_REMOVE_PIPELINE_READER",
 	214: "REPO_ADD_PIPELINE_WRITER",
+	215: "REPO_CREATE_TAG",
+	216: "REPO_DELETE_TAG",
 	301: "PIPELINE_LIST_JOB",
 }
 
@@ -181,6 +199,10 @@ var Permission_value = map[string]int32{
 	"CLUSTER_AUTH_DELETE_EXPIRED_TOKENS":         140,
 	"CLUSTER_AUTH_REVOKE_USER_TOKENS":            142,
 	"CLUSTER_AUTH_ROTATE_ROOT_TOKEN":             147,
+	"CLUSTER_AUTH_LIST_TOKENS":                   148,
+	"CLUSTER_AUTH_REVOKE_TOKEN":                  149,
+	"CLUSTER_ADMIN_MIGRATION_STATUS":             150,
+	"CLUSTER_ADMIN_VERIFY_REPLICA":               154,
 	"CLUSTER_ENTERPRISE_ACTIVATE":                114,
 	"CLUSTER_ENTERPRISE_HEARTBEAT":               115,
 	"CLUSTER_ENTERPRISE_GET_CODE":                116,
@@ -197,6 +219,9 @@ var Permission_value = map[string]int32{
 	"CLUSTER_IDENTITY_LIST_OIDC_CLIENTS":         127,
 	"CLUSTER_IDENTITY_GET_OIDC_CLIENT":           128,
 	"CLUSTER_IDENTITY_DELETE_OIDC_CLIENT":        129,
+	"CLUSTER_IDENTITY_LIST_USERS":                151,
+	"CLUSTER_IDENTITY_GET_USER":                  152,
+	"CLUSTER_IDENTITY_DELETE_USER":               153,
 	"CLUSTER_DEBUG_DUMP":                         131,
 	"CLUSTER_LICENSE_ACTIVATE":                   132,
 	"CLUSTER_LICENSE_GET_CODE":                   133,
@@ -224,6 +249,8 @@ var Permission_value = map[string]int32{
 	"REPO_ADD_PIPELINE_READER":                   212,
 	"REPO_REMOVE_PIPELINE_READER":                213,
 	"REPO_ADD_PIPELINE_WRITER":                   214,
+	"REPO_CREATE_TAG":                            215,
+	"REPO_DELETE_TAG":                            216,
 	"PIPELINE_LIST_JOB":                          301,
 }
 
@@ -813,9 +840,16 @@ var xxx_messageInfo_SetConfigurationResponse proto.InternalMessageInfo
 type TokenInfo struct {
 	// Subject (i.e. Pachyderm account) that a given token authorizes.
 	// See the note at the top of the doc for an explanation of subject structure.
-	Subject              string     `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
-	Expiration           *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty" db:"expiration"`
-	HashedToken          string     `protobuf:"bytes,3,opt,name=hashed_token,json=hashedToken,proto3" json:"hashed_token,omitempty" db:"token_hash"`
+	Subject     string     `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Expiration  *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty" db:"expiration"`
+	HashedToken string     `protobuf:"bytes,3,opt,name=hashed_token,json=hashedToken,proto3" json:"hashed_token,omitempty" db:"token_hash"`
+	// Scopes, if set, restrict the token to a subset of its subject's
+	// permissions. See TokenScope.
+	Scopes    []*TokenScope `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreatedAt *time.Time    `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
+	// last_used is the approximate time at which the token was last used to
+	// authenticate a request. It's only updated about once per minute.
+	LastUsed             *time.Time `protobuf:"bytes,6,opt,name=last_used,json=lastUsed,proto3,stdtime" json:"last_used,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -875,6 +909,94 @@ func (m *TokenInfo) GetHashedToken() string {
 	return ""
 }
 
+func (m *TokenInfo) GetScopes() []*TokenScope {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *TokenInfo) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *TokenInfo) GetLastUsed() *time.Time {
+	if m != nil {
+		return m.LastUsed
+	}
+	return nil
+}
+
+// TokenScope restricts a token to a set of roles and permissions on a
+// resource. A token with scopes is only authorized for a permission on a
+// resource if both its subject's role bindings and one of its scopes grant it.
+// A scope on the cluster applies to every resource.
+type TokenScope struct {
+	Resource             *Resource    `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Roles                []string     `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	Permissions          []Permission `protobuf:"varint,3,rep,packed,name=permissions,proto3,enum=auth_v2.Permission" json:"permissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TokenScope) Reset()         { *m = TokenScope{} }
+func (m *TokenScope) String() string { return proto.CompactTextString(m) }
+func (*TokenScope) ProtoMessage()    {}
+func (*TokenScope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{12}
+}
+func (m *TokenScope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenScope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenScope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenScope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenScope.Merge(m, src)
+}
+func (m *TokenScope) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenScope) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenScope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenScope proto.InternalMessageInfo
+
+func (m *TokenScope) GetResource() *Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *TokenScope) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *TokenScope) GetPermissions() []Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
 type AuthenticateRequest struct {
 	// This is the session state that Pachyderm creates in order to keep track of
 	// information related to the current OIDC session.
@@ -890,7 +1012,7 @@ func (m *AuthenticateRequest) Reset()         { *m = AuthenticateRequest{} }
 func (m *AuthenticateRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthenticateRequest) ProtoMessage()    {}
 func (*AuthenticateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{12}
+	return fileDescriptor_712ec48c1eaf43a2, []int{13}
 }
 func (m *AuthenticateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -947,7 +1069,7 @@ func (m *AuthenticateResponse) Reset()         { *m = AuthenticateResponse{} }
 func (m *AuthenticateResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthenticateResponse) ProtoMessage()    {}
 func (*AuthenticateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{13}
+	return fileDescriptor_712ec48c1eaf43a2, []int{14}
 }
 func (m *AuthenticateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -993,7 +1115,7 @@ func (m *WhoAmIRequest) Reset()         { *m = WhoAmIRequest{} }
 func (m *WhoAmIRequest) String() string { return proto.CompactTextString(m) }
 func (*WhoAmIRequest) ProtoMessage()    {}
 func (*WhoAmIRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{14}
+	return fileDescriptor_712ec48c1eaf43a2, []int{15}
 }
 func (m *WhoAmIRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1023,18 +1145,20 @@ func (m *WhoAmIRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_WhoAmIRequest proto.InternalMessageInfo
 
 type WhoAmIResponse struct {
-	Username             string     `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Expiration           *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty" db:"expiration"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Username   string     `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Expiration *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty" db:"expiration"`
+	// scopes are the scopes of the caller's token, if it's scoped
+	Scopes               []*TokenScope `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *WhoAmIResponse) Reset()         { *m = WhoAmIResponse{} }
 func (m *WhoAmIResponse) String() string { return proto.CompactTextString(m) }
 func (*WhoAmIResponse) ProtoMessage()    {}
 func (*WhoAmIResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{15}
+	return fileDescriptor_712ec48c1eaf43a2, []int{16}
 }
 func (m *WhoAmIResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1077,6 +1201,13 @@ func (m *WhoAmIResponse) GetExpiration() *time.Time {
 	return nil
 }
 
+func (m *WhoAmIResponse) GetScopes() []*TokenScope {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
 // Roles represents the set of roles a principal has
 type Roles struct {
 	Roles                map[string]bool `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
@@ -1089,7 +1220,7 @@ func (m *Roles) Reset()         { *m = Roles{} }
 func (m *Roles) String() string { return proto.CompactTextString(m) }
 func (*Roles) ProtoMessage()    {}
 func (*Roles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{16}
+	return fileDescriptor_712ec48c1eaf43a2, []int{17}
 }
 func (m *Roles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1138,7 +1269,7 @@ func (m *RoleBinding) Reset()         { *m = RoleBinding{} }
 func (m *RoleBinding) String() string { return proto.CompactTextString(m) }
 func (*RoleBinding) ProtoMessage()    {}
 func (*RoleBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{17}
+	return fileDescriptor_712ec48c1eaf43a2, []int{18}
 }
 func (m *RoleBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1318,7 @@ func (m *Resource) Reset()         { *m = Resource{} }
 func (m *Resource) String() string { return proto.CompactTextString(m) }
 func (*Resource) ProtoMessage()    {}
 func (*Resource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{18}
+	return fileDescriptor_712ec48c1eaf43a2, []int{19}
 }
 func (m *Resource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1241,7 +1372,7 @@ func (m *Users) Reset()         { *m = Users{} }
 func (m *Users) String() string { return proto.CompactTextString(m) }
 func (*Users) ProtoMessage()    {}
 func (*Users) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{19}
+	return fileDescriptor_712ec48c1eaf43a2, []int{20}
 }
 func (m *Users) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1288,7 +1419,7 @@ func (m *Groups) Reset()         { *m = Groups{} }
 func (m *Groups) String() string { return proto.CompactTextString(m) }
 func (*Groups) ProtoMessage()    {}
 func (*Groups) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{20}
+	return fileDescriptor_712ec48c1eaf43a2, []int{21}
 }
 func (m *Groups) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1337,7 +1468,7 @@ func (m *AuthorizeRequest) Reset()         { *m = AuthorizeRequest{} }
 func (m *AuthorizeRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthorizeRequest) ProtoMessage()    {}
 func (*AuthorizeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{21}
+	return fileDescriptor_712ec48c1eaf43a2, []int{22}
 }
 func (m *AuthorizeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1398,7 +1529,7 @@ func (m *AuthorizeResponse) Reset()         { *m = AuthorizeResponse{} }
 func (m *AuthorizeResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthorizeResponse) ProtoMessage()    {}
 func (*AuthorizeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{22}
+	return fileDescriptor_712ec48c1eaf43a2, []int{23}
 }
 func (m *AuthorizeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1598,7 @@ func (m *GetPermissionsRequest) Reset()         { *m = GetPermissionsRequest{} }
 func (m *GetPermissionsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPermissionsRequest) ProtoMessage()    {}
 func (*GetPermissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{23}
+	return fileDescriptor_712ec48c1eaf43a2, []int{24}
 }
 func (m *GetPermissionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1517,7 +1648,7 @@ func (m *GetPermissionsForPrincipalRequest) Reset()         { *m = GetPermission
 func (m *GetPermissionsForPrincipalRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPermissionsForPrincipalRequest) ProtoMessage()    {}
 func (*GetPermissionsForPrincipalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{24}
+	return fileDescriptor_712ec48c1eaf43a2, []int{25}
 }
 func (m *GetPermissionsForPrincipalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1574,7 +1705,7 @@ func (m *GetPermissionsResponse) Reset()         { *m = GetPermissionsResponse{}
 func (m *GetPermissionsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPermissionsResponse) ProtoMessage()    {}
 func (*GetPermissionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{25}
+	return fileDescriptor_712ec48c1eaf43a2, []int{26}
 }
 func (m *GetPermissionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1634,7 +1765,7 @@ func (m *ModifyRoleBindingRequest) Reset()         { *m = ModifyRoleBindingReque
 func (m *ModifyRoleBindingRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyRoleBindingRequest) ProtoMessage()    {}
 func (*ModifyRoleBindingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{26}
+	return fileDescriptor_712ec48c1eaf43a2, []int{27}
 }
 func (m *ModifyRoleBindingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1694,7 +1825,7 @@ func (m *ModifyRoleBindingResponse) Reset()         { *m = ModifyRoleBindingResp
 func (m *ModifyRoleBindingResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyRoleBindingResponse) ProtoMessage()    {}
 func (*ModifyRoleBindingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{27}
+	return fileDescriptor_712ec48c1eaf43a2, []int{28}
 }
 func (m *ModifyRoleBindingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1734,7 +1865,7 @@ func (m *GetRoleBindingRequest) Reset()         { *m = GetRoleBindingRequest{} }
 func (m *GetRoleBindingRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRoleBindingRequest) ProtoMessage()    {}
 func (*GetRoleBindingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{28}
+	return fileDescriptor_712ec48c1eaf43a2, []int{29}
 }
 func (m *GetRoleBindingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1781,7 +1912,7 @@ func (m *GetRoleBindingResponse) Reset()         { *m = GetRoleBindingResponse{}
 func (m *GetRoleBindingResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRoleBindingResponse) ProtoMessage()    {}
 func (*GetRoleBindingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{29}
+	return fileDescriptor_712ec48c1eaf43a2, []int{30}
 }
 func (m *GetRoleBindingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1856,7 +1987,7 @@ func (m *SessionInfo) Reset()         { *m = SessionInfo{} }
 func (m *SessionInfo) String() string { return proto.CompactTextString(m) }
 func (*SessionInfo) ProtoMessage()    {}
 func (*SessionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{30}
+	return fileDescriptor_712ec48c1eaf43a2, []int{31}
 }
 func (m *SessionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1916,7 +2047,7 @@ func (m *GetOIDCLoginRequest) Reset()         { *m = GetOIDCLoginRequest{} }
 func (m *GetOIDCLoginRequest) String() string { return proto.CompactTextString(m) }
 func (*GetOIDCLoginRequest) ProtoMessage()    {}
 func (*GetOIDCLoginRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{31}
+	return fileDescriptor_712ec48c1eaf43a2, []int{32}
 }
 func (m *GetOIDCLoginRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1958,7 +2089,7 @@ func (m *GetOIDCLoginResponse) Reset()         { *m = GetOIDCLoginResponse{} }
 func (m *GetOIDCLoginResponse) String() string { return proto.CompactTextString(m) }
 func (*GetOIDCLoginResponse) ProtoMessage()    {}
 func (*GetOIDCLoginResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{32}
+	return fileDescriptor_712ec48c1eaf43a2, []int{33}
 }
 func (m *GetOIDCLoginResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2007,17 +2138,20 @@ type GetRobotTokenRequest struct {
 	Robot string `protobuf:"bytes,1,opt,name=robot,proto3" json:"robot,omitempty"`
 	// ttl indicates the requested (approximate) remaining lifetime of this token,
 	// in seconds
-	TTL                  int64    `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	TTL int64 `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// scopes, if set, restrict the returned token to the given roles and
+	// permissions, on top of the robot's role bindings
+	Scopes               []*TokenScope `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *GetRobotTokenRequest) Reset()         { *m = GetRobotTokenRequest{} }
 func (m *GetRobotTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRobotTokenRequest) ProtoMessage()    {}
 func (*GetRobotTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{33}
+	return fileDescriptor_712ec48c1eaf43a2, []int{34}
 }
 func (m *GetRobotTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2060,6 +2194,13 @@ func (m *GetRobotTokenRequest) GetTTL() int64 {
 	return 0
 }
 
+func (m *GetRobotTokenRequest) GetScopes() []*TokenScope {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
 type GetRobotTokenResponse struct {
 	// A new auth token for the requested robot
 	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -2072,7 +2213,7 @@ func (m *GetRobotTokenResponse) Reset()         { *m = GetRobotTokenResponse{} }
 func (m *GetRobotTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRobotTokenResponse) ProtoMessage()    {}
 func (*GetRobotTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{34}
+	return fileDescriptor_712ec48c1eaf43a2, []int{35}
 }
 func (m *GetRobotTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2119,7 +2260,7 @@ func (m *RevokeAuthTokenRequest) Reset()         { *m = RevokeAuthTokenRequest{}
 func (m *RevokeAuthTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*RevokeAuthTokenRequest) ProtoMessage()    {}
 func (*RevokeAuthTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{35}
+	return fileDescriptor_712ec48c1eaf43a2, []int{36}
 }
 func (m *RevokeAuthTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2165,7 +2306,7 @@ func (m *RevokeAuthTokenResponse) Reset()         { *m = RevokeAuthTokenResponse
 func (m *RevokeAuthTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*RevokeAuthTokenResponse) ProtoMessage()    {}
 func (*RevokeAuthTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{36}
+	return fileDescriptor_712ec48c1eaf43a2, []int{37}
 }
 func (m *RevokeAuthTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2206,7 +2347,7 @@ func (m *SetGroupsForUserRequest) Reset()         { *m = SetGroupsForUserRequest
 func (m *SetGroupsForUserRequest) String() string { return proto.CompactTextString(m) }
 func (*SetGroupsForUserRequest) ProtoMessage()    {}
 func (*SetGroupsForUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{37}
+	return fileDescriptor_712ec48c1eaf43a2, []int{38}
 }
 func (m *SetGroupsForUserRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2259,7 +2400,7 @@ func (m *SetGroupsForUserResponse) Reset()         { *m = SetGroupsForUserRespon
 func (m *SetGroupsForUserResponse) String() string { return proto.CompactTextString(m) }
 func (*SetGroupsForUserResponse) ProtoMessage()    {}
 func (*SetGroupsForUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{38}
+	return fileDescriptor_712ec48c1eaf43a2, []int{39}
 }
 func (m *SetGroupsForUserResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2301,7 +2442,7 @@ func (m *ModifyMembersRequest) Reset()         { *m = ModifyMembersRequest{} }
 func (m *ModifyMembersRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyMembersRequest) ProtoMessage()    {}
 func (*ModifyMembersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{39}
+	return fileDescriptor_712ec48c1eaf43a2, []int{40}
 }
 func (m *ModifyMembersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2361,7 +2502,7 @@ func (m *ModifyMembersResponse) Reset()         { *m = ModifyMembersResponse{} }
 func (m *ModifyMembersResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyMembersResponse) ProtoMessage()    {}
 func (*ModifyMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{40}
+	return fileDescriptor_712ec48c1eaf43a2, []int{41}
 }
 func (m *ModifyMembersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2400,7 +2541,7 @@ func (m *GetGroupsRequest) Reset()         { *m = GetGroupsRequest{} }
 func (m *GetGroupsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGroupsRequest) ProtoMessage()    {}
 func (*GetGroupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{41}
+	return fileDescriptor_712ec48c1eaf43a2, []int{42}
 }
 func (m *GetGroupsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2440,7 +2581,7 @@ func (m *GetGroupsForPrincipalRequest) Reset()         { *m = GetGroupsForPrinci
 func (m *GetGroupsForPrincipalRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGroupsForPrincipalRequest) ProtoMessage()    {}
 func (*GetGroupsForPrincipalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{42}
+	return fileDescriptor_712ec48c1eaf43a2, []int{43}
 }
 func (m *GetGroupsForPrincipalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2487,7 +2628,7 @@ func (m *GetGroupsResponse) Reset()         { *m = GetGroupsResponse{} }
 func (m *GetGroupsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetGroupsResponse) ProtoMessage()    {}
 func (*GetGroupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{43}
+	return fileDescriptor_712ec48c1eaf43a2, []int{44}
 }
 func (m *GetGroupsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2534,7 +2675,7 @@ func (m *GetUsersRequest) Reset()         { *m = GetUsersRequest{} }
 func (m *GetUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetUsersRequest) ProtoMessage()    {}
 func (*GetUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{44}
+	return fileDescriptor_712ec48c1eaf43a2, []int{45}
 }
 func (m *GetUsersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2581,7 +2722,7 @@ func (m *GetUsersResponse) Reset()         { *m = GetUsersResponse{} }
 func (m *GetUsersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetUsersResponse) ProtoMessage()    {}
 func (*GetUsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{45}
+	return fileDescriptor_712ec48c1eaf43a2, []int{46}
 }
 func (m *GetUsersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2629,7 +2770,7 @@ func (m *ExtractAuthTokensRequest) Reset()         { *m = ExtractAuthTokensReque
 func (m *ExtractAuthTokensRequest) String() string { return proto.CompactTextString(m) }
 func (*ExtractAuthTokensRequest) ProtoMessage()    {}
 func (*ExtractAuthTokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{46}
+	return fileDescriptor_712ec48c1eaf43a2, []int{47}
 }
 func (m *ExtractAuthTokensRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2669,7 +2810,7 @@ func (m *ExtractAuthTokensResponse) Reset()         { *m = ExtractAuthTokensResp
 func (m *ExtractAuthTokensResponse) String() string { return proto.CompactTextString(m) }
 func (*ExtractAuthTokensResponse) ProtoMessage()    {}
 func (*ExtractAuthTokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{47}
+	return fileDescriptor_712ec48c1eaf43a2, []int{48}
 }
 func (m *ExtractAuthTokensResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2717,7 +2858,7 @@ func (m *RestoreAuthTokenRequest) Reset()         { *m = RestoreAuthTokenRequest
 func (m *RestoreAuthTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreAuthTokenRequest) ProtoMessage()    {}
 func (*RestoreAuthTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{48}
+	return fileDescriptor_712ec48c1eaf43a2, []int{49}
 }
 func (m *RestoreAuthTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2763,7 +2904,7 @@ func (m *RestoreAuthTokenResponse) Reset()         { *m = RestoreAuthTokenRespon
 func (m *RestoreAuthTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreAuthTokenResponse) ProtoMessage()    {}
 func (*RestoreAuthTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{49}
+	return fileDescriptor_712ec48c1eaf43a2, []int{50}
 }
 func (m *RestoreAuthTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2803,7 +2944,7 @@ func (m *RevokeAuthTokensForUserRequest) Reset()         { *m = RevokeAuthTokens
 func (m *RevokeAuthTokensForUserRequest) String() string { return proto.CompactTextString(m) }
 func (*RevokeAuthTokensForUserRequest) ProtoMessage()    {}
 func (*RevokeAuthTokensForUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{50}
+	return fileDescriptor_712ec48c1eaf43a2, []int{51}
 }
 func (m *RevokeAuthTokensForUserRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2849,7 +2990,7 @@ func (m *RevokeAuthTokensForUserResponse) Reset()         { *m = RevokeAuthToken
 func (m *RevokeAuthTokensForUserResponse) String() string { return proto.CompactTextString(m) }
 func (*RevokeAuthTokensForUserResponse) ProtoMessage()    {}
 func (*RevokeAuthTokensForUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{51}
+	return fileDescriptor_712ec48c1eaf43a2, []int{52}
 }
 func (m *RevokeAuthTokensForUserResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2888,7 +3029,7 @@ func (m *DeleteExpiredAuthTokensRequest) Reset()         { *m = DeleteExpiredAut
 func (m *DeleteExpiredAuthTokensRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteExpiredAuthTokensRequest) ProtoMessage()    {}
 func (*DeleteExpiredAuthTokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{52}
+	return fileDescriptor_712ec48c1eaf43a2, []int{53}
 }
 func (m *DeleteExpiredAuthTokensRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2927,7 +3068,7 @@ func (m *DeleteExpiredAuthTokensResponse) Reset()         { *m = DeleteExpiredAu
 func (m *DeleteExpiredAuthTokensResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteExpiredAuthTokensResponse) ProtoMessage()    {}
 func (*DeleteExpiredAuthTokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ec48c1eaf43a2, []int{53}
+	return fileDescriptor_712ec48c1eaf43a2, []int{54}
 }
 func (m *DeleteExpiredAuthTokensResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2956,252 +3097,555 @@ func (m *DeleteExpiredAuthTokensResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteExpiredAuthTokensResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("auth_v2.Permission", Permission_name, Permission_value)
-	proto.RegisterEnum("auth_v2.ResourceType", ResourceType_name, ResourceType_value)
-	proto.RegisterType((*ActivateRequest)(nil), "auth_v2.ActivateRequest")
-	proto.RegisterType((*ActivateResponse)(nil), "auth_v2.ActivateResponse")
-	proto.RegisterType((*DeactivateRequest)(nil), "auth_v2.DeactivateRequest")
-	proto.RegisterType((*DeactivateResponse)(nil), "auth_v2.DeactivateResponse")
-	proto.RegisterType((*RotateRootTokenRequest)(nil), "auth_v2.RotateRootTokenRequest")
-	proto.RegisterType((*RotateRootTokenResponse)(nil), "auth_v2.RotateRootTokenResponse")
-	proto.RegisterType((*OIDCConfig)(nil), "auth_v2.OIDCConfig")
-	proto.RegisterType((*GetConfigurationRequest)(nil), "auth_v2.GetConfigurationRequest")
-	proto.RegisterType((*GetConfigurationResponse)(nil), "auth_v2.GetConfigurationResponse")
-	proto.RegisterType((*SetConfigurationRequest)(nil), "auth_v2.SetConfigurationRequest")
-	proto.RegisterType((*SetConfigurationResponse)(nil), "auth_v2.SetConfigurationResponse")
-	proto.RegisterType((*TokenInfo)(nil), "auth_v2.TokenInfo")
-	proto.RegisterType((*AuthenticateRequest)(nil), "auth_v2.AuthenticateRequest")
-	proto.RegisterType((*AuthenticateResponse)(nil), "auth_v2.AuthenticateResponse")
-	proto.RegisterType((*WhoAmIRequest)(nil), "auth_v2.WhoAmIRequest")
-	proto.RegisterType((*WhoAmIResponse)(nil), "auth_v2.WhoAmIResponse")
-	proto.RegisterType((*Roles)(nil), "auth_v2.Roles")
-	proto.RegisterMapType((map[string]bool)(nil), "auth_v2.Roles.RolesEntry")
-	proto.RegisterType((*RoleBinding)(nil), "auth_v2.RoleBinding")
-	proto.RegisterMapType((map[string]*Roles)(nil), "auth_v2.RoleBinding.EntriesEntry")
-	proto.RegisterType((*Resource)(nil), "auth_v2.Resource")
-	proto.RegisterType((*Users)(nil), "auth_v2.Users")
-	proto.RegisterMapType((map[string]bool)(nil), "auth_v2.Users.UsernamesEntry")
-	proto.RegisterType((*Groups)(nil), "auth_v2.Groups")
-	proto.RegisterMapType((map[string]bool)(nil), "auth_v2.Groups.GroupsEntry")
-	proto.RegisterType((*AuthorizeRequest)(nil), "auth_v2.AuthorizeRequest")
-	proto.RegisterType((*AuthorizeResponse)(nil), "auth_v2.AuthorizeResponse")
-	proto.RegisterType((*GetPermissionsRequest)(nil), "auth_v2.GetPermissionsRequest")
-	proto.RegisterType((*GetPermissionsForPrincipalRequest)(nil), "auth_v2.GetPermissionsForPrincipalRequest")
-	proto.RegisterType((*GetPermissionsResponse)(nil), "auth_v2.GetPermissionsResponse")
-	proto.RegisterType((*ModifyRoleBindingRequest)(nil), "auth_v2.ModifyRoleBindingRequest")
-	proto.RegisterType((*ModifyRoleBindingResponse)(nil), "auth_v2.ModifyRoleBindingResponse")
-	proto.RegisterType((*GetRoleBindingRequest)(nil), "auth_v2.GetRoleBindingRequest")
-	proto.RegisterType((*GetRoleBindingResponse)(nil), "auth_v2.GetRoleBindingResponse")
-	proto.RegisterType((*SessionInfo)(nil), "auth_v2.SessionInfo")
-	proto.RegisterType((*GetOIDCLoginRequest)(nil), "auth_v2.GetOIDCLoginRequest")
-	proto.RegisterType((*GetOIDCLoginResponse)(nil), "auth_v2.GetOIDCLoginResponse")
-	proto.RegisterType((*GetRobotTokenRequest)(nil), "auth_v2.GetRobotTokenRequest")
-	proto.RegisterType((*GetRobotTokenResponse)(nil), "auth_v2.GetRobotTokenResponse")
-	proto.RegisterType((*RevokeAuthTokenRequest)(nil), "auth_v2.RevokeAuthTokenRequest")
-	proto.RegisterType((*RevokeAuthTokenResponse)(nil), "auth_v2.RevokeAuthTokenResponse")
-	proto.RegisterType((*SetGroupsForUserRequest)(nil), "auth_v2.SetGroupsForUserRequest")
-	proto.RegisterType((*SetGroupsForUserResponse)(nil), "auth_v2.SetGroupsForUserResponse")
-	proto.RegisterType((*ModifyMembersRequest)(nil), "auth_v2.ModifyMembersRequest")
-	proto.RegisterType((*ModifyMembersResponse)(nil), "auth_v2.ModifyMembersResponse")
-	proto.RegisterType((*GetGroupsRequest)(nil), "auth_v2.GetGroupsRequest")
-	proto.RegisterType((*GetGroupsForPrincipalRequest)(nil), "auth_v2.GetGroupsForPrincipalRequest")
-	proto.RegisterType((*GetGroupsResponse)(nil), "auth_v2.GetGroupsResponse")
-	proto.RegisterType((*GetUsersRequest)(nil), "auth_v2.GetUsersRequest")
-	proto.RegisterType((*GetUsersResponse)(nil), "auth_v2.GetUsersResponse")
-	proto.RegisterType((*ExtractAuthTokensRequest)(nil), "auth_v2.ExtractAuthTokensRequest")
-	proto.RegisterType((*ExtractAuthTokensResponse)(nil), "auth_v2.ExtractAuthTokensResponse")
-	proto.RegisterType((*RestoreAuthTokenRequest)(nil), "auth_v2.RestoreAuthTokenRequest")
-	proto.RegisterType((*RestoreAuthTokenResponse)(nil), "auth_v2.RestoreAuthTokenResponse")
-	proto.RegisterType((*RevokeAuthTokensForUserRequest)(nil), "auth_v2.RevokeAuthTokensForUserRequest")
-	proto.RegisterType((*RevokeAuthTokensForUserResponse)(nil), "auth_v2.RevokeAuthTokensForUserResponse")
-	proto.RegisterType((*DeleteExpiredAuthTokensRequest)(nil), "auth_v2.DeleteExpiredAuthTokensRequest")
-	proto.RegisterType((*DeleteExpiredAuthTokensResponse)(nil), "auth_v2.DeleteExpiredAuthTokensResponse")
+// ListAuthTokens returns the hashed tokens that have been issued, optionally
+// only those of 'subject'. Tokens are identified by their hash, so the tokens
+// themselves are never returned.
+type ListAuthTokensRequest struct {
+	Subject              string   `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("auth/auth.proto", fileDescriptor_712ec48c1eaf43a2) }
-
-var fileDescriptor_712ec48c1eaf43a2 = []byte{
-	// 2661 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x5b, 0x77, 0xdc, 0x56,
-	0xf5, 0x8f, 0xec, 0xd8, 0x9e, 0xd9, 0xe3, 0xd8, 0xca, 0xf1, 0x6d, 0xac, 0x24, 0x1e, 0x5b, 0x6d,
-	0xfe, 0x71, 0xf2, 0xa7, 0x76, 0xeb, 0x52, 0x48, 0x2f, 0x0f, 0xcc, 0x45, 0x9e, 0xa8, 0x9d, 0xdb,
-	0x3a, 0xd2, 0x24, 0x0d, 0x8b, 0x85, 0x18, 0xcf, 0x1c, 0xdb, 0x22, 0xf6, 0x68, 0x2a, 0x69, 0x4c,
-	0x5c, 0x28, 0x50, 0xee, 0x97, 0x02, 0x05, 0xd6, 0xe2, 0x9d, 0x0f, 0xc0, 0x0b, 0x7c, 0x89, 0x02,
-	0x05, 0xca, 0xf5, 0x31, 0xb0, 0xfc, 0x11, 0xf8, 0x04, 0x2c, 0x1d, 0x1d, 0x49, 0x47, 0x1a, 0x8d,
-	0x9b, 0xb4, 0xab, 0x2f, 0xb6, 0xce, 0xde, 0xbf, 0xf3, 0xdb, 0xfb, 0xec, 0xb3, 0xcf, 0x65, 0x9f,
-	0x81, 0xf9, 0xce, 0xd0, 0x3d, 0xdc, 0xf6, 0xfe, 0x6c, 0x0d, 0x6c, 0xcb, 0xb5, 0xd0, 0x8c, 0xf7,
-	0x6d, 0x9c, 0xec, 0x48, 0x8b, 0x07, 0xd6, 0x81, 0x45, 0x65, 0xdb, 0xde, 0x97, 0xaf, 0x96, 0x0a,
-	0x07, 0x96, 0x75, 0x70, 0x44, 0xb6, 0x69, 0x6b, 0x6f, 0xb8, 0xbf, 0xed, 0x9a, 0xc7, 0xc4, 0x71,
-	0x3b, 0xc7, 0x03, 0x1f, 0x20, 0x3f, 0x0b, 0xf3, 0xc5, 0xae, 0x6b, 0x9e, 0x74, 0x5c, 0x82, 0xc9,
-	0x1b, 0x43, 0xe2, 0xb8, 0xe8, 0x1a, 0x80, 0x6d, 0x59, 0xae, 0xe1, 0x5a, 0x0f, 0x48, 0x3f, 0x2f,
-	0xac, 0x0b, 0x9b, 0x59, 0x9c, 0xf5, 0x24, 0xba, 0x27, 0x90, 0x9f, 0x03, 0x31, 0xea, 0xe1, 0x0c,
-	0xac, 0xbe, 0x43, 0xbc, 0x2e, 0x83, 0x4e, 0xf7, 0x30, 0xde, 0xc5, 0x93, 0xf8, 0x5d, 0x16, 0xe0,
-	0x72, 0x85, 0x74, 0xe2, 0x66, 0xe4, 0x45, 0x40, 0xbc, 0xd0, 0x67, 0x92, 0x3f, 0x0b, 0xcb, 0xd8,
-	0x72, 0x3d, 0x49, 0x60, 0xf0, 0x31, 0xdd, 0xba, 0x0d, 0x2b, 0x23, 0x1d, 0x23, 0xef, 0xce, 0xeb,
-	0xf9, 0xeb, 0x09, 0x80, 0xa6, 0x5a, 0x29, 0x97, 0xad, 0xfe, 0xbe, 0x79, 0x80, 0x96, 0x61, 0xda,
-	0x74, 0x9c, 0x21, 0xb1, 0x19, 0x92, 0xb5, 0xd0, 0x4d, 0xc8, 0x76, 0x8f, 0x4c, 0xd2, 0x77, 0x0d,
-	0xb3, 0x97, 0x9f, 0xf0, 0x54, 0xa5, 0xd9, 0xb3, 0x47, 0x85, 0x4c, 0x99, 0x0a, 0xd5, 0x0a, 0xce,
-	0xf8, 0x6a, 0xb5, 0x87, 0x9e, 0x82, 0x4b, 0x0c, 0xea, 0x90, 0xae, 0x4d, 0xdc, 0xfc, 0x24, 0x65,
-	0x9a, 0xf5, 0x85, 0x1a, 0x95, 0xa1, 0x1d, 0x98, 0xb5, 0x49, 0xcf, 0xb4, 0x49, 0xd7, 0x35, 0x86,
-	0xb6, 0x99, 0xbf, 0x48, 0x29, 0xe7, 0xcf, 0x1e, 0x15, 0x72, 0x98, 0xc9, 0xdb, 0x58, 0xc5, 0xb9,
-	0x00, 0xd4, 0xb6, 0x4d, 0xcf, 0x37, 0xa7, 0x6b, 0x0d, 0x88, 0x93, 0x9f, 0x5a, 0x9f, 0xf4, 0x7c,
-	0xf3, 0x5b, 0xe8, 0xd3, 0xb0, 0x6c, 0x93, 0x37, 0x86, 0xa6, 0x4d, 0x0c, 0x72, 0xdc, 0x31, 0x8f,
-	0x8c, 0x13, 0x62, 0x9b, 0xfb, 0x26, 0xe9, 0xe5, 0xa7, 0xd7, 0x85, 0xcd, 0x0c, 0x5e, 0x64, 0x5a,
-	0xc5, 0x53, 0xde, 0x65, 0x3a, 0x74, 0x13, 0xc4, 0x23, 0xab, 0xdb, 0x39, 0x3a, 0xb4, 0x1c, 0xd7,
-	0x60, 0x63, 0x9e, 0xa1, 0xf8, 0xf9, 0x50, 0xae, 0x52, 0xb1, 0xbc, 0x0a, 0x2b, 0x55, 0xe2, 0xfa,
-	0x11, 0x1a, 0xda, 0x1d, 0xd7, 0xb4, 0x82, 0x79, 0x91, 0xdb, 0x90, 0x1f, 0x55, 0xb1, 0xc8, 0xbf,
-	0x08, 0x97, 0xba, 0xbc, 0x82, 0x86, 0x34, 0xb7, 0xb3, 0xb0, 0xc5, 0xb2, 0x76, 0x2b, 0x8a, 0x3b,
-	0x8e, 0x23, 0x65, 0x1d, 0x56, 0xb4, 0x74, 0x8b, 0x1f, 0x87, 0x55, 0x82, 0xbc, 0x36, 0xc6, 0x59,
-	0xf9, 0xb7, 0x02, 0x64, 0x69, 0x46, 0xa8, 0xfd, 0x7d, 0x0b, 0xe5, 0x61, 0xc6, 0x19, 0xee, 0x7d,
-	0x99, 0x74, 0x5d, 0x96, 0x07, 0x41, 0x13, 0x69, 0x00, 0xe4, 0xe1, 0xc0, 0x64, 0xb6, 0x27, 0xa8,
-	0x6d, 0x69, 0xcb, 0x5f, 0x68, 0x5b, 0xc1, 0x42, 0xdb, 0xd2, 0x83, 0x85, 0x56, 0x5a, 0xf9, 0xef,
-	0xa3, 0xc2, 0x7c, 0x6f, 0xef, 0x25, 0x39, 0xea, 0x25, 0xbf, 0xfb, 0xef, 0x82, 0x80, 0x39, 0x1a,
-	0xf4, 0x19, 0x98, 0x3d, 0xec, 0x38, 0x87, 0xa4, 0xc7, 0xb2, 0x94, 0x66, 0x4c, 0x69, 0x21, 0xe8,
-	0x4a, 0x85, 0x86, 0x87, 0x90, 0x71, 0xce, 0x07, 0xfa, 0xc9, 0xfb, 0x45, 0x58, 0x28, 0x0e, 0xdd,
-	0x43, 0xd2, 0x77, 0xcd, 0x2e, 0xb7, 0x86, 0x3f, 0x05, 0x60, 0x99, 0xbd, 0xae, 0xe1, 0x78, 0x2b,
-	0xc2, 0x1f, 0x40, 0xe9, 0xd2, 0xd9, 0xa3, 0x42, 0xd6, 0x0b, 0x8d, 0x46, 0x97, 0x49, 0xd6, 0x03,
-	0xd0, 0x4f, 0xb4, 0x0a, 0x19, 0x33, 0x30, 0x3c, 0xe1, 0x0f, 0xd6, 0x64, 0xfc, 0x2f, 0xc0, 0x62,
-	0x9c, 0xff, 0xf1, 0x56, 0xfc, 0x3c, 0x5c, 0xba, 0x77, 0x68, 0x15, 0x8f, 0xd5, 0x20, 0x4b, 0xde,
-	0x16, 0x60, 0x2e, 0x90, 0x30, 0x0a, 0x09, 0x32, 0x43, 0x87, 0xd8, 0xfd, 0xce, 0x31, 0xf3, 0x10,
-	0x87, 0xed, 0x4f, 0x24, 0xc6, 0xb2, 0x0d, 0x53, 0xd8, 0x3a, 0x22, 0x0e, 0xda, 0x86, 0x29, 0xdb,
-	0xfb, 0xc8, 0x0b, 0xeb, 0x93, 0x9b, 0xb9, 0x9d, 0xd5, 0x30, 0x71, 0xa8, 0xda, 0xff, 0xab, 0xf4,
-	0x5d, 0xfb, 0x14, 0xfb, 0x38, 0xe9, 0x36, 0x40, 0x24, 0x44, 0x22, 0x4c, 0x3e, 0x20, 0xa7, 0xcc,
-	0x67, 0xef, 0x13, 0x2d, 0xc2, 0xd4, 0x49, 0xe7, 0x68, 0x48, 0xa8, 0xa7, 0x19, 0xec, 0x37, 0x5e,
-	0x9a, 0xb8, 0x2d, 0xc8, 0xbf, 0x12, 0x20, 0xe7, 0x75, 0x2d, 0x99, 0xfd, 0x9e, 0xd9, 0x3f, 0x40,
-	0x2f, 0xc3, 0x0c, 0xe9, 0xbb, 0xb6, 0x19, 0x1a, 0xdf, 0x88, 0x19, 0x67, 0xb0, 0x2d, 0xc5, 0xc7,
-	0xf8, 0x4e, 0x04, 0x3d, 0xa4, 0x57, 0x61, 0x96, 0x57, 0xa4, 0x38, 0xf2, 0x34, 0xef, 0x48, 0x6e,
-	0x67, 0x2e, 0x3e, 0x32, 0xde, 0x31, 0x15, 0x32, 0x98, 0x38, 0xd6, 0xd0, 0xee, 0x12, 0x74, 0x13,
-	0x2e, 0xba, 0xa7, 0x03, 0x7f, 0x16, 0xe6, 0x76, 0x96, 0xa2, 0x4e, 0x0c, 0xa0, 0x9f, 0x0e, 0x08,
-	0xa6, 0x10, 0x84, 0xe0, 0x22, 0x9d, 0x30, 0x3f, 0x4d, 0xe8, 0xb7, 0xfc, 0x2d, 0x01, 0xa6, 0xda,
-	0x0e, 0xb1, 0x1d, 0xf4, 0x32, 0x64, 0x83, 0x29, 0x0c, 0xc6, 0x77, 0x2d, 0x64, 0xa3, 0x10, 0xfa,
-	0x97, 0xea, 0xfd, 0xb1, 0x45, 0x78, 0xe9, 0x15, 0x98, 0x8b, 0x2b, 0x9f, 0x28, 0xd0, 0x0f, 0x61,
-	0xba, 0x6a, 0x5b, 0xc3, 0x81, 0x83, 0x9e, 0x87, 0xe9, 0x03, 0xfa, 0xc5, 0x3c, 0xb8, 0x12, 0x7a,
-	0xe0, 0x03, 0xd8, 0x3f, 0xdf, 0x3e, 0x83, 0x4a, 0x2f, 0x42, 0x8e, 0x13, 0x3f, 0xa1, 0x65, 0xd1,
-	0x5b, 0x22, 0x96, 0x6d, 0xbe, 0x19, 0xae, 0xbf, 0x67, 0x20, 0x63, 0xb3, 0xe0, 0xb1, 0xdd, 0xe9,
-	0xf2, 0x48, 0x54, 0x71, 0x08, 0x41, 0x2f, 0x40, 0x6e, 0x40, 0xec, 0x63, 0xd3, 0x71, 0x4c, 0xab,
-	0xef, 0xe4, 0x27, 0xd6, 0x27, 0x37, 0xe7, 0xb8, 0xfd, 0xac, 0x15, 0xea, 0x30, 0x8f, 0x93, 0x7f,
-	0x27, 0xc0, 0x65, 0xce, 0x34, 0x5b, 0x57, 0x6b, 0x00, 0x9d, 0x40, 0xd8, 0xa3, 0xd6, 0x33, 0x98,
-	0x93, 0xa0, 0xe7, 0x20, 0xeb, 0x74, 0x5c, 0xd3, 0xa1, 0xe7, 0xc3, 0x39, 0xa6, 0x22, 0x14, 0x7a,
-	0x06, 0x66, 0xa8, 0xb4, 0x7f, 0x90, 0x9f, 0x1c, 0xdf, 0x21, 0xc0, 0xa0, 0xab, 0x90, 0x1d, 0xd8,
-	0x66, 0xbf, 0x6b, 0x0e, 0x3a, 0x47, 0xfe, 0xb9, 0x86, 0x23, 0x81, 0xbc, 0x0b, 0x4b, 0x55, 0xe2,
-	0x46, 0xfd, 0x9c, 0x8f, 0x16, 0x34, 0x79, 0x00, 0x1b, 0x71, 0x9e, 0x5d, 0xcb, 0x6e, 0x05, 0x56,
-	0x3e, 0xe2, 0x44, 0xc4, 0x3c, 0x9f, 0x48, 0x7a, 0x4e, 0x60, 0x39, 0xe9, 0x39, 0x8b, 0x79, 0x62,
-	0x02, 0x85, 0xc7, 0x9b, 0x40, 0x2f, 0xa9, 0xfc, 0x8d, 0x68, 0x82, 0x1e, 0xe7, 0x7e, 0x43, 0x7e,
-	0x0b, 0xf2, 0x75, 0xab, 0x67, 0xee, 0x9f, 0x72, 0x3b, 0xc2, 0x27, 0x31, 0x9e, 0xc8, 0xfc, 0x24,
-	0x6f, 0xfe, 0x0a, 0xac, 0xa6, 0x98, 0x67, 0x87, 0xa4, 0x3f, 0x79, 0x1f, 0xdb, 0x31, 0xf9, 0x0e,
-	0x0d, 0x65, 0x8a, 0x05, 0xb4, 0x05, 0x33, 0x7b, 0xbe, 0x88, 0xf1, 0x2c, 0xa6, 0xed, 0x90, 0x38,
-	0x00, 0xc9, 0x5f, 0x82, 0x9c, 0x46, 0x68, 0x3c, 0xe9, 0xb9, 0xbd, 0x08, 0x53, 0x7d, 0xab, 0xdf,
-	0x0d, 0x8e, 0x14, 0xbf, 0xe1, 0x49, 0xe9, 0xc5, 0x88, 0xc5, 0xc0, 0x6f, 0xa0, 0xeb, 0x30, 0xd7,
-	0xb5, 0xfa, 0x27, 0xc4, 0xf6, 0x7a, 0x1b, 0xc4, 0xb6, 0xe9, 0xb1, 0x9b, 0xa1, 0x97, 0x06, 0x26,
-	0x55, 0x6c, 0x5b, 0x5e, 0x82, 0x85, 0x2a, 0x71, 0xbd, 0x93, 0xb3, 0x66, 0x1d, 0x98, 0xe1, 0xc5,
-	0xe7, 0x1e, 0x2c, 0xc6, 0xc5, 0x6c, 0x00, 0x37, 0x21, 0x7b, 0xe4, 0x09, 0x8c, 0xa1, 0x7d, 0xc4,
-	0x8e, 0x5e, 0x7a, 0x51, 0xa4, 0xa8, 0x36, 0xae, 0xe1, 0x0c, 0x55, 0xb7, 0x6d, 0x3a, 0x01, 0xfe,
-	0x09, 0xcd, 0xdc, 0xa2, 0x0d, 0xb9, 0x4a, 0x89, 0xb1, 0xb5, 0x97, 0xb8, 0x01, 0xd3, 0xe9, 0xda,
-	0xb3, 0x82, 0x0b, 0x89, 0xdf, 0x40, 0xab, 0x30, 0xe9, 0xba, 0xfe, 0xc0, 0x26, 0x4b, 0x33, 0x67,
-	0x8f, 0x0a, 0x93, 0xba, 0x5e, 0xc3, 0x9e, 0x4c, 0x7e, 0x86, 0x4d, 0xd6, 0x5e, 0xf2, 0x46, 0xbc,
-	0x08, 0x53, 0xfc, 0xc1, 0xed, 0x37, 0xe4, 0x2d, 0x58, 0xc6, 0xe4, 0xc4, 0x7a, 0x40, 0xbc, 0x3d,
-	0x25, 0x69, 0x39, 0x05, 0xbf, 0x0a, 0x2b, 0x23, 0x78, 0x96, 0x26, 0x75, 0x7a, 0x7b, 0xf3, 0x77,
-	0xd4, 0x5d, 0xcb, 0xf6, 0xf6, 0xf5, 0x80, 0xeb, 0xbc, 0x63, 0x7f, 0x39, 0xdc, 0xba, 0xfd, 0x05,
-	0xc1, 0x5a, 0xec, 0xda, 0x96, 0xa0, 0x63, 0xa6, 0xee, 0xc2, 0xa2, 0x9f, 0xae, 0x75, 0x72, 0xbc,
-	0x47, 0x6c, 0x87, 0xf3, 0x99, 0xf6, 0x0e, 0x7c, 0xa6, 0x0d, 0x6f, 0x63, 0xef, 0xf4, 0x7a, 0x8c,
-	0xde, 0xfb, 0xf4, 0x6c, 0xda, 0xe4, 0xd8, 0x3a, 0x21, 0x6c, 0x15, 0xb0, 0x96, 0xbc, 0x02, 0x4b,
-	0x09, 0x5e, 0x66, 0x10, 0x81, 0x58, 0x0d, 0x9c, 0x09, 0x72, 0xe1, 0x15, 0xb8, 0x5a, 0xe5, 0x1c,
-	0x1c, 0xd9, 0x86, 0x62, 0xeb, 0x50, 0x48, 0xee, 0x2b, 0xff, 0x0f, 0x97, 0x39, 0x46, 0x36, 0x47,
-	0xcb, 0xb1, 0x63, 0x2c, 0x8a, 0xc5, 0x0d, 0x98, 0xaf, 0x12, 0x97, 0x1e, 0xa6, 0xe7, 0x0e, 0x55,
-	0x7e, 0x96, 0xfa, 0xc9, 0x80, 0x8c, 0xf4, 0x6a, 0xf2, 0x80, 0xce, 0x72, 0x27, 0xb0, 0x17, 0x66,
-	0xe5, 0xa1, 0x6b, 0x77, 0xba, 0x6e, 0x38, 0xa3, 0xe1, 0x08, 0xab, 0xb0, 0x9a, 0xa2, 0x63, 0xb4,
-	0xb7, 0x60, 0x9a, 0xa6, 0x44, 0x70, 0xe4, 0xa2, 0x70, 0xc9, 0x86, 0x17, 0x6a, 0xcc, 0x10, 0x72,
-	0xd9, 0xcb, 0x1a, 0xc7, 0xb5, 0xec, 0xd1, 0x34, 0xdb, 0xe4, 0xd3, 0x2c, 0x9d, 0x85, 0xa5, 0x9e,
-	0x04, 0xf9, 0x51, 0x12, 0x36, 0x3f, 0xaf, 0xc0, 0x5a, 0x22, 0x2d, 0x9f, 0x20, 0x05, 0xe5, 0x0d,
-	0x28, 0x8c, 0xed, 0xcd, 0x0c, 0xac, 0xc3, 0x5a, 0x85, 0x1c, 0x11, 0x97, 0x28, 0xde, 0xdd, 0x92,
-	0xf4, 0x46, 0x83, 0xb5, 0x01, 0x85, 0xb1, 0x08, 0x9f, 0xe4, 0xd6, 0xfb, 0x73, 0x00, 0xd1, 0xb1,
-	0x80, 0x72, 0x30, 0xd3, 0x6e, 0xbc, 0xd6, 0x68, 0xde, 0x6b, 0x88, 0x17, 0xd0, 0x15, 0x58, 0x29,
-	0xd7, 0xda, 0x9a, 0xae, 0x60, 0xa3, 0xde, 0xac, 0xa8, 0xbb, 0xf7, 0x8d, 0x92, 0xda, 0xa8, 0xa8,
-	0x8d, 0xaa, 0x26, 0xf6, 0x50, 0x1e, 0x16, 0x03, 0x65, 0x55, 0xd1, 0x23, 0x8d, 0x77, 0x8d, 0x5f,
-	0x0a, 0x34, 0xc5, 0xb6, 0x7e, 0xc7, 0x28, 0x96, 0x75, 0xf5, 0x6e, 0x51, 0x57, 0xc4, 0x7d, 0x9e,
-	0x91, 0xaa, 0x2a, 0x4a, 0xa8, 0x3c, 0x18, 0x51, 0x7a, 0xb4, 0xe5, 0x66, 0x63, 0x57, 0xad, 0x8a,
-	0x87, 0x23, 0x4a, 0x2d, 0x52, 0x9a, 0x68, 0x03, 0xae, 0x8e, 0xf4, 0xc4, 0xcd, 0x52, 0x53, 0x37,
-	0xf4, 0xe6, 0x6b, 0x4a, 0x43, 0xfc, 0xb1, 0x80, 0xae, 0xc3, 0x46, 0x0c, 0xc2, 0x06, 0x54, 0xc5,
-	0xcd, 0x76, 0xcb, 0xa8, 0x2b, 0xf5, 0x92, 0x82, 0x35, 0xf1, 0x38, 0xd5, 0x07, 0x8a, 0xd1, 0xc4,
-	0x3e, 0x5a, 0x4f, 0x31, 0xe3, 0x13, 0xb4, 0x35, 0xaf, 0xbb, 0x85, 0x0a, 0x70, 0x25, 0x86, 0x50,
-	0x5e, 0xd7, 0x71, 0xb1, 0xcc, 0xdc, 0xd0, 0xc4, 0x01, 0x5a, 0x03, 0x29, 0x06, 0xc0, 0x8a, 0xa6,
-	0x37, 0xb1, 0xc2, 0xfc, 0x7c, 0x03, 0x6d, 0xc3, 0xad, 0x11, 0x13, 0x2d, 0x05, 0xd7, 0x55, 0x4d,
-	0x53, 0x9b, 0x0d, 0xcd, 0xd8, 0x6d, 0x62, 0xa3, 0x85, 0xd5, 0x46, 0x59, 0x6d, 0x15, 0x6b, 0xe2,
-	0x4f, 0x04, 0x74, 0x03, 0xe4, 0x44, 0x44, 0x6b, 0x8a, 0xae, 0x18, 0xca, 0xeb, 0x2d, 0x15, 0x2b,
-	0x95, 0xc0, 0xf0, 0x3b, 0x02, 0x7a, 0x1a, 0x0a, 0x09, 0xcb, 0x77, 0x9b, 0xaf, 0x29, 0xd4, 0xf3,
-	0x00, 0xf5, 0x53, 0x01, 0x3d, 0x05, 0x6b, 0x71, 0x54, 0x53, 0x2f, 0xea, 0x8a, 0x81, 0x9b, 0x61,
-	0x2c, 0x7f, 0x29, 0xf0, 0xa3, 0x54, 0x1a, 0xba, 0x82, 0x5b, 0x58, 0xd5, 0x94, 0x68, 0x9a, 0x6d,
-	0x3e, 0x50, 0x1c, 0xe0, 0x8e, 0x52, 0xc4, 0x7a, 0x49, 0x29, 0xea, 0xa2, 0x33, 0x86, 0xc2, 0x9f,
-	0xf1, 0x8a, 0x22, 0xba, 0x68, 0x03, 0xae, 0xa5, 0x00, 0xb8, 0x7c, 0x19, 0xf2, 0x1c, 0x6a, 0x45,
-	0x69, 0xe8, 0xaa, 0x7e, 0x9f, 0x4f, 0x8b, 0x93, 0x54, 0x00, 0x97, 0x54, 0x5f, 0x49, 0x05, 0x94,
-	0xb1, 0xe2, 0x8d, 0x58, 0xad, 0xb4, 0xc4, 0x87, 0xa9, 0x80, 0x76, 0xab, 0x12, 0x00, 0x4e, 0xf9,
-	0xf9, 0x0c, 0x01, 0x35, 0x55, 0xd3, 0x3d, 0xb5, 0x26, 0xbe, 0x89, 0xae, 0x42, 0x3e, 0xd5, 0x05,
-	0xaf, 0xf7, 0x57, 0x53, 0xe9, 0xd9, 0x04, 0x7a, 0x80, 0xaf, 0xa1, 0x1b, 0xf0, 0xd4, 0x38, 0x07,
-	0xbd, 0x03, 0xdf, 0x28, 0xd7, 0x54, 0xa5, 0xa1, 0x8b, 0x6f, 0xa5, 0x02, 0x99, 0xa3, 0x3c, 0xf0,
-	0xeb, 0xe8, 0xff, 0xa2, 0x7c, 0x89, 0x3b, 0xcc, 0xc1, 0x34, 0xf1, 0x1b, 0xe8, 0x3a, 0xac, 0xa7,
-	0x3a, 0xce, 0xb3, 0x7d, 0x53, 0x40, 0x9b, 0x29, 0x76, 0xd9, 0x08, 0x78, 0xe4, 0xdb, 0x02, 0x5a,
-	0x01, 0x14, 0x20, 0x2b, 0x4a, 0xa9, 0x5d, 0x35, 0x2a, 0xed, 0x7a, 0x4b, 0xfc, 0xb6, 0x80, 0xae,
-	0x45, 0x21, 0xaa, 0xa9, 0x65, 0xa5, 0xc1, 0xa7, 0xd2, 0x77, 0x52, 0xd5, 0x61, 0x9a, 0x7c, 0x57,
-	0x40, 0xeb, 0x51, 0x08, 0xc3, 0xde, 0x95, 0x8a, 0xc1, 0x64, 0xe2, 0xf7, 0x62, 0x29, 0x1d, 0x20,
-	0x58, 0x64, 0x02, 0xd0, 0xf7, 0x53, 0x41, 0x6c, 0x18, 0x01, 0xe8, 0x07, 0x02, 0x92, 0xa3, 0x9c,
-	0x0c, 0x40, 0x34, 0x74, 0x4c, 0xa8, 0x89, 0x3f, 0x14, 0x90, 0x14, 0x6d, 0x7e, 0x6c, 0xa2, 0x34,
-	0xa5, 0x8c, 0x15, 0x5d, 0xfc, 0x99, 0x80, 0x56, 0xa3, 0x2d, 0x93, 0xf6, 0xf3, 0x35, 0x9a, 0xf8,
-	0xae, 0x80, 0x10, 0x5c, 0xf2, 0x5b, 0xcc, 0xac, 0xf8, 0x73, 0x01, 0x2d, 0xc0, 0x1c, 0x93, 0xa9,
-	0x0d, 0xad, 0xa5, 0x94, 0x75, 0xf1, 0x17, 0x89, 0x30, 0x52, 0x07, 0x8b, 0xb5, 0x9a, 0xf8, 0x23,
-	0x01, 0xcd, 0x41, 0x16, 0x2b, 0xad, 0xa6, 0x81, 0x95, 0x62, 0x45, 0x7c, 0x4f, 0x40, 0xf3, 0x00,
-	0xb4, 0x7d, 0x0f, 0xab, 0xba, 0x22, 0xfe, 0x9e, 0x5a, 0xa7, 0x82, 0xe4, 0x56, 0xfe, 0x07, 0x01,
-	0x89, 0x90, 0xa3, 0x2a, 0x66, 0xfb, 0x8f, 0x02, 0xca, 0xc3, 0x02, 0x95, 0x30, 0xcb, 0x46, 0xb9,
-	0x59, 0xaf, 0xab, 0xba, 0xf8, 0xbe, 0x80, 0x96, 0x40, 0xa4, 0x1a, 0x7f, 0xe4, 0xbe, 0xf8, 0x4f,
-	0xd4, 0x2f, 0x8e, 0x22, 0x50, 0xfc, 0x39, 0x52, 0xb0, 0x68, 0x94, 0x70, 0xb1, 0x51, 0xbe, 0x23,
-	0xfe, 0x25, 0x41, 0xc4, 0xc4, 0x1f, 0x8c, 0x10, 0x31, 0xc5, 0x5f, 0x05, 0xb4, 0x0c, 0x97, 0x63,
-	0x2e, 0xed, 0xaa, 0x35, 0x45, 0xfc, 0x1b, 0x0d, 0x53, 0xc4, 0x43, 0x85, 0x7f, 0xa7, 0x59, 0x43,
-	0x85, 0x5e, 0x2e, 0xb4, 0xd4, 0x96, 0x52, 0x53, 0x1b, 0x0a, 0x0d, 0x8d, 0x82, 0xc5, 0x7f, 0xd0,
-	0xac, 0x61, 0xc1, 0xaa, 0x37, 0xef, 0x2a, 0x23, 0x88, 0x7f, 0x8e, 0x21, 0xa0, 0xb1, 0xc4, 0xe2,
-	0xbf, 0xa8, 0x33, 0xa1, 0x94, 0x1a, 0x7e, 0xb5, 0x59, 0x12, 0x7f, 0x33, 0x71, 0xeb, 0x73, 0x30,
-	0xcb, 0xbf, 0x56, 0x78, 0x67, 0x21, 0x56, 0xb4, 0x66, 0x1b, 0x97, 0x15, 0x43, 0xbf, 0xdf, 0x52,
-	0x8c, 0xe8, 0x74, 0xcd, 0xc1, 0x4c, 0x90, 0x5b, 0x02, 0xca, 0xc0, 0x45, 0xcf, 0x9c, 0x38, 0xb1,
-	0xf3, 0x8e, 0x08, 0x93, 0xc5, 0x96, 0x8a, 0x8a, 0x90, 0x09, 0xde, 0xb7, 0x51, 0x3e, 0xbc, 0x81,
-	0x24, 0x1e, 0xc9, 0xa5, 0xd5, 0x14, 0x0d, 0xbb, 0x1e, 0x5c, 0x40, 0x55, 0x80, 0xe8, 0x69, 0x1b,
-	0x49, 0x21, 0x74, 0xe4, 0x11, 0x5c, 0xba, 0x92, 0xaa, 0x0b, 0x89, 0xee, 0xd3, 0x2b, 0x5c, 0xec,
-	0xb9, 0x12, 0xad, 0x47, 0xcf, 0x19, 0xe9, 0xef, 0xa3, 0xd2, 0xc6, 0x39, 0x08, 0x9e, 0x5a, 0x1b,
-	0x4f, 0xad, 0x7d, 0x28, 0xb5, 0x36, 0x9e, 0xba, 0x0e, 0xb3, 0xfc, 0x9b, 0x21, 0xba, 0x1a, 0xc5,
-	0x6a, 0xf4, 0xa9, 0x52, 0xba, 0x36, 0x46, 0x1b, 0xd2, 0x55, 0x20, 0x1b, 0x3e, 0x72, 0xa0, 0xd5,
-	0x18, 0x9a, 0x7f, 0x73, 0x91, 0xa4, 0x34, 0x55, 0xc8, 0xa2, 0xc1, 0x5c, 0xbc, 0x76, 0x47, 0x6b,
-	0x7c, 0x98, 0x46, 0x9f, 0x23, 0xa4, 0xc2, 0x58, 0x7d, 0x48, 0xfa, 0x00, 0xa4, 0xf1, 0x4f, 0x10,
-	0xe8, 0xd6, 0x18, 0x82, 0x94, 0x02, 0xe1, 0x71, 0x8c, 0xbd, 0x0c, 0xd3, 0xfe, 0x0b, 0x2a, 0x5a,
-	0x0e, 0xc1, 0xb1, 0x47, 0x56, 0x69, 0x65, 0x44, 0x1e, 0x76, 0xfe, 0x02, 0x5c, 0x1e, 0x29, 0xea,
-	0x51, 0x34, 0x9b, 0xe3, 0xde, 0x1b, 0x24, 0xf9, 0x3c, 0x48, 0x22, 0xb8, 0x3c, 0x75, 0x2c, 0xb8,
-	0x29, 0xbc, 0x85, 0xb1, 0x7a, 0x3e, 0x8d, 0xf8, 0xfa, 0x9a, 0x4b, 0xa3, 0x94, 0x6a, 0x9c, 0x4b,
-	0xa3, 0xb4, 0xa2, 0x5c, 0xbe, 0x80, 0x5a, 0x70, 0x29, 0x56, 0x0c, 0xa3, 0x6b, 0x71, 0x17, 0x12,
-	0xd5, 0xb6, 0xb4, 0x36, 0x4e, 0x1d, 0x32, 0xde, 0x85, 0xf9, 0x44, 0xa9, 0x80, 0x0a, 0xdc, 0x9b,
-	0x47, 0x5a, 0x25, 0x2d, 0xad, 0x8f, 0x07, 0x84, 0xbc, 0xfd, 0x91, 0xba, 0x3a, 0x28, 0x41, 0xd0,
-	0x8d, 0x71, 0xdd, 0x13, 0x25, 0x8e, 0xb4, 0xf9, 0xe1, 0xc0, 0xc4, 0x56, 0x10, 0xab, 0xae, 0xe3,
-	0x5b, 0x41, 0x5a, 0x1d, 0x1f, 0xdf, 0x0a, 0xd2, 0x4b, 0x73, 0x1a, 0xf4, 0x58, 0x11, 0xcd, 0x05,
-	0x3d, 0xad, 0x68, 0xe7, 0x82, 0x9e, 0x5e, 0x7b, 0xd3, 0xdd, 0x20, 0xac, 0x95, 0xb9, 0xdd, 0x20,
-	0x59, 0x91, 0x73, 0xbb, 0xc1, 0x48, 0x69, 0x4d, 0x97, 0xc3, 0x52, 0x6a, 0xbd, 0x8e, 0xae, 0x8f,
-	0x76, 0x4b, 0x5b, 0xae, 0xe7, 0xb3, 0x17, 0x21, 0x13, 0x54, 0xde, 0xdc, 0x11, 0x92, 0xa8, 0xda,
-	0xa5, 0xd5, 0x14, 0x0d, 0xbf, 0x5e, 0x47, 0xca, 0x6d, 0x6e, 0xbd, 0x8e, 0x2b, 0xd3, 0xb9, 0xf5,
-	0x3a, 0xb6, 0x5a, 0xf7, 0x67, 0x3c, 0x59, 0x3e, 0x23, 0x3e, 0x33, 0x53, 0xcb, 0x73, 0x6e, 0xc6,
-	0xc7, 0xd6, 0xde, 0x34, 0x79, 0xc7, 0x94, 0xbe, 0x5c, 0xf2, 0x9e, 0x5f, 0x3e, 0x73, 0xc9, 0xfb,
-	0x21, 0x55, 0x34, 0x5b, 0x84, 0xf1, 0xdf, 0x7d, 0xf9, 0x45, 0x98, 0xfa, 0x53, 0x32, 0xbf, 0x08,
-	0xd3, 0x7f, 0x32, 0x96, 0x2f, 0x94, 0x6e, 0xbf, 0x77, 0xb6, 0x26, 0x7c, 0x70, 0xb6, 0x26, 0xfc,
-	0xe7, 0x6c, 0x4d, 0xf8, 0xfc, 0xad, 0x03, 0xd3, 0x3d, 0x1c, 0xee, 0x6d, 0x75, 0xad, 0xe3, 0xed,
-	0x41, 0xa7, 0x7b, 0x78, 0xda, 0x23, 0x36, 0xff, 0x75, 0xb2, 0xb3, 0xed, 0xd8, 0x5d, 0xfa, 0xc3,
-	0xfc, 0xde, 0x34, 0xfd, 0x7d, 0xea, 0xf9, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xee, 0x8c, 0x7e,
-	0x47, 0xac, 0x1f, 0x00, 0x00,
+func (m *ListAuthTokensRequest) Reset()         { *m = ListAuthTokensRequest{} }
+func (m *ListAuthTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAuthTokensRequest) ProtoMessage()    {}
+func (*ListAuthTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{55}
+}
+func (m *ListAuthTokensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAuthTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAuthTokensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListAuthTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuthTokensRequest.Merge(m, src)
+}
+func (m *ListAuthTokensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAuthTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuthTokensRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_ListAuthTokensRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *ListAuthTokensRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
 
-// APIClient is the client API for API service.
-//
+type ListAuthTokensResponse struct {
+	Tokens               []*TokenInfo `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListAuthTokensResponse) Reset()         { *m = ListAuthTokensResponse{} }
+func (m *ListAuthTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAuthTokensResponse) ProtoMessage()    {}
+func (*ListAuthTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{56}
+}
+func (m *ListAuthTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAuthTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAuthTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListAuthTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuthTokensResponse.Merge(m, src)
+}
+func (m *ListAuthTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAuthTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuthTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuthTokensResponse proto.InternalMessageInfo
+
+func (m *ListAuthTokensResponse) GetTokens() []*TokenInfo {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+type InspectAuthTokenRequest struct {
+	HashedToken          string   `protobuf:"bytes,1,opt,name=hashed_token,json=hashedToken,proto3" json:"hashed_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InspectAuthTokenRequest) Reset()         { *m = InspectAuthTokenRequest{} }
+func (m *InspectAuthTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*InspectAuthTokenRequest) ProtoMessage()    {}
+func (*InspectAuthTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{57}
+}
+func (m *InspectAuthTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InspectAuthTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InspectAuthTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InspectAuthTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectAuthTokenRequest.Merge(m, src)
+}
+func (m *InspectAuthTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InspectAuthTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectAuthTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InspectAuthTokenRequest proto.InternalMessageInfo
+
+func (m *InspectAuthTokenRequest) GetHashedToken() string {
+	if m != nil {
+		return m.HashedToken
+	}
+	return ""
+}
+
+type InspectAuthTokenResponse struct {
+	Token                *TokenInfo `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *InspectAuthTokenResponse) Reset()         { *m = InspectAuthTokenResponse{} }
+func (m *InspectAuthTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*InspectAuthTokenResponse) ProtoMessage()    {}
+func (*InspectAuthTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{58}
+}
+func (m *InspectAuthTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InspectAuthTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InspectAuthTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InspectAuthTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectAuthTokenResponse.Merge(m, src)
+}
+func (m *InspectAuthTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *InspectAuthTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectAuthTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InspectAuthTokenResponse proto.InternalMessageInfo
+
+func (m *InspectAuthTokenResponse) GetToken() *TokenInfo {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+// RevokeAuthTokenByHash revokes a token given its hash, as returned by
+// ListAuthTokens, rather than the token itself.
+type RevokeAuthTokenByHashRequest struct {
+	HashedToken          string   `protobuf:"bytes,1,opt,name=hashed_token,json=hashedToken,proto3" json:"hashed_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAuthTokenByHashRequest) Reset()         { *m = RevokeAuthTokenByHashRequest{} }
+func (m *RevokeAuthTokenByHashRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeAuthTokenByHashRequest) ProtoMessage()    {}
+func (*RevokeAuthTokenByHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{59}
+}
+func (m *RevokeAuthTokenByHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokeAuthTokenByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeAuthTokenByHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevokeAuthTokenByHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAuthTokenByHashRequest.Merge(m, src)
+}
+func (m *RevokeAuthTokenByHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokeAuthTokenByHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAuthTokenByHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAuthTokenByHashRequest proto.InternalMessageInfo
+
+func (m *RevokeAuthTokenByHashRequest) GetHashedToken() string {
+	if m != nil {
+		return m.HashedToken
+	}
+	return ""
+}
+
+type RevokeAuthTokenByHashResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAuthTokenByHashResponse) Reset()         { *m = RevokeAuthTokenByHashResponse{} }
+func (m *RevokeAuthTokenByHashResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeAuthTokenByHashResponse) ProtoMessage()    {}
+func (*RevokeAuthTokenByHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ec48c1eaf43a2, []int{60}
+}
+func (m *RevokeAuthTokenByHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokeAuthTokenByHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeAuthTokenByHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevokeAuthTokenByHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAuthTokenByHashResponse.Merge(m, src)
+}
+func (m *RevokeAuthTokenByHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokeAuthTokenByHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAuthTokenByHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAuthTokenByHashResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("auth_v2.Permission", Permission_name, Permission_value)
+	proto.RegisterEnum("auth_v2.ResourceType", ResourceType_name, ResourceType_value)
+	proto.RegisterType((*ActivateRequest)(nil), "auth_v2.ActivateRequest")
+	proto.RegisterType((*ActivateResponse)(nil), "auth_v2.ActivateResponse")
+	proto.RegisterType((*DeactivateRequest)(nil), "auth_v2.DeactivateRequest")
+	proto.RegisterType((*DeactivateResponse)(nil), "auth_v2.DeactivateResponse")
+	proto.RegisterType((*RotateRootTokenRequest)(nil), "auth_v2.RotateRootTokenRequest")
+	proto.RegisterType((*RotateRootTokenResponse)(nil), "auth_v2.RotateRootTokenResponse")
+	proto.RegisterType((*OIDCConfig)(nil), "auth_v2.OIDCConfig")
+	proto.RegisterType((*GetConfigurationRequest)(nil), "auth_v2.GetConfigurationRequest")
+	proto.RegisterType((*GetConfigurationResponse)(nil), "auth_v2.GetConfigurationResponse")
+	proto.RegisterType((*SetConfigurationRequest)(nil), "auth_v2.SetConfigurationRequest")
+	proto.RegisterType((*SetConfigurationResponse)(nil), "auth_v2.SetConfigurationResponse")
+	proto.RegisterType((*TokenInfo)(nil), "auth_v2.TokenInfo")
+	proto.RegisterType((*TokenScope)(nil), "auth_v2.TokenScope")
+	proto.RegisterType((*AuthenticateRequest)(nil), "auth_v2.AuthenticateRequest")
+	proto.RegisterType((*AuthenticateResponse)(nil), "auth_v2.AuthenticateResponse")
+	proto.RegisterType((*WhoAmIRequest)(nil), "auth_v2.WhoAmIRequest")
+	proto.RegisterType((*WhoAmIResponse)(nil), "auth_v2.WhoAmIResponse")
+	proto.RegisterType((*Roles)(nil), "auth_v2.Roles")
+	proto.RegisterMapType((map[string]bool)(nil), "auth_v2.Roles.RolesEntry")
+	proto.RegisterType((*RoleBinding)(nil), "auth_v2.RoleBinding")
+	proto.RegisterMapType((map[string]*Roles)(nil), "auth_v2.RoleBinding.EntriesEntry")
+	proto.RegisterType((*Resource)(nil), "auth_v2.Resource")
+	proto.RegisterType((*Users)(nil), "auth_v2.Users")
+	proto.RegisterMapType((map[string]bool)(nil), "auth_v2.Users.UsernamesEntry")
+	proto.RegisterType((*Groups)(nil), "auth_v2.Groups")
+	proto.RegisterMapType((map[string]bool)(nil), "auth_v2.Groups.GroupsEntry")
+	proto.RegisterType((*AuthorizeRequest)(nil), "auth_v2.AuthorizeRequest")
+	proto.RegisterType((*AuthorizeResponse)(nil), "auth_v2.AuthorizeResponse")
+	proto.RegisterType((*GetPermissionsRequest)(nil), "auth_v2.GetPermissionsRequest")
+	proto.RegisterType((*GetPermissionsForPrincipalRequest)(nil), "auth_v2.GetPermissionsForPrincipalRequest")
+	proto.RegisterType((*GetPermissionsResponse)(nil), "auth_v2.GetPermissionsResponse")
+	proto.RegisterType((*ModifyRoleBindingRequest)(nil), "auth_v2.ModifyRoleBindingRequest")
+	proto.RegisterType((*ModifyRoleBindingResponse)(nil), "auth_v2.ModifyRoleBindingResponse")
+	proto.RegisterType((*GetRoleBindingRequest)(nil), "auth_v2.GetRoleBindingRequest")
+	proto.RegisterType((*GetRoleBindingResponse)(nil), "auth_v2.GetRoleBindingResponse")
+	proto.RegisterType((*SessionInfo)(nil), "auth_v2.SessionInfo")
+	proto.RegisterType((*GetOIDCLoginRequest)(nil), "auth_v2.GetOIDCLoginRequest")
+	proto.RegisterType((*GetOIDCLoginResponse)(nil), "auth_v2.GetOIDCLoginResponse")
+	proto.RegisterType((*GetRobotTokenRequest)(nil), "auth_v2.GetRobotTokenRequest")
+	proto.RegisterType((*GetRobotTokenResponse)(nil), "auth_v2.GetRobotTokenResponse")
+	proto.RegisterType((*RevokeAuthTokenRequest)(nil), "auth_v2.RevokeAuthTokenRequest")
+	proto.RegisterType((*RevokeAuthTokenResponse)(nil), "auth_v2.RevokeAuthTokenResponse")
+	proto.RegisterType((*SetGroupsForUserRequest)(nil), "auth_v2.SetGroupsForUserRequest")
+	proto.RegisterType((*SetGroupsForUserResponse)(nil), "auth_v2.SetGroupsForUserResponse")
+	proto.RegisterType((*ModifyMembersRequest)(nil), "auth_v2.ModifyMembersRequest")
+	proto.RegisterType((*ModifyMembersResponse)(nil), "auth_v2.ModifyMembersResponse")
+	proto.RegisterType((*GetGroupsRequest)(nil), "auth_v2.GetGroupsRequest")
+	proto.RegisterType((*GetGroupsForPrincipalRequest)(nil), "auth_v2.GetGroupsForPrincipalRequest")
+	proto.RegisterType((*GetGroupsResponse)(nil), "auth_v2.GetGroupsResponse")
+	proto.RegisterType((*GetUsersRequest)(nil), "auth_v2.GetUsersRequest")
+	proto.RegisterType((*GetUsersResponse)(nil), "auth_v2.GetUsersResponse")
+	proto.RegisterType((*ExtractAuthTokensRequest)(nil), "auth_v2.ExtractAuthTokensRequest")
+	proto.RegisterType((*ExtractAuthTokensResponse)(nil), "auth_v2.ExtractAuthTokensResponse")
+	proto.RegisterType((*RestoreAuthTokenRequest)(nil), "auth_v2.RestoreAuthTokenRequest")
+	proto.RegisterType((*RestoreAuthTokenResponse)(nil), "auth_v2.RestoreAuthTokenResponse")
+	proto.RegisterType((*RevokeAuthTokensForUserRequest)(nil), "auth_v2.RevokeAuthTokensForUserRequest")
+	proto.RegisterType((*RevokeAuthTokensForUserResponse)(nil), "auth_v2.RevokeAuthTokensForUserResponse")
+	proto.RegisterType((*DeleteExpiredAuthTokensRequest)(nil), "auth_v2.DeleteExpiredAuthTokensRequest")
+	proto.RegisterType((*DeleteExpiredAuthTokensResponse)(nil), "auth_v2.DeleteExpiredAuthTokensResponse")
+	proto.RegisterType((*ListAuthTokensRequest)(nil), "auth_v2.ListAuthTokensRequest")
+	proto.RegisterType((*ListAuthTokensResponse)(nil), "auth_v2.ListAuthTokensResponse")
+	proto.RegisterType((*InspectAuthTokenRequest)(nil), "auth_v2.InspectAuthTokenRequest")
+	proto.RegisterType((*InspectAuthTokenResponse)(nil), "auth_v2.InspectAuthTokenResponse")
+	proto.RegisterType((*RevokeAuthTokenByHashRequest)(nil), "auth_v2.RevokeAuthTokenByHashRequest")
+	proto.RegisterType((*RevokeAuthTokenByHashResponse)(nil), "auth_v2.RevokeAuthTokenByHashResponse")
+}
+
+func init() { proto.RegisterFile("auth/auth.proto", fileDescriptor_712ec48c1eaf43a2) }
+
+var fileDescriptor_712ec48c1eaf43a2 = []byte{
+	// 2943 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x59, 0x77, 0xdb, 0xd6,
+	0xb5, 0x0e, 0x24, 0xdb, 0x22, 0xb7, 0x6c, 0x09, 0x3e, 0xd6, 0x40, 0xc1, 0x92, 0x28, 0xc1, 0x71,
+	0x3c, 0xe4, 0x46, 0x4a, 0x9c, 0x9b, 0x7b, 0x9d, 0xc4, 0x77, 0xdd, 0x72, 0x80, 0x69, 0x24, 0x14,
+	0xc9, 0x75, 0x00, 0xda, 0x71, 0x57, 0x57, 0x51, 0x8a, 0x3c, 0x96, 0x50, 0x4b, 0x04, 0x03, 0x80,
+	0xaa, 0x95, 0x36, 0x9d, 0x87, 0x74, 0x4e, 0xa7, 0x74, 0x78, 0xeb, 0x4b, 0xdf, 0xda, 0x97, 0xfe,
+	0x89, 0x74, 0x4e, 0xe7, 0x3e, 0x39, 0x5d, 0x5e, 0xfd, 0x05, 0xfd, 0x05, 0x5d, 0x38, 0x38, 0x00,
+	0x0e, 0x40, 0x80, 0x96, 0x93, 0x95, 0x17, 0x09, 0x67, 0xef, 0x6f, 0x0f, 0x67, 0xef, 0x7d, 0x06,
+	0x6c, 0x10, 0x66, 0x3b, 0x43, 0x77, 0x77, 0xd3, 0xfb, 0xb3, 0x31, 0xb0, 0x2d, 0xd7, 0x42, 0x53,
+	0xde, 0xb3, 0x71, 0x70, 0x45, 0x9a, 0xdb, 0xb1, 0x76, 0x2c, 0x4a, 0xdb, 0xf4, 0x9e, 0x7c, 0xb6,
+	0x54, 0xdc, 0xb1, 0xac, 0x9d, 0x3d, 0xb2, 0x49, 0x47, 0xdb, 0xc3, 0x3b, 0x9b, 0xae, 0xb9, 0x4f,
+	0x1c, 0xb7, 0xb3, 0x3f, 0xf0, 0x01, 0xf2, 0xd3, 0x30, 0x5b, 0xea, 0xba, 0xe6, 0x41, 0xc7, 0x25,
+	0x98, 0xbc, 0x3a, 0x24, 0x8e, 0x8b, 0x56, 0x00, 0x6c, 0xcb, 0x72, 0x0d, 0xd7, 0xba, 0x4b, 0xfa,
+	0x05, 0x61, 0x4d, 0xb8, 0x98, 0xc7, 0x79, 0x8f, 0xa2, 0x7b, 0x04, 0xf9, 0x19, 0x10, 0x23, 0x09,
+	0x67, 0x60, 0xf5, 0x1d, 0xe2, 0x89, 0x0c, 0x3a, 0xdd, 0xdd, 0xb8, 0x88, 0x47, 0xf1, 0x45, 0xce,
+	0xc0, 0xe9, 0x2a, 0xe9, 0xc4, 0xcd, 0xc8, 0x73, 0x80, 0x78, 0xa2, 0xaf, 0x49, 0xfe, 0x5f, 0x58,
+	0xc0, 0x96, 0xeb, 0x51, 0x02, 0x83, 0x47, 0x74, 0xeb, 0x2a, 0x2c, 0x8e, 0x08, 0x46, 0xde, 0x8d,
+	0x93, 0xfc, 0xe9, 0x04, 0x40, 0x53, 0xad, 0x56, 0x2a, 0x56, 0xff, 0x8e, 0xb9, 0x83, 0x16, 0xe0,
+	0x84, 0xe9, 0x38, 0x43, 0x62, 0x33, 0x24, 0x1b, 0xa1, 0x4b, 0x90, 0xef, 0xee, 0x99, 0xa4, 0xef,
+	0x1a, 0x66, 0xaf, 0x30, 0xe1, 0xb1, 0xca, 0x27, 0x1f, 0xdc, 0x2f, 0xe6, 0x2a, 0x94, 0xa8, 0x56,
+	0x71, 0xce, 0x67, 0xab, 0x3d, 0x74, 0x0e, 0x4e, 0x31, 0xa8, 0x43, 0xba, 0x36, 0x71, 0x0b, 0x93,
+	0x54, 0xd3, 0x49, 0x9f, 0xa8, 0x51, 0x1a, 0xba, 0x02, 0x27, 0x6d, 0xd2, 0x33, 0x6d, 0xd2, 0x75,
+	0x8d, 0xa1, 0x6d, 0x16, 0x8e, 0x51, 0x95, 0xb3, 0x0f, 0xee, 0x17, 0xa7, 0x31, 0xa3, 0xb7, 0xb1,
+	0x8a, 0xa7, 0x03, 0x50, 0xdb, 0x36, 0x3d, 0xdf, 0x9c, 0xae, 0x35, 0x20, 0x4e, 0xe1, 0xf8, 0xda,
+	0xa4, 0xe7, 0x9b, 0x3f, 0x42, 0xff, 0x0d, 0x0b, 0x36, 0x79, 0x75, 0x68, 0xda, 0xc4, 0x20, 0xfb,
+	0x1d, 0x73, 0xcf, 0x38, 0x20, 0xb6, 0x79, 0xc7, 0x24, 0xbd, 0xc2, 0x89, 0x35, 0xe1, 0x62, 0x0e,
+	0xcf, 0x31, 0xae, 0xe2, 0x31, 0x6f, 0x32, 0x1e, 0xba, 0x04, 0xe2, 0x9e, 0xd5, 0xed, 0xec, 0xed,
+	0x5a, 0x8e, 0x6b, 0xb0, 0x39, 0x4f, 0x51, 0xfc, 0x6c, 0x48, 0x57, 0x29, 0x59, 0x5e, 0x82, 0xc5,
+	0x1a, 0x71, 0xfd, 0x08, 0x0d, 0xed, 0x8e, 0x6b, 0x5a, 0x41, 0x5e, 0xe4, 0x36, 0x14, 0x46, 0x59,
+	0x2c, 0xf2, 0xcf, 0xc3, 0xa9, 0x2e, 0xcf, 0xa0, 0x21, 0x9d, 0xbe, 0x72, 0x66, 0x83, 0x55, 0xed,
+	0x46, 0x14, 0x77, 0x1c, 0x47, 0xca, 0x3a, 0x2c, 0x6a, 0xe9, 0x16, 0xdf, 0x8f, 0x56, 0x09, 0x0a,
+	0x5a, 0x86, 0xb3, 0xf2, 0xbb, 0x13, 0x90, 0xa7, 0x15, 0xa1, 0xf6, 0xef, 0x58, 0xa8, 0x00, 0x53,
+	0xce, 0x70, 0xfb, 0xe3, 0xa4, 0xeb, 0xb2, 0x3a, 0x08, 0x86, 0x48, 0x03, 0x20, 0xf7, 0x06, 0x26,
+	0xb3, 0x3d, 0x41, 0x6d, 0x4b, 0x1b, 0xfe, 0x42, 0xdb, 0x08, 0x16, 0xda, 0x86, 0x1e, 0x2c, 0xb4,
+	0xf2, 0xe2, 0xbf, 0xef, 0x17, 0x67, 0x7b, 0xdb, 0x2f, 0xc8, 0x91, 0x94, 0xfc, 0xe6, 0xbb, 0x45,
+	0x01, 0x73, 0x6a, 0xd0, 0xff, 0xc0, 0xc9, 0xdd, 0x8e, 0xb3, 0x4b, 0x7a, 0xac, 0x4a, 0x69, 0xc5,
+	0x94, 0xcf, 0x04, 0xa2, 0x94, 0x68, 0x78, 0x08, 0x19, 0x4f, 0xfb, 0x40, 0xea, 0x2a, 0x7a, 0x32,
+	0xac, 0x88, 0x63, 0x6b, 0x93, 0xb1, 0x20, 0x50, 0xbe, 0xe6, 0xf1, 0xc2, 0x32, 0xf9, 0x7f, 0x80,
+	0xae, 0x4d, 0x3a, 0x2e, 0xe9, 0x19, 0x1d, 0xb7, 0x70, 0xfc, 0xa1, 0x9e, 0x1f, 0xa3, 0x6e, 0xe6,
+	0x99, 0x4c, 0xc9, 0x45, 0xff, 0x07, 0xf9, 0xbd, 0x8e, 0xe3, 0x1a, 0x43, 0x87, 0x95, 0xd6, 0x51,
+	0xe4, 0x73, 0x9e, 0x48, 0xdb, 0x21, 0x3d, 0xf9, 0x0d, 0x01, 0x20, 0x72, 0x0b, 0x3d, 0x05, 0x39,
+	0x9b, 0x38, 0xd6, 0xd0, 0xee, 0x12, 0x96, 0xc2, 0xd3, 0xa1, 0xf7, 0x98, 0x31, 0x70, 0x08, 0x41,
+	0x73, 0x70, 0xdc, 0xb6, 0xf6, 0x88, 0x53, 0x98, 0xa0, 0xb5, 0xef, 0x0f, 0xd0, 0x73, 0x30, 0x3d,
+	0x20, 0xf6, 0xbe, 0xe9, 0x38, 0xa6, 0xd5, 0x77, 0x0a, 0x93, 0x6b, 0x93, 0x17, 0x67, 0xb8, 0x28,
+	0xb4, 0x42, 0x1e, 0xe6, 0x71, 0xf2, 0x47, 0xe1, 0x4c, 0x69, 0xe8, 0xee, 0x92, 0xbe, 0x6b, 0x76,
+	0xb9, 0xbd, 0xef, 0xbf, 0x00, 0x2c, 0xb3, 0xd7, 0x35, 0x1c, 0x6f, 0x27, 0xf1, 0x13, 0x5f, 0x3e,
+	0xf5, 0xe0, 0x7e, 0x31, 0xef, 0x95, 0x94, 0xe6, 0x11, 0x71, 0xde, 0x03, 0xd0, 0x47, 0xb4, 0x04,
+	0x39, 0x33, 0x48, 0xd8, 0x84, 0x5f, 0x24, 0xa6, 0x9f, 0x17, 0xf9, 0x39, 0x98, 0x8b, 0xeb, 0x3f,
+	0xda, 0x4e, 0x39, 0x0b, 0xa7, 0x6e, 0xed, 0x5a, 0xa5, 0x7d, 0x35, 0x58, 0x5d, 0xbf, 0x10, 0x60,
+	0x26, 0xa0, 0x30, 0x15, 0x12, 0xe4, 0x86, 0x0e, 0xb1, 0xfb, 0x9d, 0x7d, 0xe6, 0x21, 0x0e, 0xc7,
+	0x1f, 0x4c, 0x6d, 0x46, 0x35, 0x36, 0xf9, 0xd0, 0x1a, 0x93, 0x6d, 0x38, 0x8e, 0x69, 0x62, 0x36,
+	0x83, 0x74, 0x09, 0x54, 0x68, 0x29, 0x4a, 0xad, 0x47, 0xf5, 0xff, 0x2a, 0x7d, 0xd7, 0x3e, 0x64,
+	0x99, 0x94, 0xae, 0x02, 0x44, 0x44, 0x24, 0xc2, 0xe4, 0x5d, 0x72, 0xc8, 0x26, 0xe8, 0x3d, 0x7a,
+	0xf9, 0x3f, 0xe8, 0xec, 0x0d, 0x09, 0x9d, 0x56, 0x0e, 0xfb, 0x83, 0x17, 0x26, 0xae, 0x0a, 0xf2,
+	0x5b, 0x02, 0x4c, 0x7b, 0xa2, 0x65, 0xb3, 0xdf, 0x33, 0xfb, 0x3b, 0xe8, 0x45, 0x98, 0x22, 0x7d,
+	0xd7, 0x36, 0x43, 0xe3, 0xeb, 0x31, 0xe3, 0x0c, 0xb6, 0xa1, 0xf8, 0x18, 0xdf, 0x89, 0x40, 0x42,
+	0x7a, 0x09, 0x4e, 0xf2, 0x8c, 0x14, 0x47, 0x1e, 0xe7, 0x1d, 0x99, 0xbe, 0x32, 0x13, 0x9f, 0x19,
+	0xef, 0x98, 0x0a, 0xb9, 0xa0, 0x90, 0xd1, 0x25, 0x38, 0xe6, 0x1e, 0x0e, 0xfc, 0x94, 0xcd, 0x5c,
+	0x99, 0x1f, 0xa9, 0x74, 0xfd, 0x70, 0x40, 0x30, 0x85, 0x20, 0x04, 0xc7, 0x68, 0x76, 0xfd, 0x9a,
+	0xa2, 0xcf, 0xf2, 0xe7, 0x05, 0x38, 0xde, 0x76, 0x88, 0xed, 0xa0, 0x17, 0x21, 0x1f, 0xe4, 0x3b,
+	0x98, 0xdf, 0x4a, 0xa8, 0x8d, 0x42, 0x36, 0xda, 0x01, 0xdf, 0x9f, 0x5b, 0x84, 0x97, 0xae, 0xc1,
+	0x4c, 0x9c, 0xf9, 0x48, 0x81, 0xbe, 0x07, 0x27, 0x6a, 0xb6, 0x35, 0x1c, 0x38, 0xe8, 0x59, 0x38,
+	0xb1, 0x43, 0x9f, 0x98, 0x07, 0x67, 0x43, 0x0f, 0x7c, 0x00, 0xfb, 0xe7, 0xdb, 0x67, 0x50, 0xe9,
+	0x79, 0x98, 0xe6, 0xc8, 0x8f, 0x68, 0x59, 0xf4, 0xd6, 0x93, 0x65, 0x9b, 0xaf, 0x85, 0x8b, 0xf5,
+	0x11, 0xf7, 0x8f, 0xc4, 0x4e, 0x31, 0x71, 0xc4, 0x9d, 0xe2, 0x97, 0x02, 0x9c, 0xe6, 0x4c, 0xb3,
+	0x45, 0xb8, 0x0a, 0xd0, 0x09, 0x88, 0x3d, 0x6a, 0x3d, 0x87, 0x39, 0x0a, 0x7a, 0x06, 0xf2, 0x4e,
+	0xc7, 0x35, 0x1d, 0x7a, 0x08, 0x8f, 0x31, 0x15, 0xa1, 0xd0, 0x53, 0x30, 0x45, 0xa9, 0xfd, 0x9d,
+	0x71, 0xbb, 0x58, 0x80, 0x41, 0xcb, 0x90, 0x1f, 0xd8, 0x66, 0xbf, 0x6b, 0x0e, 0x3a, 0x7b, 0xfe,
+	0xe5, 0x01, 0x47, 0x04, 0xf9, 0x3a, 0xcc, 0xd7, 0x88, 0x1b, 0xc9, 0x39, 0xef, 0x2d, 0x68, 0xf2,
+	0x00, 0xd6, 0xe3, 0x7a, 0xae, 0x5b, 0x76, 0x2b, 0xb0, 0xf2, 0x1e, 0x13, 0x11, 0xf3, 0x7c, 0x22,
+	0xe9, 0x39, 0x81, 0x85, 0xa4, 0xe7, 0x2c, 0xe6, 0x89, 0x04, 0x0a, 0x47, 0x4b, 0x60, 0xfa, 0xb9,
+	0x21, 0xbf, 0x0e, 0x85, 0x2d, 0xab, 0x67, 0xde, 0x39, 0xe4, 0x76, 0x84, 0x0f, 0x62, 0x3e, 0x91,
+	0xf9, 0x49, 0xde, 0xfc, 0x59, 0x58, 0x4a, 0x31, 0xcf, 0x6e, 0x22, 0x7e, 0xf2, 0xde, 0xb7, 0x63,
+	0xf2, 0x0d, 0x58, 0x48, 0xea, 0x61, 0xa1, 0xdc, 0x80, 0xa9, 0x6d, 0x9f, 0xc4, 0xf4, 0xcc, 0xa5,
+	0xed, 0x90, 0x38, 0x00, 0xc9, 0x1f, 0x83, 0x69, 0x8d, 0xd0, 0x78, 0xd2, 0xcb, 0xd1, 0x1c, 0x1c,
+	0xef, 0x5b, 0xfd, 0x6e, 0x70, 0xfe, 0xf8, 0x03, 0x8f, 0x4a, 0x6f, 0x9f, 0x2c, 0x06, 0xfe, 0x00,
+	0x9d, 0x87, 0x99, 0xae, 0xd5, 0x3f, 0x20, 0xb6, 0x27, 0x6d, 0x10, 0xdb, 0xa6, 0x77, 0x9b, 0x1c,
+	0x3e, 0x15, 0x51, 0x15, 0xdb, 0x96, 0xe7, 0xe1, 0x4c, 0x8d, 0xb8, 0xde, 0x31, 0x5b, 0xb7, 0x76,
+	0xcc, 0xf0, 0x76, 0x79, 0x0b, 0xe6, 0xe2, 0x64, 0x36, 0x81, 0x4b, 0x90, 0xdf, 0xf3, 0x08, 0xc6,
+	0xd0, 0xde, 0x2b, 0x08, 0xd1, 0x6d, 0x9c, 0xa2, 0xda, 0xb8, 0x8e, 0x73, 0x94, 0xdd, 0xb6, 0x69,
+	0x02, 0xfc, 0xe3, 0x9c, 0xb9, 0x45, 0x07, 0xb2, 0x4b, 0x15, 0x63, 0x6b, 0x3b, 0xf1, 0x9a, 0x41,
+	0xd3, 0xb5, 0x6d, 0x05, 0xb7, 0x3e, 0x7f, 0x80, 0x96, 0x60, 0xd2, 0x75, 0xfd, 0x89, 0x4d, 0x96,
+	0xa7, 0x1e, 0xdc, 0x2f, 0x4e, 0xea, 0x7a, 0x1d, 0x7b, 0xb4, 0x47, 0x3b, 0x1d, 0x9f, 0x82, 0xf9,
+	0x84, 0x55, 0x36, 0x9f, 0x39, 0x38, 0xce, 0x5f, 0x09, 0xfc, 0x81, 0xbc, 0x01, 0x0b, 0x98, 0x1c,
+	0x58, 0x77, 0x89, 0xb7, 0x01, 0x25, 0xdd, 0x4c, 0xc1, 0x2f, 0xc1, 0xe2, 0x08, 0x9e, 0xd5, 0xd4,
+	0x16, 0xbd, 0x4f, 0xfb, 0xdb, 0xef, 0x75, 0xcb, 0xf6, 0x0e, 0x81, 0x40, 0xd7, 0xb8, 0x0b, 0xc5,
+	0x42, 0xb8, 0xcf, 0xfb, 0xab, 0x87, 0x8d, 0xd8, 0x45, 0x3a, 0xa1, 0x8e, 0x99, 0xba, 0x09, 0x73,
+	0x7e, 0x6d, 0x6f, 0x91, 0xfd, 0x6d, 0x62, 0x3b, 0x9c, 0xcf, 0x54, 0x3a, 0xf0, 0x99, 0x0e, 0xbc,
+	0x53, 0xa0, 0xd3, 0xeb, 0x31, 0xf5, 0xde, 0xa3, 0x67, 0xd3, 0x26, 0xfb, 0xd6, 0x01, 0x61, 0x4b,
+	0x86, 0x8d, 0xe4, 0x45, 0x98, 0x4f, 0xe8, 0x65, 0x06, 0x11, 0x88, 0xb5, 0xc0, 0x99, 0xa0, 0x70,
+	0xae, 0xc1, 0x72, 0x8d, 0x73, 0x70, 0x64, 0xcf, 0x8a, 0x2d, 0x5a, 0x21, 0xb9, 0x09, 0x3d, 0x09,
+	0xa7, 0x39, 0x8d, 0x2c, 0x47, 0x0b, 0xb1, 0x33, 0x2f, 0x8a, 0xc5, 0x05, 0x98, 0xad, 0x11, 0x97,
+	0x9e, 0xbc, 0x63, 0xa7, 0x2a, 0x3f, 0x0d, 0x62, 0x04, 0x64, 0x4a, 0x97, 0x93, 0xa7, 0x79, 0x9e,
+	0x3b, 0xae, 0xbd, 0x30, 0x2b, 0xf7, 0x5c, 0xbb, 0xd3, 0x75, 0xc3, 0x8c, 0x86, 0x33, 0xac, 0xc1,
+	0x52, 0x0a, 0x8f, 0xa9, 0xbd, 0x0c, 0x27, 0x68, 0x49, 0x04, 0xe7, 0x33, 0x8a, 0x57, 0xa5, 0xb7,
+	0x8a, 0x31, 0x43, 0xc8, 0x15, 0xaf, 0x6a, 0x1c, 0xd7, 0xb2, 0x47, 0xcb, 0xec, 0x22, 0x5f, 0x66,
+	0xe9, 0x5a, 0x58, 0xe9, 0x49, 0x50, 0x18, 0x55, 0xc2, 0xf2, 0x73, 0x0d, 0x56, 0x13, 0x65, 0xf9,
+	0x08, 0x25, 0x28, 0xaf, 0x43, 0x31, 0x53, 0x9a, 0x19, 0x58, 0x83, 0xd5, 0x2a, 0xd9, 0x23, 0x2e,
+	0x51, 0xbc, 0x5b, 0x2b, 0xe9, 0x8d, 0x06, 0x6b, 0x1d, 0x8a, 0x99, 0x08, 0xa6, 0xe4, 0x19, 0x98,
+	0xaf, 0x9b, 0xce, 0x68, 0xa0, 0xb3, 0x5f, 0x05, 0xe5, 0x2a, 0x2c, 0x24, 0x45, 0xde, 0x43, 0xfc,
+	0xaf, 0xc1, 0xa2, 0xda, 0x77, 0x06, 0x84, 0x4b, 0x64, 0x60, 0x7a, 0x3d, 0xf1, 0x5a, 0xe8, 0xdb,
+	0xe7, 0xdf, 0x00, 0xe5, 0x2a, 0x14, 0x46, 0xa5, 0x99, 0x17, 0x47, 0x4f, 0x5f, 0x09, 0x96, 0x13,
+	0x41, 0x2e, 0x1f, 0xde, 0xe8, 0x38, 0xbb, 0x8f, 0xe0, 0x48, 0x11, 0x56, 0x32, 0x54, 0xf8, 0xde,
+	0x5c, 0xfe, 0x97, 0x08, 0x10, 0x1d, 0xd2, 0x68, 0x1a, 0xa6, 0xda, 0x8d, 0x97, 0x1b, 0xcd, 0x5b,
+	0x0d, 0xf1, 0x31, 0x74, 0x16, 0x16, 0x2b, 0xf5, 0xb6, 0xa6, 0x2b, 0xd8, 0xd8, 0x6a, 0x56, 0xd5,
+	0xeb, 0xb7, 0x8d, 0xb2, 0xda, 0xa8, 0xaa, 0x8d, 0x9a, 0x26, 0xf6, 0x50, 0x01, 0xe6, 0x02, 0x66,
+	0x4d, 0xd1, 0x23, 0x8e, 0xf7, 0x06, 0x36, 0x1f, 0x70, 0x4a, 0x6d, 0xfd, 0x86, 0x51, 0xaa, 0xe8,
+	0xea, 0xcd, 0x92, 0xae, 0x88, 0x77, 0x78, 0x8d, 0x94, 0x55, 0x55, 0x42, 0xe6, 0xce, 0x08, 0xd3,
+	0x53, 0x5b, 0x69, 0x36, 0xae, 0xab, 0x35, 0x71, 0x77, 0x84, 0xa9, 0x45, 0x4c, 0x13, 0xad, 0xc3,
+	0xf2, 0x88, 0x24, 0x6e, 0x96, 0x9b, 0xba, 0xa1, 0x37, 0x5f, 0x56, 0x1a, 0xe2, 0xd7, 0x05, 0x74,
+	0x1e, 0xd6, 0x63, 0x10, 0x36, 0xa1, 0x1a, 0x6e, 0xb6, 0x5b, 0xc6, 0x96, 0xb2, 0x55, 0x56, 0xb0,
+	0x26, 0xee, 0xa7, 0xfa, 0x40, 0x31, 0x9a, 0xd8, 0x47, 0x6b, 0xb0, 0x9c, 0xce, 0x34, 0xda, 0x9a,
+	0x27, 0x6e, 0xa1, 0x22, 0x9c, 0x8d, 0x21, 0x94, 0x57, 0x74, 0x5c, 0xaa, 0x30, 0x37, 0x34, 0x71,
+	0x80, 0x56, 0x41, 0x8a, 0x01, 0xb0, 0xa2, 0xe9, 0x4d, 0xac, 0x30, 0x3f, 0x5f, 0x45, 0x9b, 0x70,
+	0x79, 0xc4, 0x44, 0x4b, 0xc1, 0x5b, 0xaa, 0xa6, 0xa9, 0xcd, 0x86, 0x66, 0x5c, 0x6f, 0x62, 0xa3,
+	0x85, 0xd5, 0x46, 0x45, 0x6d, 0x95, 0xea, 0xe2, 0x37, 0x05, 0x74, 0x01, 0xe4, 0x44, 0x44, 0xeb,
+	0x8a, 0xae, 0x18, 0xca, 0x2b, 0x2d, 0x15, 0x2b, 0xd5, 0xc0, 0xf0, 0x37, 0x04, 0xf4, 0x38, 0x14,
+	0x13, 0x96, 0x6f, 0x36, 0x5f, 0x56, 0xa8, 0xe7, 0x01, 0xea, 0x5b, 0x02, 0x3a, 0x07, 0xab, 0x71,
+	0x54, 0x53, 0x2f, 0xe9, 0x8a, 0x81, 0x9b, 0x61, 0x2c, 0xbf, 0x27, 0xa0, 0x15, 0x28, 0xc4, 0x40,
+	0x75, 0x55, 0x0b, 0xa7, 0xf8, 0x7d, 0x01, 0xad, 0xc2, 0x52, 0x9a, 0x25, 0x5f, 0xfc, 0x07, 0x71,
+	0x1b, 0xd5, 0x2d, 0xb5, 0x61, 0x6c, 0xa9, 0x35, 0x5c, 0xd2, 0xd5, 0x66, 0xc3, 0xd0, 0xf4, 0x92,
+	0xde, 0xd6, 0xc4, 0xb7, 0x84, 0x58, 0x4a, 0x29, 0xe8, 0xa6, 0x82, 0xbd, 0x84, 0x61, 0xa5, 0x55,
+	0x57, 0x2b, 0x25, 0xf1, 0x27, 0x02, 0x1f, 0x6c, 0xa5, 0xa1, 0x2b, 0xb8, 0x85, 0x55, 0x4d, 0x89,
+	0xaa, 0xcd, 0xe6, 0xf3, 0xc5, 0x01, 0x6e, 0x28, 0x25, 0xac, 0x97, 0x95, 0x92, 0x2e, 0x3a, 0x19,
+	0x2a, 0xfc, 0xc2, 0xab, 0x2a, 0xa2, 0xb7, 0xc4, 0x56, 0x52, 0x00, 0x5c, 0xd9, 0x0e, 0x79, 0x1d,
+	0x6a, 0x55, 0x69, 0xe8, 0xaa, 0x7e, 0x9b, 0xaf, 0xce, 0x83, 0x54, 0x00, 0x57, 0xdb, 0x9f, 0x48,
+	0x05, 0x54, 0xb0, 0xe2, 0x05, 0x5e, 0xad, 0xb6, 0xc4, 0x7b, 0xa9, 0x80, 0x76, 0xab, 0x1a, 0x00,
+	0x0e, 0xf9, 0xb2, 0x0a, 0x01, 0x34, 0x2b, 0x6a, 0xb5, 0xa5, 0x89, 0xaf, 0xa1, 0x65, 0x28, 0x8c,
+	0xf0, 0x3d, 0x17, 0x3c, 0xe9, 0x4f, 0xa6, 0xaa, 0x67, 0x75, 0xe4, 0x01, 0x3e, 0x85, 0x2e, 0xc0,
+	0xb9, 0x2c, 0x07, 0xbd, 0x5b, 0xa0, 0x51, 0xa9, 0xab, 0x4a, 0x43, 0x17, 0x5f, 0x4f, 0x05, 0x32,
+	0x47, 0x79, 0xe0, 0xa7, 0xd1, 0x13, 0x20, 0x8f, 0x00, 0xa9, 0xc3, 0x1c, 0x4c, 0x13, 0x3f, 0x83,
+	0xce, 0xc3, 0x5a, 0xaa, 0xe3, 0xbc, 0xb6, 0xcf, 0x0a, 0xe8, 0x22, 0x9c, 0xcb, 0x9a, 0x01, 0x8f,
+	0xfc, 0x9c, 0x80, 0xd6, 0xe0, 0x6c, 0xba, 0x61, 0x7f, 0x09, 0xff, 0x30, 0x56, 0xbe, 0x31, 0x93,
+	0x1e, 0x40, 0xfc, 0x51, 0xac, 0x32, 0x93, 0xb6, 0x28, 0xe4, 0xc7, 0x02, 0x5a, 0x04, 0x14, 0x40,
+	0xaa, 0x4a, 0xb9, 0x5d, 0x33, 0xaa, 0xed, 0xad, 0x96, 0xf8, 0x85, 0xd8, 0xca, 0xa9, 0xab, 0x15,
+	0xa5, 0xc1, 0xd7, 0xeb, 0x17, 0x53, 0xd9, 0x61, 0x2d, 0x7e, 0x29, 0xe6, 0x7b, 0x28, 0x5d, 0xad,
+	0x1a, 0x8c, 0x26, 0x7e, 0x39, 0xb6, 0xb4, 0x02, 0x04, 0x0b, 0x7f, 0x00, 0xfa, 0x4a, 0x2a, 0x88,
+	0xf9, 0x1f, 0x80, 0xde, 0x10, 0x90, 0x0c, 0x2b, 0x49, 0x10, 0x0d, 0x13, 0x23, 0x6a, 0xe2, 0x57,
+	0x05, 0x24, 0x45, 0x1b, 0x3d, 0xab, 0x06, 0x4d, 0xa9, 0x60, 0x45, 0x17, 0xbf, 0x2d, 0xa0, 0xa5,
+	0xe8, 0x78, 0xa0, 0x72, 0x3e, 0x47, 0x13, 0xdf, 0x14, 0x10, 0x82, 0x53, 0xfe, 0x88, 0x99, 0x15,
+	0xbf, 0x23, 0xa0, 0x33, 0x30, 0xc3, 0x68, 0x6a, 0x43, 0x6b, 0x29, 0x15, 0x5d, 0xfc, 0x6e, 0x22,
+	0x8c, 0xd4, 0xc1, 0x52, 0xbd, 0x2e, 0x7e, 0x4d, 0x40, 0x33, 0x90, 0xc7, 0x4a, 0xab, 0x69, 0x60,
+	0xa5, 0x54, 0x15, 0xdf, 0x16, 0xd0, 0x2c, 0x00, 0x1d, 0xdf, 0xc2, 0xaa, 0xae, 0x88, 0xbf, 0xa2,
+	0xd6, 0x29, 0x21, 0x79, 0x6c, 0xfd, 0x5a, 0x40, 0x22, 0x4c, 0x53, 0x16, 0xb3, 0xfd, 0x1b, 0x01,
+	0x15, 0xe0, 0x0c, 0xa5, 0x30, 0xcb, 0x46, 0xa5, 0xb9, 0xb5, 0xa5, 0xea, 0xe2, 0x6f, 0x05, 0x34,
+	0x0f, 0x22, 0xe5, 0xf8, 0x33, 0xf7, 0xc9, 0xbf, 0xa3, 0x7e, 0x71, 0x2a, 0x02, 0xc6, 0xef, 0x23,
+	0x06, 0x8b, 0x46, 0x19, 0x97, 0x1a, 0x95, 0x1b, 0xe2, 0x1f, 0x12, 0x8a, 0x18, 0xf9, 0x9d, 0x11,
+	0x45, 0x8c, 0xf1, 0x47, 0x01, 0x2d, 0xc0, 0xe9, 0x98, 0x4b, 0xd7, 0xd5, 0xba, 0x22, 0xfe, 0x89,
+	0x86, 0x29, 0xd2, 0x43, 0x89, 0x7f, 0xa6, 0x55, 0x43, 0x89, 0x5e, 0x2d, 0xb4, 0xd4, 0x96, 0x52,
+	0x57, 0x1b, 0x0a, 0x0d, 0x8d, 0x82, 0xc5, 0xbf, 0xd0, 0xaa, 0x61, 0xc1, 0xda, 0x6a, 0xde, 0x54,
+	0x46, 0x10, 0x7f, 0xcd, 0x50, 0x40, 0x63, 0x89, 0xc5, 0xbf, 0x09, 0x68, 0x0e, 0x66, 0xf9, 0x59,
+	0xe9, 0xa5, 0x9a, 0xf8, 0xf7, 0x88, 0xca, 0x7c, 0xf7, 0xa8, 0xff, 0xa0, 0x8e, 0x87, 0x1a, 0xa8,
+	0x93, 0x2f, 0x35, 0xcb, 0xe2, 0xcf, 0x27, 0x2e, 0x7f, 0x08, 0x4e, 0xf2, 0x3d, 0x35, 0xef, 0x8e,
+	0x80, 0x15, 0xad, 0xd9, 0xc6, 0x15, 0xc5, 0xd0, 0x6f, 0xb7, 0x14, 0x23, 0xba, 0x75, 0x4c, 0xc3,
+	0x54, 0x50, 0x87, 0x02, 0xca, 0xc1, 0x31, 0xcf, 0x8a, 0x38, 0x71, 0xe5, 0x67, 0x08, 0x26, 0x4b,
+	0x2d, 0x15, 0x95, 0x20, 0x17, 0x7c, 0xea, 0x42, 0x85, 0xf0, 0xee, 0x94, 0xf8, 0x5e, 0x26, 0x2d,
+	0xa5, 0x70, 0xd8, 0x95, 0xf2, 0x31, 0x54, 0x03, 0x88, 0xbe, 0x72, 0x21, 0x29, 0x84, 0x8e, 0x7c,
+	0x0f, 0x93, 0xce, 0xa6, 0xf2, 0x42, 0x45, 0xb7, 0xe9, 0xbb, 0x43, 0xec, 0xcb, 0x05, 0x5a, 0x0b,
+	0x45, 0x32, 0x3e, 0xce, 0x48, 0xeb, 0x63, 0x10, 0xbc, 0x6a, 0x2d, 0x5b, 0xb5, 0xf6, 0x50, 0xd5,
+	0x5a, 0xb6, 0xea, 0x2d, 0x38, 0xc9, 0xb7, 0xc1, 0xd1, 0x72, 0x14, 0xab, 0xd1, 0xee, 0xbb, 0xb4,
+	0x92, 0xc1, 0x0d, 0xd5, 0x55, 0x21, 0x1f, 0xb6, 0xe2, 0xd0, 0x52, 0x0c, 0xcd, 0x77, 0x06, 0x25,
+	0x29, 0x8d, 0x15, 0x6a, 0xd1, 0x60, 0x26, 0xde, 0x61, 0x42, 0xab, 0x7c, 0x98, 0x46, 0x9b, 0x66,
+	0x52, 0x31, 0x93, 0x1f, 0x2a, 0xbd, 0x0b, 0x52, 0x76, 0xa3, 0x0c, 0x5d, 0xce, 0x50, 0x90, 0xf2,
+	0x66, 0x7a, 0x14, 0x63, 0x2f, 0xc2, 0x09, 0xff, 0xa3, 0x00, 0x5a, 0x08, 0xc1, 0xb1, 0xef, 0x06,
+	0xd2, 0xe2, 0x08, 0x3d, 0x14, 0xfe, 0x08, 0x9c, 0x1e, 0x69, 0x3d, 0xa1, 0x28, 0x9b, 0x59, 0x5d,
+	0x31, 0x49, 0x1e, 0x07, 0x49, 0x04, 0x97, 0x57, 0x1d, 0x0b, 0x6e, 0x8a, 0xde, 0x62, 0x26, 0x9f,
+	0x2f, 0x23, 0xbe, 0x0b, 0xc4, 0x95, 0x51, 0x4a, 0xcf, 0x48, 0x5a, 0xc9, 0xe0, 0x86, 0xea, 0x5a,
+	0x70, 0x2a, 0xd6, 0x85, 0x41, 0x2b, 0x71, 0x17, 0x12, 0x3d, 0x21, 0x69, 0x35, 0x8b, 0x1d, 0x6a,
+	0xbc, 0x09, 0xb3, 0x89, 0x77, 0x1f, 0x54, 0xe4, 0x3a, 0x73, 0x69, 0x2d, 0x1c, 0x69, 0x2d, 0x1b,
+	0x10, 0xea, 0xed, 0x8f, 0x34, 0x74, 0x82, 0x77, 0x5f, 0x74, 0x21, 0x4b, 0x3c, 0xf1, 0x6e, 0x2d,
+	0x5d, 0x7c, 0x38, 0x30, 0xb1, 0x15, 0xc4, 0xda, 0x3a, 0xf1, 0xad, 0x20, 0xad, 0x81, 0x24, 0xad,
+	0x8f, 0x41, 0xf0, 0x41, 0x8f, 0x75, 0x6f, 0xb8, 0xa0, 0xa7, 0x75, 0x8b, 0xa4, 0xd5, 0x2c, 0x36,
+	0xbf, 0x1b, 0x84, 0x4d, 0x1a, 0x6e, 0x37, 0x48, 0xb6, 0x82, 0x24, 0x29, 0x8d, 0xc5, 0x2d, 0x87,
+	0xf9, 0xd4, 0x46, 0x11, 0x3a, 0x3f, 0x2a, 0x96, 0xb6, 0x5c, 0xc7, 0x6b, 0x2f, 0x41, 0x2e, 0x68,
+	0xf9, 0x70, 0x47, 0x48, 0xa2, 0x5d, 0x24, 0x2d, 0xa5, 0x70, 0xf8, 0xf5, 0x3a, 0xd2, 0xe7, 0xe1,
+	0xd6, 0x6b, 0x56, 0x7f, 0x48, 0x92, 0xc7, 0x41, 0xf8, 0x8c, 0x27, 0xfb, 0x36, 0x88, 0xaf, 0xcc,
+	0xd4, 0xbe, 0x90, 0xb4, 0x3e, 0x06, 0xc1, 0x17, 0x6f, 0x46, 0xcf, 0x85, 0x2b, 0xde, 0xf1, 0x7d,
+	0x1b, 0xe9, 0xe2, 0xc3, 0x81, 0xfc, 0xd6, 0x13, 0xef, 0xc6, 0x70, 0x5b, 0x4f, 0x6a, 0x67, 0x47,
+	0x2a, 0x66, 0xf2, 0xf9, 0xf8, 0x24, 0xdb, 0x2b, 0x5c, 0x7c, 0x32, 0xfa, 0x36, 0xd2, 0xfa, 0x18,
+	0x44, 0xa8, 0x7a, 0x17, 0xe6, 0x53, 0x1b, 0x26, 0x5c, 0xe5, 0x8d, 0xeb, 0xc9, 0x48, 0x4f, 0x3c,
+	0x0c, 0x16, 0xdb, 0x9e, 0xe2, 0x3f, 0x8e, 0xe1, 0xb7, 0xa7, 0xd4, 0xdf, 0xdb, 0x48, 0x6b, 0xd9,
+	0x80, 0x40, 0x6f, 0xf9, 0xea, 0xdb, 0x0f, 0x56, 0x85, 0x77, 0x1e, 0xac, 0x0a, 0xff, 0x7c, 0xb0,
+	0x2a, 0x7c, 0xf8, 0xf2, 0x8e, 0xe9, 0xee, 0x0e, 0xb7, 0x37, 0xba, 0xd6, 0xfe, 0xa6, 0xf7, 0x49,
+	0xfb, 0xb0, 0x47, 0x6c, 0xfe, 0xe9, 0xe0, 0xca, 0xa6, 0x63, 0x77, 0xe9, 0xaf, 0x97, 0xb6, 0x4f,
+	0xd0, 0x8f, 0xd1, 0xcf, 0xfe, 0x67, 0x00, 0x78, 0xa2, 0xb1, 0xf3, 0xd1, 0x24, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// APIClient is the client API for API service.
+//
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type APIClient interface {
 	// Activate/Deactivate the auth API. 'Activate' sets an initial set of admins
@@ -3230,6 +3674,9 @@ type APIClient interface {
 	ExtractAuthTokens(ctx context.Context, in *ExtractAuthTokensRequest, opts ...grpc.CallOption) (*ExtractAuthTokensResponse, error)
 	RestoreAuthToken(ctx context.Context, in *RestoreAuthTokenRequest, opts ...grpc.CallOption) (*RestoreAuthTokenResponse, error)
 	DeleteExpiredAuthTokens(ctx context.Context, in *DeleteExpiredAuthTokensRequest, opts ...grpc.CallOption) (*DeleteExpiredAuthTokensResponse, error)
+	ListAuthTokens(ctx context.Context, in *ListAuthTokensRequest, opts ...grpc.CallOption) (*ListAuthTokensResponse, error)
+	InspectAuthToken(ctx context.Context, in *InspectAuthTokenRequest, opts ...grpc.CallOption) (*InspectAuthTokenResponse, error)
+	RevokeAuthTokenByHash(ctx context.Context, in *RevokeAuthTokenByHashRequest, opts ...grpc.CallOption) (*RevokeAuthTokenByHashResponse, error)
 	RotateRootToken(ctx context.Context, in *RotateRootTokenRequest, opts ...grpc.CallOption) (*RotateRootTokenResponse, error)
 }
 
@@ -3448,6 +3895,33 @@ func (c *aPIClient) DeleteExpiredAuthTokens(ctx context.Context, in *DeleteExpir
 	return out, nil
 }
 
+func (c *aPIClient) ListAuthTokens(ctx context.Context, in *ListAuthTokensRequest, opts ...grpc.CallOption) (*ListAuthTokensResponse, error) {
+	out := new(ListAuthTokensResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/ListAuthTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) InspectAuthToken(ctx context.Context, in *InspectAuthTokenRequest, opts ...grpc.CallOption) (*InspectAuthTokenResponse, error) {
+	out := new(InspectAuthTokenResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/InspectAuthToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RevokeAuthTokenByHash(ctx context.Context, in *RevokeAuthTokenByHashRequest, opts ...grpc.CallOption) (*RevokeAuthTokenByHashResponse, error) {
+	out := new(RevokeAuthTokenByHashResponse)
+	err := c.cc.Invoke(ctx, "/auth_v2.API/RevokeAuthTokenByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) RotateRootToken(ctx context.Context, in *RotateRootTokenRequest, opts ...grpc.CallOption) (*RotateRootTokenResponse, error) {
 	out := new(RotateRootTokenResponse)
 	err := c.cc.Invoke(ctx, "/auth_v2.API/RotateRootToken", in, out, opts...)
@@ -3485,6 +3959,9 @@ type APIServer interface {
 	ExtractAuthTokens(context.Context, *ExtractAuthTokensRequest) (*ExtractAuthTokensResponse, error)
 	RestoreAuthToken(context.Context, *RestoreAuthTokenRequest) (*RestoreAuthTokenResponse, error)
 	DeleteExpiredAuthTokens(context.Context, *DeleteExpiredAuthTokensRequest) (*DeleteExpiredAuthTokensResponse, error)
+	ListAuthTokens(context.Context, *ListAuthTokensRequest) (*ListAuthTokensResponse, error)
+	InspectAuthToken(context.Context, *InspectAuthTokenRequest) (*InspectAuthTokenResponse, error)
+	RevokeAuthTokenByHash(context.Context, *RevokeAuthTokenByHashRequest) (*RevokeAuthTokenByHashResponse, error)
 	RotateRootToken(context.Context, *RotateRootTokenRequest) (*RotateRootTokenResponse, error)
 }
 
@@ -3561,6 +4038,15 @@ func (*UnimplementedAPIServer) RestoreAuthToken(ctx context.Context, req *Restor
 func (*UnimplementedAPIServer) DeleteExpiredAuthTokens(ctx context.Context, req *DeleteExpiredAuthTokensRequest) (*DeleteExpiredAuthTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteExpiredAuthTokens not implemented")
 }
+func (*UnimplementedAPIServer) ListAuthTokens(ctx context.Context, req *ListAuthTokensRequest) (*ListAuthTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthTokens not implemented")
+}
+func (*UnimplementedAPIServer) InspectAuthToken(ctx context.Context, req *InspectAuthTokenRequest) (*InspectAuthTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectAuthToken not implemented")
+}
+func (*UnimplementedAPIServer) RevokeAuthTokenByHash(ctx context.Context, req *RevokeAuthTokenByHashRequest) (*RevokeAuthTokenByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAuthTokenByHash not implemented")
+}
 func (*UnimplementedAPIServer) RotateRootToken(ctx context.Context, req *RotateRootTokenRequest) (*RotateRootTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RotateRootToken not implemented")
 }
@@ -3983,30 +4469,84 @@ func _API_DeleteExpiredAuthTokens_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_RotateRootToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RotateRootTokenRequest)
+func _API_ListAuthTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthTokensRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).RotateRootToken(ctx, in)
+		return srv.(APIServer).ListAuthTokens(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/auth_v2.API/RotateRootToken",
+		FullMethod: "/auth_v2.API/ListAuthTokens",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RotateRootToken(ctx, req.(*RotateRootTokenRequest))
+		return srv.(APIServer).ListAuthTokens(ctx, req.(*ListAuthTokensRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _API_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "auth_v2.API",
-	HandlerType: (*APIServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Activate",
+func _API_InspectAuthToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectAuthTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectAuthToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/InspectAuthToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectAuthToken(ctx, req.(*InspectAuthTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RevokeAuthTokenByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAuthTokenByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RevokeAuthTokenByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/RevokeAuthTokenByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RevokeAuthTokenByHash(ctx, req.(*RevokeAuthTokenByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RotateRootToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateRootTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RotateRootToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth_v2.API/RotateRootToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RotateRootToken(ctx, req.(*RotateRootTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _API_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "auth_v2.API",
+	HandlerType: (*APIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Activate",
 			Handler:    _API_Activate_Handler,
 		},
 		{
@@ -4097,6 +4637,18 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteExpiredAuthTokens",
 			Handler:    _API_DeleteExpiredAuthTokens_Handler,
 		},
+		{
+			MethodName: "ListAuthTokens",
+			Handler:    _API_ListAuthTokens_Handler,
+		},
+		{
+			MethodName: "InspectAuthToken",
+			Handler:    _API_InspectAuthToken_Handler,
+		},
+		{
+			MethodName: "RevokeAuthTokenByHash",
+			Handler:    _API_RevokeAuthTokenByHash_Handler,
+		},
 		{
 			MethodName: "RotateRootToken",
 			Handler:    _API_RotateRootToken_Handler,
@@ -4536,6 +5088,40 @@ func (m *TokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.LastUsed != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUsed, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUsed):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintAuth(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CreatedAt != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintAuth(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.HashedToken) > 0 {
 		i -= len(m.HashedToken)
 		copy(dAtA[i:], m.HashedToken)
@@ -4544,12 +5130,12 @@ func (m *TokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 	}
 	if m.Expiration != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
-		if err3 != nil {
-			return 0, err3
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n3
-		i = encodeVarintAuth(dAtA, i, uint64(n3))
+		i -= n5
+		i = encodeVarintAuth(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4563,6 +5149,72 @@ func (m *TokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenScope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenScope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenScope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Permissions) > 0 {
+		dAtA7 := make([]byte, len(m.Permissions)*10)
+		var j6 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintAuth(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Resource != nil {
+		{
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AuthenticateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4689,13 +5341,27 @@ func (m *WhoAmIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Expiration != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
-		if err4 != nil {
-			return 0, err4
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n4
-		i = encodeVarintAuth(dAtA, i, uint64(n4))
+		i -= n9
+		i = encodeVarintAuth(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4973,20 +5639,20 @@ func (m *AuthorizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Permissions) > 0 {
-		dAtA7 := make([]byte, len(m.Permissions)*10)
-		var j6 int
+		dAtA12 := make([]byte, len(m.Permissions)*10)
+		var j11 int
 		for _, num := range m.Permissions {
 			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j6++
+				j11++
 			}
-			dAtA7[j6] = uint8(num)
-			j6++
+			dAtA12[j11] = uint8(num)
+			j11++
 		}
-		i -= j6
-		copy(dAtA[i:], dAtA7[:j6])
-		i = encodeVarintAuth(dAtA, i, uint64(j6))
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintAuth(dAtA, i, uint64(j11))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5037,38 +5703,38 @@ func (m *AuthorizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if len(m.Missing) > 0 {
-		dAtA10 := make([]byte, len(m.Missing)*10)
-		var j9 int
+		dAtA15 := make([]byte, len(m.Missing)*10)
+		var j14 int
 		for _, num := range m.Missing {
 			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j9++
+				j14++
 			}
-			dAtA10[j9] = uint8(num)
-			j9++
+			dAtA15[j14] = uint8(num)
+			j14++
 		}
-		i -= j9
-		copy(dAtA[i:], dAtA10[:j9])
-		i = encodeVarintAuth(dAtA, i, uint64(j9))
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintAuth(dAtA, i, uint64(j14))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if len(m.Satisfied) > 0 {
-		dAtA12 := make([]byte, len(m.Satisfied)*10)
-		var j11 int
+		dAtA17 := make([]byte, len(m.Satisfied)*10)
+		var j16 int
 		for _, num := range m.Satisfied {
 			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j11++
+				j16++
 			}
-			dAtA12[j11] = uint8(num)
-			j11++
+			dAtA17[j16] = uint8(num)
+			j16++
 		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintAuth(dAtA, i, uint64(j11))
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintAuth(dAtA, i, uint64(j16))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5204,20 +5870,20 @@ func (m *GetPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		}
 	}
 	if len(m.Permissions) > 0 {
-		dAtA16 := make([]byte, len(m.Permissions)*10)
-		var j15 int
+		dAtA21 := make([]byte, len(m.Permissions)*10)
+		var j20 int
 		for _, num := range m.Permissions {
 			for num >= 1<<7 {
-				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j15++
+				j20++
 			}
-			dAtA16[j15] = uint8(num)
-			j15++
+			dAtA21[j20] = uint8(num)
+			j20++
 		}
-		i -= j15
-		copy(dAtA[i:], dAtA16[:j15])
-		i = encodeVarintAuth(dAtA, i, uint64(j15))
+		i -= j20
+		copy(dAtA[i:], dAtA21[:j20])
+		i = encodeVarintAuth(dAtA, i, uint64(j20))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -5527,6 +6193,20 @@ func (m *GetRobotTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.TTL != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.TTL))
 		i--
@@ -6202,39 +6882,248 @@ func (m *DeleteExpiredAuthTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuth(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ListAuthTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ActivateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ListAuthTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAuthTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RootToken)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ActivateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListAuthTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	return dAtA[:n], nil
+}
+
+func (m *ListAuthTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAuthTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InspectAuthTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectAuthTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InspectAuthTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.HashedToken) > 0 {
+		i -= len(m.HashedToken)
+		copy(dAtA[i:], m.HashedToken)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.HashedToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InspectAuthTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectAuthTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InspectAuthTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Token != nil {
+		{
+			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevokeAuthTokenByHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeAuthTokenByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeAuthTokenByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.HashedToken) > 0 {
+		i -= len(m.HashedToken)
+		copy(dAtA[i:], m.HashedToken)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.HashedToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevokeAuthTokenByHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeAuthTokenByHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeAuthTokenByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuth(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ActivateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootToken)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.PachToken)
 	if l > 0 {
 		n += 1 + l + sovAuth(uint64(l))
@@ -6415,6 +7304,49 @@ func (m *TokenInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuth(uint64(l))
 	}
+	if len(m.Scopes) > 0 {
+		for _, e := range m.Scopes {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.LastUsed != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUsed)
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TokenScope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovAuth(uint64(e))
+		}
+		n += 1 + sovAuth(uint64(l)) + l
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6483,6 +7415,12 @@ func (m *WhoAmIResponse) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
 		n += 1 + l + sovAuth(uint64(l))
 	}
+	if len(m.Scopes) > 0 {
+		for _, e := range m.Scopes {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6848,6 +7786,12 @@ func (m *GetRobotTokenRequest) Size() (n int) {
 	if m.TTL != 0 {
 		n += 1 + sovAuth(uint64(m.TTL))
 	}
+	if len(m.Scopes) > 0 {
+		for _, e := range m.Scopes {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7162,16 +8106,110 @@ func (m *DeleteExpiredAuthTokensResponse) Size() (n int) {
 	return n
 }
 
-func sovAuth(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAuth(x uint64) (n int) {
-	return sovAuth(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ListAuthTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *ActivateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *ListAuthTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectAuthTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HashedToken)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectAuthTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevokeAuthTokenByHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HashedToken)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevokeAuthTokenByHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAuth(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuth(x uint64) (n int) {
+	return sovAuth(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ActivateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -8258,62 +9296,45 @@ func (m *TokenInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.HashedToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAuth
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AuthenticateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuth
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Scopes = append(m.Scopes, &TokenScope{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AuthenticateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthenticateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OIDCState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -8323,29 +9344,33 @@ func (m *AuthenticateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OIDCState = string(dAtA[iNdEx:postIndex])
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -8355,23 +9380,27 @@ func (m *AuthenticateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdToken = string(dAtA[iNdEx:postIndex])
+			if m.LastUsed == nil {
+				m.LastUsed = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUsed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8395,7 +9424,7 @@ func (m *AuthenticateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthenticateResponse) Unmarshal(dAtA []byte) error {
+func (m *TokenScope) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8418,17 +9447,17 @@ func (m *AuthenticateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthenticateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenScope: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthenticateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenScope: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PachToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -8438,75 +9467,129 @@ func (m *AuthenticateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PachToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
+			if m.Resource == nil {
+				m.Resource = &Resource{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WhoAmIRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuth
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v Permission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Permission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuth
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuth
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]Permission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Permission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Permission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WhoAmIRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WhoAmIRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -8529,7 +9612,7 @@ func (m *WhoAmIRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WhoAmIResponse) Unmarshal(dAtA []byte) error {
+func (m *AuthenticateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8552,15 +9635,15 @@ func (m *WhoAmIResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WhoAmIResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthenticateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WhoAmIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthenticateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OIDCState", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8588,13 +9671,13 @@ func (m *WhoAmIResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.OIDCState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdToken", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -8604,27 +9687,23 @@ func (m *WhoAmIResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expiration == nil {
-				m.Expiration = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IdToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8648,7 +9727,7 @@ func (m *WhoAmIResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Roles) Unmarshal(dAtA []byte) error {
+func (m *AuthenticateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8671,17 +9750,17 @@ func (m *Roles) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Roles: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthenticateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Roles: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthenticateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PachToken", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -8691,106 +9770,23 @@ func (m *Roles) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Roles == nil {
-				m.Roles = make(map[string]bool)
-			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthAuth
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthAuth
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipAuth(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthAuth
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Roles[mapkey] = mapvalue
+			m.PachToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8814,7 +9810,7 @@ func (m *Roles) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RoleBinding) Unmarshal(dAtA []byte) error {
+func (m *WhoAmIRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8837,148 +9833,19 @@ func (m *RoleBinding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RoleBinding: wiretype end group for non-group")
+			return fmt.Errorf("proto: WhoAmIRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RoleBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WhoAmIRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuth
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Entries == nil {
-				m.Entries = make(map[string]*Roles)
-			}
-			var mapkey string
-			var mapvalue *Roles
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthAuth
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthAuth
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthAuth
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthAuth
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Roles{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipAuth(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthAuth
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Entries[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if (iNdEx + skippy) > l {
@@ -8994,7 +9861,7 @@ func (m *RoleBinding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Resource) Unmarshal(dAtA []byte) error {
+func (m *WhoAmIResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9017,17 +9884,17 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Resource: wiretype end group for non-group")
+			return fmt.Errorf("proto: WhoAmIResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Resource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WhoAmIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -9037,16 +9904,29 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ResourceType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -9056,23 +9936,61 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, &TokenScope{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9096,7 +10014,7 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Users) Unmarshal(dAtA []byte) error {
+func (m *Roles) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9119,15 +10037,15 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Users: wiretype end group for non-group")
+			return fmt.Errorf("proto: Roles: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Users: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Roles: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Usernames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9154,8 +10072,8 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Usernames == nil {
-				m.Usernames = make(map[string]bool)
+			if m.Roles == nil {
+				m.Roles = make(map[string]bool)
 			}
 			var mapkey string
 			var mapvalue bool
@@ -9238,7 +10156,7 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Usernames[mapkey] = mapvalue
+			m.Roles[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9262,7 +10180,7 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Groups) Unmarshal(dAtA []byte) error {
+func (m *RoleBinding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9285,15 +10203,15 @@ func (m *Groups) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Groups: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoleBinding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Groups: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoleBinding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9320,11 +10238,11 @@ func (m *Groups) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Groups == nil {
-				m.Groups = make(map[string]bool)
+			if m.Entries == nil {
+				m.Entries = make(map[string]*Roles)
 			}
 			var mapkey string
-			var mapvalue bool
+			var mapvalue *Roles
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -9373,7 +10291,7 @@ func (m *Groups) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapvaluetemp int
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowAuth
@@ -9383,12 +10301,26 @@ func (m *Groups) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					mapvalue = bool(mapvaluetemp != 0)
+					if mapmsglen < 0 {
+						return ErrInvalidLengthAuth
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Roles{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipAuth(dAtA[iNdEx:])
@@ -9404,7 +10336,7 @@ func (m *Groups) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Groups[mapkey] = mapvalue
+			m.Entries[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9428,7 +10360,7 @@ func (m *Groups) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthorizeRequest) Unmarshal(dAtA []byte) error {
+func (m *Resource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9451,17 +10383,17 @@ func (m *AuthorizeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthorizeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Resource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthorizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Resource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -9471,28 +10403,602 @@ func (m *AuthorizeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= ResourceType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuth
-			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Users) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Users: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Users: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usernames", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
 			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usernames == nil {
+				m.Usernames = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAuth(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Usernames[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Groups) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Groups: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Groups: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Groups == nil {
+				m.Groups = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAuth(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Groups[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &Resource{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v Permission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Permission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuth
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuth
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]Permission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Permission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Permission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = &Resource{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
 			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Authorized = bool(v != 0)
 		case 2:
 			if wireType == 0 {
 				var v Permission
@@ -9510,7 +11016,76 @@ func (m *AuthorizeRequest) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.Permissions = append(m.Permissions, v)
+				m.Satisfied = append(m.Satisfied, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuth
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuth
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Satisfied) == 0 {
+					m.Satisfied = make([]Permission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Permission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Permission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Satisfied = append(m.Satisfied, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Satisfied", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v Permission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Permission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Missing = append(m.Missing, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -9534,34 +11109,153 @@ func (m *AuthorizeRequest) Unmarshal(dAtA []byte) error {
 				if postIndex < 0 {
 					return ErrInvalidLengthAuth
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Missing) == 0 {
+					m.Missing = make([]Permission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Permission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Permission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Missing = append(m.Missing, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missing", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Principal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPermissionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPermissionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.Permissions) == 0 {
-					m.Permissions = make([]Permission, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Permission
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Permission(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Permissions = append(m.Permissions, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &Resource{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -9584,7 +11278,7 @@ func (m *AuthorizeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
+func (m *GetPermissionsForPrincipalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9607,17 +11301,17 @@ func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthorizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPermissionsForPrincipalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthorizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPermissionsForPrincipalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorized", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -9627,82 +11321,112 @@ func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Authorized = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &Resource{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v Permission
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Permission(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Satisfied = append(m.Satisfied, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthAuth
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthAuth
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.Satisfied) == 0 {
-					m.Satisfied = make([]Permission, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Permission
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Permission(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Satisfied = append(m.Satisfied, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Satisfied", wireType)
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Principal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPermissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPermissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType == 0 {
 				var v Permission
 				for shift := uint(0); ; shift += 7 {
@@ -9719,7 +11443,7 @@ func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.Missing = append(m.Missing, v)
+				m.Permissions = append(m.Permissions, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -9747,8 +11471,8 @@ func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				var elementCount int
-				if elementCount != 0 && len(m.Missing) == 0 {
-					m.Missing = make([]Permission, 0, elementCount)
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]Permission, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v Permission
@@ -9766,14 +11490,14 @@ func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.Missing = append(m.Missing, v)
+					m.Permissions = append(m.Permissions, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Missing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9801,7 +11525,7 @@ func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Principal = string(dAtA[iNdEx:postIndex])
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9825,7 +11549,7 @@ func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPermissionsRequest) Unmarshal(dAtA []byte) error {
+func (m *ModifyRoleBindingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9848,10 +11572,10 @@ func (m *GetPermissionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPermissionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModifyRoleBindingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModifyRoleBindingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9890,6 +11614,70 @@ func (m *GetPermissionsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Principal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -9912,7 +11700,7 @@ func (m *GetPermissionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPermissionsForPrincipalRequest) Unmarshal(dAtA []byte) error {
+func (m *ModifyRoleBindingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9935,10 +11723,61 @@ func (m *GetPermissionsForPrincipalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPermissionsForPrincipalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModifyRoleBindingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPermissionsForPrincipalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModifyRoleBindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRoleBindingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRoleBindingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRoleBindingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9977,38 +11816,6 @@ func (m *GetPermissionsForPrincipalRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Principal = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -10031,7 +11838,7 @@ func (m *GetPermissionsForPrincipalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPermissionsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetRoleBindingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10054,86 +11861,17 @@ func (m *GetPermissionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPermissionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRoleBindingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRoleBindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v Permission
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Permission(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Permissions = append(m.Permissions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAuth
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthAuth
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthAuth
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Permissions) == 0 {
-					m.Permissions = make([]Permission, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Permission
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAuth
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Permission(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Permissions = append(m.Permissions, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -10143,23 +11881,27 @@ func (m *GetPermissionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			if m.Binding == nil {
+				m.Binding = &RoleBinding{}
+			}
+			if err := m.Binding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10183,7 +11925,7 @@ func (m *GetPermissionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModifyRoleBindingRequest) Unmarshal(dAtA []byte) error {
+func (m *SessionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10206,17 +11948,17 @@ func (m *ModifyRoleBindingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModifyRoleBindingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModifyRoleBindingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -10226,31 +11968,27 @@ func (m *ModifyRoleBindingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = &Resource{}
-			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10278,13 +12016,13 @@ func (m *ModifyRoleBindingRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Principal = string(dAtA[iNdEx:postIndex])
+			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionErr", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -10294,24 +12032,12 @@ func (m *ModifyRoleBindingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.ConversionErr = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -10334,7 +12060,7 @@ func (m *ModifyRoleBindingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModifyRoleBindingResponse) Unmarshal(dAtA []byte) error {
+func (m *GetOIDCLoginRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10357,10 +12083,10 @@ func (m *ModifyRoleBindingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModifyRoleBindingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOIDCLoginRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModifyRoleBindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOIDCLoginRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10385,7 +12111,7 @@ func (m *ModifyRoleBindingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRoleBindingRequest) Unmarshal(dAtA []byte) error {
+func (m *GetOIDCLoginResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10408,17 +12134,17 @@ func (m *GetRoleBindingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRoleBindingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOIDCLoginResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRoleBindingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOIDCLoginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LoginURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -10428,27 +12154,55 @@ func (m *GetRoleBindingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = &Resource{}
+			m.LoginURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10472,7 +12226,7 @@ func (m *GetRoleBindingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRoleBindingResponse) Unmarshal(dAtA []byte) error {
+func (m *GetRobotTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10495,15 +12249,66 @@ func (m *GetRoleBindingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRoleBindingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRobotTokenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRoleBindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRobotTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Robot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Robot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			m.TTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TTL |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10530,10 +12335,8 @@ func (m *GetRoleBindingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Binding == nil {
-				m.Binding = &RoleBinding{}
-			}
-			if err := m.Binding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Scopes = append(m.Scopes, &TokenScope{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10559,7 +12362,7 @@ func (m *GetRoleBindingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionInfo) Unmarshal(dAtA []byte) error {
+func (m *GetRobotTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10582,15 +12385,15 @@ func (m *SessionInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRobotTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRobotTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10618,45 +12421,64 @@ func (m *SessionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonce = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevokeAuthTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConversionErr", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevokeAuthTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevokeAuthTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -10666,12 +12488,24 @@ func (m *SessionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ConversionErr = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -10694,7 +12528,7 @@ func (m *SessionInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetOIDCLoginRequest) Unmarshal(dAtA []byte) error {
+func (m *RevokeAuthTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10717,10 +12551,10 @@ func (m *GetOIDCLoginRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetOIDCLoginRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevokeAuthTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetOIDCLoginRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevokeAuthTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10745,7 +12579,7 @@ func (m *GetOIDCLoginRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetOIDCLoginResponse) Unmarshal(dAtA []byte) error {
+func (m *SetGroupsForUserRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10768,15 +12602,15 @@ func (m *GetOIDCLoginResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetOIDCLoginResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetGroupsForUserRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetOIDCLoginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetGroupsForUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoginURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10804,11 +12638,11 @@ func (m *GetOIDCLoginResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LoginURL = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10836,7 +12670,7 @@ func (m *GetOIDCLoginResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10860,7 +12694,7 @@ func (m *GetOIDCLoginResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRobotTokenRequest) Unmarshal(dAtA []byte) error {
+func (m *SetGroupsForUserResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10883,63 +12717,12 @@ func (m *GetRobotTokenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRobotTokenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetGroupsForUserResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRobotTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetGroupsForUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Robot", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Robot = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
-			}
-			m.TTL = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TTL |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -10962,7 +12745,7 @@ func (m *GetRobotTokenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRobotTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *ModifyMembersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10985,15 +12768,15 @@ func (m *GetRobotTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRobotTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModifyMembersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRobotTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModifyMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11021,62 +12804,43 @@ func (m *GetRobotTokenResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAuth
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RevokeAuthTokenRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuth
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RevokeAuthTokenRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevokeAuthTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Add = append(m.Add, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11104,7 +12868,7 @@ func (m *RevokeAuthTokenRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.Remove = append(m.Remove, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11128,7 +12892,7 @@ func (m *RevokeAuthTokenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RevokeAuthTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *ModifyMembersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11151,10 +12915,10 @@ func (m *RevokeAuthTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevokeAuthTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModifyMembersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevokeAuthTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModifyMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11179,7 +12943,7 @@ func (m *RevokeAuthTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetGroupsForUserRequest) Unmarshal(dAtA []byte) error {
+func (m *GetGroupsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11202,47 +12966,66 @@ func (m *SetGroupsForUserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetGroupsForUserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetGroupsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetGroupsForUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetGroupsForPrincipalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetGroupsForPrincipalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetGroupsForPrincipalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11270,7 +13053,7 @@ func (m *SetGroupsForUserRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			m.Principal = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11294,7 +13077,7 @@ func (m *SetGroupsForUserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetGroupsForUserResponse) Unmarshal(dAtA []byte) error {
+func (m *GetGroupsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11317,12 +13100,44 @@ func (m *SetGroupsForUserResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetGroupsForUserResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetGroupsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetGroupsForUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -11345,7 +13160,7 @@ func (m *SetGroupsForUserResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModifyMembersRequest) Unmarshal(dAtA []byte) error {
+func (m *GetUsersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11368,10 +13183,10 @@ func (m *ModifyMembersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModifyMembersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetUsersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModifyMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetUsersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11406,41 +13221,60 @@ func (m *ModifyMembersRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetUsersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Add = append(m.Add, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetUsersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetUsersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usernames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11468,7 +13302,7 @@ func (m *ModifyMembersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Remove = append(m.Remove, string(dAtA[iNdEx:postIndex]))
+			m.Usernames = append(m.Usernames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11492,7 +13326,7 @@ func (m *ModifyMembersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModifyMembersResponse) Unmarshal(dAtA []byte) error {
+func (m *ExtractAuthTokensRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11515,10 +13349,10 @@ func (m *ModifyMembersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModifyMembersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExtractAuthTokensRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModifyMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExtractAuthTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11543,7 +13377,7 @@ func (m *ModifyMembersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetGroupsRequest) Unmarshal(dAtA []byte) error {
+func (m *ExtractAuthTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11566,12 +13400,46 @@ func (m *GetGroupsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetGroupsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExtractAuthTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExtractAuthTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &TokenInfo{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -11594,7 +13462,7 @@ func (m *GetGroupsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetGroupsForPrincipalRequest) Unmarshal(dAtA []byte) error {
+func (m *RestoreAuthTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11617,17 +13485,17 @@ func (m *GetGroupsForPrincipalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetGroupsForPrincipalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreAuthTokenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetGroupsForPrincipalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreAuthTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -11637,23 +13505,27 @@ func (m *GetGroupsForPrincipalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Principal = string(dAtA[iNdEx:postIndex])
+			if m.Token == nil {
+				m.Token = &TokenInfo{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11677,7 +13549,58 @@ func (m *GetGroupsForPrincipalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetGroupsResponse) Unmarshal(dAtA []byte) error {
+func (m *RestoreAuthTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreAuthTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreAuthTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevokeAuthTokensForUserRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11700,15 +13623,15 @@ func (m *GetGroupsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetGroupsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevokeAuthTokensForUserRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevokeAuthTokensForUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11736,7 +13659,7 @@ func (m *GetGroupsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11760,7 +13683,7 @@ func (m *GetGroupsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetUsersRequest) Unmarshal(dAtA []byte) error {
+func (m *RevokeAuthTokensForUserResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11783,44 +13706,12 @@ func (m *GetUsersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetUsersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevokeAuthTokensForUserResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetUsersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevokeAuthTokensForUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Group = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -11843,7 +13734,7 @@ func (m *GetUsersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetUsersResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteExpiredAuthTokensRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11866,44 +13757,12 @@ func (m *GetUsersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetUsersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteExpiredAuthTokensRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetUsersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteExpiredAuthTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Usernames", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Usernames = append(m.Usernames, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -11926,7 +13785,7 @@ func (m *GetUsersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExtractAuthTokensRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteExpiredAuthTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11949,10 +13808,10 @@ func (m *ExtractAuthTokensRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExtractAuthTokensRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteExpiredAuthTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtractAuthTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteExpiredAuthTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11977,7 +13836,7 @@ func (m *ExtractAuthTokensRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExtractAuthTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *ListAuthTokensRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12000,17 +13859,17 @@ func (m *ExtractAuthTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExtractAuthTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListAuthTokensRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtractAuthTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListAuthTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -12020,25 +13879,23 @@ func (m *ExtractAuthTokensResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, &TokenInfo{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12062,7 +13919,7 @@ func (m *ExtractAuthTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestoreAuthTokenRequest) Unmarshal(dAtA []byte) error {
+func (m *ListAuthTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12085,15 +13942,15 @@ func (m *RestoreAuthTokenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestoreAuthTokenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListAuthTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestoreAuthTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListAuthTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12120,10 +13977,8 @@ func (m *RestoreAuthTokenRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Token == nil {
-				m.Token = &TokenInfo{}
-			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tokens = append(m.Tokens, &TokenInfo{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12149,7 +14004,7 @@ func (m *RestoreAuthTokenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestoreAuthTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *InspectAuthTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12172,12 +14027,44 @@ func (m *RestoreAuthTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestoreAuthTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectAuthTokenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestoreAuthTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectAuthTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashedToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashedToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -12200,7 +14087,7 @@ func (m *RestoreAuthTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RevokeAuthTokensForUserRequest) Unmarshal(dAtA []byte) error {
+func (m *InspectAuthTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12223,17 +14110,17 @@ func (m *RevokeAuthTokensForUserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevokeAuthTokensForUserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectAuthTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevokeAuthTokensForUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectAuthTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -12243,23 +14130,27 @@ func (m *RevokeAuthTokensForUserRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			if m.Token == nil {
+				m.Token = &TokenInfo{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12283,7 +14174,7 @@ func (m *RevokeAuthTokensForUserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RevokeAuthTokensForUserResponse) Unmarshal(dAtA []byte) error {
+func (m *RevokeAuthTokenByHashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12306,63 +14197,44 @@ func (m *RevokeAuthTokensForUserResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevokeAuthTokensForUserResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevokeAuthTokenByHashRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevokeAuthTokensForUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevokeAuthTokenByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashedToken", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAuth
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteExpiredAuthTokensRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuth
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteExpiredAuthTokensRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteExpiredAuthTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.HashedToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -12385,7 +14257,7 @@ func (m *DeleteExpiredAuthTokensRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteExpiredAuthTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *RevokeAuthTokenByHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12408,10 +14280,10 @@ func (m *DeleteExpiredAuthTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteExpiredAuthTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevokeAuthTokenByHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteExpiredAuthTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevokeAuthTokenByHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
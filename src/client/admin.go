@@ -14,3 +14,18 @@ func (c APIClient) InspectCluster() (*admin.ClusterInfo, error) {
 	}
 	return clusterInfo, nil
 }
+
+// MigrationStatus returns the database migrations known to pachd and whether
+// each has been applied. If dryRun is set, pending migrations are applied in a
+// transaction that is rolled back, and if verify is set, the database schema is
+// checked against the schema pachd expects.
+func (c APIClient) MigrationStatus(dryRun, verify bool) (*admin.MigrationStatusResponse, error) {
+	resp, err := c.AdminAPIClient.MigrationStatus(c.Ctx(), &admin.MigrationStatusRequest{
+		DryRun: dryRun,
+		Verify: verify,
+	})
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp, nil
+}
@@ -29,6 +29,14 @@ func NewBranch(repoName string, branchName string) *pfs.Branch {
 	}
 }
 
+// NewTag creates a pfs.Tag
+func NewTag(repoName string, tagName string) *pfs.Tag {
+	return &pfs.Tag{
+		Repo: NewRepo(repoName),
+		Name: tagName,
+	}
+}
+
 // NewCommit creates a pfs.Commit.
 func NewCommit(repoName string, branchName string, commitID string) *pfs.Commit {
 	return &pfs.Commit{
@@ -92,6 +100,41 @@ func (c APIClient) InspectRepo(repoName string) (*pfs.RepoInfo, error) {
 	return resp, nil
 }
 
+// InspectRepoStorage returns the deduplicated object storage used by all
+// commits in a Repo. Bytes are unique if deleting the repo would free them,
+// and shared if they are also referenced from outside the repo. A cached
+// summary is returned unless the repo has changed since it was computed or
+// refresh is set.
+func (c APIClient) InspectRepoStorage(repoName string, refresh bool) (*pfs.StorageUsage, error) {
+	return c.inspectStorage(&pfs.InspectStorageRequest{
+		Repo:    NewRepo(repoName),
+		Refresh: refresh,
+	})
+}
+
+// InspectBranchStorage returns the deduplicated object storage used by the
+// history of a branch, see InspectRepoStorage.
+func (c APIClient) InspectBranchStorage(repoName string, branchName string, refresh bool) (*pfs.StorageUsage, error) {
+	return c.inspectStorage(&pfs.InspectStorageRequest{
+		Branch:  NewBranch(repoName, branchName),
+		Refresh: refresh,
+	})
+}
+
+// InspectCommitStorage returns the deduplicated object storage used by a
+// single commit, see InspectRepoStorage.
+func (c APIClient) InspectCommitStorage(repoName string, branchName string, commitID string, refresh bool) (*pfs.StorageUsage, error) {
+	return c.inspectStorage(&pfs.InspectStorageRequest{
+		Commit:  NewCommit(repoName, branchName, commitID),
+		Refresh: refresh,
+	})
+}
+
+func (c APIClient) inspectStorage(req *pfs.InspectStorageRequest) (*pfs.StorageUsage, error) {
+	usage, err := c.PfsAPIClient.InspectStorage(c.Ctx(), req)
+	return usage, grpcutil.ScrubGRPC(err)
+}
+
 // ListRepo returns info about user Repos
 func (c APIClient) ListRepo() ([]*pfs.RepoInfo, error) {
 	return c.ListRepoByType(pfs.UserRepoType)
@@ -130,6 +173,24 @@ func (c APIClient) DeleteRepo(repoName string, force bool) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// ForkRepo creates a new repo, repoName, whose initial commit on branch
+// shares the data of the source commit without copying it. If branch is
+// empty, the source commit's branch name is used.
+func (c APIClient) ForkRepo(source *pfs.Commit, repoName string, branch string) (*pfs.Commit, error) {
+	commit, err := c.PfsAPIClient.ForkRepo(
+		c.Ctx(),
+		&pfs.ForkRepoRequest{
+			Source: source,
+			Repo:   NewRepo(repoName),
+			Branch: branch,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return commit, nil
+}
+
 // StartCommit begins the process of committing data to a Repo. Once started
 // you can write to the Commit with PutFile and when all the data has been
 // written you must finish the Commit with FinishCommit. NOTE, data is not
@@ -152,6 +213,22 @@ func (c APIClient) StartCommit(repoName string, branchName string) (*pfs.Commit,
 	return commit, nil
 }
 
+// StartCommitIfHead is like StartCommit, but only starts the commit if the
+// current head of the branch is the commit 'expectedHeadID'.
+func (c APIClient) StartCommitIfHead(repoName string, branchName string, expectedHeadID string) (*pfs.Commit, error) {
+	commit, err := c.PfsAPIClient.StartCommit(
+		c.Ctx(),
+		&pfs.StartCommitRequest{
+			Branch:       NewBranch(repoName, branchName),
+			ExpectedHead: NewCommit(repoName, "", expectedHeadID),
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return commit, nil
+}
+
 // StartCommitParent begins the process of committing data to a Repo. Once started
 // you can write to the Commit with PutFile and when all the data has been
 // written you must finish the Commit with FinishCommit. NOTE, data is not
@@ -299,6 +376,26 @@ func (c APIClient) CreateBranch(repoName string, branchName string, commitBranch
 	return grpcutil.ScrubGRPC(err)
 }
 
+// CreateBranchIfHead is like CreateBranch, but only updates the branch if its
+// current head is the commit 'expectedHeadID'. If the branch has moved, an
+// error is returned for which pfsserver.IsBranchHeadMovedErr is true.
+func (c APIClient) CreateBranchIfHead(repoName string, branchName string, commitBranch string, commitID string, provenance []*pfs.Branch, expectedHeadID string) error {
+	var head *pfs.Commit
+	if commitBranch != "" || commitID != "" {
+		head = NewCommit(repoName, commitBranch, commitID)
+	}
+	_, err := c.PfsAPIClient.CreateBranch(
+		c.Ctx(),
+		&pfs.CreateBranchRequest{
+			Branch:       NewBranch(repoName, branchName),
+			Head:         head,
+			Provenance:   provenance,
+			ExpectedHead: NewCommit(repoName, "", expectedHeadID),
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // CreateBranchTrigger Creates a branch with a trigger. Note: triggers and
 // provenance are mutually exclusive. See the docs on triggers to learn more
 // about why this is.
@@ -357,6 +454,56 @@ func (c APIClient) DeleteBranch(repoName string, branchName string, force bool)
 	return grpcutil.ScrubGRPC(err)
 }
 
+// CreateTag creates a tag pointing at a finished commit. Tags can't be
+// moved, and can be used in place of a branch name to refer to the commit.
+func (c APIClient) CreateTag(repoName string, tagName string, commitBranch string, commitID string) error {
+	_, err := c.PfsAPIClient.CreateTag(
+		c.Ctx(),
+		&pfs.CreateTagRequest{
+			Tag:    NewTag(repoName, tagName),
+			Commit: NewCommit(repoName, commitBranch, commitID),
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// InspectTag returns information on a specific PFS tag
+func (c APIClient) InspectTag(repoName string, tagName string) (*pfs.TagInfo, error) {
+	tagInfo, err := c.PfsAPIClient.InspectTag(
+		c.Ctx(),
+		&pfs.InspectTagRequest{
+			Tag: NewTag(repoName, tagName),
+		},
+	)
+	return tagInfo, grpcutil.ScrubGRPC(err)
+}
+
+// ListTag lists the tags on a Repo.
+func (c APIClient) ListTag(repoName string) ([]*pfs.TagInfo, error) {
+	tagInfos, err := c.PfsAPIClient.ListTag(
+		c.Ctx(),
+		&pfs.ListTagRequest{
+			Repo: NewRepo(repoName),
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return tagInfos.TagInfo, nil
+}
+
+// DeleteTag deletes a tag. The commit it points to is left intact, and can be
+// squashed once no other tags point to it.
+func (c APIClient) DeleteTag(repoName string, tagName string) error {
+	_, err := c.PfsAPIClient.DeleteTag(
+		c.Ctx(),
+		&pfs.DeleteTagRequest{
+			Tag: NewTag(repoName, tagName),
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // SquashCommit deletes a commit.
 func (c APIClient) SquashCommit(repoName string, branchName string, commitID string) error {
 	_, err := c.PfsAPIClient.SquashCommit(
@@ -487,12 +634,63 @@ func (c APIClient) ClearCommit(repoName string, branchName string, commitID stri
 	return err
 }
 
+// MergeCommit creates a commit on top of ours that also contains the
+// changes made in theirs since their common ancestor. If paths were changed
+// differently on both sides, they are returned as conflicts and resolved
+// according to strategy.
+func (c APIClient) MergeCommit(ours, theirs *pfs.Commit, strategy pfs.MergeStrategy) (*pfs.MergeCommitResponse, error) {
+	resp, err := c.PfsAPIClient.MergeCommit(
+		c.Ctx(),
+		&pfs.MergeCommitRequest{
+			Ours:     ours,
+			Theirs:   theirs,
+			Strategy: strategy,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp, nil
+}
+
+// RevertCommit creates a commit on the commit's branch that undoes the changes
+// the commit made relative to its parent.
+func (c APIClient) RevertCommit(repoName string, branchName string, commitID string) (*pfs.Commit, error) {
+	commit, err := c.PfsAPIClient.RevertCommit(
+		c.Ctx(),
+		&pfs.RevertCommitRequest{
+			Commit: NewCommit(repoName, branchName, commitID),
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return commit, nil
+}
+
 // Fsck performs checks on pfs. Errors that are encountered will be passed
 // onError. These aren't errors in the traditional sense, in that they don't
 // prevent the completion of fsck. Errors that do prevent completion will be
 // returned from the function.
 func (c APIClient) Fsck(fix bool, cb func(*pfs.FsckResponse) error) error {
-	fsckClient, err := c.PfsAPIClient.Fsck(c.Ctx(), &pfs.FsckRequest{Fix: fix})
+	return c.fsck(&pfs.FsckRequest{Fix: fix}, cb)
+}
+
+// FsckDeep performs the same checks as Fsck and additionally verifies that
+// every chunk referenced by the given commits exists in object storage and
+// matches its hash. If no commits are given, every commit is checked.
+// Corrupted or missing files are reported through cb with the Commit and File
+// fields of the response set.
+func (c APIClient) FsckDeep(fix bool, commits []*pfs.Commit, cb func(*pfs.FsckResponse) error) error {
+	return c.fsck(&pfs.FsckRequest{
+		Fix:     fix,
+		Deep:    true,
+		Commits: commits,
+	}, cb)
+}
+
+func (c APIClient) fsck(req *pfs.FsckRequest, cb func(*pfs.FsckResponse) error) error {
+	fsckClient, err := c.PfsAPIClient.Fsck(c.Ctx(), req)
 	if err != nil {
 		return grpcutil.ScrubGRPC(err)
 	}
@@ -514,6 +712,17 @@ func (c APIClient) Fsck(fix bool, cb func(*pfs.FsckResponse) error) error {
 	return nil
 }
 
+// VerifyReplica reports how complete the replica object store is. If
+// checkObjects is true, it also checks that every replicated chunk exists in
+// the replica, and if fix is also true, replicates the missing chunks again.
+func (c APIClient) VerifyReplica(checkObjects, fix bool) (*pfs.VerifyReplicaResponse, error) {
+	resp, err := c.PfsAPIClient.VerifyReplica(c.Ctx(), &pfs.VerifyReplicaRequest{
+		CheckObjects: checkObjects,
+		Fix:          fix,
+	})
+	return resp, grpcutil.ScrubGRPC(err)
+}
+
 // FsckFastExit performs checks on pfs, similar to Fsck, except that it returns the
 // first fsck error it encounters and exits.
 func (c APIClient) FsckFastExit() error {
@@ -550,3 +759,16 @@ func (c APIClient) RunPFSLoadTest(spec []byte, seed ...int64) (_ *pfs.RunLoadTes
 	}
 	return c.PfsAPIClient.RunLoadTest(c.Ctx(), req)
 }
+
+// RunPFSLoadTestScenario runs only the named scenario in a PFS load test
+// spec. An empty scenario runs every scenario in the spec.
+func (c APIClient) RunPFSLoadTestScenario(spec []byte, scenario string, seed int64) (_ *pfs.RunLoadTestResponse, retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	return c.PfsAPIClient.RunLoadTest(c.Ctx(), &pfs.RunLoadTestRequest{
+		Spec:     spec,
+		Seed:     seed,
+		Scenario: scenario,
+	})
+}
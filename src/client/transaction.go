@@ -291,6 +291,9 @@ func (c *pfsBuilderClient) DeleteRepo(ctx context.Context, req *pfs.DeleteRepoRe
 	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{DeleteRepo: req})
 	return nil, nil
 }
+func (c *pfsBuilderClient) ForkRepo(ctx context.Context, req *pfs.ForkRepoRequest, opts ...grpc.CallOption) (*pfs.Commit, error) {
+	return nil, unsupportedError("ForkRepo")
+}
 func (c *pfsBuilderClient) StartCommit(ctx context.Context, req *pfs.StartCommitRequest, opts ...grpc.CallOption) (*pfs.Commit, error) {
 	// Note that since we are batching requests (no extra round-trips), we do not
 	// have the commit id to return here. If you need an operation that relies
@@ -322,10 +325,21 @@ func (c *ppsBuilderClient) UpdateJobState(ctx context.Context, req *pps.UpdateJo
 	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{UpdateJobState: req})
 	return nil, nil
 }
-func (c *ppsBuilderClient) CreatePipeline(ctx context.Context, req *pps.CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+func (c *ppsBuilderClient) CreatePipeline(ctx context.Context, req *pps.CreatePipelineRequest, opts ...grpc.CallOption) (*pps.CreatePipelineResponse, error) {
+	if req.DryRun {
+		return nil, errors.New("pipeline dry runs are not supported in transactions")
+	}
 	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{CreatePipeline: req})
 	return nil, nil
 }
+func (c *ppsBuilderClient) DeletePipeline(ctx context.Context, req *pps.DeletePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{DeletePipeline: req})
+	return nil, nil
+}
+func (c *authBuilderClient) ModifyRoleBinding(ctx context.Context, req *auth.ModifyRoleBindingRequest, opts ...grpc.CallOption) (*auth.ModifyRoleBindingResponse, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{ModifyRoleBinding: req})
+	return nil, nil
+}
 
 // Boilerplate for making unsupported API requests error when used on a TransactionBuilder
 func unsupportedError(name string) error {
@@ -356,9 +370,27 @@ func (c *pfsBuilderClient) SubscribeCommit(ctx context.Context, req *pfs.Subscri
 func (c *pfsBuilderClient) ClearCommit(ctx context.Context, req *pfs.ClearCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("ClearCommit")
 }
+func (c *pfsBuilderClient) MergeCommit(ctx context.Context, req *pfs.MergeCommitRequest, opts ...grpc.CallOption) (*pfs.MergeCommitResponse, error) {
+	return nil, unsupportedError("MergeCommit")
+}
+func (c *pfsBuilderClient) RevertCommit(ctx context.Context, req *pfs.RevertCommitRequest, opts ...grpc.CallOption) (*pfs.Commit, error) {
+	return nil, unsupportedError("RevertCommit")
+}
 func (c *pfsBuilderClient) InspectBranch(ctx context.Context, req *pfs.InspectBranchRequest, opts ...grpc.CallOption) (*pfs.BranchInfo, error) {
 	return nil, unsupportedError("InspectBranch")
 }
+func (c *pfsBuilderClient) CreateTag(ctx context.Context, req *pfs.CreateTagRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("CreateTag")
+}
+func (c *pfsBuilderClient) InspectTag(ctx context.Context, req *pfs.InspectTagRequest, opts ...grpc.CallOption) (*pfs.TagInfo, error) {
+	return nil, unsupportedError("InspectTag")
+}
+func (c *pfsBuilderClient) ListTag(ctx context.Context, req *pfs.ListTagRequest, opts ...grpc.CallOption) (*pfs.TagInfos, error) {
+	return nil, unsupportedError("ListTag")
+}
+func (c *pfsBuilderClient) DeleteTag(ctx context.Context, req *pfs.DeleteTagRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("DeleteTag")
+}
 func (c *pfsBuilderClient) ListBranch(ctx context.Context, req *pfs.ListBranchRequest, opts ...grpc.CallOption) (*pfs.BranchInfos, error) {
 	return nil, unsupportedError("ListBranch")
 }
@@ -389,6 +421,12 @@ func (c *pfsBuilderClient) DeleteAll(ctx context.Context, req *types.Empty, opts
 func (c *pfsBuilderClient) Fsck(ctx context.Context, req *pfs.FsckRequest, opts ...grpc.CallOption) (pfs.API_FsckClient, error) {
 	return nil, unsupportedError("Fsck")
 }
+func (c *pfsBuilderClient) InspectStorage(ctx context.Context, req *pfs.InspectStorageRequest, opts ...grpc.CallOption) (*pfs.StorageUsage, error) {
+	return nil, unsupportedError("InspectStorage")
+}
+func (c *pfsBuilderClient) VerifyReplica(ctx context.Context, req *pfs.VerifyReplicaRequest, opts ...grpc.CallOption) (*pfs.VerifyReplicaResponse, error) {
+	return nil, unsupportedError("VerifyReplica")
+}
 func (c *pfsBuilderClient) CreateFileset(ctx context.Context, opts ...grpc.CallOption) (pfs.API_CreateFilesetClient, error) {
 	return nil, unsupportedError("CreateFileset")
 }
@@ -435,9 +473,6 @@ func (c *ppsBuilderClient) InspectPipeline(ctx context.Context, req *pps.Inspect
 func (c *ppsBuilderClient) ListPipeline(ctx context.Context, req *pps.ListPipelineRequest, opts ...grpc.CallOption) (*pps.PipelineInfos, error) {
 	return nil, unsupportedError("ListPipeline")
 }
-func (c *ppsBuilderClient) DeletePipeline(ctx context.Context, req *pps.DeletePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	return nil, unsupportedError("DeletePipeline")
-}
 func (c *ppsBuilderClient) StartPipeline(ctx context.Context, req *pps.StartPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("StartPipeline")
 }
@@ -487,9 +522,6 @@ func (c *authBuilderClient) SetConfiguration(ctx context.Context, req *auth.SetC
 func (c *authBuilderClient) GetRoleBinding(ctx context.Context, req *auth.GetRoleBindingRequest, opts ...grpc.CallOption) (*auth.GetRoleBindingResponse, error) {
 	return nil, unsupportedError("GetRoleBinding")
 }
-func (c *authBuilderClient) ModifyRoleBinding(ctx context.Context, req *auth.ModifyRoleBindingRequest, opts ...grpc.CallOption) (*auth.ModifyRoleBindingResponse, error) {
-	return nil, unsupportedError("ModifyRoleBinding")
-}
 func (c *authBuilderClient) DeleteRoleBinding(ctx context.Context, req *auth.Resource, opts ...grpc.CallOption) error {
 	return unsupportedError("DeleteRoleBinding")
 }
@@ -541,6 +573,15 @@ func (c *authBuilderClient) ExtractAuthTokens(ctx context.Context, req *auth.Ext
 func (c *authBuilderClient) RestoreAuthToken(ctx context.Context, req *auth.RestoreAuthTokenRequest, opts ...grpc.CallOption) (*auth.RestoreAuthTokenResponse, error) {
 	return nil, unsupportedError("RestoreAuthToken")
 }
+func (c *authBuilderClient) ListAuthTokens(ctx context.Context, req *auth.ListAuthTokensRequest, opts ...grpc.CallOption) (*auth.ListAuthTokensResponse, error) {
+	return nil, unsupportedError("ListAuthTokens")
+}
+func (c *authBuilderClient) InspectAuthToken(ctx context.Context, req *auth.InspectAuthTokenRequest, opts ...grpc.CallOption) (*auth.InspectAuthTokenResponse, error) {
+	return nil, unsupportedError("InspectAuthToken")
+}
+func (c *authBuilderClient) RevokeAuthTokenByHash(ctx context.Context, req *auth.RevokeAuthTokenByHashRequest, opts ...grpc.CallOption) (*auth.RevokeAuthTokenByHashResponse, error) {
+	return nil, unsupportedError("RevokeAuthTokenByHash")
+}
 
 func (c *enterpriseBuilderClient) Activate(ctx context.Context, req *enterprise.ActivateRequest, opts ...grpc.CallOption) (*enterprise.ActivateResponse, error) {
 	return nil, unsupportedError("Activate")
@@ -565,6 +606,9 @@ func (c *versionBuilderClient) GetVersion(ctx context.Context, req *types.Empty,
 func (c *adminBuilderClient) InspectCluster(ctx context.Context, req *types.Empty, opts ...grpc.CallOption) (*admin.ClusterInfo, error) {
 	return nil, unsupportedError("InspectCluster")
 }
+func (c *adminBuilderClient) MigrationStatus(ctx context.Context, req *admin.MigrationStatusRequest, opts ...grpc.CallOption) (*admin.MigrationStatusResponse, error) {
+	return nil, unsupportedError("MigrationStatus")
+}
 
 func (c *transactionBuilderClient) BatchTransaction(ctx context.Context, req *transaction.BatchTransactionRequest, opts ...grpc.CallOption) (*transaction.TransactionInfo, error) {
 	return nil, unsupportedError("BatchTransaction")
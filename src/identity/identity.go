@@ -13,6 +13,9 @@ var (
 
 	// ErrAlreadyExists is returned if the client or connector ID already exists
 	ErrAlreadyExists = status.Error(codes.Internal, "ID already exists")
+
+	// ErrUserNotFound is returned if no user with the given email has logged in
+	ErrUserNotFound = status.Error(codes.NotFound, "user does not exist")
 )
 
 // IsErrInvalidID checks if an error is a ErrInvalidID
@@ -30,3 +33,11 @@ func IsErrAlreadyExists(err error) bool {
 	}
 	return strings.Contains(err.Error(), status.Convert(ErrAlreadyExists).Message())
 }
+
+// IsErrUserNotFound checks if an error is a ErrUserNotFound
+func IsErrUserNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), status.Convert(ErrUserNotFound).Message())
+}
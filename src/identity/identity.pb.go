@@ -33,11 +33,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // User represents an IDP user that has authenticated via OIDC
 type User struct {
-	Email                string     `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	LastAuthenticated    *time.Time `protobuf:"bytes,2,opt,name=last_authenticated,json=lastAuthenticated,proto3,stdtime" json:"last_authenticated,omitempty" db:"last_authenticated"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Email             string     `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	LastAuthenticated *time.Time `protobuf:"bytes,2,opt,name=last_authenticated,json=lastAuthenticated,proto3,stdtime" json:"last_authenticated,omitempty" db:"last_authenticated"`
+	// connector_id is the IDP connector the user most recently logged in with
+	ConnectorId          string   `protobuf:"bytes,3,opt,name=connector_id,json=connectorId,proto3" json:"connector_id,omitempty" db:"connector_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *User) Reset()         { *m = User{} }
@@ -87,6 +89,85 @@ func (m *User) GetLastAuthenticated() *time.Time {
 	return nil
 }
 
+func (m *User) GetConnectorId() string {
+	if m != nil {
+		return m.ConnectorId
+	}
+	return ""
+}
+
+// UserSession is an OIDC session held by a user, backed by a refresh token
+type UserSession struct {
+	ClientId             string     `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ConnectorId          string     `protobuf:"bytes,2,opt,name=connector_id,json=connectorId,proto3" json:"connector_id,omitempty"`
+	CreatedAt            *time.Time `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
+	LastUsed             *time.Time `protobuf:"bytes,4,opt,name=last_used,json=lastUsed,proto3,stdtime" json:"last_used,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *UserSession) Reset()         { *m = UserSession{} }
+func (m *UserSession) String() string { return proto.CompactTextString(m) }
+func (*UserSession) ProtoMessage()    {}
+func (*UserSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{1}
+}
+func (m *UserSession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserSession.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserSession.Merge(m, src)
+}
+func (m *UserSession) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserSession proto.InternalMessageInfo
+
+func (m *UserSession) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *UserSession) GetConnectorId() string {
+	if m != nil {
+		return m.ConnectorId
+	}
+	return ""
+}
+
+func (m *UserSession) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *UserSession) GetLastUsed() *time.Time {
+	if m != nil {
+		return m.LastUsed
+	}
+	return nil
+}
+
 // IdentityServerConfig is the configuration for the identity web server.
 // When the configuration is changed the web server is reloaded automatically.
 type IdentityServerConfig struct {
@@ -100,7 +181,7 @@ func (m *IdentityServerConfig) Reset()         { *m = IdentityServerConfig{} }
 func (m *IdentityServerConfig) String() string { return proto.CompactTextString(m) }
 func (*IdentityServerConfig) ProtoMessage()    {}
 func (*IdentityServerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{1}
+	return fileDescriptor_2433c1f46177a3e0, []int{2}
 }
 func (m *IdentityServerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -147,7 +228,7 @@ func (m *SetIdentityServerConfigRequest) Reset()         { *m = SetIdentityServe
 func (m *SetIdentityServerConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*SetIdentityServerConfigRequest) ProtoMessage()    {}
 func (*SetIdentityServerConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{2}
+	return fileDescriptor_2433c1f46177a3e0, []int{3}
 }
 func (m *SetIdentityServerConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -193,7 +274,7 @@ func (m *SetIdentityServerConfigResponse) Reset()         { *m = SetIdentityServ
 func (m *SetIdentityServerConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*SetIdentityServerConfigResponse) ProtoMessage()    {}
 func (*SetIdentityServerConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{3}
+	return fileDescriptor_2433c1f46177a3e0, []int{4}
 }
 func (m *SetIdentityServerConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,7 +313,7 @@ func (m *GetIdentityServerConfigRequest) Reset()         { *m = GetIdentityServe
 func (m *GetIdentityServerConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIdentityServerConfigRequest) ProtoMessage()    {}
 func (*GetIdentityServerConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{4}
+	return fileDescriptor_2433c1f46177a3e0, []int{5}
 }
 func (m *GetIdentityServerConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +353,7 @@ func (m *GetIdentityServerConfigResponse) Reset()         { *m = GetIdentityServ
 func (m *GetIdentityServerConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIdentityServerConfigResponse) ProtoMessage()    {}
 func (*GetIdentityServerConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{5}
+	return fileDescriptor_2433c1f46177a3e0, []int{6}
 }
 func (m *GetIdentityServerConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -332,7 +413,7 @@ func (m *IDPConnector) Reset()         { *m = IDPConnector{} }
 func (m *IDPConnector) String() string { return proto.CompactTextString(m) }
 func (*IDPConnector) ProtoMessage()    {}
 func (*IDPConnector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{6}
+	return fileDescriptor_2433c1f46177a3e0, []int{7}
 }
 func (m *IDPConnector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,7 +488,7 @@ func (m *CreateIDPConnectorRequest) Reset()         { *m = CreateIDPConnectorReq
 func (m *CreateIDPConnectorRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateIDPConnectorRequest) ProtoMessage()    {}
 func (*CreateIDPConnectorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{7}
+	return fileDescriptor_2433c1f46177a3e0, []int{8}
 }
 func (m *CreateIDPConnectorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,7 +534,7 @@ func (m *CreateIDPConnectorResponse) Reset()         { *m = CreateIDPConnectorRe
 func (m *CreateIDPConnectorResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateIDPConnectorResponse) ProtoMessage()    {}
 func (*CreateIDPConnectorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{8}
+	return fileDescriptor_2433c1f46177a3e0, []int{9}
 }
 func (m *CreateIDPConnectorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,7 +574,7 @@ func (m *UpdateIDPConnectorRequest) Reset()         { *m = UpdateIDPConnectorReq
 func (m *UpdateIDPConnectorRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateIDPConnectorRequest) ProtoMessage()    {}
 func (*UpdateIDPConnectorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{9}
+	return fileDescriptor_2433c1f46177a3e0, []int{10}
 }
 func (m *UpdateIDPConnectorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -539,7 +620,7 @@ func (m *UpdateIDPConnectorResponse) Reset()         { *m = UpdateIDPConnectorRe
 func (m *UpdateIDPConnectorResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateIDPConnectorResponse) ProtoMessage()    {}
 func (*UpdateIDPConnectorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{10}
+	return fileDescriptor_2433c1f46177a3e0, []int{11}
 }
 func (m *UpdateIDPConnectorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -578,7 +659,7 @@ func (m *ListIDPConnectorsRequest) Reset()         { *m = ListIDPConnectorsReque
 func (m *ListIDPConnectorsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListIDPConnectorsRequest) ProtoMessage()    {}
 func (*ListIDPConnectorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{11}
+	return fileDescriptor_2433c1f46177a3e0, []int{12}
 }
 func (m *ListIDPConnectorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,7 +699,7 @@ func (m *ListIDPConnectorsResponse) Reset()         { *m = ListIDPConnectorsResp
 func (m *ListIDPConnectorsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListIDPConnectorsResponse) ProtoMessage()    {}
 func (*ListIDPConnectorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{12}
+	return fileDescriptor_2433c1f46177a3e0, []int{13}
 }
 func (m *ListIDPConnectorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -665,7 +746,7 @@ func (m *GetIDPConnectorRequest) Reset()         { *m = GetIDPConnectorRequest{}
 func (m *GetIDPConnectorRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIDPConnectorRequest) ProtoMessage()    {}
 func (*GetIDPConnectorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{13}
+	return fileDescriptor_2433c1f46177a3e0, []int{14}
 }
 func (m *GetIDPConnectorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -712,7 +793,7 @@ func (m *GetIDPConnectorResponse) Reset()         { *m = GetIDPConnectorResponse
 func (m *GetIDPConnectorResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIDPConnectorResponse) ProtoMessage()    {}
 func (*GetIDPConnectorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{14}
+	return fileDescriptor_2433c1f46177a3e0, []int{15}
 }
 func (m *GetIDPConnectorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -759,7 +840,7 @@ func (m *DeleteIDPConnectorRequest) Reset()         { *m = DeleteIDPConnectorReq
 func (m *DeleteIDPConnectorRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteIDPConnectorRequest) ProtoMessage()    {}
 func (*DeleteIDPConnectorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{15}
+	return fileDescriptor_2433c1f46177a3e0, []int{16}
 }
 func (m *DeleteIDPConnectorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -805,7 +886,7 @@ func (m *DeleteIDPConnectorResponse) Reset()         { *m = DeleteIDPConnectorRe
 func (m *DeleteIDPConnectorResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteIDPConnectorResponse) ProtoMessage()    {}
 func (*DeleteIDPConnectorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{16}
+	return fileDescriptor_2433c1f46177a3e0, []int{17}
 }
 func (m *DeleteIDPConnectorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -849,7 +930,7 @@ func (m *OIDCClient) Reset()         { *m = OIDCClient{} }
 func (m *OIDCClient) String() string { return proto.CompactTextString(m) }
 func (*OIDCClient) ProtoMessage()    {}
 func (*OIDCClient) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{17}
+	return fileDescriptor_2433c1f46177a3e0, []int{18}
 }
 func (m *OIDCClient) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1005,7 @@ func (m *CreateOIDCClientRequest) Reset()         { *m = CreateOIDCClientRequest
 func (m *CreateOIDCClientRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateOIDCClientRequest) ProtoMessage()    {}
 func (*CreateOIDCClientRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{18}
+	return fileDescriptor_2433c1f46177a3e0, []int{19}
 }
 func (m *CreateOIDCClientRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -971,7 +1052,7 @@ func (m *CreateOIDCClientResponse) Reset()         { *m = CreateOIDCClientRespon
 func (m *CreateOIDCClientResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateOIDCClientResponse) ProtoMessage()    {}
 func (*CreateOIDCClientResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{19}
+	return fileDescriptor_2433c1f46177a3e0, []int{20}
 }
 func (m *CreateOIDCClientResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1018,7 +1099,7 @@ func (m *GetOIDCClientRequest) Reset()         { *m = GetOIDCClientRequest{} }
 func (m *GetOIDCClientRequest) String() string { return proto.CompactTextString(m) }
 func (*GetOIDCClientRequest) ProtoMessage()    {}
 func (*GetOIDCClientRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{20}
+	return fileDescriptor_2433c1f46177a3e0, []int{21}
 }
 func (m *GetOIDCClientRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1065,7 +1146,7 @@ func (m *GetOIDCClientResponse) Reset()         { *m = GetOIDCClientResponse{} }
 func (m *GetOIDCClientResponse) String() string { return proto.CompactTextString(m) }
 func (*GetOIDCClientResponse) ProtoMessage()    {}
 func (*GetOIDCClientResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{21}
+	return fileDescriptor_2433c1f46177a3e0, []int{22}
 }
 func (m *GetOIDCClientResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1111,7 +1192,7 @@ func (m *ListOIDCClientsRequest) Reset()         { *m = ListOIDCClientsRequest{}
 func (m *ListOIDCClientsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListOIDCClientsRequest) ProtoMessage()    {}
 func (*ListOIDCClientsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{22}
+	return fileDescriptor_2433c1f46177a3e0, []int{23}
 }
 func (m *ListOIDCClientsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1151,7 +1232,7 @@ func (m *ListOIDCClientsResponse) Reset()         { *m = ListOIDCClientsResponse
 func (m *ListOIDCClientsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListOIDCClientsResponse) ProtoMessage()    {}
 func (*ListOIDCClientsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{23}
+	return fileDescriptor_2433c1f46177a3e0, []int{24}
 }
 func (m *ListOIDCClientsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1198,7 +1279,7 @@ func (m *UpdateOIDCClientRequest) Reset()         { *m = UpdateOIDCClientRequest
 func (m *UpdateOIDCClientRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateOIDCClientRequest) ProtoMessage()    {}
 func (*UpdateOIDCClientRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{24}
+	return fileDescriptor_2433c1f46177a3e0, []int{25}
 }
 func (m *UpdateOIDCClientRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1244,7 +1325,7 @@ func (m *UpdateOIDCClientResponse) Reset()         { *m = UpdateOIDCClientRespon
 func (m *UpdateOIDCClientResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateOIDCClientResponse) ProtoMessage()    {}
 func (*UpdateOIDCClientResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{25}
+	return fileDescriptor_2433c1f46177a3e0, []int{26}
 }
 func (m *UpdateOIDCClientResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1284,7 +1365,7 @@ func (m *DeleteOIDCClientRequest) Reset()         { *m = DeleteOIDCClientRequest
 func (m *DeleteOIDCClientRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteOIDCClientRequest) ProtoMessage()    {}
 func (*DeleteOIDCClientRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{26}
+	return fileDescriptor_2433c1f46177a3e0, []int{27}
 }
 func (m *DeleteOIDCClientRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1330,7 +1411,7 @@ func (m *DeleteOIDCClientResponse) Reset()         { *m = DeleteOIDCClientRespon
 func (m *DeleteOIDCClientResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteOIDCClientResponse) ProtoMessage()    {}
 func (*DeleteOIDCClientResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{27}
+	return fileDescriptor_2433c1f46177a3e0, []int{28}
 }
 func (m *DeleteOIDCClientResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1359,24 +1440,24 @@ func (m *DeleteOIDCClientResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteOIDCClientResponse proto.InternalMessageInfo
 
-type DeleteAllRequest struct {
+type ListUsersRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteAllRequest) Reset()         { *m = DeleteAllRequest{} }
-func (m *DeleteAllRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteAllRequest) ProtoMessage()    {}
-func (*DeleteAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{28}
+func (m *ListUsersRequest) Reset()         { *m = ListUsersRequest{} }
+func (m *ListUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUsersRequest) ProtoMessage()    {}
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{29}
 }
-func (m *DeleteAllRequest) XXX_Unmarshal(b []byte) error {
+func (m *ListUsersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteAllRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListUsersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1386,36 +1467,37 @@ func (m *DeleteAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *DeleteAllRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteAllRequest.Merge(m, src)
+func (m *ListUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersRequest.Merge(m, src)
 }
-func (m *DeleteAllRequest) XXX_Size() int {
+func (m *ListUsersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteAllRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteAllRequest.DiscardUnknown(m)
+func (m *ListUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteAllRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListUsersRequest proto.InternalMessageInfo
 
-type DeleteAllResponse struct {
+type ListUsersResponse struct {
+	Users                []*User  `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteAllResponse) Reset()         { *m = DeleteAllResponse{} }
-func (m *DeleteAllResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteAllResponse) ProtoMessage()    {}
-func (*DeleteAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{29}
+func (m *ListUsersResponse) Reset()         { *m = ListUsersResponse{} }
+func (m *ListUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUsersResponse) ProtoMessage()    {}
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{30}
 }
-func (m *DeleteAllResponse) XXX_Unmarshal(b []byte) error {
+func (m *ListUsersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteAllResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListUsersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1425,161 +1507,459 @@ func (m *DeleteAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *DeleteAllResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteAllResponse.Merge(m, src)
+func (m *ListUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersResponse.Merge(m, src)
 }
-func (m *DeleteAllResponse) XXX_Size() int {
+func (m *ListUsersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteAllResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteAllResponse.DiscardUnknown(m)
+func (m *ListUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteAllResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListUsersResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*User)(nil), "identity_v2.User")
-	proto.RegisterType((*IdentityServerConfig)(nil), "identity_v2.IdentityServerConfig")
-	proto.RegisterType((*SetIdentityServerConfigRequest)(nil), "identity_v2.SetIdentityServerConfigRequest")
-	proto.RegisterType((*SetIdentityServerConfigResponse)(nil), "identity_v2.SetIdentityServerConfigResponse")
-	proto.RegisterType((*GetIdentityServerConfigRequest)(nil), "identity_v2.GetIdentityServerConfigRequest")
-	proto.RegisterType((*GetIdentityServerConfigResponse)(nil), "identity_v2.GetIdentityServerConfigResponse")
-	proto.RegisterType((*IDPConnector)(nil), "identity_v2.IDPConnector")
-	proto.RegisterType((*CreateIDPConnectorRequest)(nil), "identity_v2.CreateIDPConnectorRequest")
-	proto.RegisterType((*CreateIDPConnectorResponse)(nil), "identity_v2.CreateIDPConnectorResponse")
-	proto.RegisterType((*UpdateIDPConnectorRequest)(nil), "identity_v2.UpdateIDPConnectorRequest")
-	proto.RegisterType((*UpdateIDPConnectorResponse)(nil), "identity_v2.UpdateIDPConnectorResponse")
-	proto.RegisterType((*ListIDPConnectorsRequest)(nil), "identity_v2.ListIDPConnectorsRequest")
-	proto.RegisterType((*ListIDPConnectorsResponse)(nil), "identity_v2.ListIDPConnectorsResponse")
-	proto.RegisterType((*GetIDPConnectorRequest)(nil), "identity_v2.GetIDPConnectorRequest")
-	proto.RegisterType((*GetIDPConnectorResponse)(nil), "identity_v2.GetIDPConnectorResponse")
-	proto.RegisterType((*DeleteIDPConnectorRequest)(nil), "identity_v2.DeleteIDPConnectorRequest")
-	proto.RegisterType((*DeleteIDPConnectorResponse)(nil), "identity_v2.DeleteIDPConnectorResponse")
-	proto.RegisterType((*OIDCClient)(nil), "identity_v2.OIDCClient")
-	proto.RegisterType((*CreateOIDCClientRequest)(nil), "identity_v2.CreateOIDCClientRequest")
-	proto.RegisterType((*CreateOIDCClientResponse)(nil), "identity_v2.CreateOIDCClientResponse")
-	proto.RegisterType((*GetOIDCClientRequest)(nil), "identity_v2.GetOIDCClientRequest")
-	proto.RegisterType((*GetOIDCClientResponse)(nil), "identity_v2.GetOIDCClientResponse")
-	proto.RegisterType((*ListOIDCClientsRequest)(nil), "identity_v2.ListOIDCClientsRequest")
-	proto.RegisterType((*ListOIDCClientsResponse)(nil), "identity_v2.ListOIDCClientsResponse")
-	proto.RegisterType((*UpdateOIDCClientRequest)(nil), "identity_v2.UpdateOIDCClientRequest")
-	proto.RegisterType((*UpdateOIDCClientResponse)(nil), "identity_v2.UpdateOIDCClientResponse")
-	proto.RegisterType((*DeleteOIDCClientRequest)(nil), "identity_v2.DeleteOIDCClientRequest")
-	proto.RegisterType((*DeleteOIDCClientResponse)(nil), "identity_v2.DeleteOIDCClientResponse")
-	proto.RegisterType((*DeleteAllRequest)(nil), "identity_v2.DeleteAllRequest")
-	proto.RegisterType((*DeleteAllResponse)(nil), "identity_v2.DeleteAllResponse")
+func (m *ListUsersResponse) GetUsers() []*User {
+	if m != nil {
+		return m.Users
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("identity/identity.proto", fileDescriptor_2433c1f46177a3e0) }
-
-var fileDescriptor_2433c1f46177a3e0 = []byte{
-	// 928 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0x8e, 0x93, 0x6c, 0x50, 0x4e, 0xb7, 0xb0, 0x1d, 0x42, 0xec, 0x58, 0x4b, 0x92, 0x7a, 0xbb,
-	0x25, 0x68, 0x91, 0x23, 0xc2, 0x05, 0x02, 0x71, 0xd3, 0x4d, 0xa5, 0x10, 0xa8, 0x44, 0x95, 0xfe,
-	0x08, 0x01, 0x22, 0x72, 0xec, 0x69, 0xea, 0x2a, 0xb1, 0xc3, 0xcc, 0x24, 0x52, 0xdf, 0x80, 0x0b,
-	0x2e, 0x10, 0x2f, 0xc1, 0xab, 0x70, 0xc9, 0x13, 0x00, 0xea, 0x23, 0xf0, 0x04, 0xc8, 0xf6, 0x38,
-	0xb1, 0xc7, 0x3f, 0x69, 0x0b, 0xdc, 0x8d, 0xcf, 0x7c, 0xe7, 0x7c, 0x33, 0xdf, 0x39, 0xf9, 0x46,
-	0x01, 0xd9, 0xb6, 0xb0, 0xc3, 0x6c, 0x76, 0xdb, 0x0d, 0x17, 0xfa, 0x82, 0xb8, 0xcc, 0x45, 0x3b,
-	0xe1, 0xf7, 0x78, 0xd5, 0x53, 0x5b, 0x53, 0xd7, 0x9d, 0xce, 0x70, 0xd7, 0xdf, 0x9a, 0x2c, 0xaf,
-	0xba, 0xcc, 0x9e, 0x63, 0xca, 0x8c, 0xf9, 0x22, 0x40, 0xab, 0xb5, 0xa9, 0x3b, 0x75, 0xfd, 0x65,
-	0xd7, 0x5b, 0x05, 0x51, 0xed, 0x47, 0x09, 0xca, 0x17, 0x14, 0x13, 0x54, 0x83, 0x27, 0x78, 0x6e,
-	0xd8, 0x33, 0x45, 0x6a, 0x4b, 0x9d, 0xea, 0x28, 0xf8, 0x40, 0x37, 0x80, 0x66, 0x06, 0x65, 0x63,
-	0x63, 0xc9, 0xae, 0x3d, 0x2e, 0xd3, 0x60, 0xd8, 0x52, 0x8a, 0x6d, 0xa9, 0xb3, 0xd3, 0x53, 0xf5,
-	0x80, 0x52, 0x0f, 0x29, 0xf5, 0xf3, 0x90, 0xf2, 0x75, 0xeb, 0xef, 0x3f, 0x5a, 0xb2, 0x35, 0xf9,
-	0x54, 0x4b, 0x66, 0x6b, 0x3f, 0xff, 0xd9, 0x92, 0x46, 0x7b, 0xde, 0xc6, 0x51, 0x2c, 0xae, 0x43,
-	0x6d, 0xc8, 0x2f, 0x74, 0x86, 0xc9, 0x0a, 0x93, 0xbe, 0xeb, 0x5c, 0xd9, 0x53, 0x54, 0x87, 0x8a,
-	0x4d, 0xe9, 0x12, 0x13, 0x7e, 0x34, 0xfe, 0xa5, 0x7d, 0x0b, 0xcd, 0x33, 0xcc, 0xd2, 0x52, 0x46,
-	0xf8, 0x87, 0x25, 0xa6, 0x0c, 0x7d, 0x02, 0x15, 0xd3, 0x0f, 0xf8, 0x99, 0x3b, 0xbd, 0x7d, 0x3d,
-	0xa2, 0x98, 0x9e, 0x9a, 0xc9, 0x13, 0xb4, 0x7d, 0x68, 0x65, 0x16, 0xa7, 0x0b, 0xd7, 0xa1, 0x58,
-	0x6b, 0x43, 0x73, 0x90, 0xcb, 0xaf, 0x7d, 0x07, 0xad, 0x41, 0x7e, 0x91, 0x7f, 0x73, 0xc4, 0x9f,
-	0x24, 0x78, 0x3a, 0x3c, 0x3e, 0xed, 0xbb, 0x8e, 0x83, 0x4d, 0xe6, 0x12, 0xf4, 0x26, 0x14, 0x6d,
-	0x8b, 0x8b, 0x54, 0xb4, 0x2d, 0x84, 0xa0, 0xec, 0x18, 0x73, 0xec, 0xb7, 0xab, 0x3a, 0xf2, 0xd7,
-	0x5e, 0x8c, 0xdd, 0x2e, 0xb0, 0x52, 0x0a, 0x62, 0xde, 0x1a, 0x1d, 0xc0, 0x6e, 0x50, 0xf2, 0x12,
-	0x13, 0x6a, 0xbb, 0x8e, 0x52, 0x6e, 0x4b, 0x9d, 0xd2, 0x28, 0x1e, 0x44, 0x4d, 0x80, 0x1b, 0xea,
-	0x3a, 0xc1, 0x21, 0x94, 0x27, 0x7e, 0x7e, 0x24, 0xa2, 0x9d, 0x43, 0xa3, 0x4f, 0xb0, 0xc1, 0x70,
-	0xf4, 0x4c, 0x61, 0x27, 0x3e, 0x86, 0xaa, 0x19, 0xc6, 0xf8, 0x4d, 0x1b, 0xf1, 0x9b, 0x46, 0x93,
-	0x36, 0x58, 0xed, 0x39, 0xa8, 0x69, 0x55, 0x79, 0x0b, 0xce, 0xa1, 0x71, 0xb1, 0xb0, 0xfe, 0x07,
-	0xce, 0xb4, 0xaa, 0x9c, 0x53, 0x05, 0xe5, 0xc4, 0xa6, 0x2c, 0xba, 0x47, 0xc3, 0x86, 0x5f, 0x42,
-	0x23, 0x65, 0x6f, 0xdd, 0x6a, 0x58, 0x73, 0x50, 0x45, 0x6a, 0x97, 0xf2, 0x0f, 0x14, 0x01, 0x6b,
-	0x1d, 0xa8, 0x7b, 0x83, 0x94, 0x72, 0x49, 0xa1, 0xe7, 0xda, 0x08, 0xe4, 0x04, 0x92, 0xf3, 0x3f,
-	0x5a, 0x8f, 0x57, 0xd0, 0x38, 0xc6, 0x33, 0x9c, 0xae, 0xb2, 0x78, 0x80, 0xe7, 0xa0, 0xa6, 0x81,
-	0xb9, 0x78, 0xbf, 0x48, 0x00, 0x5f, 0x0d, 0x8f, 0xfb, 0xfd, 0x99, 0x8d, 0x9d, 0x44, 0x32, 0x7a,
-	0x01, 0xbb, 0x04, 0x5b, 0x36, 0xc1, 0x26, 0x1b, 0x2f, 0x89, 0x4d, 0x95, 0x62, 0xbb, 0xd4, 0xa9,
-	0x8e, 0x9e, 0x86, 0xc1, 0x0b, 0x62, 0x53, 0x0f, 0xc4, 0xc8, 0x92, 0x32, 0x6c, 0x8d, 0x17, 0x18,
-	0x13, 0xaa, 0x94, 0x02, 0x10, 0x0f, 0x9e, 0x7a, 0xb1, 0xf5, 0xec, 0x97, 0x23, 0xb3, 0x5f, 0x87,
-	0x0a, 0xc5, 0x26, 0xc1, 0x8c, 0x4f, 0x2f, 0xff, 0xd2, 0xbe, 0x00, 0x39, 0x98, 0xb1, 0xcd, 0xc9,
-	0xc2, 0xdb, 0x75, 0xa1, 0x62, 0xfa, 0x01, 0x2e, 0x98, 0x1c, 0x13, 0x2c, 0x82, 0xe7, 0x30, 0xed,
-	0x4b, 0x50, 0x92, 0xb5, 0x78, 0x03, 0x1e, 0x5c, 0xec, 0x10, 0x6a, 0x03, 0xcc, 0x92, 0xa7, 0x12,
-	0x35, 0xff, 0x1c, 0xde, 0x11, 0x70, 0x8f, 0x65, 0x54, 0xa0, 0xee, 0x0d, 0xf0, 0x66, 0x67, 0x3d,
-	0xda, 0x27, 0x20, 0x27, 0x76, 0x38, 0xcb, 0x87, 0xf0, 0x46, 0x90, 0x1e, 0x4e, 0x75, 0x26, 0x4d,
-	0x88, 0xf3, 0x24, 0x0f, 0x7e, 0x62, 0xff, 0x81, 0xe4, 0x2a, 0x28, 0xc9, 0x5a, 0x7c, 0xde, 0xde,
-	0x07, 0x39, 0x98, 0xc6, 0xed, 0x22, 0xaa, 0xa0, 0x24, 0xa1, 0xbc, 0x0c, 0x82, 0x67, 0xc1, 0xde,
-	0xd1, 0x6c, 0x16, 0x0a, 0xf2, 0x36, 0xec, 0x45, 0x62, 0x01, 0xb0, 0xf7, 0x2b, 0x40, 0xe9, 0xe8,
-	0x74, 0x88, 0x56, 0x20, 0x67, 0x3c, 0x1f, 0xe8, 0x55, 0xec, 0x3e, 0xf9, 0x2f, 0x98, 0xfa, 0xc1,
-	0xfd, 0xc0, 0xfc, 0x98, 0x05, 0x8f, 0x77, 0x70, 0x2f, 0xde, 0xc1, 0x43, 0x78, 0x07, 0x5b, 0x79,
-	0xa7, 0x80, 0x92, 0x36, 0x8d, 0x0e, 0x63, 0x55, 0x32, 0x5f, 0x07, 0xf5, 0xbd, 0xad, 0xb8, 0x28,
-	0x51, 0xd2, 0x9b, 0x05, 0xa2, 0xcc, 0x27, 0x41, 0x20, 0xca, 0x31, 0xf9, 0x02, 0xb2, 0x60, 0x2f,
-	0x61, 0xe5, 0xe8, 0x65, 0x2c, 0x3f, 0xeb, 0x19, 0x50, 0x0f, 0xb7, 0xc1, 0xd6, 0x2c, 0xdf, 0xc3,
-	0x5b, 0x82, 0x5d, 0xa3, 0x17, 0x09, 0xe9, 0x53, 0x2e, 0x72, 0x90, 0x0f, 0x8a, 0xca, 0x95, 0x74,
-	0x63, 0x41, 0xae, 0x4c, 0x6f, 0x17, 0xe4, 0xca, 0xb1, 0xf5, 0x02, 0x32, 0xe0, 0x99, 0xe8, 0x7b,
-	0xe8, 0x20, 0xa5, 0xad, 0x89, 0xdf, 0xa1, 0xfa, 0x72, 0x0b, 0x2a, 0x4a, 0x21, 0xfe, 0xce, 0x05,
-	0x8a, 0x0c, 0x4b, 0x11, 0x28, 0x32, 0xcd, 0xa2, 0x80, 0xbe, 0x86, 0xdd, 0x98, 0x91, 0xa2, 0x7d,
-	0x51, 0xe7, 0x64, 0x71, 0x2d, 0x0f, 0x12, 0x6d, 0xb4, 0x60, 0x9f, 0x42, 0xa3, 0xd3, 0x6d, 0x57,
-	0x68, 0x74, 0x86, 0x03, 0x07, 0xe2, 0x88, 0xee, 0x25, 0x88, 0x93, 0xe1, 0x83, 0x82, 0x38, 0x99,
-	0x16, 0x58, 0x40, 0x27, 0x50, 0x5d, 0x1b, 0x1e, 0x7a, 0x37, 0x25, 0x6b, 0x63, 0x8e, 0x6a, 0x33,
-	0x6b, 0x3b, 0xac, 0xf6, 0xfa, 0xb3, 0xdf, 0xee, 0x9a, 0xd2, 0xef, 0x77, 0x4d, 0xe9, 0xaf, 0xbb,
-	0xa6, 0xf4, 0x8d, 0x3e, 0xb5, 0xd9, 0xf5, 0x72, 0xa2, 0x9b, 0xee, 0xbc, 0xbb, 0x30, 0xcc, 0xeb,
-	0x5b, 0x0b, 0x93, 0xe8, 0x6a, 0xd5, 0xeb, 0x52, 0x62, 0xae, 0xff, 0x00, 0x4d, 0x2a, 0xfe, 0x7f,
-	0x8e, 0x8f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x58, 0xa3, 0x33, 0x08, 0x1c, 0x0d, 0x00, 0x00,
+type InspectUserRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *InspectUserRequest) Reset()         { *m = InspectUserRequest{} }
+func (m *InspectUserRequest) String() string { return proto.CompactTextString(m) }
+func (*InspectUserRequest) ProtoMessage()    {}
+func (*InspectUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{31}
+}
+func (m *InspectUserRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InspectUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InspectUserRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InspectUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectUserRequest.Merge(m, src)
+}
+func (m *InspectUserRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InspectUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectUserRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_InspectUserRequest proto.InternalMessageInfo
 
-// APIClient is the client API for API service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type APIClient interface {
-	SetIdentityServerConfig(ctx context.Context, in *SetIdentityServerConfigRequest, opts ...grpc.CallOption) (*SetIdentityServerConfigResponse, error)
-	GetIdentityServerConfig(ctx context.Context, in *GetIdentityServerConfigRequest, opts ...grpc.CallOption) (*GetIdentityServerConfigResponse, error)
-	CreateIDPConnector(ctx context.Context, in *CreateIDPConnectorRequest, opts ...grpc.CallOption) (*CreateIDPConnectorResponse, error)
-	UpdateIDPConnector(ctx context.Context, in *UpdateIDPConnectorRequest, opts ...grpc.CallOption) (*UpdateIDPConnectorResponse, error)
-	ListIDPConnectors(ctx context.Context, in *ListIDPConnectorsRequest, opts ...grpc.CallOption) (*ListIDPConnectorsResponse, error)
-	GetIDPConnector(ctx context.Context, in *GetIDPConnectorRequest, opts ...grpc.CallOption) (*GetIDPConnectorResponse, error)
-	DeleteIDPConnector(ctx context.Context, in *DeleteIDPConnectorRequest, opts ...grpc.CallOption) (*DeleteIDPConnectorResponse, error)
-	CreateOIDCClient(ctx context.Context, in *CreateOIDCClientRequest, opts ...grpc.CallOption) (*CreateOIDCClientResponse, error)
-	UpdateOIDCClient(ctx context.Context, in *UpdateOIDCClientRequest, opts ...grpc.CallOption) (*UpdateOIDCClientResponse, error)
-	GetOIDCClient(ctx context.Context, in *GetOIDCClientRequest, opts ...grpc.CallOption) (*GetOIDCClientResponse, error)
-	ListOIDCClients(ctx context.Context, in *ListOIDCClientsRequest, opts ...grpc.CallOption) (*ListOIDCClientsResponse, error)
-	DeleteOIDCClient(ctx context.Context, in *DeleteOIDCClientRequest, opts ...grpc.CallOption) (*DeleteOIDCClientResponse, error)
-	DeleteAll(ctx context.Context, in *DeleteAllRequest, opts ...grpc.CallOption) (*DeleteAllResponse, error)
+func (m *InspectUserRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
 }
 
-type aPIClient struct {
-	cc *grpc.ClientConn
+type InspectUserResponse struct {
+	User                 *User          `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Sessions             []*UserSession `protobuf:"bytes,2,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func NewAPIClient(cc *grpc.ClientConn) APIClient {
-	return &aPIClient{cc}
+func (m *InspectUserResponse) Reset()         { *m = InspectUserResponse{} }
+func (m *InspectUserResponse) String() string { return proto.CompactTextString(m) }
+func (*InspectUserResponse) ProtoMessage()    {}
+func (*InspectUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{32}
+}
+func (m *InspectUserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InspectUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InspectUserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InspectUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectUserResponse.Merge(m, src)
+}
+func (m *InspectUserResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *InspectUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectUserResponse.DiscardUnknown(m)
 }
 
-func (c *aPIClient) SetIdentityServerConfig(ctx context.Context, in *SetIdentityServerConfigRequest, opts ...grpc.CallOption) (*SetIdentityServerConfigResponse, error) {
-	out := new(SetIdentityServerConfigResponse)
-	err := c.cc.Invoke(ctx, "/identity_v2.API/SetIdentityServerConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_InspectUserResponse proto.InternalMessageInfo
+
+func (m *InspectUserResponse) GetUser() *User {
+	if m != nil {
+		return m.User
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) GetIdentityServerConfig(ctx context.Context, in *GetIdentityServerConfigRequest, opts ...grpc.CallOption) (*GetIdentityServerConfigResponse, error) {
-	out := new(GetIdentityServerConfigResponse)
+func (m *InspectUserResponse) GetSessions() []*UserSession {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+type DeleteUserRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteUserRequest) Reset()         { *m = DeleteUserRequest{} }
+func (m *DeleteUserRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteUserRequest) ProtoMessage()    {}
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{33}
+}
+func (m *DeleteUserRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteUserRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteUserRequest.Merge(m, src)
+}
+func (m *DeleteUserRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteUserRequest proto.InternalMessageInfo
+
+func (m *DeleteUserRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type DeleteUserResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteUserResponse) Reset()         { *m = DeleteUserResponse{} }
+func (m *DeleteUserResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteUserResponse) ProtoMessage()    {}
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{34}
+}
+func (m *DeleteUserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteUserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteUserResponse.Merge(m, src)
+}
+func (m *DeleteUserResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteUserResponse proto.InternalMessageInfo
+
+type DeleteAllRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAllRequest) Reset()         { *m = DeleteAllRequest{} }
+func (m *DeleteAllRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAllRequest) ProtoMessage()    {}
+func (*DeleteAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{35}
+}
+func (m *DeleteAllRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteAllRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAllRequest.Merge(m, src)
+}
+func (m *DeleteAllRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAllRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAllRequest proto.InternalMessageInfo
+
+type DeleteAllResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAllResponse) Reset()         { *m = DeleteAllResponse{} }
+func (m *DeleteAllResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAllResponse) ProtoMessage()    {}
+func (*DeleteAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{36}
+}
+func (m *DeleteAllResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteAllResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAllResponse.Merge(m, src)
+}
+func (m *DeleteAllResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAllResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAllResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*User)(nil), "identity_v2.User")
+	proto.RegisterType((*UserSession)(nil), "identity_v2.UserSession")
+	proto.RegisterType((*IdentityServerConfig)(nil), "identity_v2.IdentityServerConfig")
+	proto.RegisterType((*SetIdentityServerConfigRequest)(nil), "identity_v2.SetIdentityServerConfigRequest")
+	proto.RegisterType((*SetIdentityServerConfigResponse)(nil), "identity_v2.SetIdentityServerConfigResponse")
+	proto.RegisterType((*GetIdentityServerConfigRequest)(nil), "identity_v2.GetIdentityServerConfigRequest")
+	proto.RegisterType((*GetIdentityServerConfigResponse)(nil), "identity_v2.GetIdentityServerConfigResponse")
+	proto.RegisterType((*IDPConnector)(nil), "identity_v2.IDPConnector")
+	proto.RegisterType((*CreateIDPConnectorRequest)(nil), "identity_v2.CreateIDPConnectorRequest")
+	proto.RegisterType((*CreateIDPConnectorResponse)(nil), "identity_v2.CreateIDPConnectorResponse")
+	proto.RegisterType((*UpdateIDPConnectorRequest)(nil), "identity_v2.UpdateIDPConnectorRequest")
+	proto.RegisterType((*UpdateIDPConnectorResponse)(nil), "identity_v2.UpdateIDPConnectorResponse")
+	proto.RegisterType((*ListIDPConnectorsRequest)(nil), "identity_v2.ListIDPConnectorsRequest")
+	proto.RegisterType((*ListIDPConnectorsResponse)(nil), "identity_v2.ListIDPConnectorsResponse")
+	proto.RegisterType((*GetIDPConnectorRequest)(nil), "identity_v2.GetIDPConnectorRequest")
+	proto.RegisterType((*GetIDPConnectorResponse)(nil), "identity_v2.GetIDPConnectorResponse")
+	proto.RegisterType((*DeleteIDPConnectorRequest)(nil), "identity_v2.DeleteIDPConnectorRequest")
+	proto.RegisterType((*DeleteIDPConnectorResponse)(nil), "identity_v2.DeleteIDPConnectorResponse")
+	proto.RegisterType((*OIDCClient)(nil), "identity_v2.OIDCClient")
+	proto.RegisterType((*CreateOIDCClientRequest)(nil), "identity_v2.CreateOIDCClientRequest")
+	proto.RegisterType((*CreateOIDCClientResponse)(nil), "identity_v2.CreateOIDCClientResponse")
+	proto.RegisterType((*GetOIDCClientRequest)(nil), "identity_v2.GetOIDCClientRequest")
+	proto.RegisterType((*GetOIDCClientResponse)(nil), "identity_v2.GetOIDCClientResponse")
+	proto.RegisterType((*ListOIDCClientsRequest)(nil), "identity_v2.ListOIDCClientsRequest")
+	proto.RegisterType((*ListOIDCClientsResponse)(nil), "identity_v2.ListOIDCClientsResponse")
+	proto.RegisterType((*UpdateOIDCClientRequest)(nil), "identity_v2.UpdateOIDCClientRequest")
+	proto.RegisterType((*UpdateOIDCClientResponse)(nil), "identity_v2.UpdateOIDCClientResponse")
+	proto.RegisterType((*DeleteOIDCClientRequest)(nil), "identity_v2.DeleteOIDCClientRequest")
+	proto.RegisterType((*DeleteOIDCClientResponse)(nil), "identity_v2.DeleteOIDCClientResponse")
+	proto.RegisterType((*ListUsersRequest)(nil), "identity_v2.ListUsersRequest")
+	proto.RegisterType((*ListUsersResponse)(nil), "identity_v2.ListUsersResponse")
+	proto.RegisterType((*InspectUserRequest)(nil), "identity_v2.InspectUserRequest")
+	proto.RegisterType((*InspectUserResponse)(nil), "identity_v2.InspectUserResponse")
+	proto.RegisterType((*DeleteUserRequest)(nil), "identity_v2.DeleteUserRequest")
+	proto.RegisterType((*DeleteUserResponse)(nil), "identity_v2.DeleteUserResponse")
+	proto.RegisterType((*DeleteAllRequest)(nil), "identity_v2.DeleteAllRequest")
+	proto.RegisterType((*DeleteAllResponse)(nil), "identity_v2.DeleteAllResponse")
+}
+
+func init() { proto.RegisterFile("identity/identity.proto", fileDescriptor_2433c1f46177a3e0) }
+
+var fileDescriptor_2433c1f46177a3e0 = []byte{
+	// 1153 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0x66, 0x63, 0x27, 0xc4, 0xc7, 0x09, 0xd4, 0xd3, 0x34, 0xde, 0x0c, 0xc5, 0x76, 0xa6, 0xf9,
+	0x2b, 0x45, 0xb6, 0x30, 0x48, 0xfc, 0xa8, 0x08, 0xe5, 0x47, 0x0a, 0x86, 0x48, 0x8d, 0x36, 0x49,
+	0x85, 0x00, 0x11, 0x39, 0xbb, 0x53, 0x67, 0x2b, 0xc7, 0x6b, 0x76, 0xc6, 0x91, 0xf2, 0x0e, 0x5c,
+	0x20, 0x2e, 0x79, 0x1c, 0xae, 0xb8, 0x41, 0xe2, 0x09, 0x0a, 0xca, 0x23, 0xf4, 0x09, 0xd0, 0xec,
+	0xcc, 0x6e, 0x66, 0xff, 0xbc, 0x69, 0x81, 0xbb, 0x99, 0x73, 0xbe, 0x73, 0xbe, 0xf3, 0xe7, 0x39,
+	0x9b, 0x40, 0xdd, 0x75, 0xe8, 0x88, 0xbb, 0xfc, 0xaa, 0x13, 0x1e, 0xda, 0x63, 0xdf, 0xe3, 0x1e,
+	0xaa, 0x86, 0xf7, 0xd3, 0xcb, 0x2e, 0x6e, 0x0e, 0x3c, 0x6f, 0x30, 0xa4, 0x9d, 0x40, 0x75, 0x36,
+	0x79, 0xd6, 0xe1, 0xee, 0x05, 0x65, 0xbc, 0x7f, 0x31, 0x96, 0x68, 0xbc, 0x34, 0xf0, 0x06, 0x5e,
+	0x70, 0xec, 0x88, 0x93, 0x94, 0x92, 0xdf, 0x0c, 0x28, 0x9f, 0x30, 0xea, 0xa3, 0x25, 0x98, 0xa5,
+	0x17, 0x7d, 0x77, 0x68, 0x1a, 0x2d, 0x63, 0xab, 0x62, 0xc9, 0x0b, 0x7a, 0x0e, 0x68, 0xd8, 0x67,
+	0xfc, 0xb4, 0x3f, 0xe1, 0xe7, 0x82, 0xcb, 0xee, 0x73, 0xea, 0x98, 0x33, 0x2d, 0x63, 0xab, 0xda,
+	0xc5, 0x6d, 0x49, 0xd9, 0x0e, 0x29, 0xdb, 0xc7, 0x21, 0xe5, 0x4e, 0xf3, 0xe5, 0x8b, 0x66, 0xdd,
+	0x39, 0xfb, 0x8c, 0xa4, 0xad, 0xc9, 0xcf, 0x7f, 0x35, 0x0d, 0xab, 0x26, 0x14, 0xdb, 0xba, 0x1c,
+	0x7d, 0x02, 0x0b, 0xb6, 0x37, 0x1a, 0x51, 0x9b, 0x7b, 0xfe, 0xa9, 0xeb, 0x98, 0x25, 0x11, 0xc8,
+	0xce, 0xbd, 0x97, 0x2f, 0x9a, 0x35, 0xe1, 0x49, 0xd7, 0x11, 0xab, 0x1a, 0x5d, 0x7b, 0x0e, 0xf9,
+	0xc3, 0x80, 0xaa, 0x48, 0xe2, 0x88, 0x32, 0xe6, 0x7a, 0x23, 0xf4, 0x0e, 0x54, 0xec, 0xa1, 0x4b,
+	0x47, 0x5c, 0xb8, 0x91, 0xf9, 0xcc, 0x4b, 0x41, 0xcf, 0x41, 0xab, 0x09, 0x9a, 0x99, 0x40, 0xaf,
+	0xfb, 0x43, 0x5f, 0x00, 0xd8, 0x3e, 0x15, 0x41, 0x9d, 0xf6, 0xb9, 0x59, 0x2a, 0xcc, 0xb6, 0x1c,
+	0xa4, 0x54, 0x51, 0x36, 0xdb, 0x1c, 0x7d, 0x0e, 0x95, 0x20, 0xf1, 0x09, 0xa3, 0x8e, 0x59, 0xbe,
+	0xa5, 0xfd, 0xbc, 0x30, 0x39, 0x61, 0xd4, 0x21, 0x6d, 0x58, 0xea, 0xa9, 0xd6, 0x1e, 0x51, 0xff,
+	0x92, 0xfa, 0xbb, 0xde, 0xe8, 0x99, 0x3b, 0x40, 0xcb, 0x30, 0xe7, 0x32, 0x36, 0xa1, 0xbe, 0x4a,
+	0x4a, 0xdd, 0xc8, 0x77, 0xd0, 0x38, 0xa2, 0x3c, 0xcb, 0xc4, 0xa2, 0x3f, 0x4e, 0x28, 0xe3, 0xe8,
+	0x53, 0x98, 0xb3, 0x03, 0x41, 0x60, 0x59, 0xed, 0xae, 0xb6, 0xb5, 0xd9, 0x69, 0x67, 0x5a, 0x2a,
+	0x03, 0xb2, 0x0a, 0xcd, 0x5c, 0xe7, 0x6c, 0xec, 0x8d, 0x18, 0x25, 0x2d, 0x68, 0xec, 0x4f, 0xe5,
+	0x27, 0xdf, 0x43, 0x73, 0x7f, 0xba, 0x93, 0x7f, 0x13, 0xe2, 0x4f, 0x06, 0x2c, 0xf4, 0xf6, 0x0e,
+	0x77, 0xc3, 0x16, 0xa2, 0xb7, 0x60, 0x26, 0xea, 0xfc, 0x8c, 0xeb, 0x20, 0x04, 0xe5, 0x51, 0xff,
+	0x82, 0xaa, 0x5e, 0x07, 0x67, 0x21, 0xe3, 0x57, 0x63, 0x2a, 0xc7, 0xcc, 0x0a, 0xce, 0x68, 0x0d,
+	0x16, 0xa5, 0xcb, 0xa7, 0xd4, 0x17, 0x93, 0x14, 0xf4, 0xae, 0x64, 0xc5, 0x85, 0xa8, 0x01, 0xf0,
+	0x9c, 0x79, 0x23, 0x19, 0x84, 0x39, 0x1b, 0xd8, 0x6b, 0x12, 0x72, 0x0c, 0x2b, 0xbb, 0xc1, 0x28,
+	0xe8, 0x31, 0x85, 0x9d, 0xf8, 0x18, 0x2a, 0xd1, 0xa8, 0xa9, 0x4c, 0x57, 0xe2, 0x99, 0xea, 0x46,
+	0x37, 0x58, 0x72, 0x1f, 0x70, 0x96, 0x57, 0xd5, 0x82, 0x63, 0x58, 0x39, 0x19, 0x3b, 0xff, 0x03,
+	0x67, 0x96, 0x57, 0xc5, 0x89, 0xc1, 0x3c, 0x70, 0x19, 0xd7, 0x75, 0x2c, 0x6c, 0xf8, 0x53, 0x58,
+	0xc9, 0xd0, 0x45, 0xad, 0x86, 0x88, 0x83, 0x99, 0x46, 0xab, 0x34, 0x3d, 0x20, 0x0d, 0x4c, 0xb6,
+	0x60, 0x59, 0x0c, 0x52, 0x46, 0x92, 0x89, 0x9e, 0x13, 0x0b, 0xea, 0x29, 0xa4, 0xe2, 0x7f, 0xed,
+	0x7a, 0x3c, 0x82, 0x95, 0x3d, 0x3a, 0xa4, 0x9c, 0xde, 0x26, 0x80, 0xfb, 0x80, 0xb3, 0xc0, 0xaa,
+	0x78, 0xbf, 0x18, 0x00, 0x4f, 0x7a, 0x7b, 0xbb, 0xbb, 0xc1, 0xbb, 0x94, 0x9a, 0xd8, 0x07, 0xb0,
+	0xe8, 0x53, 0xc7, 0xf5, 0xa9, 0xcd, 0x4f, 0x27, 0xbe, 0xcb, 0xcc, 0x99, 0x56, 0x69, 0xab, 0x62,
+	0x2d, 0x84, 0xc2, 0x13, 0xdf, 0x65, 0x02, 0xc4, 0xfd, 0x09, 0x13, 0xef, 0xd4, 0x98, 0x52, 0x9f,
+	0x99, 0x25, 0x09, 0x52, 0xc2, 0x43, 0x21, 0x8b, 0x66, 0xbf, 0xac, 0xcd, 0xfe, 0x32, 0xcc, 0x31,
+	0x6a, 0xfb, 0x94, 0xab, 0xe9, 0x55, 0x37, 0xf2, 0x15, 0xd4, 0xe5, 0x8c, 0xdd, 0x44, 0x16, 0x66,
+	0xd7, 0x81, 0x39, 0xf9, 0x84, 0xaa, 0x82, 0xd5, 0x63, 0x05, 0xd3, 0xf0, 0x0a, 0x46, 0xbe, 0x06,
+	0x33, 0xed, 0x4b, 0x35, 0xe0, 0x95, 0x9d, 0x6d, 0xc0, 0xd2, 0x3e, 0xe5, 0xe9, 0xa8, 0x92, 0x35,
+	0xff, 0x12, 0xee, 0x25, 0x70, 0xaf, 0xcb, 0x68, 0xc2, 0xb2, 0x18, 0xe0, 0x1b, 0x4d, 0x34, 0xda,
+	0x07, 0x50, 0x4f, 0x69, 0x14, 0xcb, 0x07, 0xf0, 0xa6, 0x34, 0x0f, 0xa7, 0x3a, 0x97, 0x26, 0xc4,
+	0x89, 0x92, 0xcb, 0x9f, 0xd8, 0x7f, 0x50, 0x72, 0x0c, 0x66, 0xda, 0x97, 0x9a, 0xb7, 0x87, 0x50,
+	0x97, 0xd3, 0x58, 0x5c, 0x44, 0x0c, 0x66, 0x1a, 0xaa, 0xdc, 0x20, 0xb8, 0x23, 0x92, 0x17, 0xdb,
+	0x36, 0x2a, 0xc8, 0x63, 0xa8, 0x69, 0x32, 0x55, 0x8a, 0x4d, 0x98, 0x9d, 0x08, 0x81, 0x2a, 0x44,
+	0x2d, 0x16, 0xbb, 0x80, 0x5a, 0x52, 0x4f, 0xde, 0x03, 0xd4, 0x1b, 0xb1, 0xb1, 0x98, 0x69, 0x21,
+	0x55, 0x31, 0x65, 0x7e, 0x8e, 0x10, 0x1f, 0xee, 0xc6, 0xb0, 0x8a, 0x6b, 0x1d, 0xca, 0xc2, 0x97,
+	0x2a, 0x53, 0x06, 0x55, 0xa0, 0x46, 0x1f, 0xc1, 0x3c, 0x93, 0x5f, 0x08, 0xf2, 0xe7, 0x54, 0xed,
+	0x9a, 0x29, 0xa8, 0xfa, 0x84, 0xb0, 0x22, 0x24, 0x79, 0x08, 0x35, 0x59, 0x8d, 0xe2, 0xf0, 0x96,
+	0x00, 0xe9, 0xd0, 0x9b, 0x92, 0x49, 0xe9, 0xf6, 0x70, 0x18, 0x96, 0xec, 0x2e, 0xd4, 0x34, 0x99,
+	0x04, 0x76, 0x7f, 0x5d, 0x80, 0xd2, 0xf6, 0x61, 0x0f, 0x5d, 0x42, 0x3d, 0x67, 0xe3, 0xa2, 0x47,
+	0xb1, 0x80, 0xa7, 0x2f, 0x7d, 0xfc, 0xfe, 0xed, 0xc0, 0x2a, 0xcc, 0x37, 0xd0, 0xa5, 0x7c, 0x31,
+	0x8b, 0x79, 0xf7, 0x5f, 0x85, 0x77, 0xbf, 0x90, 0x77, 0x00, 0x28, 0xbd, 0xd9, 0xd0, 0x46, 0xcc,
+	0x4b, 0xee, 0x42, 0xc5, 0x9b, 0x85, 0x38, 0x9d, 0x28, 0xbd, 0xce, 0x12, 0x44, 0xb9, 0x5b, 0x14,
+	0x6f, 0x16, 0xe2, 0x22, 0x22, 0x47, 0xfe, 0x22, 0x74, 0x2d, 0x43, 0xeb, 0x31, 0xfb, 0xbc, 0xcd,
+	0x89, 0x37, 0x8a, 0x60, 0x11, 0xcb, 0x0f, 0xf0, 0x76, 0x62, 0xc3, 0xa1, 0x07, 0xa9, 0xd2, 0x67,
+	0x24, 0xb2, 0x36, 0x1d, 0xa4, 0x97, 0x2b, 0xbd, 0xc0, 0x12, 0xe5, 0xca, 0x5d, 0x87, 0x78, 0xb3,
+	0x10, 0x17, 0x11, 0xf5, 0xe1, 0x4e, 0x72, 0x55, 0xa0, 0xb5, 0x8c, 0xb6, 0xa6, 0x9e, 0x2e, 0xbc,
+	0x5e, 0x80, 0xd2, 0x29, 0x92, 0x4f, 0x63, 0x82, 0x22, 0xe7, 0x15, 0xc6, 0xeb, 0x05, 0xa8, 0x88,
+	0xe2, 0x1b, 0x58, 0x8c, 0xed, 0x1e, 0xb4, 0x9a, 0xac, 0x73, 0xda, 0x39, 0x99, 0x06, 0xd1, 0x1b,
+	0x9d, 0xd8, 0x38, 0x89, 0x46, 0x67, 0x6f, 0x2a, 0xbc, 0x36, 0x1d, 0xa4, 0x17, 0x27, 0xf9, 0xe0,
+	0x27, 0x8a, 0x93, 0xb3, 0x3a, 0xf0, 0x7a, 0x01, 0x2a, 0xa2, 0x38, 0x80, 0x4a, 0xb4, 0x23, 0xd0,
+	0xbb, 0xa9, 0xb8, 0xf4, 0x7d, 0x82, 0x1b, 0x79, 0xea, 0xc8, 0x9b, 0x05, 0x55, 0x6d, 0x0f, 0xa0,
+	0x66, 0xcc, 0x20, 0xbd, 0x4d, 0x70, 0x2b, 0x1f, 0x10, 0xf9, 0x7c, 0x02, 0x70, 0xf3, 0x78, 0xa3,
+	0x46, 0x46, 0x62, 0xba, 0xc7, 0x66, 0xae, 0x5e, 0x4f, 0x39, 0x7a, 0xe3, 0x13, 0x29, 0x27, 0xf7,
+	0x01, 0x6e, 0xe4, 0xa9, 0x43, 0x6f, 0x3b, 0x8f, 0x7f, 0xbf, 0x6e, 0x18, 0x7f, 0x5e, 0x37, 0x8c,
+	0xbf, 0xaf, 0x1b, 0xc6, 0xb7, 0xed, 0x81, 0xcb, 0xcf, 0x27, 0x67, 0x6d, 0xdb, 0xbb, 0xe8, 0x8c,
+	0xfb, 0xf6, 0xf9, 0x95, 0x43, 0x7d, 0xfd, 0x74, 0xd9, 0xed, 0x30, 0xdf, 0x8e, 0xfe, 0x61, 0x70,
+	0x36, 0x17, 0xfc, 0xd5, 0xf9, 0xe1, 0x3f, 0x03, 0x00, 0xd2, 0x01, 0xf7, 0x50, 0x4c, 0x10, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// APIClient is the client API for API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIClient interface {
+	SetIdentityServerConfig(ctx context.Context, in *SetIdentityServerConfigRequest, opts ...grpc.CallOption) (*SetIdentityServerConfigResponse, error)
+	GetIdentityServerConfig(ctx context.Context, in *GetIdentityServerConfigRequest, opts ...grpc.CallOption) (*GetIdentityServerConfigResponse, error)
+	CreateIDPConnector(ctx context.Context, in *CreateIDPConnectorRequest, opts ...grpc.CallOption) (*CreateIDPConnectorResponse, error)
+	UpdateIDPConnector(ctx context.Context, in *UpdateIDPConnectorRequest, opts ...grpc.CallOption) (*UpdateIDPConnectorResponse, error)
+	ListIDPConnectors(ctx context.Context, in *ListIDPConnectorsRequest, opts ...grpc.CallOption) (*ListIDPConnectorsResponse, error)
+	GetIDPConnector(ctx context.Context, in *GetIDPConnectorRequest, opts ...grpc.CallOption) (*GetIDPConnectorResponse, error)
+	DeleteIDPConnector(ctx context.Context, in *DeleteIDPConnectorRequest, opts ...grpc.CallOption) (*DeleteIDPConnectorResponse, error)
+	CreateOIDCClient(ctx context.Context, in *CreateOIDCClientRequest, opts ...grpc.CallOption) (*CreateOIDCClientResponse, error)
+	UpdateOIDCClient(ctx context.Context, in *UpdateOIDCClientRequest, opts ...grpc.CallOption) (*UpdateOIDCClientResponse, error)
+	GetOIDCClient(ctx context.Context, in *GetOIDCClientRequest, opts ...grpc.CallOption) (*GetOIDCClientResponse, error)
+	ListOIDCClients(ctx context.Context, in *ListOIDCClientsRequest, opts ...grpc.CallOption) (*ListOIDCClientsResponse, error)
+	DeleteOIDCClient(ctx context.Context, in *DeleteOIDCClientRequest, opts ...grpc.CallOption) (*DeleteOIDCClientResponse, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	InspectUser(ctx context.Context, in *InspectUserRequest, opts ...grpc.CallOption) (*InspectUserResponse, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	DeleteAll(ctx context.Context, in *DeleteAllRequest, opts ...grpc.CallOption) (*DeleteAllResponse, error)
+}
+
+type aPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAPIClient(cc *grpc.ClientConn) APIClient {
+	return &aPIClient{cc}
+}
+
+func (c *aPIClient) SetIdentityServerConfig(ctx context.Context, in *SetIdentityServerConfigRequest, opts ...grpc.CallOption) (*SetIdentityServerConfigResponse, error) {
+	out := new(SetIdentityServerConfigResponse)
+	err := c.cc.Invoke(ctx, "/identity_v2.API/SetIdentityServerConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetIdentityServerConfig(ctx context.Context, in *GetIdentityServerConfigRequest, opts ...grpc.CallOption) (*GetIdentityServerConfigResponse, error) {
+	out := new(GetIdentityServerConfigResponse)
 	err := c.cc.Invoke(ctx, "/identity_v2.API/GetIdentityServerConfig", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -1677,6 +2057,33 @@ func (c *aPIClient) DeleteOIDCClient(ctx context.Context, in *DeleteOIDCClientRe
 	return out, nil
 }
 
+func (c *aPIClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, "/identity_v2.API/ListUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) InspectUser(ctx context.Context, in *InspectUserRequest, opts ...grpc.CallOption) (*InspectUserResponse, error) {
+	out := new(InspectUserResponse)
+	err := c.cc.Invoke(ctx, "/identity_v2.API/InspectUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, "/identity_v2.API/DeleteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) DeleteAll(ctx context.Context, in *DeleteAllRequest, opts ...grpc.CallOption) (*DeleteAllResponse, error) {
 	out := new(DeleteAllResponse)
 	err := c.cc.Invoke(ctx, "/identity_v2.API/DeleteAll", in, out, opts...)
@@ -1700,6 +2107,9 @@ type APIServer interface {
 	GetOIDCClient(context.Context, *GetOIDCClientRequest) (*GetOIDCClientResponse, error)
 	ListOIDCClients(context.Context, *ListOIDCClientsRequest) (*ListOIDCClientsResponse, error)
 	DeleteOIDCClient(context.Context, *DeleteOIDCClientRequest) (*DeleteOIDCClientResponse, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	InspectUser(context.Context, *InspectUserRequest) (*InspectUserResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
 	DeleteAll(context.Context, *DeleteAllRequest) (*DeleteAllResponse, error)
 }
 
@@ -1743,6 +2153,15 @@ func (*UnimplementedAPIServer) ListOIDCClients(ctx context.Context, req *ListOID
 func (*UnimplementedAPIServer) DeleteOIDCClient(ctx context.Context, req *DeleteOIDCClientRequest) (*DeleteOIDCClientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteOIDCClient not implemented")
 }
+func (*UnimplementedAPIServer) ListUsers(ctx context.Context, req *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (*UnimplementedAPIServer) InspectUser(ctx context.Context, req *InspectUserRequest) (*InspectUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectUser not implemented")
+}
+func (*UnimplementedAPIServer) DeleteUser(ctx context.Context, req *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
 func (*UnimplementedAPIServer) DeleteAll(ctx context.Context, req *DeleteAllRequest) (*DeleteAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
 }
@@ -1967,35 +2386,89 @@ func _API_DeleteOIDCClient_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAllRequest)
+func _API_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).DeleteAll(ctx, in)
+		return srv.(APIServer).ListUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/identity_v2.API/DeleteAll",
+		FullMethod: "/identity_v2.API/ListUsers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteAll(ctx, req.(*DeleteAllRequest))
+		return srv.(APIServer).ListUsers(ctx, req.(*ListUsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _API_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "identity_v2.API",
-	HandlerType: (*APIServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetIdentityServerConfig",
-			Handler:    _API_SetIdentityServerConfig_Handler,
-		},
-		{
-			MethodName: "GetIdentityServerConfig",
-			Handler:    _API_GetIdentityServerConfig_Handler,
+func _API_InspectUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/identity_v2.API/InspectUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectUser(ctx, req.(*InspectUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/identity_v2.API/DeleteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/identity_v2.API/DeleteAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteAll(ctx, req.(*DeleteAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _API_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "identity_v2.API",
+	HandlerType: (*APIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetIdentityServerConfig",
+			Handler:    _API_SetIdentityServerConfig_Handler,
+		},
+		{
+			MethodName: "GetIdentityServerConfig",
+			Handler:    _API_GetIdentityServerConfig_Handler,
 		},
 		{
 			MethodName: "CreateIDPConnector",
@@ -2037,6 +2510,18 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteOIDCClient",
 			Handler:    _API_DeleteOIDCClient_Handler,
 		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _API_ListUsers_Handler,
+		},
+		{
+			MethodName: "InspectUser",
+			Handler:    _API_InspectUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _API_DeleteUser_Handler,
+		},
 		{
 			MethodName: "DeleteAll",
 			Handler:    _API_DeleteAll_Handler,
@@ -2070,6 +2555,13 @@ func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ConnectorId) > 0 {
+		i -= len(m.ConnectorId)
+		copy(dAtA[i:], m.ConnectorId)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.ConnectorId)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.LastAuthenticated != nil {
 		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastAuthenticated, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastAuthenticated):])
 		if err1 != nil {
@@ -2090,6 +2582,67 @@ func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UserSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastUsed != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUsed, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUsed):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintIdentity(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAt != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintIdentity(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectorId) > 0 {
+		i -= len(m.ConnectorId)
+		copy(dAtA[i:], m.ConnectorId)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.ConnectorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *IdentityServerConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3062,7 +3615,7 @@ func (m *DeleteOIDCClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteAllRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListUsersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3072,12 +3625,12 @@ func (m *DeleteAllRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteAllRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListUsersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListUsersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3089,7 +3642,7 @@ func (m *DeleteAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteAllResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListUsersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3099,12 +3652,12 @@ func (m *DeleteAllResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteAllResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListUsersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListUsersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3113,97 +3666,345 @@ func (m *DeleteAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Users) > 0 {
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIdentity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintIdentity(dAtA []byte, offset int, v uint64) int {
-	offset -= sovIdentity(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *InspectUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *User) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovIdentity(uint64(l))
-	}
-	if m.LastAuthenticated != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastAuthenticated)
-		n += 1 + l + sovIdentity(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *InspectUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IdentityServerConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *InspectUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovIdentity(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SetIdentityServerConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovIdentity(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *InspectUserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SetIdentityServerConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *InspectUserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InspectUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIdentity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.User != nil {
+		{
+			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIdentity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetIdentityServerConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *GetIdentityServerConfigResponse) Size() (n int) {
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteUserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteUserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIdentity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIdentity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *User) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if m.LastAuthenticated != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastAuthenticated)
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	l = len(m.ConnectorId)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UserSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	l = len(m.ConnectorId)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if m.LastUsed != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUsed)
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IdentityServerConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetIdentityServerConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetIdentityServerConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetIdentityServerConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetIdentityServerConfigResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3582,7 +4383,7 @@ func (m *DeleteOIDCClientResponse) Size() (n int) {
 	return n
 }
 
-func (m *DeleteAllRequest) Size() (n int) {
+func (m *ListUsersRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3594,47 +4395,143 @@ func (m *DeleteAllRequest) Size() (n int) {
 	return n
 }
 
-func (m *DeleteAllResponse) Size() (n int) {
+func (m *ListUsersResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.Size()
+			n += 1 + l + sovIdentity(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func sovIdentity(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozIdentity(x uint64) (n int) {
-	return sovIdentity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *InspectUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *User) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowIdentity
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *InspectUserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovIdentity(uint64(l))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteUserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovIdentity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIdentity(x uint64) (n int) {
+	return sovIdentity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *User) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
 			return fmt.Errorf("proto: User: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
@@ -3709,6 +4606,38 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIdentity(dAtA[iNdEx:])
@@ -3731,7 +4660,7 @@ func (m *User) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IdentityServerConfig) Unmarshal(dAtA []byte) error {
+func (m *UserSession) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3754,15 +4683,15 @@ func (m *IdentityServerConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IdentityServerConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserSession: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdentityServerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserSession: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3790,7 +4719,111 @@ func (m *IdentityServerConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUsed == nil {
+				m.LastUsed = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUsed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3814,7 +4847,7 @@ func (m *IdentityServerConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetIdentityServerConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *IdentityServerConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3837,17 +4870,17 @@ func (m *SetIdentityServerConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetIdentityServerConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IdentityServerConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetIdentityServerConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IdentityServerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIdentity
@@ -3857,27 +4890,23 @@ func (m *SetIdentityServerConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIdentity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIdentity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &IdentityServerConfig{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3901,7 +4930,7 @@ func (m *SetIdentityServerConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetIdentityServerConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *SetIdentityServerConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3924,20 +4953,107 @@ func (m *SetIdentityServerConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetIdentityServerConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetIdentityServerConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetIdentityServerConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetIdentityServerConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIdentity(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthIdentity
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &IdentityServerConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetIdentityServerConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetIdentityServerConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetIdentityServerConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -4264,8 +5380,335 @@ func (m *IDPConnector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JsonConfig = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.JsonConfig = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateIDPConnectorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateIDPConnectorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateIDPConnectorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Connector == nil {
+				m.Connector = &IDPConnector{}
+			}
+			if err := m.Connector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateIDPConnectorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateIDPConnectorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateIDPConnectorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateIDPConnectorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateIDPConnectorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateIDPConnectorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Connector == nil {
+				m.Connector = &IDPConnector{}
+			}
+			if err := m.Connector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateIDPConnectorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateIDPConnectorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateIDPConnectorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListIDPConnectorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListIDPConnectorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListIDPConnectorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIdentity(dAtA[iNdEx:])
@@ -4288,7 +5731,7 @@ func (m *IDPConnector) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateIDPConnectorRequest) Unmarshal(dAtA []byte) error {
+func (m *ListIDPConnectorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4311,15 +5754,15 @@ func (m *CreateIDPConnectorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateIDPConnectorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListIDPConnectorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateIDPConnectorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListIDPConnectorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Connector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Connectors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4346,10 +5789,8 @@ func (m *CreateIDPConnectorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Connector == nil {
-				m.Connector = &IDPConnector{}
-			}
-			if err := m.Connector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Connectors = append(m.Connectors, &IDPConnector{})
+			if err := m.Connectors[len(m.Connectors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4375,7 +5816,7 @@ func (m *CreateIDPConnectorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateIDPConnectorResponse) Unmarshal(dAtA []byte) error {
+func (m *GetIDPConnectorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4398,12 +5839,44 @@ func (m *CreateIDPConnectorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateIDPConnectorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetIDPConnectorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateIDPConnectorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetIDPConnectorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIdentity(dAtA[iNdEx:])
@@ -4426,7 +5899,7 @@ func (m *CreateIDPConnectorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateIDPConnectorRequest) Unmarshal(dAtA []byte) error {
+func (m *GetIDPConnectorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4449,10 +5922,10 @@ func (m *UpdateIDPConnectorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateIDPConnectorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetIDPConnectorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateIDPConnectorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetIDPConnectorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4513,7 +5986,7 @@ func (m *UpdateIDPConnectorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateIDPConnectorResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteIDPConnectorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4536,12 +6009,44 @@ func (m *UpdateIDPConnectorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateIDPConnectorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteIDPConnectorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateIDPConnectorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteIDPConnectorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIdentity(dAtA[iNdEx:])
@@ -4564,7 +6069,7 @@ func (m *UpdateIDPConnectorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListIDPConnectorsRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteIDPConnectorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4587,10 +6092,10 @@ func (m *ListIDPConnectorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListIDPConnectorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteIDPConnectorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListIDPConnectorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteIDPConnectorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4615,7 +6120,7 @@ func (m *ListIDPConnectorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListIDPConnectorsResponse) Unmarshal(dAtA []byte) error {
+func (m *OIDCClient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4638,17 +6143,17 @@ func (m *ListIDPConnectorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListIDPConnectorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OIDCClient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListIDPConnectorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OIDCClient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Connectors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIdentity
@@ -4658,25 +6163,151 @@ func (m *ListIDPConnectorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedirectUris", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedirectUris = append(m.RedirectUris, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedPeers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedPeers = append(m.TrustedPeers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIdentity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIdentity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Connectors = append(m.Connectors, &IDPConnector{})
-			if err := m.Connectors[len(m.Connectors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4700,7 +6331,7 @@ func (m *ListIDPConnectorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetIDPConnectorRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateOIDCClientRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4723,17 +6354,17 @@ func (m *GetIDPConnectorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetIDPConnectorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateOIDCClientRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetIDPConnectorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateOIDCClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIdentity
@@ -4743,23 +6374,27 @@ func (m *GetIDPConnectorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIdentity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIdentity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.Client == nil {
+				m.Client = &OIDCClient{}
+			}
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4783,7 +6418,7 @@ func (m *GetIDPConnectorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetIDPConnectorResponse) Unmarshal(dAtA []byte) error {
+func (m *CreateOIDCClientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4806,15 +6441,15 @@ func (m *GetIDPConnectorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetIDPConnectorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateOIDCClientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetIDPConnectorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateOIDCClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Connector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4841,10 +6476,10 @@ func (m *GetIDPConnectorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Connector == nil {
-				m.Connector = &IDPConnector{}
+			if m.Client == nil {
+				m.Client = &OIDCClient{}
 			}
-			if err := m.Connector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4870,7 +6505,7 @@ func (m *GetIDPConnectorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteIDPConnectorRequest) Unmarshal(dAtA []byte) error {
+func (m *GetOIDCClientRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4893,10 +6528,10 @@ func (m *DeleteIDPConnectorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteIDPConnectorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOIDCClientRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteIDPConnectorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOIDCClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4953,58 +6588,7 @@ func (m *DeleteIDPConnectorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteIDPConnectorResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowIdentity
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteIDPConnectorResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteIDPConnectorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIdentity(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthIdentity
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OIDCClient) Unmarshal(dAtA []byte) error {
+func (m *GetOIDCClientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5027,49 +6611,17 @@ func (m *OIDCClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OIDCClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOIDCClientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OIDCClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOIDCClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIdentity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIdentity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthIdentity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedirectUris", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIdentity
@@ -5079,120 +6631,79 @@ func (m *OIDCClient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIdentity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIdentity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RedirectUris = append(m.RedirectUris, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustedPeers", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIdentity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIdentity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthIdentity
+			if m.Client == nil {
+				m.Client = &OIDCClient{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TrustedPeers = append(m.TrustedPeers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIdentity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIdentity
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthIdentity
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIdentity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIdentity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthIdentity
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListOIDCClientsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Secret = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListOIDCClientsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListOIDCClientsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIdentity(dAtA[iNdEx:])
@@ -5215,7 +6726,7 @@ func (m *OIDCClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateOIDCClientRequest) Unmarshal(dAtA []byte) error {
+func (m *ListOIDCClientsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5238,15 +6749,15 @@ func (m *CreateOIDCClientRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateOIDCClientRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListOIDCClientsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateOIDCClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListOIDCClientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5273,10 +6784,8 @@ func (m *CreateOIDCClientRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Client == nil {
-				m.Client = &OIDCClient{}
-			}
-			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Clients = append(m.Clients, &OIDCClient{})
+			if err := m.Clients[len(m.Clients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5302,7 +6811,7 @@ func (m *CreateOIDCClientRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateOIDCClientResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateOIDCClientRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5325,10 +6834,10 @@ func (m *CreateOIDCClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateOIDCClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateOIDCClientRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateOIDCClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateOIDCClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5389,7 +6898,7 @@ func (m *CreateOIDCClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetOIDCClientRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateOIDCClientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5412,10 +6921,61 @@ func (m *GetOIDCClientRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetOIDCClientRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateOIDCClientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetOIDCClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateOIDCClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteOIDCClientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteOIDCClientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteOIDCClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5472,7 +7032,7 @@ func (m *GetOIDCClientRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetOIDCClientResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteOIDCClientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5495,48 +7055,12 @@ func (m *GetOIDCClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetOIDCClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteOIDCClientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetOIDCClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteOIDCClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIdentity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthIdentity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthIdentity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Client == nil {
-				m.Client = &OIDCClient{}
-			}
-			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIdentity(dAtA[iNdEx:])
@@ -5559,7 +7083,7 @@ func (m *GetOIDCClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListOIDCClientsRequest) Unmarshal(dAtA []byte) error {
+func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5582,10 +7106,10 @@ func (m *ListOIDCClientsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListOIDCClientsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListUsersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListOIDCClientsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListUsersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5610,7 +7134,7 @@ func (m *ListOIDCClientsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListOIDCClientsResponse) Unmarshal(dAtA []byte) error {
+func (m *ListUsersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5633,15 +7157,15 @@ func (m *ListOIDCClientsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListOIDCClientsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListUsersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListOIDCClientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListUsersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5668,8 +7192,8 @@ func (m *ListOIDCClientsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Clients = append(m.Clients, &OIDCClient{})
-			if err := m.Clients[len(m.Clients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Users = append(m.Users, &User{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5695,7 +7219,7 @@ func (m *ListOIDCClientsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateOIDCClientRequest) Unmarshal(dAtA []byte) error {
+func (m *InspectUserRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5718,17 +7242,17 @@ func (m *UpdateOIDCClientRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateOIDCClientRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectUserRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateOIDCClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIdentity
@@ -5738,27 +7262,23 @@ func (m *UpdateOIDCClientRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIdentity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIdentity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Client == nil {
-				m.Client = &OIDCClient{}
-			}
-			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5782,7 +7302,7 @@ func (m *UpdateOIDCClientRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateOIDCClientResponse) Unmarshal(dAtA []byte) error {
+func (m *InspectUserResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5805,12 +7325,82 @@ func (m *UpdateOIDCClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateOIDCClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectUserResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateOIDCClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.User == nil {
+				m.User = &User{}
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, &UserSession{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIdentity(dAtA[iNdEx:])
@@ -5833,7 +7423,7 @@ func (m *UpdateOIDCClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteOIDCClientRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteUserRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5856,15 +7446,15 @@ func (m *DeleteOIDCClientRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteOIDCClientRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteUserRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteOIDCClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5892,7 +7482,7 @@ func (m *DeleteOIDCClientRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5916,7 +7506,7 @@ func (m *DeleteOIDCClientRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteOIDCClientResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteUserResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5939,10 +7529,10 @@ func (m *DeleteOIDCClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteOIDCClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteUserResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteOIDCClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -36,7 +36,9 @@ func unauthenticated(ctx context.Context, _ authiface.APIServer, fullMethod stri
 func authenticated(ctx context.Context, authApi authiface.APIServer, fullMethod string) (string, error) {
 	r, err := authApi.WhoAmI(ctx, &auth.WhoAmIRequest{})
 	var username string
-	if err == nil {
+	// The username of a scoped token isn't cached, so that every authorization
+	// check looks up (and applies) the token's scopes.
+	if err == nil && len(r.Scopes) == 0 {
 		username = r.Username
 	}
 	return username, err
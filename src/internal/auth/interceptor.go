@@ -22,7 +22,8 @@ var authHandlers = map[string]authHandler{
 	//
 
 	// Allow InspectCluster to succeed before a user logs in
-	"/admin_v2.API/InspectCluster": unauthenticated,
+	"/admin_v2.API/InspectCluster":  unauthenticated,
+	"/admin_v2.API/MigrationStatus": authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_ADMIN_MIGRATION_STATUS)),
 
 	//
 	// Auth API
@@ -59,6 +60,9 @@ var authHandlers = map[string]authHandler{
 	"/auth_v2.API/DeleteExpiredAuthTokens":    clusterPermissions(auth.Permission_CLUSTER_AUTH_DELETE_EXPIRED_TOKENS),
 	"/auth_v2.API/RevokeAuthTokensForUser":    clusterPermissions(auth.Permission_CLUSTER_AUTH_REVOKE_USER_TOKENS),
 	"/auth_v2.API/RotateRootToken":            clusterPermissions(auth.Permission_CLUSTER_AUTH_ROTATE_ROOT_TOKEN),
+	"/auth_v2.API/ListAuthTokens":             clusterPermissions(auth.Permission_CLUSTER_AUTH_LIST_TOKENS),
+	"/auth_v2.API/InspectAuthToken":           clusterPermissions(auth.Permission_CLUSTER_AUTH_LIST_TOKENS),
+	"/auth_v2.API/RevokeAuthTokenByHash":      clusterPermissions(auth.Permission_CLUSTER_AUTH_REVOKE_TOKEN),
 
 	//
 	// Debug API
@@ -98,6 +102,9 @@ var authHandlers = map[string]authHandler{
 	"/identity_v2.API/GetOIDCClient":           clusterPermissions(auth.Permission_CLUSTER_IDENTITY_GET_OIDC_CLIENT),
 	"/identity_v2.API/ListOIDCClients":         clusterPermissions(auth.Permission_CLUSTER_IDENTITY_LIST_OIDC_CLIENTS),
 	"/identity_v2.API/DeleteOIDCClient":        clusterPermissions(auth.Permission_CLUSTER_IDENTITY_DELETE_OIDC_CLIENT),
+	"/identity_v2.API/ListUsers":               clusterPermissions(auth.Permission_CLUSTER_IDENTITY_LIST_USERS),
+	"/identity_v2.API/InspectUser":             clusterPermissions(auth.Permission_CLUSTER_IDENTITY_GET_USER),
+	"/identity_v2.API/DeleteUser":              clusterPermissions(auth.Permission_CLUSTER_IDENTITY_DELETE_USER),
 	"/identity_v2.API/DeleteAll":               clusterPermissions(auth.Permission_CLUSTER_DELETE_ALL),
 
 	//
@@ -109,6 +116,7 @@ var authHandlers = map[string]authHandler{
 	"/license_v2.API/UpdateCluster":     authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LICENSE_UPDATE_CLUSTER)),
 	"/license_v2.API/DeleteCluster":     authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LICENSE_DELETE_CLUSTER)),
 	"/license_v2.API/ListClusters":      authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LICENSE_LIST_CLUSTERS)),
+	"/license_v2.API/ListClusterUsage":  authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LICENSE_LIST_CLUSTERS)),
 	"/license_v2.API/DeleteAll":         authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_DELETE_ALL)),
 	// Heartbeat relies on the shared secret generated at cluster registration-time
 	"/license_v2.API/Heartbeat":        unauthenticated,
@@ -124,6 +132,7 @@ var authHandlers = map[string]authHandler{
 	"/pfs_v2.API/InspectRepo":     authDisabledOr(authenticated),
 	"/pfs_v2.API/ListRepo":        authDisabledOr(authenticated),
 	"/pfs_v2.API/DeleteRepo":      authDisabledOr(authenticated),
+	"/pfs_v2.API/ForkRepo":        authDisabledOr(authenticated),
 	"/pfs_v2.API/StartCommit":     authDisabledOr(authenticated),
 	"/pfs_v2.API/FinishCommit":    authDisabledOr(authenticated),
 	"/pfs_v2.API/InspectCommit":   authDisabledOr(authenticated),
@@ -132,10 +141,16 @@ var authHandlers = map[string]authHandler{
 	"/pfs_v2.API/FlushCommit":     authDisabledOr(authenticated),
 	"/pfs_v2.API/SubscribeCommit": authDisabledOr(authenticated),
 	"/pfs_v2.API/ClearCommit":     authDisabledOr(authenticated),
+	"/pfs_v2.API/MergeCommit":     authDisabledOr(authenticated),
+	"/pfs_v2.API/RevertCommit":    authDisabledOr(authenticated),
 	"/pfs_v2.API/CreateBranch":    authDisabledOr(authenticated),
 	"/pfs_v2.API/InspectBranch":   authDisabledOr(authenticated),
 	"/pfs_v2.API/ListBranch":      authDisabledOr(authenticated),
 	"/pfs_v2.API/DeleteBranch":    authDisabledOr(authenticated),
+	"/pfs_v2.API/CreateTag":       authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectTag":      authDisabledOr(authenticated),
+	"/pfs_v2.API/ListTag":         authDisabledOr(authenticated),
+	"/pfs_v2.API/DeleteTag":       authDisabledOr(authenticated),
 	"/pfs_v2.API/ModifyFile":      authDisabledOr(authenticated),
 	"/pfs_v2.API/GetFileTAR":      authDisabledOr(authenticated),
 	"/pfs_v2.API/InspectFile":     authDisabledOr(authenticated),
@@ -145,6 +160,8 @@ var authHandlers = map[string]authHandler{
 	"/pfs_v2.API/DiffFile":        authDisabledOr(authenticated),
 	"/pfs_v2.API/DeleteAll":       authDisabledOr(authenticated),
 	"/pfs_v2.API/Fsck":            authDisabledOr(authenticated),
+	"/pfs_v2.API/InspectStorage":  authDisabledOr(authenticated),
+	"/pfs_v2.API/VerifyReplica":   authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_ADMIN_VERIFY_REPLICA)),
 	"/pfs_v2.API/CreateFileset":   authDisabledOr(authenticated),
 	"/pfs_v2.API/GetFileset":      authDisabledOr(authenticated),
 	"/pfs_v2.API/AddFileset":      authDisabledOr(authenticated),
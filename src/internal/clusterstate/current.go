@@ -3,6 +3,8 @@ package clusterstate
 import (
 	"context"
 
+	"github.com/jmoiron/sqlx"
+
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
@@ -12,6 +14,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactiondb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/work"
 	"github.com/pachyderm/pachyderm/v2/src/server/auth"
 	authserver "github.com/pachyderm/pachyderm/v2/src/server/auth/server"
 	"github.com/pachyderm/pachyderm/v2/src/server/identity"
@@ -85,4 +88,99 @@ var DesiredClusterState migrations.State = migrations.InitialState().
 	}).
 	Apply("license clusters client_id column", func(ctx context.Context, env migrations.Env) error {
 		return license.AddClusterClientIdColumn(ctx, env.Tx)
+	}).
+	Apply("create work schema", func(ctx context.Context, env migrations.Env) error {
+		_, err := env.Tx.ExecContext(ctx, `CREATE SCHEMA work`)
+		return errors.EnsureStack(err)
+	}).
+	Apply("work task queue v0", func(ctx context.Context, env migrations.Env) error {
+		return work.SetupPostgresTaskQueueV0(ctx, env.Tx)
+	}).
+	Apply("auth tokens scopes column", func(ctx context.Context, env migrations.Env) error {
+		return auth.AddScopesToAuthTokensTable(ctx, env.Tx)
+	}).
+	Apply("auth tokens last used column", func(ctx context.Context, env migrations.Env) error {
+		return auth.AddLastUsedToAuthTokensTable(ctx, env.Tx)
+	}).
+	Apply("auth group providers collection", func(ctx context.Context, env migrations.Env) error {
+		return col.SetupPostgresCollections(ctx, env.Tx, authserver.GroupSyncCollections()...)
+	}).
+	Apply("identity users connector column", func(ctx context.Context, env migrations.Env) error {
+		return identity.AddConnectorIDToUsersTable(ctx, env.Tx)
+	}).
+	Apply("license cluster usage v0", func(ctx context.Context, env migrations.Env) error {
+		return license.CreateClusterUsageTable(ctx, env.Tx)
+	}).
+	Apply("pfs storage usage v0", func(ctx context.Context, env migrations.Env) error {
+		return pfsserver.SetupPostgresStorageUsageV0(ctx, env.Tx)
+	}).
+	Apply("storage chunk replicas v0", func(ctx context.Context, env migrations.Env) error {
+		return chunk.SetupReplicasV0(ctx, env.Tx)
+	}).
+	Apply("storage chunk tiers v0", func(ctx context.Context, env migrations.Env) error {
+		return chunk.SetupTiersV0(ctx, env.Tx)
+	}).
+	Apply("pfs tags collection", func(ctx context.Context, env migrations.Env) error {
+		return col.SetupPostgresCollections(ctx, env.Tx, pfsdb.TagCollections()...)
+	}).
+	Apply("storage chunk replica failures v0", func(ctx context.Context, env migrations.Env) error {
+		return chunk.SetupReplicaFailuresV0(ctx, env.Tx)
 	})
+
+// allCollections is the set of collections that should exist once
+// DesiredClusterState has been actualized. Migrations that create collections
+// should add them here as well, but migrations must not set it up, since it
+// grows as collections are added.
+func allCollections() []col.PostgresCollection {
+	collections := []col.PostgresCollection{}
+	collections = append(collections, pfsdb.AllCollections()...)
+	collections = append(collections, pfsdb.TagCollections()...)
+	collections = append(collections, ppsdb.AllCollections()...)
+	collections = append(collections, transactiondb.AllCollections()...)
+	collections = append(collections, authserver.AllCollections()...)
+	collections = append(collections, authserver.GroupSyncCollections()...)
+	return collections
+}
+
+// desiredTables is the set of tables, outside of the collections schema, that
+// should exist once DesiredClusterState has been actualized. Migrations that
+// create tables should add them here as well.
+var desiredTables = []string{
+	"public.migrations",
+	"storage.tracker_objects",
+	"storage.tracker_refs",
+	"storage.chunk_objects",
+	"storage.chunk_replicas",
+	"storage.chunk_replica_failures",
+	"storage.chunk_tiers",
+	"storage.keys",
+	"storage.filesets",
+	"license.clusters",
+	"license.cluster_usage",
+	"pfs.commit_diffs",
+	"pfs.commit_totals",
+	"pfs.storage_usage",
+	"identity.users",
+	"identity.config",
+	"auth.auth_tokens",
+	"work.claims",
+}
+
+// Verify checks that the database is in DesiredClusterState: that every
+// migration has been applied and finished, and that the tables and
+// collections created by those migrations exist.
+func Verify(ctx context.Context, db *sqlx.DB) error {
+	if err := migrations.Verify(ctx, db, DesiredClusterState); err != nil {
+		return err
+	}
+	for _, table := range desiredTables {
+		var exists bool
+		if err := db.GetContext(ctx, &exists, `SELECT to_regclass($1) IS NOT NULL`, table); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if !exists {
+			return errors.Errorf("table %s does not exist", table)
+		}
+	}
+	return col.VerifyPostgresCollections(ctx, db, allCollections()...)
+}
@@ -167,7 +167,8 @@ func parseFile(arg string) (*pfs.File, int, error) {
 }
 
 // ParseCommit takes an argument of the form "repo[@branch-or-commit]" and
-// returns the corresponding *pfs.Commit.
+// returns the corresponding *pfs.Commit. A tag may be given in place of the
+// branch, in which case it is resolved by pachd like a branch.
 func ParseCommit(arg string) (*pfs.Commit, error) {
 	file, numFields, err := parseFile(arg)
 	if err != nil {
@@ -219,6 +220,19 @@ func ParseBranches(args []string) ([]*pfs.Branch, error) {
 	return results, nil
 }
 
+// ParseTag takes an argument of the form "repo@tag" and returns the
+// corresponding *pfs.Tag.
+func ParseTag(arg string) (*pfs.Tag, error) {
+	branch, err := ParseBranch(arg)
+	if err != nil {
+		return nil, err
+	}
+	if branch.Name == "" {
+		return nil, errors.Errorf("invalid format \"%s\": tag cannot be empty", arg)
+	}
+	return &pfs.Tag{Repo: branch.Repo, Name: branch.Name}, nil
+}
+
 // ParseCommitProvenance takes an argument of the form "repo@branch=commit" and
 // returns the corresponding *pfs.CommitProvenance.
 func ParseCommitProvenance(arg string) (*pfs.CommitProvenance, error) {
@@ -0,0 +1,264 @@
+package cmdutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serde"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
+
+	"github.com/spf13/pflag"
+)
+
+// OutputFormatHelp describes the values of the --output (-o) flag.
+const OutputFormatHelp = `Output format: "table" (the default), "json", "yaml", "jsonl" ` +
+	`(one JSON object per line), "template=<go template>" (e.g. ` +
+	`'template={{.repo.name}}') or "custom-columns=<HEADER>:<field.path>,..." ` +
+	`(e.g. 'custom-columns=NAME:repo.name,SIZE:size_bytes'). Templates and ` +
+	`columns refer to fields by the names in the json output.`
+
+// OutputFlags returns a flag set containing the --output (-o) flag, which
+// sets the output format of list and inspect commands, and the older --raw
+// flag, which is equivalent to '--output json'.
+func OutputFlags(raw *bool, output *string) *pflag.FlagSet {
+	return outputFlags(raw, output, "o")
+}
+
+// OutputFlagsWithoutShorthand is like OutputFlags, but --output has no -o
+// shorthand, for commands that already used -o for something else.
+func OutputFlagsWithoutShorthand(raw *bool, output *string) *pflag.FlagSet {
+	return outputFlags(raw, output, "")
+}
+
+func outputFlags(raw *bool, output *string, shorthand string) *pflag.FlagSet {
+	outputFlags := pflag.NewFlagSet("", pflag.ContinueOnError)
+	outputFlags.BoolVar(raw, "raw", false, "Disable pretty printing; serialize data structures to an encoding such as json or yaml (equivalent to --output json, or the --output format if it's set)")
+	outputFlags.StringVarP(output, "output", shorthand, "", OutputFormatHelp)
+	return outputFlags
+}
+
+// Printer prints the results of list and inspect commands in a structured
+// output format. Results are printed as they're passed to Print, so that
+// streaming commands can print results as they arrive.
+type Printer interface {
+	// Print prints a single result.
+	Print(msg proto.Message) error
+	// Flush writes any buffered output, and must be called after the last
+	// result is printed.
+	Flush() error
+}
+
+// NewPrinter returns a Printer for the format set by OutputFlags. It returns
+// nil if the command should pretty-print its results as a table (the default).
+func NewPrinter(raw bool, output string, w io.Writer) (Printer, error) {
+	format, arg := output, ""
+	if i := strings.IndexByte(output, '='); i >= 0 {
+		format, arg = output[:i], output[i+1:]
+	}
+	switch strings.ToLower(format) {
+	case "", "table":
+		if !raw {
+			return nil, nil
+		}
+		if format == "table" {
+			return nil, errors.New("cannot set --raw with --output table")
+		}
+		return newEncoderPrinter("json", w)
+	case "json", "yaml":
+		return newEncoderPrinter(strings.ToLower(format), w)
+	case "jsonl", "ndjson":
+		return &jsonlPrinter{w: w}, nil
+	case "template", "go-template":
+		if arg == "" {
+			return nil, errors.New("--output template requires a template, e.g. 'template={{.repo.name}}'")
+		}
+		tmpl, err := template.New("output").Option("missingkey=zero").Parse(arg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse output template")
+		}
+		return &templatePrinter{w: w, tmpl: tmpl}, nil
+	case "custom-columns":
+		return newColumnsPrinter(arg, w)
+	default:
+		return nil, errors.Errorf("unrecognized output format %q (must be \"table\", \"json\", \"yaml\", \"jsonl\", \"template=...\" or \"custom-columns=...\")", output)
+	}
+}
+
+// PrintAll prints every message in 'msgs' with 'p', and flushes it.
+func PrintAll(p Printer, msgs ...proto.Message) error {
+	for _, msg := range msgs {
+		if err := p.Print(msg); err != nil {
+			return err
+		}
+	}
+	return p.Flush()
+}
+
+type encoderPrinter struct {
+	e serde.Encoder
+}
+
+func newEncoderPrinter(format string, w io.Writer) (Printer, error) {
+	e, err := serde.GetEncoder(format, w,
+		serde.WithIndent(2),
+		serde.WithOrigName(true),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &encoderPrinter{e: e}, nil
+}
+
+func (p *encoderPrinter) Print(msg proto.Message) error {
+	return p.e.EncodeProto(msg)
+}
+
+func (p *encoderPrinter) Flush() error {
+	return nil
+}
+
+// jsonlPrinter prints newline-delimited JSON, one result per line.
+type jsonlPrinter struct {
+	w io.Writer
+}
+
+func (p *jsonlPrinter) Print(msg proto.Message) error {
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	var buf bytes.Buffer
+	if err := marshaler.Marshal(&buf, msg); err != nil {
+		return errors.EnsureStack(err)
+	}
+	buf.WriteByte('\n')
+	_, err := p.w.Write(buf.Bytes())
+	return errors.EnsureStack(err)
+}
+
+func (p *jsonlPrinter) Flush() error {
+	return nil
+}
+
+// templatePrinter executes a template for each result, followed by a newline.
+type templatePrinter struct {
+	w    io.Writer
+	tmpl *template.Template
+}
+
+func (p *templatePrinter) Print(msg proto.Message) error {
+	fields, err := toFields(msg)
+	if err != nil {
+		return err
+	}
+	if err := p.tmpl.Execute(p.w, fields); err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err = fmt.Fprintln(p.w)
+	return errors.EnsureStack(err)
+}
+
+func (p *templatePrinter) Flush() error {
+	return nil
+}
+
+// columnsPrinter prints the fields at the given paths as a table.
+type columnsPrinter struct {
+	w     *tabwriter.Writer
+	paths [][]string
+}
+
+func newColumnsPrinter(spec string, w io.Writer) (Printer, error) {
+	if spec == "" {
+		return nil, errors.New("--output custom-columns requires a list of columns, e.g. 'custom-columns=NAME:repo.name'")
+	}
+	var headers []string
+	var paths [][]string
+	for _, column := range strings.Split(spec, ",") {
+		parts := strings.SplitN(column, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.Errorf("invalid column %q, columns must have the form <HEADER>:<field.path>", column)
+		}
+		headers = append(headers, parts[0])
+		paths = append(paths, strings.Split(strings.TrimPrefix(parts[1], "."), "."))
+	}
+	return &columnsPrinter{
+		w:     tabwriter.NewWriter(w, strings.Join(headers, "\t")+"\n"),
+		paths: paths,
+	}, nil
+}
+
+func (p *columnsPrinter) Print(msg proto.Message) error {
+	fields, err := toFields(msg)
+	if err != nil {
+		return err
+	}
+	values := make([]string, len(p.paths))
+	for i, path := range p.paths {
+		values[i] = formatField(lookupField(fields, path))
+	}
+	_, err = fmt.Fprintln(p.w, strings.Join(values, "\t"))
+	return errors.EnsureStack(err)
+}
+
+func (p *columnsPrinter) Flush() error {
+	return errors.EnsureStack(p.w.Flush())
+}
+
+// toFields converts 'msg' to the maps and lists that its json output decodes
+// to, so that templates and columns use the same field names as json output.
+func toFields(msg proto.Message) (interface{}, error) {
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	data, err := marshaler.MarshalToString(msg)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var fields interface{}
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return fields, nil
+}
+
+// lookupField returns the value at 'path' in 'fields', or nil if there isn't
+// one. Path elements that are numbers index into lists.
+func lookupField(fields interface{}, path []string) interface{} {
+	for _, elem := range path {
+		switch f := fields.(type) {
+		case map[string]interface{}:
+			fields = f[elem]
+		case []interface{}:
+			var i int
+			if _, err := fmt.Sscanf(elem, "%d", &i); err != nil || i < 0 || i >= len(f) {
+				return nil
+			}
+			fields = f[i]
+		default:
+			return nil
+		}
+	}
+	return fields
+}
+
+func formatField(field interface{}) string {
+	switch f := field.(type) {
+	case nil:
+		return "<none>"
+	case string:
+		return f
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(f)
+		if err != nil {
+			return fmt.Sprint(f)
+		}
+		return string(data)
+	default:
+		return fmt.Sprint(f)
+	}
+}
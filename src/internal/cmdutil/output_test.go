@@ -0,0 +1,60 @@
+package cmdutil
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+func printRepos(t *testing.T, raw bool, output string) string {
+	t.Helper()
+	var buf bytes.Buffer
+	p, err := NewPrinter(raw, output, &buf)
+	require.NoError(t, err)
+	require.NotNil(t, p)
+	require.NoError(t, PrintAll(p,
+		&pfs.RepoInfo{Repo: &pfs.Repo{Name: "images", Type: pfs.UserRepoType}, SizeBytes: 10},
+		&pfs.RepoInfo{Repo: &pfs.Repo{Name: "edges", Type: pfs.UserRepoType}},
+	))
+	return buf.String()
+}
+
+func TestNewPrinter(t *testing.T) {
+	for _, output := range []string{"", "table"} {
+		p, err := NewPrinter(false, output, &bytes.Buffer{})
+		require.NoError(t, err)
+		require.Nil(t, p)
+	}
+	_, err := NewPrinter(true, "table", &bytes.Buffer{})
+	require.YesError(t, err)
+	_, err = NewPrinter(false, "xml", &bytes.Buffer{})
+	require.YesError(t, err)
+	_, err = NewPrinter(false, "template={{.repo", &bytes.Buffer{})
+	require.YesError(t, err)
+	_, err = NewPrinter(false, "custom-columns=NAME", &bytes.Buffer{})
+	require.YesError(t, err)
+}
+
+func TestPrinterFormats(t *testing.T) {
+	// --raw is equivalent to --output json
+	require.Equal(t, printRepos(t, false, "json"), printRepos(t, true, ""))
+	require.True(t, strings.Contains(printRepos(t, false, "json"), `"size_bytes": "10"`))
+	require.True(t, strings.Contains(printRepos(t, false, "yaml"), "name: images"))
+
+	require.Equal(t,
+		`{"repo":{"name":"images","type":"user"},"size_bytes":"10"}`+"\n"+
+			`{"repo":{"name":"edges","type":"user"}}`+"\n",
+		printRepos(t, false, "jsonl"))
+
+	require.Equal(t, "images 10\nedges <no value>\n",
+		printRepos(t, false, "template={{.repo.name}} {{.size_bytes}}"))
+
+	lines := strings.Split(strings.TrimSpace(printRepos(t, false, "custom-columns=NAME:repo.name,SIZE:.size_bytes")), "\n")
+	require.Equal(t, 3, len(lines))
+	require.Equal(t, []string{"NAME", "SIZE"}, strings.Fields(lines[0]))
+	require.Equal(t, []string{"images", "10"}, strings.Fields(lines[1]))
+	require.Equal(t, []string{"edges", "<none>"}, strings.Fields(lines[2]))
+}
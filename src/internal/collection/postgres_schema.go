@@ -219,3 +219,35 @@ func SetupPostgresCollections(ctx context.Context, sqlTx *sqlx.Tx, collections .
 	}
 	return nil
 }
+
+// VerifyPostgresCollections checks that the tables backing the given
+// collections exist and have a column for each of their indexes.
+func VerifyPostgresCollections(ctx context.Context, db *sqlx.DB, collections ...PostgresCollection) error {
+	for _, pgc := range collections {
+		col := pgc.(*postgresCollection)
+		var columns []string
+		if err := db.SelectContext(ctx, &columns, `
+	SELECT column_name FROM information_schema.columns
+	WHERE table_schema = 'collections' AND table_name = $1
+	`, col.table); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if len(columns) == 0 {
+			return errors.Errorf("table collections.%s does not exist", col.table)
+		}
+		have := make(map[string]bool)
+		for _, c := range columns {
+			have[c] = true
+		}
+		expected := []string{"createdat", "updatedat", "proto", "version", "key"}
+		for _, idx := range col.indexes {
+			expected = append(expected, indexFieldName(idx))
+		}
+		for _, c := range expected {
+			if !have[c] {
+				return errors.Errorf("table collections.%s is missing column %q", col.table, c)
+			}
+		}
+	}
+	return nil
+}
@@ -76,10 +76,14 @@ var (
 	// The name of the local volume (mounted kubernetes secret) where pachd
 	// should read a TLS cert and private key for authenticating with clients
 	tlsVolumeName = "pachd-tls-cert"
+
 	// The name of the kubernetes secret mount in the TLS volume (see
 	// tlsVolumeName)
 	tlsSecretName = "pachd-tls-cert"
 
+	// The name of the hostPath volume for the on-disk chunk cache
+	chunkCacheVolumeName = "chunk-cache"
+
 	// IAMAnnotation is the annotation used for the IAM role, this can work
 	// with something like kube2iam as an alternative way to provide
 	// credentials.
@@ -129,6 +133,30 @@ const (
 
 	// PutFileConcurrencyLimitEnvVar is the environment variable for the PutFile concurrency limit.
 	PutFileConcurrencyLimitEnvVar = "STORAGE_PUT_FILE_CONCURRENCY_LIMIT"
+
+	// ChunkCachePathEnvVar is the environment variable for the on-disk chunk cache directory.
+	ChunkCachePathEnvVar = "STORAGE_CHUNK_CACHE_PATH"
+
+	// ChunkCacheBytesEnvVar is the environment variable for the size of the on-disk chunk cache.
+	ChunkCacheBytesEnvVar = "STORAGE_CHUNK_CACHE_BYTES"
+
+	// ChunkCacheHostPathEnvVar is the environment variable for the host directory backing the on-disk chunk cache.
+	ChunkCacheHostPathEnvVar = "STORAGE_CHUNK_CACHE_HOST_PATH"
+
+	// ReplicaURLEnvVar is the environment variable for the replica object store URL.
+	ReplicaURLEnvVar = "STORAGE_REPLICA_URL"
+
+	// ColdTierURLEnvVar is the environment variable for the cold tier object store URL.
+	ColdTierURLEnvVar = "STORAGE_COLD_TIER_URL"
+
+	// ColdTierAgeEnvVar is the environment variable for how long chunks go unread before they are moved to the cold tier.
+	ColdTierAgeEnvVar = "STORAGE_COLD_TIER_AGE"
+
+	// ColdTierPromoteEnvVar is the environment variable for whether chunks read from the cold tier are moved back to the hot tier.
+	ColdTierPromoteEnvVar = "STORAGE_COLD_TIER_PROMOTE"
+
+	// ChunkCacheMountPath is where the on-disk chunk cache is mounted in pachd and worker sidecars.
+	ChunkCacheMountPath = "/pach-chunk-cache"
 )
 
 const (
@@ -139,12 +167,30 @@ const (
 
 	// DefaultPutFileConcurrencyLimit is the default maximum number of concurrent files that can be uploaded over GRPC or downloaded from external sources (ex. HTTP or blob storage).
 	DefaultPutFileConcurrencyLimit = 100
+
+	// DefaultChunkCacheBytes is the default size of the on-disk chunk cache.
+	DefaultChunkCacheBytes = 10 << 30
+
+	// DefaultColdTierAge is the default time that chunks go unread before they are moved to the cold tier.
+	DefaultColdTierAge = "2160h"
 )
 
 // StorageOpts are options that are applicable to the storage layer.
 type StorageOpts struct {
 	UploadConcurrencyLimit  int
 	PutFileConcurrencyLimit int
+	// ChunkCacheHostPath, if set, is a directory on each node that pachd and
+	// the worker sidecars on that node share as an on-disk chunk cache.
+	ChunkCacheHostPath string
+	ChunkCacheBytes    int64
+	// ReplicaURL, if set, is the object store (e.g. s3://bucket) that chunks
+	// are asynchronously replicated to for disaster recovery.
+	ReplicaURL string
+	// ColdTierURL, if set, is the object store that chunks which have not
+	// been read for ColdTierAge are moved to.
+	ColdTierURL     string
+	ColdTierAge     string
+	ColdTierPromote bool
 }
 
 const (
@@ -476,10 +522,58 @@ func GetSecretEnvVars(storageBackend string) []v1.EnvVar {
 }
 
 func getStorageEnvVars(opts *AssetOpts) []v1.EnvVar {
-	return []v1.EnvVar{
+	envVars := []v1.EnvVar{
 		{Name: UploadConcurrencyLimitEnvVar, Value: strconv.Itoa(opts.StorageOpts.UploadConcurrencyLimit)},
 		{Name: PutFileConcurrencyLimitEnvVar, Value: strconv.Itoa(opts.StorageOpts.PutFileConcurrencyLimit)},
 	}
+	if opts.StorageOpts.ChunkCacheHostPath != "" {
+		envVars = append(envVars, ChunkCacheEnvVars(ChunkCacheMountPath, opts.StorageOpts.ChunkCacheBytes)...)
+		envVars = append(envVars, v1.EnvVar{Name: ChunkCacheHostPathEnvVar, Value: opts.StorageOpts.ChunkCacheHostPath})
+	}
+	if opts.StorageOpts.ReplicaURL != "" {
+		envVars = append(envVars, v1.EnvVar{Name: ReplicaURLEnvVar, Value: opts.StorageOpts.ReplicaURL})
+	}
+	if opts.StorageOpts.ColdTierURL != "" {
+		envVars = append(envVars, ColdTierEnvVars(opts.StorageOpts.ColdTierURL, opts.StorageOpts.ColdTierAge, opts.StorageOpts.ColdTierPromote)...)
+	}
+	return envVars
+}
+
+// ColdTierEnvVars returns the environment variables that configure a cold
+// tier for chunk storage.
+func ColdTierEnvVars(url, age string, promote bool) []v1.EnvVar {
+	return []v1.EnvVar{
+		{Name: ColdTierURLEnvVar, Value: url},
+		{Name: ColdTierAgeEnvVar, Value: age},
+		{Name: ColdTierPromoteEnvVar, Value: strconv.FormatBool(promote)},
+	}
+}
+
+// ChunkCacheEnvVars returns the environment variables that configure an
+// on-disk chunk cache in path.
+func ChunkCacheEnvVars(path string, bytes int64) []v1.EnvVar {
+	return []v1.EnvVar{
+		{Name: ChunkCachePathEnvVar, Value: path},
+		{Name: ChunkCacheBytesEnvVar, Value: strconv.FormatInt(bytes, 10)},
+	}
+}
+
+// GetChunkCacheVolumeAndMount returns a Volume and VolumeMount for an on-disk
+// chunk cache backed by hostPath.
+func GetChunkCacheVolumeAndMount(hostPath string) (v1.Volume, v1.VolumeMount) {
+	pathType := v1.HostPathDirectoryOrCreate
+	return v1.Volume{
+			Name: chunkCacheVolumeName,
+			VolumeSource: v1.VolumeSource{
+				HostPath: &v1.HostPathVolumeSource{
+					Path: hostPath,
+					Type: &pathType,
+				},
+			},
+		}, v1.VolumeMount{
+			Name:      chunkCacheVolumeName,
+			MountPath: ChunkCacheMountPath,
+		}
 }
 
 func versionedPachdImage(opts *AssetOpts) string {
@@ -558,6 +652,11 @@ func PachdDeployment(opts *AssetOpts, objectStoreBackend Backend, hostPath strin
 	volume, mount := GetBackendSecretVolumeAndMount(backendEnvVar)
 	volumes = append(volumes, volume)
 	volumeMounts = append(volumeMounts, mount)
+	if opts.StorageOpts.ChunkCacheHostPath != "" {
+		volume, mount := GetChunkCacheVolumeAndMount(opts.StorageOpts.ChunkCacheHostPath)
+		volumes = append(volumes, volume)
+		volumeMounts = append(volumeMounts, mount)
+	}
 	if opts.TLS != nil {
 		volumes = append(volumes, v1.Volume{
 			Name: tlsVolumeName,
@@ -288,6 +288,12 @@ func standardDeployCmds() []*cobra.Command {
 	var tlsCertKey string
 	var uploadConcurrencyLimit int
 	var putFileConcurrencyLimit int
+	var chunkCacheHostPath string
+	var chunkCacheBytes int64
+	var replicaURL string
+	var coldTierURL string
+	var coldTierAge string
+	var coldTierPromote bool
 	var clusterDeploymentID string
 	var requireCriticalServersOnly bool
 	var workerServiceAccountName string
@@ -314,6 +320,12 @@ func standardDeployCmds() []*cobra.Command {
 		cmd.Flags().StringVar(&tlsCertKey, "tls", "", "string of the form \"<cert path>,<key path>\" of the signed TLS certificate and private key that Pachd should use for TLS authentication (enables TLS-encrypted communication with Pachd)")
 		cmd.Flags().IntVar(&uploadConcurrencyLimit, "upload-concurrency-limit", assets.DefaultUploadConcurrencyLimit, "The maximum number of concurrent object storage uploads per Pachd instance.")
 		cmd.Flags().IntVar(&putFileConcurrencyLimit, "put-file-concurrency-limit", assets.DefaultPutFileConcurrencyLimit, "The maximum number of files to upload or fetch from remote sources (HTTP, blob storage) using PutFile concurrently.")
+		cmd.Flags().StringVar(&chunkCacheHostPath, "chunk-cache-host-path", "", "If set, a directory on each node that pachd and pipeline workers on that node share as an on-disk cache of chunks read from object storage.")
+		cmd.Flags().Int64Var(&chunkCacheBytes, "chunk-cache-bytes", assets.DefaultChunkCacheBytes, "The maximum size of the on-disk chunk cache per process. Ignored unless --chunk-cache-host-path is set.")
+		cmd.Flags().StringVar(&replicaURL, "replica-url", "", "If set, an object store URL (e.g. s3://bucket) that chunks are asynchronously replicated to for disaster recovery. The replica uses the same credentials as the primary object store.")
+		cmd.Flags().StringVar(&coldTierURL, "cold-tier-url", "", "If set, an object store URL (e.g. s3://bucket) that chunks which have not been read recently are moved to. The cold tier uses the same credentials as the primary object store.")
+		cmd.Flags().StringVar(&coldTierAge, "cold-tier-age", assets.DefaultColdTierAge, "How long chunks go unread before they are moved to the cold tier. Ignored unless --cold-tier-url is set.")
+		cmd.Flags().BoolVar(&coldTierPromote, "cold-tier-promote", false, "Move chunks that are read from the cold tier back to the primary object store. Ignored unless --cold-tier-url is set.")
 		cmd.Flags().StringVar(&clusterDeploymentID, "cluster-deployment-id", "", "Set an ID for the cluster deployment. Defaults to a random value.")
 		cmd.Flags().BoolVar(&requireCriticalServersOnly, "require-critical-servers-only", assets.DefaultRequireCriticalServersOnly, "Only require the critical Pachd servers to startup and run without errors.")
 		cmd.Flags().BoolVar(&enterpriseServer, "enterprise-server", false, "Deploy the Enterprise Server.")
@@ -421,6 +433,12 @@ func standardDeployCmds() []*cobra.Command {
 			StorageOpts: assets.StorageOpts{
 				UploadConcurrencyLimit:  uploadConcurrencyLimit,
 				PutFileConcurrencyLimit: putFileConcurrencyLimit,
+				ChunkCacheHostPath:      chunkCacheHostPath,
+				ChunkCacheBytes:         chunkCacheBytes,
+				ReplicaURL:              replicaURL,
+				ColdTierURL:             coldTierURL,
+				ColdTierAge:             coldTierAge,
+				ColdTierPromote:         coldTierPromote,
 			},
 			Version:                    version.PrettyPrintVersion(version.Version),
 			LogLevel:                   logLevel,
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/jmoiron/sqlx"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/sirupsen/logrus"
@@ -84,7 +85,7 @@ func InitialState() State {
 // ApplyMigrations does the necessary work to actualize state.
 // It will manipulate the objects available in baseEnv, and use the migrations table in db.
 func ApplyMigrations(ctx context.Context, db *sqlx.DB, baseEnv Env, state State) error {
-	for _, state := range collectStates(make([]State, 0, state.n+1), state) {
+	for _, state := range States(state) {
 		if err := applyMigration(ctx, db, baseEnv, state); err != nil {
 			return err
 		}
@@ -92,6 +93,12 @@ func ApplyMigrations(ctx context.Context, db *sqlx.DB, baseEnv Env, state State)
 	return nil
 }
 
+// States returns the states that must be actualized to reach state, in the
+// order they are applied, starting with the initial state.
+func States(state State) []State {
+	return collectStates(make([]State, 0, state.n+1), state)
+}
+
 // collectStates does a reverse order traversal of a linked list and adds each item to a slice
 func collectStates(slice []State, s State) []State {
 	if s.prev != nil {
@@ -107,42 +114,111 @@ func applyMigration(ctx context.Context, db *sqlx.DB, baseEnv Env, state State)
 	}
 	env := baseEnv
 	env.Tx = tx
-	if err := func() error {
-		if state.n == 0 {
-			if err := state.change(ctx, env); err != nil {
-				panic(err)
-			}
-		}
-		_, err := tx.ExecContext(ctx, `LOCK TABLE migrations IN EXCLUSIVE MODE`)
-		if err != nil {
-			return errors.EnsureStack(err)
-		}
-		if finished, err := isFinished(ctx, tx, state); err != nil {
-			return err
-		} else if finished {
-			// skip migration
-			logrus.Infof("migration %d already applied", state.n)
-			return nil
-		}
-		if _, err := tx.ExecContext(ctx, `INSERT INTO migrations (id, name, start_time) VALUES ($1, $2, CURRENT_TIMESTAMP)`, state.n, state.name); err != nil {
-			return errors.EnsureStack(err)
+	if err := applyMigrationInTx(ctx, env, state); err != nil {
+		if err := tx.Rollback(); err != nil {
+			logrus.Error(err)
 		}
-		logrus.Infof("applying migration %d %s", state.n, state.name)
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(tx.Commit())
+}
+
+// applyMigrationInTx applies a single state in env.Tx, recording it in the
+// migrations table. It's a no-op if the state has already been applied.
+func applyMigrationInTx(ctx context.Context, env Env, state State) error {
+	tx := env.Tx
+	if state.n == 0 {
 		if err := state.change(ctx, env); err != nil {
-			return err
+			panic(err)
 		}
-		if _, err := tx.ExecContext(ctx, `UPDATE migrations SET end_time = CURRENT_TIMESTAMP WHERE id = $1`, state.n); err != nil {
-			return errors.EnsureStack(err)
+	}
+	_, err := tx.ExecContext(ctx, `LOCK TABLE migrations IN EXCLUSIVE MODE`)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if finished, err := isFinished(ctx, tx, state); err != nil {
+		return err
+	} else if finished {
+		// skip migration
+		logrus.Infof("migration %d already applied", state.n)
+		return nil
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO migrations (id, name, start_time) VALUES ($1, $2, CURRENT_TIMESTAMP)`, state.n, state.name); err != nil {
+		return errors.EnsureStack(err)
+	}
+	logrus.Infof("applying migration %d %s", state.n, state.name)
+	if err := state.change(ctx, env); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE migrations SET end_time = CURRENT_TIMESTAMP WHERE id = $1`, state.n); err != nil {
+		return errors.EnsureStack(err)
+	}
+	logrus.Infof("successfully applied migration %d", state.n)
+	return nil
+}
+
+// DryRun applies every pending migration needed to actualize state in a
+// single transaction, which is always rolled back. It returns the error from
+// the first migration that fails, if any.
+// Only changes made through env.Tx are rolled back, so migrations that modify
+// other objects in baseEnv should not be dry-run.
+func DryRun(ctx context.Context, db *sqlx.DB, baseEnv Env, state State) error {
+	return col.NewDryrunSQLTx(ctx, db, func(tx *sqlx.Tx) error {
+		env := baseEnv
+		env.Tx = tx
+		for _, state := range States(state) {
+			if err := applyMigrationInTx(ctx, env, state); err != nil {
+				return errors.Wrapf(err, "migration %d %s", state.n, state.name)
+			}
 		}
-		logrus.Infof("successfully applied migration %d", state.n)
 		return nil
-	}(); err != nil {
-		if err := tx.Rollback(); err != nil {
-			logrus.Error(err)
+	})
+}
+
+// AppliedState is a record of a migration in the migrations table.
+type AppliedState struct {
+	Number    int        `db:"id"`
+	Name      string     `db:"name"`
+	StartTime time.Time  `db:"start_time"`
+	EndTime   *time.Time `db:"end_time"`
+}
+
+// ListApplied returns the migrations recorded in the migrations table, ordered
+// by number. It returns no states if the migrations table does not exist yet.
+func ListApplied(ctx context.Context, db *sqlx.DB) ([]AppliedState, error) {
+	if exists, err := migrationsTableExists(ctx, db); err != nil || !exists {
+		return nil, err
+	}
+	var applied []AppliedState
+	if err := db.SelectContext(ctx, &applied, `SELECT id, name, start_time, end_time FROM migrations ORDER BY id`); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return applied, nil
+}
+
+// Verify checks that the migrations table records exactly the states needed
+// to actualize state, in order, and that all of them finished.
+func Verify(ctx context.Context, db *sqlx.DB, state State) error {
+	applied, err := ListApplied(ctx, db)
+	if err != nil {
+		return err
+	}
+	states := States(state)
+	for i, a := range applied {
+		if i >= len(states) {
+			return errors.Errorf("database has migration %d %s, which is newer than the application is expecting", a.Number, a.Name)
+		}
+		if a.Number != states[i].n || a.Name != states[i].name {
+			return errors.Errorf("migration mismatch %d HAVE: %d %s WANT: %s", states[i].n, a.Number, a.Name, states[i].name)
+		}
+		if a.EndTime == nil {
+			return errors.Errorf("migration %d %s never finished", a.Number, a.Name)
 		}
-		return errors.EnsureStack(err)
 	}
-	return errors.EnsureStack(tx.Commit())
+	if len(applied) < len(states) {
+		return errors.Errorf("%d migrations have not been applied, starting with %d %s", len(states)-len(applied), states[len(applied)].n, states[len(applied)].name)
+	}
+	return nil
 }
 
 // BlockUntil blocks until state is actualized.
@@ -150,21 +226,13 @@ func applyMigration(ctx context.Context, db *sqlx.DB, baseEnv Env, state State)
 // by calling ApplyMigrations.
 // If the cluster ever enters a state newer than the state passed to BlockUntil, it errors.
 func BlockUntil(ctx context.Context, db *sqlx.DB, state State) error {
-	const (
-		schemaName = "public"
-		tableName  = "migrations"
-	)
 	// poll database until this state is registered
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
 	for {
-		var tableExists bool
-		if err := db.GetContext(ctx, &tableExists, `SELECT EXISTS (
-			SELECT FROM information_schema.tables
-			WHERE table_schema = $1
-			AND table_name = $2
-		)`, schemaName, tableName); err != nil {
-			return errors.EnsureStack(err)
+		tableExists, err := migrationsTableExists(ctx, db)
+		if err != nil {
+			return err
 		}
 		if tableExists {
 			var latest int
@@ -185,6 +253,22 @@ func BlockUntil(ctx context.Context, db *sqlx.DB, state State) error {
 	}
 }
 
+func migrationsTableExists(ctx context.Context, db *sqlx.DB) (bool, error) {
+	const (
+		schemaName = "public"
+		tableName  = "migrations"
+	)
+	var tableExists bool
+	if err := db.GetContext(ctx, &tableExists, `SELECT EXISTS (
+		SELECT FROM information_schema.tables
+		WHERE table_schema = $1
+		AND table_name = $2
+	)`, schemaName, tableName); err != nil {
+		return false, errors.EnsureStack(err)
+	}
+	return tableExists, nil
+}
+
 func isFinished(ctx context.Context, tx *sqlx.Tx, state State) (bool, error) {
 	var name string
 	if err := tx.GetContext(ctx, &name, `
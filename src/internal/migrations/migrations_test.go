@@ -49,3 +49,35 @@ func TestMigration(t *testing.T) {
 	require.NoError(t, db.GetContext(ctx, &max, `SELECT max(id) FROM migrations`))
 	assert.Equal(t, state.Number(), max)
 }
+
+func TestDryRunAndVerify(t *testing.T) {
+	db := testutil.NewTestDB(t)
+	ctx := context.Background()
+	state := InitialState().
+		Apply("test 1", func(ctx context.Context, env Env) error {
+			_, err := env.Tx.ExecContext(ctx, `CREATE TABLE test_table1 (id BIGSERIAL PRIMARY KEY);`)
+			return err
+		})
+	require.NoError(t, DryRun(ctx, db, Env{}, state))
+	// the dry run should leave no trace in the database
+	applied, err := ListApplied(ctx, db)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(applied))
+	require.YesError(t, Verify(ctx, db, state))
+
+	require.NoError(t, ApplyMigrations(ctx, db, Env{}, state))
+	applied, err = ListApplied(ctx, db)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(applied))
+	require.Equal(t, "test 1", applied[1].Name)
+	require.NoError(t, Verify(ctx, db, state))
+
+	// a pending migration that fails is reported by the dry run
+	failing := state.Apply("test 2", func(ctx context.Context, env Env) error {
+		_, err := env.Tx.ExecContext(ctx, `CREATE TABLE test_table1 (id BIGSERIAL PRIMARY KEY);`)
+		return err
+	})
+	require.YesError(t, DryRun(ctx, db, Env{}, failing))
+	require.YesError(t, Verify(ctx, db, failing))
+	require.NoError(t, Verify(ctx, db, state))
+}
@@ -19,6 +19,7 @@ const (
 	commitsCollectionName     = "commits"
 	openCommitsCollectionName = "open_commits"
 	commitsetsCollectionName  = "commitsets"
+	tagsCollectionName        = "tags"
 )
 
 var ReposTypeIndex = &col.Index{
@@ -148,6 +149,47 @@ func Commitsets(db *sqlx.DB, listener *col.PostgresListener) col.PostgresCollect
 	)
 }
 
+var TagsRepoIndex = &col.Index{
+	Name: "repo",
+	Extract: func(val proto.Message) string {
+		return RepoKey(val.(*pfs.TagInfo).Tag.Repo)
+	},
+}
+
+var TagsCommitIndex = &col.Index{
+	Name: "commit",
+	Extract: func(val proto.Message) string {
+		return CommitKey(val.(*pfs.TagInfo).Commit)
+	},
+}
+
+var tagsIndexes = []*col.Index{TagsRepoIndex, TagsCommitIndex}
+
+func TagKey(tag *pfs.Tag) string {
+	return RepoKey(tag.Repo) + "@" + tag.Name
+}
+
+// Tags returns a collection of tags
+func Tags(db *sqlx.DB, listener *col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		tagsCollectionName,
+		db,
+		listener,
+		&pfs.TagInfo{},
+		tagsIndexes,
+		func(key string) error {
+			keyParts := strings.Split(key, "@")
+			if len(keyParts) != 2 {
+				return errors.Errorf("tag key %s isn't valid, use TagKey to generate it", key)
+			}
+			if uuid.IsUUIDWithoutDashes(keyParts[1]) {
+				return errors.Errorf("tag name cannot be a UUID V4")
+			}
+			return repoKeyCheck(keyParts[0])
+		},
+	)
+}
+
 // AllCollections returns a list of all the PFS collections for
 // postgres-initialization purposes. These collections are not usable for
 // querying.
@@ -160,3 +202,12 @@ func AllCollections() []col.PostgresCollection {
 		col.NewPostgresCollection(commitsetsCollectionName, nil, nil, nil, commitsetsIndexes, nil),
 	}
 }
+
+// TagCollections returns the collections used for tags, for
+// postgres-initialization purposes. They were added after AllCollections was
+// first set up, so they're created by a separate migration.
+func TagCollections() []col.PostgresCollection {
+	return []col.PostgresCollection{
+		col.NewPostgresCollection(tagsCollectionName, nil, nil, nil, tagsIndexes, nil),
+	}
+}
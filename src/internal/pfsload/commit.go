@@ -2,6 +2,7 @@ package pfsload
 
 import (
 	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 )
 
 type CommitsSpec struct {
@@ -13,30 +14,30 @@ type CommitsSpec struct {
 	FileSourceSpecs []*FileSourceSpec `yaml:"fileSources,omitempty"`
 }
 
-func Commits(pachClient *client.APIClient, repo, branch string, spec *CommitsSpec, seed int64) error {
-	env, err := NewEnv(NewPachClient(pachClient), spec, seed)
-	if err != nil {
-		return err
+func Commit(env *Env, pachClient *client.APIClient, repo, branch string, spec *CommitsSpec) (*pfs.Commit, error) {
+	var commit *pfs.Commit
+	if err := env.Stats().Time("startCommit", func() (int64, error) {
+		var err error
+		commit, err = pachClient.StartCommit(repo, branch)
+		return 0, err
+	}); err != nil {
+		return nil, err
 	}
-	for i := 0; i < spec.Count; i++ {
-		commit, err := pachClient.StartCommit(repo, branch)
-		if err != nil {
-			return err
+	for _, operationsSpec := range spec.OperationsSpecs {
+		if err := Operations(env, repo, branch, commit.ID, operationsSpec); err != nil {
+			return nil, err
 		}
-		for _, operationsSpec := range spec.OperationsSpecs {
-			if err := Operations(env, repo, branch, commit.ID, operationsSpec); err != nil {
-				return err
-			}
-		}
-		if err := pachClient.FinishCommit(repo, branch, commit.ID); err != nil {
-			return err
-		}
-		validator := env.Validator()
-		if validator != nil {
-			if err := validator.Validate(env.Client(), commit); err != nil {
-				return err
-			}
+	}
+	if err := env.Stats().Time("finishCommit", func() (int64, error) {
+		return 0, pachClient.FinishCommit(repo, branch, commit.ID)
+	}); err != nil {
+		return nil, err
+	}
+	validator := env.Validator()
+	if validator != nil {
+		if err := validator.Validate(env.Client(), commit); err != nil {
+			return nil, err
 		}
 	}
-	return nil
+	return commit, nil
 }
@@ -9,6 +9,7 @@ type Env struct {
 	validator   *Validator
 	fileSources map[string]FileSource
 	random      *rand.Rand
+	stats       *Stats
 }
 
 func NewEnv(client Client, spec *CommitsSpec, seed int64) (*Env, error) {
@@ -42,6 +43,7 @@ func NewEnv(client Client, spec *CommitsSpec, seed int64) (*Env, error) {
 		validator:   validator,
 		fileSources: fileSources,
 		random:      random,
+		stats:       NewStats(),
 	}, nil
 }
 
@@ -60,3 +62,7 @@ func (e *Env) FileSource(name string) FileSource {
 func (e *Env) Rand() *rand.Rand {
 	return e.random
 }
+
+func (e *Env) Stats() *Stats {
+	return e.stats
+}
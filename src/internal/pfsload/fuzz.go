@@ -3,7 +3,9 @@ package pfsload
 import (
 	"math/rand"
 
+	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 )
 
 func FuzzOperation(env *Env, repo, branch, commit string, specs []*OperationSpec) error {
@@ -28,6 +30,28 @@ func FuzzOperation(env *Env, repo, branch, commit string, specs []*OperationSpec
 	panic("should not be able to reach here")
 }
 
+func FuzzRead(env *Env, pachClient *client.APIClient, commit *pfs.Commit, files []*pfs.FileInfo, specs []*ReadSpec) error {
+	var totalProb int
+	for _, spec := range specs {
+		if err := validateProb(spec.Prob); err != nil {
+			return err
+		}
+		totalProb += spec.Prob
+	}
+	if totalProb != 100 {
+		return errors.Errorf("read probabilities must add up to 100")
+	}
+	totalProb = 0
+	prob := env.Rand().Intn(100)
+	for _, spec := range specs {
+		totalProb += spec.Prob
+		if prob < totalProb {
+			return Read(env, pachClient, commit, files, spec)
+		}
+	}
+	panic("should not be able to reach here")
+}
+
 func FuzzFile(env *Env, specs []*FileSpec) (*MemFile, error) {
 	var totalProb int
 	for _, spec := range specs {
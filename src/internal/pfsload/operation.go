@@ -45,13 +45,19 @@ func PutFile(env *Env, repo, branch, commit string, spec *PutFileSpec) error {
 	if err != nil {
 		return err
 	}
-	return c.WithModifyFileClient(context.Background(), client.NewCommit(repo, branch, commit), func(mf client.ModifyFile) error {
-		for _, file := range files {
-			if err := mf.PutFile(file.Path(), file.Reader()); err != nil {
-				return err
+	var bytes int64
+	for _, file := range files {
+		bytes += int64(len(file.content))
+	}
+	return env.Stats().Time("putFile", func() (int64, error) {
+		return bytes, c.WithModifyFileClient(context.Background(), client.NewCommit(repo, branch, commit), func(mf client.ModifyFile) error {
+			for _, file := range files {
+				if err := mf.PutFile(file.Path(), file.Reader()); err != nil {
+					return err
+				}
 			}
-		}
-		return nil
+			return nil
+		})
 	})
 }
 
@@ -65,17 +71,19 @@ func DeleteFile(env *Env, repo, branch, commit string, spec *DeleteFileSpec) err
 		return err
 	}
 	c := env.Client()
-	return c.WithModifyFileClient(context.Background(), client.NewCommit(repo, branch, commit), func(mf client.ModifyFile) error {
-		for i := 0; i < spec.Count; i++ {
-			p, err := nextDeletePath(env, spec)
-			if err != nil {
-				return err
+	return env.Stats().Time("deleteFile", func() (int64, error) {
+		return 0, c.WithModifyFileClient(context.Background(), client.NewCommit(repo, branch, commit), func(mf client.ModifyFile) error {
+			for i := 0; i < spec.Count; i++ {
+				p, err := nextDeletePath(env, spec)
+				if err != nil {
+					return err
+				}
+				if err := mf.DeleteFile(p); err != nil {
+					return err
+				}
 			}
-			if err := mf.DeleteFile(p); err != nil {
-				return err
-			}
-		}
-		return nil
+			return nil
+		})
 	})
 }
 
@@ -0,0 +1,56 @@
+package pfsload
+
+import (
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+const defaultPipelineGlob = "/*"
+
+type PipelineSpec struct {
+	Image       string   `yaml:"image,omitempty"`
+	Cmd         []string `yaml:"cmd,omitempty"`
+	Stdin       []string `yaml:"stdin,omitempty"`
+	Glob        string   `yaml:"glob,omitempty"`
+	Parallelism uint64   `yaml:"parallelism,omitempty"`
+}
+
+// CreatePipeline creates a pipeline named name that takes the branch as input.
+// By default, the pipeline copies its input to its output.
+func CreatePipeline(pachClient *client.APIClient, name, repo, branch string, spec *PipelineSpec) error {
+	cmd, stdin := spec.Cmd, spec.Stdin
+	if len(cmd) == 0 {
+		cmd = []string{"bash"}
+		if len(stdin) == 0 {
+			stdin = []string{"cp -r /pfs/" + repo + "/. /pfs/out/"}
+		}
+	}
+	glob := spec.Glob
+	if glob == "" {
+		glob = defaultPipelineGlob
+	}
+	parallelism := spec.Parallelism
+	if parallelism == 0 {
+		parallelism = 1
+	}
+	return pachClient.CreatePipeline(
+		name,
+		spec.Image,
+		cmd,
+		stdin,
+		&pps.ParallelismSpec{Constant: parallelism},
+		client.NewPFSInputOpts("", repo, branch, glob, "", "", false, false, nil),
+		"",
+		false,
+	)
+}
+
+// WaitJob waits for the job that processes commit in the pipeline, and
+// records how long it took.
+func WaitJob(env *Env, pachClient *client.APIClient, commit *pfs.Commit, pipeline string) error {
+	return env.Stats().Time("job", func() (int64, error) {
+		_, err := pachClient.FlushCommitAll([]*pfs.Commit{commit}, []*pfs.Repo{client.NewRepo(pipeline)})
+		return 0, err
+	})
+}
@@ -0,0 +1,156 @@
+package pfsload
+
+import (
+	"io"
+	"io/ioutil"
+	"path"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+type ReadsSpec struct {
+	Count     int         `yaml:"count,omitempty"`
+	ReadSpecs []*ReadSpec `yaml:"read,omitempty"`
+}
+
+// Reads runs the reads in spec against the files in a finished commit.
+func Reads(env *Env, pachClient *client.APIClient, commit *pfs.Commit, spec *ReadsSpec) error {
+	files, err := listFiles(pachClient, commit)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	for i := 0; i < spec.Count; i++ {
+		if err := FuzzRead(env, pachClient, commit, files, spec.ReadSpecs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func listFiles(pachClient *client.APIClient, commit *pfs.Commit) ([]*pfs.FileInfo, error) {
+	var files []*pfs.FileInfo
+	if err := pachClient.WalkFile(commit, "/", func(fi *pfs.FileInfo) error {
+		if fi.FileType == pfs.FileType_FILE {
+			files = append(files, fi)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+type ReadSpec struct {
+	GetFileSpec    *GetFileSpec    `yaml:"getFile,omitempty"`
+	RangedReadSpec *RangedReadSpec `yaml:"rangedRead,omitempty"`
+	ListFileSpec   *ListFileSpec   `yaml:"listFile,omitempty"`
+	GlobFileSpec   *GlobFileSpec   `yaml:"globFile,omitempty"`
+	Prob           int             `yaml:"prob,omitempty"`
+}
+
+func Read(env *Env, pachClient *client.APIClient, commit *pfs.Commit, files []*pfs.FileInfo, spec *ReadSpec) error {
+	file := files[env.Rand().Intn(len(files))]
+	switch {
+	case spec.GetFileSpec != nil:
+		return GetFile(env, pachClient, commit, file, spec.GetFileSpec)
+	case spec.RangedReadSpec != nil:
+		return RangedRead(env, pachClient, commit, file, spec.RangedReadSpec)
+	case spec.ListFileSpec != nil:
+		return ListFile(env, pachClient, commit, file, spec.ListFileSpec)
+	case spec.GlobFileSpec != nil:
+		return GlobFile(env, pachClient, commit, spec.GlobFileSpec)
+	default:
+		return errors.Errorf("read must specify a type of read")
+	}
+}
+
+type GetFileSpec struct{}
+
+// GetFile reads a random file in full.
+func GetFile(env *Env, pachClient *client.APIClient, commit *pfs.Commit, file *pfs.FileInfo, spec *GetFileSpec) error {
+	return env.Stats().Time("getFile", func() (int64, error) {
+		w := &countWriter{}
+		err := pachClient.GetFile(commit, file.File.Path, w)
+		return w.size, err
+	})
+}
+
+type RangedReadSpec struct {
+	SizeSpecs []*SizeSpec `yaml:"size,omitempty"`
+}
+
+// RangedRead reads a range of a random file, starting at a random offset.
+func RangedRead(env *Env, pachClient *client.APIClient, commit *pfs.Commit, file *pfs.FileInfo, spec *RangedReadSpec) error {
+	sizeSpec, err := FuzzSize(spec.SizeSpecs, env.Rand())
+	if err != nil {
+		return err
+	}
+	size := int64(sizeSpec.Min)
+	if sizeSpec.Max > sizeSpec.Min {
+		size += env.Rand().Int63n(int64(sizeSpec.Max - sizeSpec.Min))
+	}
+	var offset int64
+	if file.SizeBytes > 0 {
+		offset = env.Rand().Int63n(int64(file.SizeBytes))
+	}
+	// The file's size is known from listing the commit, so the timed read is a
+	// single GetFile. PFS doesn't support range requests, so the read discards
+	// the file up to the offset.
+	return env.Stats().Time("rangedRead", func() (int64, error) {
+		r, err := pachClient.GetFileReader(commit, file.File.Path)
+		if err != nil {
+			return 0, err
+		}
+		if _, err := io.CopyN(ioutil.Discard, r, offset); err != nil {
+			return 0, err
+		}
+		n, err := io.CopyN(&countWriter{}, r, size)
+		if errors.Is(err, io.EOF) {
+			err = nil
+		}
+		return n, err
+	})
+}
+
+type ListFileSpec struct{}
+
+// ListFile lists the directory containing a random file.
+func ListFile(env *Env, pachClient *client.APIClient, commit *pfs.Commit, file *pfs.FileInfo, spec *ListFileSpec) error {
+	return env.Stats().Time("listFile", func() (int64, error) {
+		return 0, pachClient.ListFile(commit, path.Dir(file.File.Path), func(*pfs.FileInfo) error {
+			return nil
+		})
+	})
+}
+
+type GlobFileSpec struct {
+	Pattern string `yaml:"pattern,omitempty"`
+}
+
+// GlobFile globs the commit with the pattern in spec, which defaults to
+// matching every file.
+func GlobFile(env *Env, pachClient *client.APIClient, commit *pfs.Commit, spec *GlobFileSpec) error {
+	pattern := spec.Pattern
+	if pattern == "" {
+		pattern = "**"
+	}
+	return env.Stats().Time("globFile", func() (int64, error) {
+		return 0, pachClient.GlobFile(commit, pattern, func(*pfs.FileInfo) error {
+			return nil
+		})
+	})
+}
+
+type countWriter struct {
+	size int64
+}
+
+func (w *countWriter) Write(data []byte) (int, error) {
+	w.size += int64(len(data))
+	return len(data), nil
+}
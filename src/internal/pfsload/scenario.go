@@ -0,0 +1,109 @@
+package pfsload
+
+import (
+	"reflect"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// LoadSpec is a load test specification. It either lists named scenarios,
+// or is itself a single unnamed scenario.
+type LoadSpec struct {
+	ScenarioSpecs []*ScenarioSpec `yaml:"scenarios,omitempty"`
+	ScenarioSpec  `yaml:",inline"`
+}
+
+// ParseLoadSpec parses a load test specification.
+func ParseLoadSpec(data []byte) (*LoadSpec, error) {
+	spec := &LoadSpec{}
+	if err := yaml.UnmarshalStrict(data, spec); err != nil {
+		return nil, err
+	}
+	if len(spec.ScenarioSpecs) == 0 {
+		return spec, nil
+	}
+	if !reflect.DeepEqual(spec.ScenarioSpec, ScenarioSpec{}) {
+		return nil, errors.Errorf("a spec with scenarios can't also be a scenario")
+	}
+	names := make(map[string]bool)
+	for _, scenarioSpec := range spec.ScenarioSpecs {
+		if scenarioSpec.Name == "" {
+			return nil, errors.Errorf("scenarios must be named")
+		}
+		if names[scenarioSpec.Name] {
+			return nil, errors.Errorf("duplicate scenario %s", scenarioSpec.Name)
+		}
+		names[scenarioSpec.Name] = true
+	}
+	return spec, nil
+}
+
+// Scenarios returns the scenarios in the spec, or only the scenario with the
+// given name if name is set.
+func (s *LoadSpec) Scenarios(name string) ([]*ScenarioSpec, error) {
+	scenarioSpecs := s.ScenarioSpecs
+	if len(scenarioSpecs) == 0 {
+		scenarioSpecs = []*ScenarioSpec{&s.ScenarioSpec}
+	}
+	if name == "" {
+		return scenarioSpecs, nil
+	}
+	for _, scenarioSpec := range scenarioSpecs {
+		if scenarioSpec.Name == name {
+			return []*ScenarioSpec{scenarioSpec}, nil
+		}
+	}
+	return nil, errors.Errorf("scenario %s not found", name)
+}
+
+// ScenarioSpec is a named workload. A scenario with a seed runs the same
+// operations each time it runs.
+type ScenarioSpec struct {
+	Name         string `yaml:"name,omitempty"`
+	Seed         int64  `yaml:"seed,omitempty"`
+	CommitsSpec  `yaml:",inline"`
+	ReadsSpec    *ReadsSpec    `yaml:"reads,omitempty"`
+	PipelineSpec *PipelineSpec `yaml:"pipeline,omitempty"`
+}
+
+// Scenario runs a scenario on a branch. Each commit is followed by the
+// scenario's reads, and the job that processes it when the scenario has a
+// pipeline. The stats of the operations that ran are returned even if the
+// scenario fails.
+func Scenario(pachClient *client.APIClient, repo, branch string, spec *ScenarioSpec, seed int64) (_ *Stats, retErr error) {
+	env, err := NewEnv(NewPachClient(pachClient), &spec.CommitsSpec, seed)
+	if err != nil {
+		return nil, err
+	}
+	var pipeline string
+	if spec.PipelineSpec != nil {
+		pipeline = repo + "_" + branch
+		if err := CreatePipeline(pachClient, pipeline, repo, branch, spec.PipelineSpec); err != nil {
+			return env.Stats(), err
+		}
+		defer func() {
+			if err := pachClient.DeletePipeline(pipeline, false); err != nil && retErr == nil {
+				retErr = err
+			}
+		}()
+	}
+	for i := 0; i < spec.Count; i++ {
+		commit, err := Commit(env, pachClient, repo, branch, &spec.CommitsSpec)
+		if err != nil {
+			return env.Stats(), err
+		}
+		if pipeline != "" {
+			if err := WaitJob(env, pachClient, commit, pipeline); err != nil {
+				return env.Stats(), err
+			}
+		}
+		if spec.ReadsSpec != nil {
+			if err := Reads(env, pachClient, commit, spec.ReadsSpec); err != nil {
+				return env.Stats(), err
+			}
+		}
+	}
+	return env.Stats(), nil
+}
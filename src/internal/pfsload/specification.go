@@ -3,6 +3,18 @@ package pfsload
 const LoadSpecification string = `
 Specification:
 
+A spec is either a list of named scenarios, or a single unnamed scenario.
+
+scenarios: [ ScenarioSpec ]
+
+-- ScenarioSpec --
+
+name: string
+seed: int
+reads: ReadsSpec
+pipeline: PipelineSpec
+(CommitSpec fields)
+
 -- CommitSpec --
 
 count: int
@@ -66,41 +78,103 @@ min: int
 max: int
 prob: int [0, 100]
 
+-- ReadsSpec --
+
+count: int
+read: [ ReadSpec ]
+
+-- ReadSpec --
+
+getFile: {}
+rangedRead:
+  size: [ SizeSpec ]
+listFile: {}
+globFile:
+  pattern: string
+prob: int [0, 100]
+
+-- PipelineSpec --
+
+image: string
+cmd: [ string ]
+stdin: [ string ]
+glob: string
+parallelism: int
+
 Example: 
 
-count: 5
-operations:
-  - count: 5
-    operation:
-      - putFile:
-          files:
-            count: 5
-            file:
-              - source: "random"
+scenarios:
+  - name: "small-files"
+    seed: 1
+    count: 5
+    operations:
+      - count: 5
+        operation:
+          - putFile:
+              files:
+                count: 100
+                file:
+                  - source: "random"
+                    prob: 100
+            prob: 100
+    fileSources:
+      - name: "random"
+        random:
+          size:
+            - min: 100
+              max: 1000
+              prob: 100
+    reads:
+      count: 20
+      read:
+        - getFile: {}
+          prob: 40
+        - rangedRead:
+            size:
+              - min: 10
+                max: 100
                 prob: 100
-        prob: 70 
-      - deleteFile:
-          count: 5
-          directoryProb: 20 
-        prob: 30 
-validator: {}
-fileSources:
-  - name: "random"
-    random:
-      directory:
-        depth: 3
-        run: 3
-      size:
-        - min: 1000
-          max: 10000
-          prob: 30 
-        - min: 10000
-          max: 100000
-          prob: 30 
-        - min: 1000000
-          max: 10000000
-          prob: 30 
-        - min: 10000000
-          max: 100000000
-          prob: 10 
+          prob: 30
+        - listFile: {}
+          prob: 20
+        - globFile:
+            pattern: "/*"
+          prob: 10
+    pipeline: {}
+  - name: "mixed"
+    count: 5
+    operations:
+      - count: 5
+        operation:
+          - putFile:
+              files:
+                count: 5
+                file:
+                  - source: "random"
+                    prob: 100
+            prob: 70 
+          - deleteFile:
+              count: 5
+              directoryProb: 20 
+            prob: 30 
+    validator: {}
+    fileSources:
+      - name: "random"
+        random:
+          directory:
+            depth: 3
+            run: 3
+          size:
+            - min: 1000
+              max: 10000
+              prob: 30 
+            - min: 10000
+              max: 100000
+              prob: 30 
+            - min: 1000000
+              max: 10000000
+              prob: 30 
+            - min: 10000000
+              max: 100000000
+              prob: 10 
 `
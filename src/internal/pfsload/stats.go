@@ -0,0 +1,131 @@
+package pfsload
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// latencyBuckets are the upper bounds of the latency histogram buckets.
+// Latencies above the last bound go in an unbounded overflow bucket.
+var latencyBuckets = []time.Duration{
+	time.Millisecond,
+	2 * time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	20 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	200 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2 * time.Second,
+	5 * time.Second,
+	10 * time.Second,
+	30 * time.Second,
+	time.Minute,
+}
+
+// Stats records the latency and size of the operations in a load test.
+// Stats is not safe for concurrent use.
+type Stats struct {
+	operations []string
+	stats      map[string]*operationStats
+}
+
+type operationStats struct {
+	latencies []time.Duration
+	bytes     int64
+}
+
+func NewStats() *Stats {
+	return &Stats{stats: make(map[string]*operationStats)}
+}
+
+// Time runs f and records its latency and the number of bytes it returns
+// under operation. Nothing is recorded if f fails.
+func (s *Stats) Time(operation string, f func() (int64, error)) error {
+	start := time.Now()
+	bytes, err := f()
+	if err != nil {
+		return err
+	}
+	s.Record(operation, time.Since(start), bytes)
+	return nil
+}
+
+func (s *Stats) Record(operation string, latency time.Duration, bytes int64) {
+	ops, ok := s.stats[operation]
+	if !ok {
+		ops = &operationStats{}
+		s.stats[operation] = ops
+		s.operations = append(s.operations, operation)
+	}
+	ops.latencies = append(ops.latencies, latency)
+	ops.bytes += bytes
+}
+
+// Operations returns the stats of each operation, in the order the
+// operations were first recorded. Throughput is computed over duration, the
+// wall-clock time that the operations ran in.
+func (s *Stats) Operations(duration time.Duration) []*pfs.LoadTestOperationStats {
+	var result []*pfs.LoadTestOperationStats
+	for _, operation := range s.operations {
+		result = append(result, s.stats[operation].toProto(operation, duration))
+	}
+	return result
+}
+
+func (ops *operationStats) toProto(operation string, duration time.Duration) *pfs.LoadTestOperationStats {
+	latencies := make([]time.Duration, len(ops.latencies))
+	copy(latencies, ops.latencies)
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	var total time.Duration
+	for _, latency := range latencies {
+		total += latency
+	}
+	result := &pfs.LoadTestOperationStats{
+		Operation: operation,
+		Count:     int64(len(latencies)),
+		Bytes:     ops.bytes,
+		Total:     types.DurationProto(total),
+		P50:       types.DurationProto(percentile(latencies, 0.5)),
+		P90:       types.DurationProto(percentile(latencies, 0.9)),
+		P99:       types.DurationProto(percentile(latencies, 0.99)),
+		Max:       types.DurationProto(latencies[len(latencies)-1]),
+		Histogram: histogram(latencies),
+	}
+	if duration > 0 {
+		result.OpsPerSecond = float64(len(latencies)) / duration.Seconds()
+		result.BytesPerSecond = float64(ops.bytes) / duration.Seconds()
+	}
+	return result
+}
+
+// percentile returns the p-th percentile of sorted, which must not be empty.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	i := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if i < 0 {
+		i = 0
+	}
+	return sorted[i]
+}
+
+func histogram(sorted []time.Duration) []*pfs.LoadTestHistogramBucket {
+	buckets := make([]*pfs.LoadTestHistogramBucket, len(latencyBuckets)+1)
+	for i, upperBound := range latencyBuckets {
+		buckets[i] = &pfs.LoadTestHistogramBucket{UpperBound: types.DurationProto(upperBound)}
+	}
+	buckets[len(latencyBuckets)] = &pfs.LoadTestHistogramBucket{}
+	i := 0
+	for _, latency := range sorted {
+		for i < len(latencyBuckets) && latency > latencyBuckets[i] {
+			i++
+		}
+		buckets[i].Count++
+	}
+	return buckets
+}
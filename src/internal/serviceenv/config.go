@@ -29,6 +29,7 @@ type GlobalConfiguration struct {
 	PostgresServicePort int    `env:"POSTGRES_SERVICE_PORT"`
 	PostgresServiceSSL  string `env:"POSTGRES_SERVICE_SSL,default=disable"`
 	PostgresDBName      string `env:"POSTGRES_DATABASE_NAME"`
+	WorkBackend         string `env:"WORK_BACKEND,default=etcd"`
 
 	// PPSSpecCommitID and PPSPipelineName are only set for workers and sidecar
 	// pachd instances. Because both pachd and worker need to know the spec commit
@@ -73,9 +74,20 @@ type PachdSpecificConfiguration struct {
 	// TODO: Merge this with the worker specific pod name (PPS_POD_NAME) into a global configuration pod name.
 	PachdPodName string `env:"PACHD_POD_NAME,required"`
 
+	// PPSWorkerBackend selects how pipeline workers are run: as pods in
+	// kubernetes ("kubernetes") or as processes on the pachd host ("local").
+	// PPSLocalWorkerBinary and PPSLocalWorkerRoot are only used by the latter.
+	PPSWorkerBackend     string `env:"PPS_WORKER_BACKEND,default=kubernetes"`
+	PPSLocalWorkerBinary string `env:"PPS_LOCAL_WORKER_BINARY,default=worker"`
+	PPSLocalWorkerRoot   string `env:"PPS_LOCAL_WORKER_ROOT,default=/tmp/pach/workers"`
+
 	IdentityServerDatabase string `env:"IDENTITY_SERVER_DATABASE,default=dex"`
 	IdentityServerUser     string `env:"IDENTITY_SERVER_USER,default=postgres"`
 	IdentityServerPassword string `env:"IDENTITY_SERVER_PASSWORD"`
+
+	// GroupSyncConfig is the path to a file that configures the external
+	// sources of group membership that the auth service periodically syncs.
+	GroupSyncConfig string `env:"GROUP_SYNC_CONFIG,default="`
 }
 
 // StorageConfiguration contains the storage configuration.
@@ -91,6 +103,14 @@ type StorageConfiguration struct {
 	StorageFileSetsMaxOpen         int    `env:"STORAGE_FILESETS_MAX_OPEN,default=50"`
 	StorageDiskCacheSize           int    `env:"STORAGE_DISK_CACHE_SIZE,default=100"`
 	StorageMemoryCacheSize         int    `env:"STORAGE_MEMORY_CACHE_SIZE,default=100"`
+	StorageChunkCachePath          string `env:"STORAGE_CHUNK_CACHE_PATH"`
+	StorageChunkCacheBytes         int64  `env:"STORAGE_CHUNK_CACHE_BYTES,default=10737418240"`
+	StorageChunkCacheHostPath      string `env:"STORAGE_CHUNK_CACHE_HOST_PATH"`
+	StorageReplicaURL              string `env:"STORAGE_REPLICA_URL"`
+	StorageReplicaGracePeriod      string `env:"STORAGE_REPLICA_GRACE_PERIOD,default=24h"`
+	StorageColdTierURL             string `env:"STORAGE_COLD_TIER_URL"`
+	StorageColdTierAge             string `env:"STORAGE_COLD_TIER_AGE,default=2160h"`
+	StorageColdTierPromote         bool   `env:"STORAGE_COLD_TIER_PROMOTE,default=false"`
 }
 
 // WorkerFullConfiguration contains the full worker configuration.
@@ -102,10 +122,14 @@ type WorkerFullConfiguration struct {
 // WorkerSpecificConfiguration contains the worker specific configuration.
 type WorkerSpecificConfiguration struct {
 	// Worker gets its own IP here, via the k8s downward API. It then writes that
-	// IP back to etcd so that pachd can discover it
+	// IP back to etcd so that pachd can discover it. Workers that share a host
+	// (i.e. local workers) set this to a full host:port instead
 	PPSWorkerIP string `env:"PPS_WORKER_IP,required"`
 	// The name of this pod
 	PodName string `env:"PPS_POD_NAME,required"`
+	// The directory under which the worker mounts its inputs and outputs. This
+	// is only changed for workers that don't run in their own container.
+	PPSWorkerRoot string `env:"PPS_WORKER_ROOT,default=/"`
 }
 
 // FeatureFlags contains the configuration for feature flags.  XXX: if you're
@@ -11,9 +11,11 @@ import (
 
 	"github.com/chmduquesne/rollinghash/buzhash64"
 	units "github.com/docker/go-units"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/randutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
@@ -98,6 +100,85 @@ func TestCopy(t *testing.T) {
 	}
 }
 
+func TestCheck(t *testing.T) {
+	objC, chunks := newTestStorage(t)
+	ctx := context.Background()
+	seed := time.Now().UTC().UnixNano()
+	msg := fmt.Sprint("seed: ", strconv.FormatInt(seed, 10))
+	random := rand.New(rand.NewSource(seed))
+	as := generateAnnotations(random, test{1 * units.KB, 1 * units.MB})
+	writeAnnotations(t, chunks, as, msg)
+	var refs []*Ref
+	for _, a := range as {
+		for _, dataRef := range a.dataRefs {
+			refs = append(refs, dataRef.Ref)
+		}
+	}
+	require.True(t, len(refs) > 0, msg)
+	for _, ref := range refs {
+		require.NoError(t, chunks.Check(ctx, ref, false), msg)
+		require.NoError(t, chunks.Check(ctx, ref, true), msg)
+	}
+	// Corrupt every object, then check that the hash verification fails.
+	var names []string
+	require.NoError(t, objC.Walk(ctx, "", func(name string) error {
+		names = append(names, name)
+		return nil
+	}))
+	for _, name := range names {
+		require.NoError(t, objC.Put(ctx, name, bytes.NewReader([]byte("corrupt"))))
+	}
+	for _, ref := range refs {
+		require.NoError(t, chunks.Check(ctx, ref, false), msg)
+		require.YesError(t, chunks.Check(ctx, ref, true), msg)
+	}
+	// Delete every object, then check that the chunks are reported missing.
+	for _, name := range names {
+		require.NoError(t, objC.Delete(ctx, name))
+	}
+	for _, ref := range refs {
+		require.True(t, errors.Is(chunks.Check(ctx, ref, false), ErrChunkNotExists), msg)
+		require.True(t, errors.Is(chunks.Check(ctx, ref, true), ErrChunkNotExists), msg)
+	}
+}
+
+// TestCheckBypassesCache checks that Check reports chunks that are missing
+// from object storage, even when they are still in the chunk cache.
+func TestCheckBypassesCache(t *testing.T) {
+	diskCache, err := kv.NewDiskCache(t.TempDir(), units.GB)
+	require.NoError(t, err)
+	objC, chunks := newTestStorage(t, WithDiskCache(diskCache))
+	ctx := context.Background()
+	seed := time.Now().UTC().UnixNano()
+	msg := fmt.Sprint("seed: ", strconv.FormatInt(seed, 10))
+	random := rand.New(rand.NewSource(seed))
+	as := generateAnnotations(random, test{1 * units.KB, 1 * units.MB})
+	// Writing the chunks also puts them in the cache.
+	writeAnnotations(t, chunks, as, msg)
+	var refs []*Ref
+	for _, a := range as {
+		for _, dataRef := range a.dataRefs {
+			refs = append(refs, dataRef.Ref)
+		}
+	}
+	require.True(t, len(refs) > 0, msg)
+	for _, ref := range refs {
+		require.NoError(t, chunks.Check(ctx, ref, true), msg)
+	}
+	var names []string
+	require.NoError(t, objC.Walk(ctx, "", func(name string) error {
+		names = append(names, name)
+		return nil
+	}))
+	for _, name := range names {
+		require.NoError(t, objC.Delete(ctx, name))
+	}
+	for _, ref := range refs {
+		require.True(t, errors.Is(chunks.Check(ctx, ref, false), ErrChunkNotExists), msg)
+		require.True(t, errors.Is(chunks.Check(ctx, ref, true), ErrChunkNotExists), msg)
+	}
+}
+
 func BenchmarkWriter(b *testing.B) {
 	_, chunks := newTestStorage(b)
 	seed := time.Now().UTC().UnixNano()
@@ -205,8 +286,8 @@ func readAnnotations(t *testing.T, chunks *Storage, annotations []*testAnnotatio
 
 // newTestStorage is like NewTestStorage except it doesn't need an external tracker
 // it is for testing this package, not for reuse.
-func newTestStorage(t testing.TB) (obj.Client, *Storage) {
+func newTestStorage(t testing.TB, opts ...StorageOption) (obj.Client, *Storage) {
 	db := testutil.NewTestDB(t)
 	tr := track.NewTestTracker(t, db)
-	return NewTestStorage(t, db, tr)
+	return NewTestStorage(t, db, tr, opts...)
 }
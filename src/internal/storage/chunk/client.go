@@ -10,6 +10,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
 )
@@ -29,6 +30,8 @@ type trackedClient struct {
 	tracker track.Tracker
 	renewer *track.Renewer
 	ttl     time.Duration
+	// cold is nil unless the storage has a cold tier.
+	cold *coldTier
 }
 
 // NewClient returns a client which will write to objc, mdstore, and tracker.  Name is used
@@ -75,6 +78,7 @@ func (c *trackedClient) Create(ctx context.Context, md Metadata, chunkData []byt
 		}
 		if len(ents) > 0 {
 			needUpload = false
+			gen = ents[0].Gen
 			return nil
 		}
 		if err := tx.Get(&gen, `
@@ -93,6 +97,10 @@ func (c *trackedClient) Create(ctx context.Context, md Metadata, chunkData []byt
 		return nil, err
 	}
 	if !needUpload {
+		if c.cold != nil {
+			// The chunk is referenced by new data, so keep it in the hot tier.
+			c.cold.recordRead(ctx, chunkID, gen)
+		}
 		return chunkID, nil
 	}
 	key := chunkKey(chunkID, gen)
@@ -112,21 +120,86 @@ func (c *trackedClient) Create(ctx context.Context, md Metadata, chunkData []byt
 
 // Get writes data for a chunk with ID chunkID to w.
 func (c *trackedClient) Get(ctx context.Context, chunkID ID, cb kv.ValueCallback) (retErr error) {
+	if c.cold != nil {
+		gen, cold, err := c.locate(ctx, chunkID)
+		if err != nil {
+			return err
+		}
+		return c.cold.get(ctx, c.store, chunkID, gen, cold, cb)
+	}
+	key, err := c.key(ctx, chunkID)
+	if err != nil {
+		return err
+	}
+	return c.store.Get(ctx, key, cb)
+}
+
+// Check verifies that the object for a chunk exists and, if readChunk is true,
+// that its contents match the chunk's ID. The client's store must be the
+// uncached object store, as it is for Storage.Check.
+func (c *trackedClient) Check(ctx context.Context, chunkID ID, readChunk bool) error {
+	gen, cold, err := c.locate(ctx, chunkID)
+	if err != nil {
+		return err
+	}
+	key := chunkKey(chunkID, gen)
+	store := c.store
+	if cold {
+		store = c.cold.objStore
+	}
+	if !readChunk {
+		exists, err := store.Exists(ctx, key)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errors.Wrapf(ErrChunkNotExists, "object %s", key)
+		}
+		return nil
+	}
+	if err := store.Get(ctx, key, func(data []byte) error {
+		return verifyData(chunkID, data)
+	}); err != nil {
+		if pacherr.IsNotExist(err) {
+			return errors.Wrapf(ErrChunkNotExists, "object %s", key)
+		}
+		return err
+	}
+	return nil
+}
+
+// key returns the object key for the uploaded generation of a chunk.
+func (c *trackedClient) key(ctx context.Context, chunkID ID) ([]byte, error) {
+	gen, _, err := c.locate(ctx, chunkID)
+	if err != nil {
+		return nil, err
+	}
+	return chunkKey(chunkID, gen), nil
+}
+
+// locate returns the uploaded generation of a chunk and whether it is in the
+// cold tier.
+func (c *trackedClient) locate(ctx context.Context, chunkID ID) (uint64, bool, error) {
 	var gen uint64
-	err := c.db.Get(&gen, `
-	SELECT gen
-	FROM storage.chunk_objects
-	WHERE uploaded = TRUE AND tombstone = FALSE AND chunk_id = $1
-	LIMIT 1
-	`, chunkID)
+	var cold bool
+	var err error
+	if c.cold != nil {
+		gen, cold, err = c.cold.locate(ctx, chunkID)
+	} else {
+		err = c.db.GetContext(ctx, &gen, `
+		SELECT gen
+		FROM storage.chunk_objects
+		WHERE uploaded = TRUE AND tombstone = FALSE AND chunk_id = $1
+		LIMIT 1
+		`, chunkID)
+	}
 	if err != nil {
 		if err == sql.ErrNoRows {
 			err = errors.Errorf("no objects for chunk %v", chunkID)
 		}
-		return err
+		return 0, false, err
 	}
-	key := chunkKey(chunkID, gen)
-	return c.store.Get(ctx, key, cb)
+	return gen, cold, nil
 }
 
 // Close closes the client, stopping the background renewal of created objects
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
 	"github.com/sirupsen/logrus"
 )
 
@@ -80,7 +81,15 @@ func (gc *GarbageCollector) deleteOne(ctx context.Context, ent Entry) error {
 }
 
 func (gc *GarbageCollector) deleteObject(ctx context.Context, chunkID ID, gen uint64) error {
-	return gc.s.store.Delete(ctx, chunkKey(chunkID, gen))
+	if gc.s.cold == nil {
+		return gc.s.store.Delete(ctx, chunkKey(chunkID, gen))
+	}
+	// The object is in one of the tiers, so it is not an error for it to be
+	// missing from the other.
+	if err := gc.s.store.Delete(ctx, chunkKey(chunkID, gen)); err != nil && !pacherr.IsNotExist(err) {
+		return err
+	}
+	return gc.s.cold.delete(ctx, chunkID, gen)
 }
 
 func (gc *GarbageCollector) deleteEntry(ctx context.Context, chunkID ID, gen uint64) error {
@@ -4,10 +4,13 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/chmduquesne/rollinghash/buzhash64"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 )
 
@@ -29,6 +32,24 @@ func WithObjectCache(fastLayer obj.Client, size int) StorageOption {
 	}
 }
 
+// WithDiskCache adds a cache for chunk objects in front of object storage.
+// The cache holds chunks as they are stored in object storage, so they are
+// still encrypted.
+func WithDiskCache(cache kv.GetPut) StorageOption {
+	return func(s *Storage) {
+		s.diskCache = cache
+	}
+}
+
+// WithColdTier adds a cold tier that chunks which have not been created or
+// read within after are moved to by a Tierer. If promote is true, chunks read
+// from the cold tier are moved back to the hot tier.
+func WithColdTier(cold obj.Client, after time.Duration, promote bool) StorageOption {
+	return func(s *Storage) {
+		s.cold = newColdTier(s.db, kv.NewFromObjectClient(cold), after, promote)
+	}
+}
+
 // WithSecret sets the secret used to generate chunk encryption keys
 func WithSecret(secret []byte) StorageOption {
 	return func(s *Storage) {
@@ -83,5 +104,27 @@ func StorageOptions(conf *serviceenv.Configuration) ([]StorageOption, error) {
 		}
 		opts = append(opts, WithObjectCache(diskCache, conf.StorageDiskCacheSize))
 	}
+	if conf.StorageChunkCachePath != "" {
+		chunkCache, err := kv.NewDiskCache(conf.StorageChunkCachePath, conf.StorageChunkCacheBytes)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithDiskCache(chunkCache))
+	}
+	if conf.StorageColdTierURL != "" {
+		url, err := obj.ParseURL(conf.StorageColdTierURL)
+		if err != nil {
+			return nil, err
+		}
+		cold, err := obj.NewClientFromURLAndSecret(url)
+		if err != nil {
+			return nil, err
+		}
+		after, err := time.ParseDuration(conf.StorageColdTierAge)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse cold tier age")
+		}
+		opts = append(opts, WithColdTier(cold, after, conf.StorageColdTierPromote))
+	}
 	return opts, nil
 }
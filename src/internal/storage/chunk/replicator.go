@@ -0,0 +1,396 @@
+package chunk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	replicationBatchSize = 100
+	// replicationRetryDelay is how long a chunk that could not be replicated
+	// waits before it is retried. The delay doubles with each failed attempt,
+	// up to maxReplicationRetryDelay.
+	replicationRetryDelay    = time.Minute
+	maxReplicationRetryDelay = 6 * time.Hour
+)
+
+var (
+	replicationLag = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "pachyderm",
+			Subsystem: "storage_replication",
+			Name:      "lag_seconds",
+			Help:      "Age of the oldest chunk that has not been replicated",
+		},
+	)
+	replicationPending = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "pachyderm",
+			Subsystem: "storage_replication",
+			Name:      "pending_chunks",
+			Help:      "Number of chunks that have not been replicated",
+		},
+	)
+	replicationResults = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "pachyderm",
+			Subsystem: "storage_replication",
+			Name:      "operations",
+			Help:      "Replica copies and deletes, count by operation and result",
+		},
+		[]string{"operation", "result"},
+	)
+)
+
+// Replicator asynchronously copies chunk objects to a replica object store,
+// and deletes them from the replica a grace period after the garbage
+// collector deletes them from the primary. The grace period allows a restore
+// from the replica to a point in time shortly before a deletion.
+//
+// Progress is tracked in storage.chunk_replicas, so a replicator that is
+// enabled on an existing cluster replicates every existing chunk. Failed
+// attempts are tracked in storage.chunk_replica_failures, so that a chunk that
+// keeps failing is retried less often and doesn't hold up the others.
+type Replicator struct {
+	s           *Storage
+	replica     kv.Store
+	gracePeriod time.Duration
+	log         *logrus.Logger
+}
+
+// NewReplicator returns a replicator that replicates the chunks in s to
+// replica.
+func NewReplicator(s *Storage, replica obj.Client, gracePeriod time.Duration) *Replicator {
+	for _, m := range []prometheus.Collector{replicationLag, replicationPending, replicationResults} {
+		if err := prometheus.Register(m); err != nil {
+			if !errors.As(err, &prometheus.AlreadyRegisteredError{}) {
+				logrus.Errorf("error registering prometheus metric: %v", err)
+			}
+		}
+	}
+	return &Replicator{
+		s:           s,
+		replica:     kv.NewFromObjectClient(replica),
+		gracePeriod: gracePeriod,
+		log:         logrus.StandardLogger(),
+	}
+}
+
+// RunForever calls RunOnce until the context is cancelled, logging any errors.
+func (r *Replicator) RunForever(ctx context.Context) error {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		if err := r.RunOnce(ctx); err != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			default:
+			}
+			r.log.Errorf("during chunk replication: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce copies every pending chunk that is due to the replica, then deletes
+// the chunks whose grace period has passed.
+func (r *Replicator) RunOnce(ctx context.Context) error {
+	for {
+		ents, err := r.pending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(ents) == 0 {
+			break
+		}
+		for _, ent := range ents {
+			if err := r.replicateOne(ctx, ent); err != nil {
+				return err
+			}
+		}
+		if err := r.updateMetrics(ctx); err != nil {
+			return err
+		}
+	}
+	if err := r.updateMetrics(ctx); err != nil {
+		return err
+	}
+	if err := r.markDeleted(ctx); err != nil {
+		return err
+	}
+	return r.deleteExpired(ctx)
+}
+
+// pending returns chunk objects that are uploaded and have not been
+// replicated, skipping those that failed to replicate and are not yet due for
+// a retry.
+func (r *Replicator) pending(ctx context.Context) ([]Entry, error) {
+	var ents []Entry
+	if err := r.s.db.SelectContext(ctx, &ents, `
+		SELECT co.chunk_id, co.gen
+		FROM storage.chunk_objects co
+		LEFT JOIN storage.chunk_replicas cr ON co.chunk_id = cr.chunk_id AND co.gen = cr.gen
+		LEFT JOIN storage.chunk_replica_failures crf ON co.chunk_id = crf.chunk_id AND co.gen = crf.gen
+		WHERE co.uploaded = TRUE AND co.tombstone = FALSE AND cr.chunk_id IS NULL
+			AND (crf.next_attempt_at IS NULL OR crf.next_attempt_at <= CURRENT_TIMESTAMP)
+		ORDER BY co.created_at
+		LIMIT $1
+	`, replicationBatchSize); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return ents, nil
+}
+
+// replicateOne copies a chunk object to the replica, retrying transient
+// errors. If the chunk can't be copied, the failure is recorded so that the
+// chunk is retried later.
+func (r *Replicator) replicateOne(ctx context.Context, ent Entry) error {
+	key := chunkKey(ent.ChunkID, ent.Gen)
+	err := backoff.RetryUntilCancel(ctx, func() error {
+		return r.s.getObject(ctx, ent, func(data []byte) error {
+			return r.replica.Put(ctx, key, data)
+		})
+	}, backoff.New60sBackOff(), func(err error, d time.Duration) error {
+		if pacherr.IsNotExist(err) {
+			return err
+		}
+		r.log.Errorf("error replicating chunk %s: %v: retrying in: %v", key, err, d)
+		return nil
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		replicationResults.WithLabelValues("copy", "error").Inc()
+		// If the object does not exist, the garbage collector probably deleted
+		// the chunk after it was selected, and it will not be pending on the
+		// next run.
+		r.log.Errorf("could not replicate chunk %s: %v", key, err)
+		return r.recordFailure(ctx, ent)
+	}
+	replicationResults.WithLabelValues("copy", "success").Inc()
+	if _, err := r.s.db.ExecContext(ctx, `
+		INSERT INTO storage.chunk_replicas (chunk_id, gen)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, ent.ChunkID, ent.Gen); err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err = r.s.db.ExecContext(ctx, `
+		DELETE FROM storage.chunk_replica_failures
+		WHERE chunk_id = $1 AND gen = $2
+	`, ent.ChunkID, ent.Gen)
+	return errors.EnsureStack(err)
+}
+
+// recordFailure records a failed attempt to replicate a chunk, and delays its
+// next attempt.
+func (r *Replicator) recordFailure(ctx context.Context, ent Entry) error {
+	_, err := r.s.db.ExecContext(ctx, `
+		INSERT INTO storage.chunk_replica_failures (chunk_id, gen, attempts, next_attempt_at)
+		VALUES ($1, $2, 1, CURRENT_TIMESTAMP + $3 * INTERVAL '1 second')
+		ON CONFLICT (chunk_id, gen) DO UPDATE SET
+			attempts = chunk_replica_failures.attempts + 1,
+			next_attempt_at = CURRENT_TIMESTAMP + LEAST(POWER(2, chunk_replica_failures.attempts) * $3, $4) * INTERVAL '1 second'
+	`, ent.ChunkID, ent.Gen, replicationRetryDelay.Seconds(), maxReplicationRetryDelay.Seconds())
+	return errors.EnsureStack(err)
+}
+
+// markDeleted starts the grace period for replicated chunks whose objects the
+// garbage collector has deleted from the primary, and forgets the failures of
+// those that were never replicated.
+func (r *Replicator) markDeleted(ctx context.Context) error {
+	if _, err := r.s.db.ExecContext(ctx, `
+		DELETE FROM storage.chunk_replica_failures crf
+		WHERE NOT EXISTS (
+			SELECT 1 FROM storage.chunk_objects co
+			WHERE co.chunk_id = crf.chunk_id AND co.gen = crf.gen AND co.tombstone = FALSE
+		)
+	`); err != nil {
+		return errors.EnsureStack(err)
+	}
+	_, err := r.s.db.ExecContext(ctx, `
+		UPDATE storage.chunk_replicas cr
+		SET deleted_at = CURRENT_TIMESTAMP
+		WHERE deleted_at IS NULL AND NOT EXISTS (
+			SELECT 1 FROM storage.chunk_objects co
+			WHERE co.chunk_id = cr.chunk_id AND co.gen = cr.gen AND co.tombstone = FALSE
+		)
+	`)
+	return errors.EnsureStack(err)
+}
+
+// deleteExpired deletes chunks from the replica once their grace period has
+// passed.
+func (r *Replicator) deleteExpired(ctx context.Context) error {
+	var ents []Entry
+	if err := r.s.db.SelectContext(ctx, &ents, `
+		SELECT chunk_id, gen
+		FROM storage.chunk_replicas
+		WHERE deleted_at < CURRENT_TIMESTAMP - $1 * INTERVAL '1 second'
+	`, r.gracePeriod.Seconds()); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, ent := range ents {
+		key := chunkKey(ent.ChunkID, ent.Gen)
+		if err := r.replica.Delete(ctx, key); err != nil && !pacherr.IsNotExist(err) {
+			replicationResults.WithLabelValues("delete", "error").Inc()
+			return err
+		}
+		replicationResults.WithLabelValues("delete", "success").Inc()
+		if _, err := r.s.db.ExecContext(ctx, `
+			DELETE FROM storage.chunk_replicas
+			WHERE chunk_id = $1 AND gen = $2
+		`, ent.ChunkID, ent.Gen); err != nil {
+			return errors.EnsureStack(err)
+		}
+		r.log.WithFields(logrus.Fields{
+			"chunk_id": ent.ChunkID,
+			"gen":      ent.Gen,
+		}).Infof("deleting replica object for chunk entry")
+	}
+	return nil
+}
+
+func (r *Replicator) updateMetrics(ctx context.Context) error {
+	status, err := r.status(ctx)
+	if err != nil {
+		return err
+	}
+	replicationPending.Set(float64(status.Pending))
+	replicationLag.Set(status.Lag.Seconds())
+	return nil
+}
+
+// ReplicaStatus describes how complete a replica is.
+type ReplicaStatus struct {
+	// Chunks is the number of chunk objects in the primary.
+	Chunks int64
+	// Pending is the number of chunk objects that have not been replicated.
+	Pending int64
+	// Lag is the age of the oldest pending chunk object.
+	Lag time.Duration
+	// Missing lists the chunk objects that were replicated but are not in
+	// the replica. It is only populated by Verify with checkObjects set.
+	Missing []string
+}
+
+func (r *Replicator) status(ctx context.Context) (*ReplicaStatus, error) {
+	var row struct {
+		Chunks  int64        `db:"chunks"`
+		Pending int64        `db:"pending"`
+		Oldest  sql.NullTime `db:"oldest"`
+		Now     time.Time    `db:"now"`
+	}
+	if err := r.s.db.GetContext(ctx, &row, `
+		SELECT
+			COUNT(*) AS chunks,
+			COUNT(*) FILTER (WHERE cr.chunk_id IS NULL) AS pending,
+			MIN(co.created_at) FILTER (WHERE cr.chunk_id IS NULL) AS oldest,
+			CURRENT_TIMESTAMP::TIMESTAMP AS now
+		FROM storage.chunk_objects co
+		LEFT JOIN storage.chunk_replicas cr ON co.chunk_id = cr.chunk_id AND co.gen = cr.gen
+		WHERE co.uploaded = TRUE AND co.tombstone = FALSE
+	`); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	status := &ReplicaStatus{
+		Chunks:  row.Chunks,
+		Pending: row.Pending,
+	}
+	if row.Oldest.Valid {
+		status.Lag = row.Now.Sub(row.Oldest.Time)
+	}
+	return status, nil
+}
+
+// Verify reports how complete the replica is. If checkObjects is true, it
+// also checks that the object for every replicated chunk exists in the
+// replica, and if fix is also true, marks the missing chunks as pending so
+// that they are replicated again.
+func (r *Replicator) Verify(ctx context.Context, checkObjects, fix bool) (*ReplicaStatus, error) {
+	if !checkObjects {
+		return r.status(ctx)
+	}
+	var ents []Entry
+	if err := r.s.db.SelectContext(ctx, &ents, `
+		SELECT chunk_id, gen
+		FROM storage.chunk_replicas
+		WHERE deleted_at IS NULL
+	`); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var missing []string
+	for _, ent := range ents {
+		key := chunkKey(ent.ChunkID, ent.Gen)
+		exists, err := r.replica.Exists(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			continue
+		}
+		missing = append(missing, string(key))
+		if fix {
+			if _, err := r.s.db.ExecContext(ctx, `
+				DELETE FROM storage.chunk_replicas
+				WHERE chunk_id = $1 AND gen = $2
+			`, ent.ChunkID, ent.Gen); err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+		}
+	}
+	status, err := r.status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status.Missing = missing
+	return status, nil
+}
+
+// SetupReplicasV0 creates the table that tracks replicated chunk objects.
+func SetupReplicasV0(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE storage.chunk_replicas (
+			chunk_id BYTEA NOT NULL,
+			gen INT8 NOT NULL,
+			replicated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			deleted_at TIMESTAMP,
+
+			PRIMARY KEY(chunk_id, gen)
+		);
+		CREATE INDEX ON storage.chunk_replicas (deleted_at);
+	`)
+	return errors.EnsureStack(err)
+}
+
+// SetupReplicaFailuresV0 creates the table that tracks failed attempts to
+// replicate chunk objects.
+func SetupReplicaFailuresV0(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE storage.chunk_replica_failures (
+			chunk_id BYTEA NOT NULL,
+			gen INT8 NOT NULL,
+			attempts INT8 NOT NULL,
+			next_attempt_at TIMESTAMP NOT NULL,
+
+			PRIMARY KEY(chunk_id, gen)
+		);
+	`)
+	return errors.EnsureStack(err)
+}
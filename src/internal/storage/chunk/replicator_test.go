@@ -0,0 +1,134 @@
+package chunk
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+)
+
+func setupReplicas(ctx context.Context, t *testing.T, db *sqlx.DB) {
+	require.NoError(t, dbutil.WithTx(ctx, db, func(tx *sqlx.Tx) error {
+		if err := SetupReplicasV0(ctx, tx); err != nil {
+			return err
+		}
+		return SetupReplicaFailuresV0(ctx, tx)
+	}))
+}
+
+func TestReplicator(t *testing.T) {
+	ctx := context.Background()
+	db := testutil.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	oc, s := NewTestStorage(t, db, tracker)
+	setupReplicas(ctx, t, db)
+	replica, _ := obj.NewTestClient(t)
+	r := NewReplicator(s, replica, 0)
+
+	writeRandom(ctx, t, s)
+	status, err := r.Verify(ctx, false, false)
+	require.NoError(t, err)
+	require.True(t, status.Chunks > 0)
+	require.Equal(t, status.Chunks, status.Pending)
+
+	require.NoError(t, r.RunOnce(ctx))
+	status, err = r.Verify(ctx, true, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), status.Pending)
+	require.Equal(t, 0, len(status.Missing))
+	primaryCount, err := countObjects(ctx, oc)
+	require.NoError(t, err)
+	replicaCount, err := countObjects(ctx, replica)
+	require.NoError(t, err)
+	require.Equal(t, primaryCount, replicaCount)
+
+	// Remove an object from the replica, then check that verification finds
+	// it and that fixing it replicates it again.
+	var name string
+	require.NoError(t, replica.Walk(ctx, "", func(p string) error {
+		name = p
+		return nil
+	}))
+	require.NoError(t, replica.Delete(ctx, name))
+	status, err = r.Verify(ctx, true, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{name}, status.Missing)
+	require.Equal(t, int64(1), status.Pending)
+	require.NoError(t, r.RunOnce(ctx))
+	exists, err := replica.Exists(ctx, name)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	// Garbage collect every chunk, then check that the deletions are
+	// replicated.
+	_, err = db.ExecContext(ctx, `UPDATE storage.tracker_objects SET expires_at = CURRENT_TIMESTAMP - interval '1 hour'`)
+	require.NoError(t, err)
+	deleter := track.DeleterMux(func(tid string) track.Deleter {
+		switch {
+		case strings.HasPrefix(tid, TrackerPrefix):
+			return s.NewDeleter()
+		case strings.HasPrefix(tid, track.TmpTrackerPrefix):
+			return track.NewTmpDeleter()
+		default:
+			return nil
+		}
+	})
+	require.NoError(t, track.NewGarbageCollector(tracker, time.Minute, deleter).RunUntilEmpty(ctx))
+	require.NoError(t, NewGC(s).RunOnce(ctx))
+	require.NoError(t, r.RunOnce(ctx))
+	require.NoError(t, r.RunOnce(ctx))
+	replicaCount, err = countObjects(ctx, replica)
+	require.NoError(t, err)
+	require.Equal(t, 0, replicaCount)
+}
+
+func TestReplicatorSkipsFailingChunks(t *testing.T) {
+	ctx := context.Background()
+	db := testutil.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	_, s := NewTestStorage(t, db, tracker)
+	setupReplicas(ctx, t, db)
+	replica, _ := obj.NewTestClient(t)
+	r := NewReplicator(s, replica, 0)
+
+	// A chunk whose object is missing from the primary is the oldest pending
+	// chunk, so it's first in line.
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO storage.chunk_objects (chunk_id, size, uploaded, created_at)
+		VALUES ($1, 1, TRUE, CURRENT_TIMESTAMP - INTERVAL '1 day')
+	`, []byte("missing"))
+	require.NoError(t, err)
+	writeRandom(ctx, t, s)
+
+	// The other chunks are still replicated, and the failure is recorded.
+	require.NoError(t, r.RunOnce(ctx))
+	status, err := r.Verify(ctx, false, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), status.Pending)
+	var attempts int64
+	require.NoError(t, db.GetContext(ctx, &attempts, `
+		SELECT attempts FROM storage.chunk_replica_failures WHERE chunk_id = $1
+	`, []byte("missing")))
+	require.Equal(t, int64(1), attempts)
+
+	// The failing chunk isn't retried until it's due.
+	require.NoError(t, r.RunOnce(ctx))
+	require.NoError(t, db.GetContext(ctx, &attempts, `
+		SELECT attempts FROM storage.chunk_replica_failures WHERE chunk_id = $1
+	`, []byte("missing")))
+	require.Equal(t, int64(1), attempts)
+	_, err = db.ExecContext(ctx, `UPDATE storage.chunk_replica_failures SET next_attempt_at = CURRENT_TIMESTAMP - INTERVAL '1 second'`)
+	require.NoError(t, err)
+	require.NoError(t, r.RunOnce(ctx))
+	require.NoError(t, db.GetContext(ctx, &attempts, `
+		SELECT attempts FROM storage.chunk_replica_failures WHERE chunk_id = $1
+	`, []byte("missing")))
+	require.Equal(t, int64(2), attempts)
+}
@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
 )
@@ -21,7 +23,10 @@ const (
 type Storage struct {
 	objClient obj.Client
 	store     kv.Store
+	objStore  kv.Store
 	memCache  kv.GetPut
+	diskCache kv.GetPut
+	cold      *coldTier
 	tracker   track.Tracker
 	db        *sqlx.DB
 
@@ -43,6 +48,13 @@ func NewStorage(objC obj.Client, memCache kv.GetPut, db *sqlx.DB, tracker track.
 		opt(s)
 	}
 	s.store = kv.NewFromObjectClient(s.objClient)
+	s.objStore = s.store
+	if s.diskCache != nil {
+		s.store = kv.NewCachedStore(s.store, s.diskCache)
+		if s.cold != nil {
+			s.cold.store = kv.NewCachedStore(s.cold.objStore, s.diskCache)
+		}
+	}
 	s.objClient = nil
 	return s
 }
@@ -50,7 +62,7 @@ func NewStorage(objC obj.Client, memCache kv.GetPut, db *sqlx.DB, tracker track.
 // NewReader creates a new Reader.
 func (s *Storage) NewReader(ctx context.Context, dataRefs []*DataRef) *Reader {
 	// using the empty string for the tmp id to disable the renewer
-	client := NewClient(s.store, s.db, s.tracker, "")
+	client := s.newClient("")
 	return newReader(ctx, client, s.memCache, dataRefs)
 }
 
@@ -61,10 +73,27 @@ func (s *Storage) NewWriter(ctx context.Context, name string, cb WriterCallback,
 	if name == "" {
 		panic("name must not be empty")
 	}
-	client := NewClient(s.store, s.db, s.tracker, name)
+	client := s.newClient(name)
 	return newWriter(ctx, client, s.memCache, s.createOpts, cb, opts...)
 }
 
+func (s *Storage) newClient(name string) *trackedClient {
+	client := NewClient(s.store, s.db, s.tracker, name).(*trackedClient)
+	client.cold = s.cold
+	return client
+}
+
+// getObject reads a chunk object from object storage, bypassing the chunk
+// cache. Objects in the cold tier are read from the cold tier.
+func (s *Storage) getObject(ctx context.Context, ent Entry, cb kv.ValueCallback) error {
+	key := chunkKey(ent.ChunkID, ent.Gen)
+	err := s.objStore.Get(ctx, key, cb)
+	if s.cold != nil && pacherr.IsNotExist(err) {
+		return s.cold.objStore.Get(ctx, key, cb)
+	}
+	return err
+}
+
 // List lists all of the chunks in object storage.
 func (s *Storage) List(ctx context.Context, cb func(id ID) error) error {
 	return s.store.Walk(ctx, nil, func(key []byte) error {
@@ -76,3 +105,81 @@ func (s *Storage) List(ctx context.Context, cb func(id ID) error) error {
 func (s *Storage) NewDeleter() track.Deleter {
 	return &deleter{}
 }
+
+// Check verifies that the chunk referenced by ref exists in object storage.
+// If readChunk is true, the chunk is also read and checked against its hash.
+// The chunk cache is bypassed.
+func (s *Storage) Check(ctx context.Context, ref *Ref, readChunk bool) error {
+	client := NewClient(s.objStore, s.db, s.tracker, "").(*trackedClient)
+	client.cold = s.cold
+	return client.Check(ctx, ID(ref.Id), readChunk)
+}
+
+// Usage is the object storage used by a set of chunks.
+type Usage struct {
+	// UniqueBytes and UniqueChunks count the chunks that would be freed if the
+	// referencing objects were deleted.
+	UniqueBytes  int64
+	UniqueChunks int64
+	// SharedBytes and SharedChunks count the chunks that are also referenced
+	// from elsewhere.
+	SharedBytes  int64
+	SharedChunks int64
+}
+
+const usageBatchSize = 1000
+
+// UsageGeneration returns a value that changes whenever the result of Usage
+// may have changed for any set of tracker IDs. A chunk is shared depending on
+// every tracker object in the cluster, so it changes whenever any tracker
+// object is created or deleted.
+func (s *Storage) UsageGeneration(ctx context.Context) (string, error) {
+	return s.tracker.Generation(ctx)
+}
+
+// Usage computes the object storage used by the chunks reachable from the
+// tracker objects with the given IDs.
+func (s *Storage) Usage(ctx context.Context, trackerIDs []string) (*Usage, error) {
+	shared := make(map[string]bool)
+	var ids [][]byte
+	if err := s.tracker.IterateReachable(ctx, trackerIDs, TrackerPrefix, func(tid string, isShared bool) error {
+		id, err := ParseTrackerID(tid)
+		if err != nil {
+			return err
+		}
+		shared[string(id)] = isShared
+		ids = append(ids, id)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	usage := &Usage{}
+	for len(ids) > 0 {
+		batch := ids
+		if len(batch) > usageBatchSize {
+			batch = batch[:usageBatchSize]
+		}
+		ids = ids[len(batch):]
+		var sizes []struct {
+			ChunkID []byte `db:"chunk_id"`
+			Size    int64  `db:"size"`
+		}
+		if err := s.db.SelectContext(ctx, &sizes, `
+			SELECT DISTINCT ON (chunk_id) chunk_id, size
+			FROM storage.chunk_objects
+			WHERE chunk_id = ANY($1) AND uploaded = TRUE AND tombstone = FALSE
+		`, pq.ByteaArray(batch)); err != nil {
+			return nil, err
+		}
+		for _, x := range sizes {
+			if shared[string(x.ChunkID)] {
+				usage.SharedBytes += x.Size
+				usage.SharedChunks++
+			} else {
+				usage.UniqueBytes += x.Size
+				usage.UniqueChunks++
+			}
+		}
+	}
+	return usage, nil
+}
@@ -0,0 +1,326 @@
+package chunk
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	tieringBatchSize = 100
+	// readRecordInterval is the minimum time between recording reads of the
+	// same chunk from the same process.
+	readRecordInterval = time.Hour
+	maxRecordedReads   = 100000
+)
+
+var (
+	tieringMoves = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "pachyderm",
+			Subsystem: "storage_tiering",
+			Name:      "moves",
+			Help:      "Chunks moved between tiers, count by destination tier and result",
+		},
+		[]string{"tier", "result"},
+	)
+	tieringColdReads = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "pachyderm",
+			Subsystem: "storage_tiering",
+			Name:      "cold_reads",
+			Help:      "Number of chunk reads served from the cold tier",
+		},
+	)
+)
+
+// coldTier is a second, cheaper object store that holds chunks which have
+// not been read recently. The location of each chunk object is recorded in
+// storage.chunk_tiers; chunk objects without an entry are in the hot tier.
+type coldTier struct {
+	db *sqlx.DB
+	// store is used for reads, and may be cached. objStore is the uncached
+	// cold object store.
+	store    kv.Store
+	objStore kv.Store
+	after    time.Duration
+	promote  bool
+
+	mu    sync.Mutex
+	reads map[string]time.Time
+}
+
+func newColdTier(db *sqlx.DB, store kv.Store, after time.Duration, promote bool) *coldTier {
+	for _, m := range []prometheus.Collector{tieringMoves, tieringColdReads} {
+		if err := prometheus.Register(m); err != nil {
+			if !errors.As(err, &prometheus.AlreadyRegisteredError{}) {
+				logrus.Errorf("error registering prometheus metric: %v", err)
+			}
+		}
+	}
+	return &coldTier{
+		db:       db,
+		store:    store,
+		objStore: store,
+		after:    after,
+		promote:  promote,
+		reads:    make(map[string]time.Time),
+	}
+}
+
+// locate returns the uploaded generation of a chunk and whether it is in the
+// cold tier.
+func (t *coldTier) locate(ctx context.Context, chunkID ID) (uint64, bool, error) {
+	var row struct {
+		Gen  uint64 `db:"gen"`
+		Cold bool   `db:"cold"`
+	}
+	if err := t.db.GetContext(ctx, &row, `
+	SELECT co.gen, COALESCE(ct.cold, FALSE) AS cold
+	FROM storage.chunk_objects co
+	LEFT JOIN storage.chunk_tiers ct ON co.chunk_id = ct.chunk_id AND co.gen = ct.gen
+	WHERE co.uploaded = TRUE AND co.tombstone = FALSE AND co.chunk_id = $1
+	LIMIT 1
+	`, chunkID); err != nil {
+		return 0, false, err
+	}
+	return row.Gen, row.Cold, nil
+}
+
+// get reads a chunk object from the tier it is located in. A chunk may move
+// between being located and being read, so a missing object is read from the
+// other tier.
+func (t *coldTier) get(ctx context.Context, hot kv.Store, chunkID ID, gen uint64, cold bool, cb kv.ValueCallback) error {
+	t.recordRead(ctx, chunkID, gen)
+	key := chunkKey(chunkID, gen)
+	if !cold {
+		err := hot.Get(ctx, key, cb)
+		if !pacherr.IsNotExist(err) {
+			return err
+		}
+		cold = true
+	}
+	tieringColdReads.Inc()
+	err := t.store.Get(ctx, key, func(data []byte) error {
+		if t.promote {
+			if err := t.promoteOne(ctx, hot, chunkID, gen, data); err != nil {
+				logrus.Errorf("error promoting chunk %s to the hot tier: %v", key, err)
+			}
+		}
+		return cb(data)
+	})
+	if pacherr.IsNotExist(err) {
+		return hot.Get(ctx, key, cb)
+	}
+	return err
+}
+
+// recordRead records that a chunk object was read, so that it is not moved to
+// the cold tier. Reads are recorded at most once per readRecordInterval for
+// each chunk, and errors are logged rather than failing the read.
+func (t *coldTier) recordRead(ctx context.Context, chunkID ID, gen uint64) {
+	key := string(chunkKey(chunkID, gen))
+	now := time.Now()
+	t.mu.Lock()
+	if last, ok := t.reads[key]; ok && now.Sub(last) < readRecordInterval {
+		t.mu.Unlock()
+		return
+	}
+	if len(t.reads) >= maxRecordedReads {
+		t.reads = make(map[string]time.Time)
+	}
+	t.reads[key] = now
+	t.mu.Unlock()
+	if _, err := t.db.ExecContext(ctx, `
+	INSERT INTO storage.chunk_tiers (chunk_id, gen)
+	VALUES ($1, $2)
+	ON CONFLICT (chunk_id, gen) DO UPDATE SET last_read_at = CURRENT_TIMESTAMP
+	`, chunkID, gen); err != nil {
+		logrus.Errorf("error recording read of chunk %s: %v", key, err)
+	}
+}
+
+// promoteOne moves a chunk object that was read from the cold tier back to
+// the hot tier.
+func (t *coldTier) promoteOne(ctx context.Context, hot kv.Store, chunkID ID, gen uint64, data []byte) error {
+	key := chunkKey(chunkID, gen)
+	if err := hot.Put(ctx, key, data); err != nil {
+		tieringMoves.WithLabelValues("hot", "error").Inc()
+		return err
+	}
+	if _, err := t.db.ExecContext(ctx, `
+	UPDATE storage.chunk_tiers
+	SET cold = FALSE, moved_at = CURRENT_TIMESTAMP, last_read_at = CURRENT_TIMESTAMP
+	WHERE chunk_id = $1 AND gen = $2
+	`, chunkID, gen); err != nil {
+		tieringMoves.WithLabelValues("hot", "error").Inc()
+		return errors.EnsureStack(err)
+	}
+	tieringMoves.WithLabelValues("hot", "success").Inc()
+	if err := t.objStore.Delete(ctx, key); err != nil && !pacherr.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// delete removes a chunk object from the cold tier and forgets its location.
+func (t *coldTier) delete(ctx context.Context, chunkID ID, gen uint64) error {
+	if err := t.objStore.Delete(ctx, chunkKey(chunkID, gen)); err != nil && !pacherr.IsNotExist(err) {
+		return err
+	}
+	_, err := t.db.ExecContext(ctx, `
+	DELETE FROM storage.chunk_tiers
+	WHERE chunk_id = $1 AND gen = $2
+	`, chunkID, gen)
+	return errors.EnsureStack(err)
+}
+
+// Tierer moves chunk objects that have not been created or read within the
+// cold tier's age to the cold tier. Chunks that are still referenced by new
+// commits are recorded as read when they are deduplicated, so the chunks that
+// are moved are the ones that are only referenced by old commits.
+type Tierer struct {
+	s   *Storage
+	log *logrus.Logger
+}
+
+// NewTierer returns a tierer for s, which must have a cold tier.
+func NewTierer(s *Storage) *Tierer {
+	if s.cold == nil {
+		panic("storage must have a cold tier")
+	}
+	return &Tierer{s: s, log: logrus.StandardLogger()}
+}
+
+// RunForever calls RunOnce until the context is cancelled, logging any errors.
+func (t *Tierer) RunForever(ctx context.Context) error {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		if err := t.RunOnce(ctx); err != nil {
+			select {
+			case <-ctx.Done():
+				return err
+			default:
+			}
+			t.log.Errorf("during chunk tiering: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce moves every eligible chunk object to the cold tier.
+func (t *Tierer) RunOnce(ctx context.Context) error {
+	for {
+		ents, err := t.candidates(ctx)
+		if err != nil {
+			return err
+		}
+		if len(ents) == 0 {
+			return nil
+		}
+		failed := false
+		for _, ent := range ents {
+			ok, err := t.moveOne(ctx, ent)
+			if err != nil {
+				return err
+			}
+			failed = failed || !ok
+		}
+		if failed {
+			// Failed chunks are still candidates, so stop here rather than
+			// retrying them in a loop. They are retried on the next run.
+			return nil
+		}
+	}
+}
+
+// candidates returns hot chunk objects that were neither created nor read
+// within the cold tier's age.
+func (t *Tierer) candidates(ctx context.Context) ([]Entry, error) {
+	var ents []Entry
+	if err := t.s.db.SelectContext(ctx, &ents, `
+		SELECT co.chunk_id, co.gen
+		FROM storage.chunk_objects co
+		LEFT JOIN storage.chunk_tiers ct ON co.chunk_id = ct.chunk_id AND co.gen = ct.gen
+		WHERE co.uploaded = TRUE AND co.tombstone = FALSE
+			AND co.created_at < CURRENT_TIMESTAMP - $1 * INTERVAL '1 second'
+			AND (ct.chunk_id IS NULL OR (ct.cold = FALSE AND ct.last_read_at < CURRENT_TIMESTAMP - $1 * INTERVAL '1 second'))
+		ORDER BY co.created_at
+		LIMIT $2
+	`, t.s.cold.after.Seconds(), tieringBatchSize); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return ents, nil
+}
+
+// moveOne copies a chunk object to the cold tier, records its new location,
+// then deletes it from the hot tier. It returns false if the chunk could not
+// be moved.
+func (t *Tierer) moveOne(ctx context.Context, ent Entry) (bool, error) {
+	key := chunkKey(ent.ChunkID, ent.Gen)
+	if err := t.s.objStore.Get(ctx, key, func(data []byte) error {
+		return t.s.cold.objStore.Put(ctx, key, data)
+	}); err != nil {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		tieringMoves.WithLabelValues("cold", "error").Inc()
+		t.log.Errorf("could not move chunk %s to the cold tier: %v", key, err)
+		return false, nil
+	}
+	// The chunk is only marked cold if it has not been read since it was
+	// selected.
+	res, err := t.s.db.ExecContext(ctx, `
+		INSERT INTO storage.chunk_tiers (chunk_id, gen, cold, moved_at, last_read_at)
+		VALUES ($1, $2, TRUE, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP - $3 * INTERVAL '1 second')
+		ON CONFLICT (chunk_id, gen) DO UPDATE SET cold = TRUE, moved_at = CURRENT_TIMESTAMP
+		WHERE storage.chunk_tiers.last_read_at < CURRENT_TIMESTAMP - $3 * INTERVAL '1 second'
+	`, ent.ChunkID, ent.Gen, t.s.cold.after.Seconds())
+	if err != nil {
+		return false, errors.EnsureStack(err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, errors.EnsureStack(err)
+	}
+	if n == 0 {
+		return true, t.s.cold.objStore.Delete(ctx, key)
+	}
+	tieringMoves.WithLabelValues("cold", "success").Inc()
+	if err := t.s.objStore.Delete(ctx, key); err != nil && !pacherr.IsNotExist(err) {
+		return false, err
+	}
+	t.log.WithFields(logrus.Fields{
+		"chunk_id": ent.ChunkID,
+		"gen":      ent.Gen,
+	}).Infof("moved chunk object to the cold tier")
+	return true, nil
+}
+
+// SetupTiersV0 creates the table that records the tier of chunk objects.
+func SetupTiersV0(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE storage.chunk_tiers (
+			chunk_id BYTEA NOT NULL,
+			gen INT8 NOT NULL,
+			cold BOOLEAN NOT NULL DEFAULT FALSE,
+			moved_at TIMESTAMP,
+			last_read_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+			PRIMARY KEY(chunk_id, gen)
+		);
+	`)
+	return errors.EnsureStack(err)
+}
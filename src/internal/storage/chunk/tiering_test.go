@@ -0,0 +1,104 @@
+package chunk
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	units "github.com/docker/go-units"
+	"github.com/jmoiron/sqlx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+)
+
+func TestTierer(t *testing.T) {
+	ctx := context.Background()
+	db := testutil.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	cold, err := obj.NewLocalClient(t.TempDir())
+	require.NoError(t, err)
+	hot, s := NewTestStorage(t, db, tracker, WithColdTier(cold, 0, false))
+	require.NoError(t, dbutil.WithTx(ctx, db, func(tx *sqlx.Tx) error {
+		return SetupTiersV0(ctx, tx)
+	}))
+	as := generateAnnotations(rand.New(rand.NewSource(10)), test{1 * units.KB, 1 * units.MB})
+	writeAnnotations(t, s, as, "")
+	hotCount, err := countObjects(ctx, hot)
+	require.NoError(t, err)
+	require.True(t, hotCount > 0)
+
+	// Every chunk is older than the cold tier age, so they are all moved.
+	require.NoError(t, NewTierer(s).RunOnce(ctx))
+	count, err := countObjects(ctx, hot)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+	count, err = countObjects(ctx, cold)
+	require.NoError(t, err)
+	require.Equal(t, hotCount, count)
+
+	// Reads are transparently served from the cold tier. A separate storage is
+	// used so that reads are not served from the writer's memory cache.
+	s = NewStorage(hot, kv.NewMemCache(10), db, tracker, WithColdTier(cold, 0, false))
+	readAnnotations(t, s, as, "")
+	count, err = countObjects(ctx, cold)
+	require.NoError(t, err)
+	require.Equal(t, hotCount, count)
+
+	// With promotion enabled, reads move the chunks back to the hot tier.
+	s = NewStorage(hot, kv.NewMemCache(10), db, tracker, WithColdTier(cold, 0, true))
+	readAnnotations(t, s, as, "")
+	count, err = countObjects(ctx, hot)
+	require.NoError(t, err)
+	require.Equal(t, hotCount, count)
+	count, err = countObjects(ctx, cold)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+}
+
+// TestTierCheckBypassesCache checks that Check reports chunks that are missing
+// from the cold tier, even when they are still in the chunk cache.
+func TestTierCheckBypassesCache(t *testing.T) {
+	ctx := context.Background()
+	db := testutil.NewTestDB(t)
+	tracker := track.NewTestTracker(t, db)
+	cold, err := obj.NewLocalClient(t.TempDir())
+	require.NoError(t, err)
+	diskCache, err := kv.NewDiskCache(t.TempDir(), units.GB)
+	require.NoError(t, err)
+	_, s := NewTestStorage(t, db, tracker, WithDiskCache(diskCache), WithColdTier(cold, 0, false))
+	require.NoError(t, dbutil.WithTx(ctx, db, func(tx *sqlx.Tx) error {
+		return SetupTiersV0(ctx, tx)
+	}))
+	as := generateAnnotations(rand.New(rand.NewSource(10)), test{1 * units.KB, 1 * units.MB})
+	writeAnnotations(t, s, as, "")
+	require.NoError(t, NewTierer(s).RunOnce(ctx))
+	// Reading the chunks from the cold tier puts them in the cache.
+	readAnnotations(t, s, as, "")
+	var refs []*Ref
+	for _, a := range as {
+		for _, dataRef := range a.dataRefs {
+			refs = append(refs, dataRef.Ref)
+		}
+	}
+	require.True(t, len(refs) > 0)
+	for _, ref := range refs {
+		require.NoError(t, s.Check(ctx, ref, true))
+	}
+	var names []string
+	require.NoError(t, cold.Walk(ctx, "", func(name string) error {
+		names = append(names, name)
+		return nil
+	}))
+	for _, name := range names {
+		require.NoError(t, cold.Delete(ctx, name))
+	}
+	for _, ref := range refs {
+		require.True(t, errors.Is(s.Check(ctx, ref, false), ErrChunkNotExists))
+		require.True(t, errors.Is(s.Check(ctx, ref, true), ErrChunkNotExists))
+	}
+}
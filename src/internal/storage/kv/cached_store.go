@@ -0,0 +1,53 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/sirupsen/logrus"
+)
+
+type cachedStore struct {
+	Store
+	cache GetPut
+}
+
+// NewCachedStore returns store with reads served from cache when possible.
+// Values are added to cache when they are read from or written to store.
+// Failures in cache are logged rather than returned, since store is the source
+// of truth. Keys must be content addressed: a key's value must never change,
+// since cache is not invalidated on Delete.
+func NewCachedStore(store Store, cache GetPut) Store {
+	return &cachedStore{
+		Store: store,
+		cache: cache,
+	}
+}
+
+func (cs *cachedStore) Get(ctx context.Context, key []byte, cb ValueCallback) error {
+	var cbErr error
+	if err := cs.cache.Get(ctx, key, func(value []byte) error {
+		cbErr = cb(value)
+		return nil
+	}); err == nil {
+		return cbErr
+	} else if !pacherr.IsNotExist(err) {
+		logrus.Errorf("error reading from cache: %v", err)
+	}
+	return cs.Store.Get(ctx, key, func(value []byte) error {
+		if err := cs.cache.Put(ctx, key, value); err != nil {
+			logrus.Errorf("error writing to cache: %v", err)
+		}
+		return cb(value)
+	})
+}
+
+func (cs *cachedStore) Put(ctx context.Context, key, value []byte) error {
+	if err := cs.Store.Put(ctx, key, value); err != nil {
+		return err
+	}
+	if err := cs.cache.Put(ctx, key, value); err != nil {
+		logrus.Errorf("error writing to cache: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+package kv
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+const diskCacheTmpDir = "tmp"
+
+var (
+	diskCacheRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "pachyderm",
+			Subsystem: "storage_disk_cache",
+			Name:      "requests",
+			Help:      "Disk cache lookups, count by result (hit or miss)",
+		},
+		[]string{"result"},
+	)
+	diskCacheEvictions = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "pachyderm",
+			Subsystem: "storage_disk_cache",
+			Name:      "evictions",
+			Help:      "Number of values evicted from the disk cache",
+		},
+	)
+	diskCacheBytes = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "pachyderm",
+			Subsystem: "storage_disk_cache",
+			Name:      "bytes",
+			Help:      "Size of the values tracked by the disk cache",
+		},
+	)
+	registerDiskCacheMetrics sync.Once
+)
+
+type diskCacheEntry struct {
+	name string
+	size int64
+}
+
+// diskCache is a size bounded LRU cache that stores each value in its own
+// file, named by the hash of its key. Values are written to a temporary file
+// and renamed into place, so a crash never leaves a partial value behind, and
+// multiple processes (e.g. pachd and the worker sidecars on a node) can share
+// the same directory. Each process tracks recency for the files it has seen,
+// so the size bound is enforced per process and is approximate when the
+// directory is shared.
+type diskCache struct {
+	dir      string
+	maxBytes int64
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[string]*list.Element
+	size    int64
+}
+
+// NewDiskCache returns a cache that stores values in files under dir, evicting
+// the least recently used values once they exceed maxBytes. Values already in
+// dir, for example from a previous process or another process on the same
+// node, are reused.
+func NewDiskCache(dir string, maxBytes int64) (GetPut, error) {
+	registerDiskCacheMetrics.Do(func() {
+		for _, m := range []prometheus.Collector{diskCacheRequests, diskCacheEvictions, diskCacheBytes} {
+			if err := prometheus.Register(m); err != nil {
+				if !errors.As(err, &prometheus.AlreadyRegisteredError{}) {
+					logrus.Errorf("error registering prometheus metric: %v", err)
+				}
+			}
+		}
+	})
+	if maxBytes < 1 {
+		return nil, errors.Errorf("disk cache size must be positive, got %d", maxBytes)
+	}
+	dc := &diskCache{
+		dir:      dir,
+		maxBytes: maxBytes,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+	if err := os.MkdirAll(filepath.Join(dir, diskCacheTmpDir), 0755); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if err := dc.load(); err != nil {
+		return nil, err
+	}
+	return dc, nil
+}
+
+// load populates the LRU from the files in the cache directory, oldest
+// modification time first.
+func (dc *diskCache) load() error {
+	type file struct {
+		name    string
+		size    int64
+		modTime time.Time
+	}
+	var files []file
+	if err := filepath.Walk(dc.dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dc.dir, p)
+		if err != nil {
+			return err
+		}
+		if filepath.Dir(rel) == diskCacheTmpDir {
+			// Left behind by a crashed writer. Another process could be
+			// writing it right now, so only remove it once it is stale.
+			if time.Since(info.ModTime()) > time.Hour {
+				return os.Remove(p)
+			}
+			return nil
+		}
+		files = append(files, file{name: filepath.Base(p), size: info.Size(), modTime: info.ModTime()})
+		return nil
+	}); err != nil {
+		return errors.EnsureStack(err)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].modTime.Before(files[j].modTime)
+	})
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	for _, f := range files {
+		dc.add(f.name, f.size)
+	}
+	dc.evict()
+	return nil
+}
+
+func (dc *diskCache) Get(ctx context.Context, key []byte, cb ValueCallback) error {
+	name := diskCacheName(key)
+	p := dc.path(name)
+	data, err := ioutil.ReadFile(p)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return errors.EnsureStack(err)
+		}
+		diskCacheRequests.WithLabelValues("miss").Inc()
+		dc.mu.Lock()
+		dc.remove(name)
+		dc.mu.Unlock()
+		return pacherr.NewNotExist("kv.diskCache", string(key))
+	}
+	diskCacheRequests.WithLabelValues("hit").Inc()
+	// Bump the modification time so that other processes sharing the
+	// directory see the access when they next load it.
+	now := time.Now()
+	if err := os.Chtimes(p, now, now); err != nil && !os.IsNotExist(err) {
+		logrus.Errorf("error updating disk cache access time: %v", err)
+	}
+	dc.mu.Lock()
+	dc.add(name, int64(len(data)))
+	dc.mu.Unlock()
+	return cb(data)
+}
+
+func (dc *diskCache) Put(ctx context.Context, key, value []byte) (retErr error) {
+	name := diskCacheName(key)
+	dc.mu.Lock()
+	_, ok := dc.entries[name]
+	dc.mu.Unlock()
+	if ok {
+		// Keys are content addressed, so the existing value is the same.
+		return nil
+	}
+	f, err := ioutil.TempFile(filepath.Join(dc.dir, diskCacheTmpDir), name)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if retErr != nil {
+			os.Remove(f.Name())
+		}
+	}()
+	if _, err := f.Write(value); err != nil {
+		f.Close()
+		return errors.EnsureStack(err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return errors.EnsureStack(err)
+	}
+	if err := f.Close(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	p := dc.path(name)
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if err := os.Rename(f.Name(), p); err != nil {
+		return errors.EnsureStack(err)
+	}
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	dc.add(name, int64(len(value)))
+	dc.evict()
+	return nil
+}
+
+// add marks name as the most recently used entry.
+func (dc *diskCache) add(name string, size int64) {
+	if e, ok := dc.entries[name]; ok {
+		dc.lru.MoveToBack(e)
+		return
+	}
+	dc.entries[name] = dc.lru.PushBack(&diskCacheEntry{name: name, size: size})
+	dc.size += size
+	diskCacheBytes.Add(float64(size))
+}
+
+func (dc *diskCache) remove(name string) {
+	e, ok := dc.entries[name]
+	if !ok {
+		return
+	}
+	entry := dc.lru.Remove(e).(*diskCacheEntry)
+	delete(dc.entries, name)
+	dc.size -= entry.size
+	diskCacheBytes.Sub(float64(entry.size))
+}
+
+// evict removes the least recently used entries until the cache is within its
+// size bound.
+func (dc *diskCache) evict() {
+	for dc.size > dc.maxBytes && dc.lru.Len() > 0 {
+		entry := dc.lru.Front().Value.(*diskCacheEntry)
+		if err := os.Remove(dc.path(entry.name)); err != nil && !os.IsNotExist(err) {
+			logrus.Errorf("error evicting from disk cache: %v", err)
+		}
+		dc.remove(entry.name)
+		diskCacheEvictions.Inc()
+	}
+}
+
+// path shards files by the first byte of their name to keep directories small.
+func (dc *diskCache) path(name string) string {
+	return filepath.Join(dc.dir, name[:2], name)
+}
+
+func diskCacheName(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:])
+}
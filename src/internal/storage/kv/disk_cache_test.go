@@ -0,0 +1,45 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestDiskCache(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	get := func(c GetPut, key string) (string, error) {
+		var value string
+		err := c.Get(ctx, []byte(key), func(data []byte) error {
+			value = string(data)
+			return nil
+		})
+		return value, err
+	}
+	c, err := NewDiskCache(dir, 10)
+	require.NoError(t, err)
+	_, err = get(c, "a")
+	require.True(t, pacherr.IsNotExist(err))
+	require.NoError(t, c.Put(ctx, []byte("a"), []byte("aaaa")))
+	require.NoError(t, c.Put(ctx, []byte("b"), []byte("bbbb")))
+	value, err := get(c, "a")
+	require.NoError(t, err)
+	require.Equal(t, "aaaa", value)
+	// "b" is now the least recently used value, so it is evicted.
+	require.NoError(t, c.Put(ctx, []byte("c"), []byte("cccc")))
+	_, err = get(c, "b")
+	require.True(t, pacherr.IsNotExist(err))
+
+	// A new cache in the same directory reuses the existing values.
+	c, err = NewDiskCache(dir, 10)
+	require.NoError(t, err)
+	value, err = get(c, "a")
+	require.NoError(t, err)
+	require.Equal(t, "aaaa", value)
+	value, err = get(c, "c")
+	require.NoError(t, err)
+	require.Equal(t, "cccc", value)
+}
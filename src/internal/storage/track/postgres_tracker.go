@@ -2,6 +2,7 @@ package track
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"time"
 
@@ -204,6 +205,63 @@ func (t *postgresTracker) IterateDeletable(ctx context.Context, cb func(id strin
 	return rows.Err()
 }
 
+func (t *postgresTracker) IterateReachable(ctx context.Context, ids []string, prefix string, cb func(id string, shared bool) error) (retErr error) {
+	rows, err := t.db.QueryxContext(ctx, `
+		WITH RECURSIVE reachable(int_id) AS (
+			SELECT int_id FROM storage.tracker_objects WHERE str_id = ANY($1)
+			UNION
+			SELECT to_id FROM storage.tracker_refs JOIN reachable ON from_id = reachable.int_id
+		), other_reachable(int_id) AS (
+			SELECT int_id FROM storage.tracker_objects
+			WHERE int_id NOT IN (SELECT to_id FROM storage.tracker_refs)
+			AND str_id <> ALL($1)
+			UNION
+			SELECT to_id FROM storage.tracker_refs JOIN other_reachable ON from_id = other_reachable.int_id
+		)
+		SELECT str_id, int_id IN (SELECT int_id FROM other_reachable) AS shared
+		FROM storage.tracker_objects
+		WHERE int_id IN (SELECT int_id FROM reachable)
+		AND str_id <> ALL($1)
+		AND str_id LIKE $2 || '%'
+	`, pq.StringArray(ids), prefix)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := rows.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	for rows.Next() {
+		var id string
+		var shared bool
+		if err := rows.Scan(&id, &shared); err != nil {
+			return err
+		}
+		if err := cb(id, shared); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// Generation combines the highest int_id ever assigned that's still present
+// with the number of objects. int_ids only increase, so every create raises
+// the former, and every delete changes one or the other.
+func (t *postgresTracker) Generation(ctx context.Context) (string, error) {
+	var gen struct {
+		MaxID int64 `db:"max_id"`
+		Count int64 `db:"count"`
+	}
+	if err := t.db.GetContext(ctx, &gen, `
+		SELECT COALESCE(MAX(int_id), 0) AS max_id, COUNT(*) AS count
+		FROM storage.tracker_objects
+	`); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d/%d", gen.MaxID, gen.Count), nil
+}
+
 func (t *postgresTracker) getDownstream(tx *sqlx.Tx, intID int) ([]string, error) {
 	dwn := []string{}
 	if err := tx.Select(&dwn, `
@@ -53,6 +53,13 @@ type Tracker interface {
 
 	// IterateDeletable calls cb with all the objects objects which are no longer referenced and have expired or are tombstoned
 	IterateDeletable(ctx context.Context, cb func(id string) error) error
+
+	// IterateReachable calls cb with every object whose id starts with prefix and that is transitively downstream of the objects in ids.
+	// shared is true if the object is also reachable from an unreferenced object that is not in ids.
+	IterateReachable(ctx context.Context, ids []string, prefix string, cb func(id string, shared bool) error) error
+
+	// Generation returns a value that changes whenever any object is created or deleted.
+	Generation(ctx context.Context) (string, error)
 }
 
 // TestTracker runs a TestSuite to ensure Tracker is properly implemented
@@ -143,6 +150,52 @@ func TestTracker(t *testing.T, newTracker func(testing.TB) Tracker) {
 				require.ElementsEqual(t, []string{"expire"}, toExpire)
 			},
 		},
+		{
+			"IterateReachable",
+			func(t *testing.T, tracker Tracker) {
+				require.NoError(t, Create(ctx, tracker, "leaf/1", []string{}, 0))
+				require.NoError(t, Create(ctx, tracker, "leaf/2", []string{}, 0))
+				require.NoError(t, Create(ctx, tracker, "leaf/3", []string{}, 0))
+				require.NoError(t, Create(ctx, tracker, "mid/1", []string{"leaf/1", "leaf/2"}, 0))
+				require.NoError(t, Create(ctx, tracker, "root/1", []string{"mid/1"}, 0))
+				require.NoError(t, Create(ctx, tracker, "root/2", []string{"leaf/2", "leaf/3"}, 0))
+
+				reachable := make(map[string]bool)
+				require.NoError(t, tracker.IterateReachable(ctx, []string{"root/1"}, "leaf/", func(id string, shared bool) error {
+					reachable[id] = shared
+					return nil
+				}))
+				require.Equal(t, map[string]bool{"leaf/1": false, "leaf/2": true}, reachable)
+
+				reachable = make(map[string]bool)
+				require.NoError(t, tracker.IterateReachable(ctx, []string{"root/1", "root/2"}, "", func(id string, shared bool) error {
+					reachable[id] = shared
+					return nil
+				}))
+				require.Equal(t, map[string]bool{"mid/1": false, "leaf/1": false, "leaf/2": false, "leaf/3": false}, reachable)
+			},
+		},
+		{
+			"Generation",
+			func(t *testing.T, tracker Tracker) {
+				seen := make(map[string]bool)
+				next := func() {
+					gen, err := tracker.Generation(ctx)
+					require.NoError(t, err)
+					require.False(t, seen[gen])
+					seen[gen] = true
+				}
+				next()
+				require.NoError(t, Create(ctx, tracker, "test-id", []string{}, 0))
+				next()
+				require.NoError(t, Create(ctx, tracker, "test-id-2", []string{"test-id"}, 0))
+				next()
+				require.NoError(t, Delete(ctx, tracker, "test-id-2"))
+				next()
+				require.NoError(t, Create(ctx, tracker, "test-id-3", []string{"test-id"}, 0))
+				next()
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
@@ -46,17 +46,22 @@ func linkServers(mockServerPtr interface{}, realServer interface{}) {
 
 type inspectClusterFunc func(context.Context, *types.Empty) (*admin.ClusterInfo, error)
 
+type migrationStatusFunc func(context.Context, *admin.MigrationStatusRequest) (*admin.MigrationStatusResponse, error)
+
 type mockInspectCluster struct{ handler inspectClusterFunc }
+type mockMigrationStatus struct{ handler migrationStatusFunc }
 
-func (mock *mockInspectCluster) Use(cb inspectClusterFunc) { mock.handler = cb }
+func (mock *mockInspectCluster) Use(cb inspectClusterFunc)   { mock.handler = cb }
+func (mock *mockMigrationStatus) Use(cb migrationStatusFunc) { mock.handler = cb }
 
 type adminServerAPI struct {
 	mock *mockAdminServer
 }
 
 type mockAdminServer struct {
-	api            adminServerAPI
-	InspectCluster mockInspectCluster
+	api             adminServerAPI
+	InspectCluster  mockInspectCluster
+	MigrationStatus mockMigrationStatus
 }
 
 func (api *adminServerAPI) InspectCluster(ctx context.Context, req *types.Empty) (*admin.ClusterInfo, error) {
@@ -65,6 +70,12 @@ func (api *adminServerAPI) InspectCluster(ctx context.Context, req *types.Empty)
 	}
 	return nil, errors.Errorf("unhandled pachd mock admin.InspectCluster")
 }
+func (api *adminServerAPI) MigrationStatus(ctx context.Context, req *admin.MigrationStatusRequest) (*admin.MigrationStatusResponse, error) {
+	if api.mock.MigrationStatus.handler != nil {
+		return api.mock.MigrationStatus.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock admin.MigrationStatus")
+}
 
 /* Auth Server Mocks */
 
@@ -94,6 +105,9 @@ type extractAuthTokensFunc func(context.Context, *auth.ExtractAuthTokensRequest)
 type restoreAuthTokenFunc func(context.Context, *auth.RestoreAuthTokenRequest) (*auth.RestoreAuthTokenResponse, error)
 type deleteExpiredAuthTokensFunc func(context.Context, *auth.DeleteExpiredAuthTokensRequest) (*auth.DeleteExpiredAuthTokensResponse, error)
 type RotateRootTokenFunc func(context.Context, *auth.RotateRootTokenRequest) (*auth.RotateRootTokenResponse, error)
+type listAuthTokensFunc func(context.Context, *auth.ListAuthTokensRequest) (*auth.ListAuthTokensResponse, error)
+type inspectAuthTokenFunc func(context.Context, *auth.InspectAuthTokenRequest) (*auth.InspectAuthTokenResponse, error)
+type revokeAuthTokenByHashFunc func(context.Context, *auth.RevokeAuthTokenByHashRequest) (*auth.RevokeAuthTokenByHashResponse, error)
 
 type mockActivateAuth struct{ handler activateAuthFunc }
 type mockDeactivateAuth struct{ handler deactivateAuthFunc }
@@ -122,6 +136,9 @@ type mockExtractAuthTokens struct{ handler extractAuthTokensFunc }
 type mockRestoreAuthToken struct{ handler restoreAuthTokenFunc }
 type mockDeleteExpiredAuthTokens struct{ handler deleteExpiredAuthTokensFunc }
 type mockRotateRootToken struct{ handler RotateRootTokenFunc }
+type mockListAuthTokens struct{ handler listAuthTokensFunc }
+type mockInspectAuthToken struct{ handler inspectAuthTokenFunc }
+type mockRevokeAuthTokenByHash struct{ handler revokeAuthTokenByHashFunc }
 
 func (mock *mockActivateAuth) Use(cb activateAuthFunc)                             { mock.handler = cb }
 func (mock *mockDeactivateAuth) Use(cb deactivateAuthFunc)                         { mock.handler = cb }
@@ -147,6 +164,9 @@ func (mock *mockExtractAuthTokens) Use(cb extractAuthTokensFunc)
 func (mock *mockRestoreAuthToken) Use(cb restoreAuthTokenFunc)                     { mock.handler = cb }
 func (mock *mockDeleteExpiredAuthTokens) Use(cb deleteExpiredAuthTokensFunc)       { mock.handler = cb }
 func (mock *mockRotateRootToken) Use(cb RotateRootTokenFunc)                       { mock.handler = cb }
+func (mock *mockListAuthTokens) Use(cb listAuthTokensFunc)                         { mock.handler = cb }
+func (mock *mockInspectAuthToken) Use(cb inspectAuthTokenFunc)                     { mock.handler = cb }
+func (mock *mockRevokeAuthTokenByHash) Use(cb revokeAuthTokenByHashFunc)           { mock.handler = cb }
 
 type authServerAPI struct {
 	mock *mockAuthServer
@@ -178,6 +198,9 @@ type mockAuthServer struct {
 	RestoreAuthToken           mockRestoreAuthToken
 	DeleteExpiredAuthTokens    mockDeleteExpiredAuthTokens
 	RotateRootToken            mockRotateRootToken
+	ListAuthTokens             mockListAuthTokens
+	InspectAuthToken           mockInspectAuthToken
+	RevokeAuthTokenByHash      mockRevokeAuthTokenByHash
 }
 
 func (api *authServerAPI) Activate(ctx context.Context, req *auth.ActivateRequest) (*auth.ActivateResponse, error) {
@@ -329,6 +352,27 @@ func (api *authServerAPI) RotateRootToken(ctx context.Context, req *auth.RotateR
 	return nil, errors.Errorf("unhandled pachd mock auth.RotateRootToken")
 }
 
+func (api *authServerAPI) ListAuthTokens(ctx context.Context, req *auth.ListAuthTokensRequest) (*auth.ListAuthTokensResponse, error) {
+	if api.mock.ListAuthTokens.handler != nil {
+		return api.mock.ListAuthTokens.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.ListAuthTokens")
+}
+
+func (api *authServerAPI) InspectAuthToken(ctx context.Context, req *auth.InspectAuthTokenRequest) (*auth.InspectAuthTokenResponse, error) {
+	if api.mock.InspectAuthToken.handler != nil {
+		return api.mock.InspectAuthToken.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.InspectAuthToken")
+}
+
+func (api *authServerAPI) RevokeAuthTokenByHash(ctx context.Context, req *auth.RevokeAuthTokenByHashRequest) (*auth.RevokeAuthTokenByHashResponse, error) {
+	if api.mock.RevokeAuthTokenByHash.handler != nil {
+		return api.mock.RevokeAuthTokenByHash.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.RevokeAuthTokenByHash")
+}
+
 /* Enterprise Server Mocks */
 
 type activateEnterpriseFunc func(context.Context, *enterprise.ActivateRequest) (*enterprise.ActivateResponse, error)
@@ -400,6 +444,7 @@ type createRepoFunc func(context.Context, *pfs.CreateRepoRequest) (*types.Empty,
 type inspectRepoFunc func(context.Context, *pfs.InspectRepoRequest) (*pfs.RepoInfo, error)
 type listRepoFunc func(context.Context, *pfs.ListRepoRequest) (*pfs.ListRepoResponse, error)
 type deleteRepoFunc func(context.Context, *pfs.DeleteRepoRequest) (*types.Empty, error)
+type forkRepoFunc func(context.Context, *pfs.ForkRepoRequest) (*pfs.Commit, error)
 type startCommitFunc func(context.Context, *pfs.StartCommitRequest) (*pfs.Commit, error)
 type finishCommitFunc func(context.Context, *pfs.FinishCommitRequest) (*types.Empty, error)
 type inspectCommitFunc func(context.Context, *pfs.InspectCommitRequest) (*pfs.CommitInfo, error)
@@ -408,10 +453,16 @@ type squashCommitFunc func(context.Context, *pfs.SquashCommitRequest) (*types.Em
 type flushCommitFunc func(*pfs.FlushCommitRequest, pfs.API_FlushCommitServer) error
 type subscribeCommitFunc func(*pfs.SubscribeCommitRequest, pfs.API_SubscribeCommitServer) error
 type clearCommitFunc func(context.Context, *pfs.ClearCommitRequest) (*types.Empty, error)
+type mergeCommitFunc func(context.Context, *pfs.MergeCommitRequest) (*pfs.MergeCommitResponse, error)
+type revertCommitFunc func(context.Context, *pfs.RevertCommitRequest) (*pfs.Commit, error)
 type createBranchFunc func(context.Context, *pfs.CreateBranchRequest) (*types.Empty, error)
 type inspectBranchFunc func(context.Context, *pfs.InspectBranchRequest) (*pfs.BranchInfo, error)
 type listBranchFunc func(context.Context, *pfs.ListBranchRequest) (*pfs.BranchInfos, error)
 type deleteBranchFunc func(context.Context, *pfs.DeleteBranchRequest) (*types.Empty, error)
+type createTagFunc func(context.Context, *pfs.CreateTagRequest) (*types.Empty, error)
+type inspectTagFunc func(context.Context, *pfs.InspectTagRequest) (*pfs.TagInfo, error)
+type listTagFunc func(context.Context, *pfs.ListTagRequest) (*pfs.TagInfos, error)
+type deleteTagFunc func(context.Context, *pfs.DeleteTagRequest) (*types.Empty, error)
 type modifyFileFunc func(pfs.API_ModifyFileServer) error
 type getFileTARFunc func(*pfs.GetFileRequest, pfs.API_GetFileTARServer) error
 type inspectFileFunc func(context.Context, *pfs.InspectFileRequest) (*pfs.FileInfo, error)
@@ -421,6 +472,8 @@ type globFileFunc func(*pfs.GlobFileRequest, pfs.API_GlobFileServer) error
 type diffFileFunc func(*pfs.DiffFileRequest, pfs.API_DiffFileServer) error
 type deleteAllPFSFunc func(context.Context, *types.Empty) (*types.Empty, error)
 type fsckFunc func(*pfs.FsckRequest, pfs.API_FsckServer) error
+type inspectStorageFunc func(context.Context, *pfs.InspectStorageRequest) (*pfs.StorageUsage, error)
+type verifyReplicaFunc func(context.Context, *pfs.VerifyReplicaRequest) (*pfs.VerifyReplicaResponse, error)
 type createFilesetFunc func(pfs.API_CreateFilesetServer) error
 type addFilesetFunc func(context.Context, *pfs.AddFilesetRequest) (*types.Empty, error)
 type getFilesetFunc func(context.Context, *pfs.GetFilesetRequest) (*pfs.CreateFilesetResponse, error)
@@ -432,6 +485,7 @@ type mockCreateRepo struct{ handler createRepoFunc }
 type mockInspectRepo struct{ handler inspectRepoFunc }
 type mockListRepo struct{ handler listRepoFunc }
 type mockDeleteRepo struct{ handler deleteRepoFunc }
+type mockForkRepo struct{ handler forkRepoFunc }
 type mockStartCommit struct{ handler startCommitFunc }
 type mockFinishCommit struct{ handler finishCommitFunc }
 type mockInspectCommit struct{ handler inspectCommitFunc }
@@ -440,10 +494,16 @@ type mockSquashCommit struct{ handler squashCommitFunc }
 type mockFlushCommit struct{ handler flushCommitFunc }
 type mockSubscribeCommit struct{ handler subscribeCommitFunc }
 type mockClearCommit struct{ handler clearCommitFunc }
+type mockMergeCommit struct{ handler mergeCommitFunc }
+type mockRevertCommit struct{ handler revertCommitFunc }
 type mockCreateBranch struct{ handler createBranchFunc }
 type mockInspectBranch struct{ handler inspectBranchFunc }
 type mockListBranch struct{ handler listBranchFunc }
 type mockDeleteBranch struct{ handler deleteBranchFunc }
+type mockCreateTag struct{ handler createTagFunc }
+type mockInspectTag struct{ handler inspectTagFunc }
+type mockListTag struct{ handler listTagFunc }
+type mockDeleteTag struct{ handler deleteTagFunc }
 type mockModifyFile struct{ handler modifyFileFunc }
 type mockGetFileTAR struct{ handler getFileTARFunc }
 type mockInspectFile struct{ handler inspectFileFunc }
@@ -453,6 +513,8 @@ type mockGlobFile struct{ handler globFileFunc }
 type mockDiffFile struct{ handler diffFileFunc }
 type mockDeleteAllPFS struct{ handler deleteAllPFSFunc }
 type mockFsck struct{ handler fsckFunc }
+type mockInspectStorage struct{ handler inspectStorageFunc }
+type mockVerifyReplica struct{ handler verifyReplicaFunc }
 type mockCreateFileset struct{ handler createFilesetFunc }
 type mockAddFileset struct{ handler addFilesetFunc }
 type mockGetFileset struct{ handler getFilesetFunc }
@@ -464,6 +526,7 @@ func (mock *mockCreateRepo) Use(cb createRepoFunc)           { mock.handler = cb
 func (mock *mockInspectRepo) Use(cb inspectRepoFunc)         { mock.handler = cb }
 func (mock *mockListRepo) Use(cb listRepoFunc)               { mock.handler = cb }
 func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)           { mock.handler = cb }
+func (mock *mockForkRepo) Use(cb forkRepoFunc)               { mock.handler = cb }
 func (mock *mockStartCommit) Use(cb startCommitFunc)         { mock.handler = cb }
 func (mock *mockFinishCommit) Use(cb finishCommitFunc)       { mock.handler = cb }
 func (mock *mockInspectCommit) Use(cb inspectCommitFunc)     { mock.handler = cb }
@@ -472,10 +535,16 @@ func (mock *mockSquashCommit) Use(cb squashCommitFunc)       { mock.handler = cb
 func (mock *mockFlushCommit) Use(cb flushCommitFunc)         { mock.handler = cb }
 func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc) { mock.handler = cb }
 func (mock *mockClearCommit) Use(cb clearCommitFunc)         { mock.handler = cb }
+func (mock *mockMergeCommit) Use(cb mergeCommitFunc)         { mock.handler = cb }
+func (mock *mockRevertCommit) Use(cb revertCommitFunc)       { mock.handler = cb }
 func (mock *mockCreateBranch) Use(cb createBranchFunc)       { mock.handler = cb }
 func (mock *mockInspectBranch) Use(cb inspectBranchFunc)     { mock.handler = cb }
 func (mock *mockListBranch) Use(cb listBranchFunc)           { mock.handler = cb }
 func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)       { mock.handler = cb }
+func (mock *mockCreateTag) Use(cb createTagFunc)             { mock.handler = cb }
+func (mock *mockInspectTag) Use(cb inspectTagFunc)           { mock.handler = cb }
+func (mock *mockListTag) Use(cb listTagFunc)                 { mock.handler = cb }
+func (mock *mockDeleteTag) Use(cb deleteTagFunc)             { mock.handler = cb }
 func (mock *mockModifyFile) Use(cb modifyFileFunc)           { mock.handler = cb }
 func (mock *mockGetFileTAR) Use(cb getFileTARFunc)           { mock.handler = cb }
 func (mock *mockInspectFile) Use(cb inspectFileFunc)         { mock.handler = cb }
@@ -485,6 +554,8 @@ func (mock *mockGlobFile) Use(cb globFileFunc)               { mock.handler = cb
 func (mock *mockDiffFile) Use(cb diffFileFunc)               { mock.handler = cb }
 func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)       { mock.handler = cb }
 func (mock *mockFsck) Use(cb fsckFunc)                       { mock.handler = cb }
+func (mock *mockInspectStorage) Use(cb inspectStorageFunc)   { mock.handler = cb }
+func (mock *mockVerifyReplica) Use(cb verifyReplicaFunc)     { mock.handler = cb }
 func (mock *mockCreateFileset) Use(cb createFilesetFunc)     { mock.handler = cb }
 func (mock *mockAddFileset) Use(cb addFilesetFunc)           { mock.handler = cb }
 func (mock *mockGetFileset) Use(cb getFilesetFunc)           { mock.handler = cb }
@@ -502,6 +573,7 @@ type mockPFSServer struct {
 	InspectRepo     mockInspectRepo
 	ListRepo        mockListRepo
 	DeleteRepo      mockDeleteRepo
+	ForkRepo        mockForkRepo
 	StartCommit     mockStartCommit
 	FinishCommit    mockFinishCommit
 	InspectCommit   mockInspectCommit
@@ -510,10 +582,16 @@ type mockPFSServer struct {
 	FlushCommit     mockFlushCommit
 	SubscribeCommit mockSubscribeCommit
 	ClearCommit     mockClearCommit
+	MergeCommit     mockMergeCommit
+	RevertCommit    mockRevertCommit
 	CreateBranch    mockCreateBranch
 	InspectBranch   mockInspectBranch
 	ListBranch      mockListBranch
 	DeleteBranch    mockDeleteBranch
+	CreateTag       mockCreateTag
+	InspectTag      mockInspectTag
+	ListTag         mockListTag
+	DeleteTag       mockDeleteTag
 	ModifyFile      mockModifyFile
 	GetFileTAR      mockGetFileTAR
 	InspectFile     mockInspectFile
@@ -523,6 +601,8 @@ type mockPFSServer struct {
 	DiffFile        mockDiffFile
 	DeleteAll       mockDeleteAllPFS
 	Fsck            mockFsck
+	InspectStorage  mockInspectStorage
+	VerifyReplica   mockVerifyReplica
 	CreateFileset   mockCreateFileset
 	AddFileset      mockAddFileset
 	GetFileset      mockGetFileset
@@ -560,6 +640,12 @@ func (api *pfsServerAPI) DeleteRepo(ctx context.Context, req *pfs.DeleteRepoRequ
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteRepo")
 }
+func (api *pfsServerAPI) ForkRepo(ctx context.Context, req *pfs.ForkRepoRequest) (*pfs.Commit, error) {
+	if api.mock.ForkRepo.handler != nil {
+		return api.mock.ForkRepo.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.ForkRepo")
+}
 func (api *pfsServerAPI) StartCommit(ctx context.Context, req *pfs.StartCommitRequest) (*pfs.Commit, error) {
 	if api.mock.StartCommit.handler != nil {
 		return api.mock.StartCommit.handler(ctx, req)
@@ -608,6 +694,18 @@ func (api *pfsServerAPI) ClearCommit(ctx context.Context, req *pfs.ClearCommitRe
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.ClearCommit")
 }
+func (api *pfsServerAPI) MergeCommit(ctx context.Context, req *pfs.MergeCommitRequest) (*pfs.MergeCommitResponse, error) {
+	if api.mock.MergeCommit.handler != nil {
+		return api.mock.MergeCommit.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.MergeCommit")
+}
+func (api *pfsServerAPI) RevertCommit(ctx context.Context, req *pfs.RevertCommitRequest) (*pfs.Commit, error) {
+	if api.mock.RevertCommit.handler != nil {
+		return api.mock.RevertCommit.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.RevertCommit")
+}
 func (api *pfsServerAPI) CreateBranch(ctx context.Context, req *pfs.CreateBranchRequest) (*types.Empty, error) {
 	if api.mock.CreateBranch.handler != nil {
 		return api.mock.CreateBranch.handler(ctx, req)
@@ -632,6 +730,30 @@ func (api *pfsServerAPI) DeleteBranch(ctx context.Context, req *pfs.DeleteBranch
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteBranch")
 }
+func (api *pfsServerAPI) CreateTag(ctx context.Context, req *pfs.CreateTagRequest) (*types.Empty, error) {
+	if api.mock.CreateTag.handler != nil {
+		return api.mock.CreateTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.CreateTag")
+}
+func (api *pfsServerAPI) InspectTag(ctx context.Context, req *pfs.InspectTagRequest) (*pfs.TagInfo, error) {
+	if api.mock.InspectTag.handler != nil {
+		return api.mock.InspectTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectTag")
+}
+func (api *pfsServerAPI) ListTag(ctx context.Context, req *pfs.ListTagRequest) (*pfs.TagInfos, error) {
+	if api.mock.ListTag.handler != nil {
+		return api.mock.ListTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.ListTag")
+}
+func (api *pfsServerAPI) DeleteTag(ctx context.Context, req *pfs.DeleteTagRequest) (*types.Empty, error) {
+	if api.mock.DeleteTag.handler != nil {
+		return api.mock.DeleteTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteTag")
+}
 func (api *pfsServerAPI) ModifyFile(serv pfs.API_ModifyFileServer) error {
 	if api.mock.ModifyFile.handler != nil {
 		return api.mock.ModifyFile.handler(serv)
@@ -686,6 +808,18 @@ func (api *pfsServerAPI) Fsck(req *pfs.FsckRequest, serv pfs.API_FsckServer) err
 	}
 	return errors.Errorf("unhandled pachd mock pfs.Fsck")
 }
+func (api *pfsServerAPI) InspectStorage(ctx context.Context, req *pfs.InspectStorageRequest) (*pfs.StorageUsage, error) {
+	if api.mock.InspectStorage.handler != nil {
+		return api.mock.InspectStorage.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectStorage")
+}
+func (api *pfsServerAPI) VerifyReplica(ctx context.Context, req *pfs.VerifyReplicaRequest) (*pfs.VerifyReplicaResponse, error) {
+	if api.mock.VerifyReplica.handler != nil {
+		return api.mock.VerifyReplica.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.VerifyReplica")
+}
 func (api *pfsServerAPI) CreateFileset(srv pfs.API_CreateFilesetServer) error {
 	if api.mock.CreateFileset.handler != nil {
 		return api.mock.CreateFileset.handler(srv)
@@ -729,7 +863,7 @@ type updateJobStateFunc func(context.Context, *pps.UpdateJobStateRequest) (*type
 type inspectDatumFunc func(context.Context, *pps.InspectDatumRequest) (*pps.DatumInfo, error)
 type listDatumFunc func(*pps.ListDatumRequest, pps.API_ListDatumServer) error
 type restartDatumFunc func(context.Context, *pps.RestartDatumRequest) (*types.Empty, error)
-type createPipelineFunc func(context.Context, *pps.CreatePipelineRequest) (*types.Empty, error)
+type createPipelineFunc func(context.Context, *pps.CreatePipelineRequest) (*pps.CreatePipelineResponse, error)
 type inspectPipelineFunc func(context.Context, *pps.InspectPipelineRequest) (*pps.PipelineInfo, error)
 type listPipelineFunc func(context.Context, *pps.ListPipelineRequest) (*pps.PipelineInfos, error)
 type deletePipelineFunc func(context.Context, *pps.DeletePipelineRequest) (*types.Empty, error)
@@ -890,7 +1024,7 @@ func (api *ppsServerAPI) RestartDatum(ctx context.Context, req *pps.RestartDatum
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.RestartDatum")
 }
-func (api *ppsServerAPI) CreatePipeline(ctx context.Context, req *pps.CreatePipelineRequest) (*types.Empty, error) {
+func (api *ppsServerAPI) CreatePipeline(ctx context.Context, req *pps.CreatePipelineRequest) (*pps.CreatePipelineResponse, error) {
 	if api.mock.CreatePipeline.handler != nil {
 		return api.mock.CreatePipeline.handler(ctx, req)
 	}
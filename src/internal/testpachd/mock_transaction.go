@@ -39,6 +39,36 @@ func (mock *mockCreatePipelineInTransaction) Use(cb createPipelineInTransactionF
 	mock.handler = cb
 }
 
+type deletePipelineInTransactionFunc func(*txncontext.TransactionContext, *pps.DeletePipelineRequest) error
+
+type mockDeletePipelineInTransaction struct {
+	handler deletePipelineInTransactionFunc
+}
+
+func (mock *mockDeletePipelineInTransaction) Use(cb deletePipelineInTransactionFunc) {
+	mock.handler = cb
+}
+
+type startPipelineInTransactionFunc func(*txncontext.TransactionContext, *pps.StartPipelineRequest, *string, **pfs.Commit) error
+
+type mockStartPipelineInTransaction struct {
+	handler startPipelineInTransactionFunc
+}
+
+func (mock *mockStartPipelineInTransaction) Use(cb startPipelineInTransactionFunc) {
+	mock.handler = cb
+}
+
+type stopPipelineInTransactionFunc func(*txncontext.TransactionContext, *pps.StopPipelineRequest, *string, **pfs.Commit) error
+
+type mockStopPipelineInTransaction struct {
+	handler stopPipelineInTransactionFunc
+}
+
+func (mock *mockStopPipelineInTransaction) Use(cb stopPipelineInTransactionFunc) {
+	mock.handler = cb
+}
+
 type ppsTransactionAPI struct {
 	ppsServerAPI
 	mock *MockPPSTransactionServer
@@ -51,6 +81,9 @@ type MockPPSTransactionServer struct {
 	StopJobInTransaction        mockStopJobInTransaction
 	UpdateJobStateInTransaction mockUpdateJobStateInTransaction
 	CreatePipelineInTransaction mockCreatePipelineInTransaction
+	DeletePipelineInTransaction mockDeletePipelineInTransaction
+	StartPipelineInTransaction  mockStartPipelineInTransaction
+	StopPipelineInTransaction   mockStopPipelineInTransaction
 }
 
 func (api *ppsTransactionAPI) StopJobInTransaction(txnCtx *txncontext.TransactionContext, req *pps.StopJobRequest) error {
@@ -74,6 +107,27 @@ func (api *ppsTransactionAPI) CreatePipelineInTransaction(txnCtx *txncontext.Tra
 	return fmt.Errorf("unhandled pachd mock: pps.CreatePipelineInTransaction")
 }
 
+func (api *ppsTransactionAPI) DeletePipelineInTransaction(txnCtx *txncontext.TransactionContext, req *pps.DeletePipelineRequest) error {
+	if api.mock.DeletePipelineInTransaction.handler != nil {
+		return api.mock.DeletePipelineInTransaction.handler(txnCtx, req)
+	}
+	return fmt.Errorf("unhandled pachd mock: pps.DeletePipelineInTransaction")
+}
+
+func (api *ppsTransactionAPI) StartPipelineInTransaction(txnCtx *txncontext.TransactionContext, req *pps.StartPipelineRequest, filesetID *string, prevSpecCommit **pfs.Commit) error {
+	if api.mock.StartPipelineInTransaction.handler != nil {
+		return api.mock.StartPipelineInTransaction.handler(txnCtx, req, filesetID, prevSpecCommit)
+	}
+	return fmt.Errorf("unhandled pachd mock: pps.StartPipelineInTransaction")
+}
+
+func (api *ppsTransactionAPI) StopPipelineInTransaction(txnCtx *txncontext.TransactionContext, req *pps.StopPipelineRequest, filesetID *string, prevSpecCommit **pfs.Commit) error {
+	if api.mock.StopPipelineInTransaction.handler != nil {
+		return api.mock.StopPipelineInTransaction.handler(txnCtx, req, filesetID, prevSpecCommit)
+	}
+	return fmt.Errorf("unhandled pachd mock: pps.StopPipelineInTransaction")
+}
+
 // NewMockPPSTransactionServer instantiates a MockPPSTransactionServer
 func NewMockPPSTransactionServer() *MockPPSTransactionServer {
 	result := &MockPPSTransactionServer{}
@@ -3,7 +3,9 @@ package testpachd
 import (
 	"net"
 	"net/url"
+	"os/exec"
 	"path"
+	"path/filepath"
 	"testing"
 
 	units "github.com/docker/go-units"
@@ -19,6 +21,7 @@ import (
 	authtesting "github.com/pachyderm/pachyderm/v2/src/server/auth/testing"
 	pfsapi "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs/server"
+	ppsapi "github.com/pachyderm/pachyderm/v2/src/server/pps"
 	txnserver "github.com/pachyderm/pachyderm/v2/src/server/transaction/server"
 )
 
@@ -34,13 +37,41 @@ type RealEnv struct {
 	PFSServer                pfsapi.APIServer
 	TransactionServer        txnserver.APIServer
 	MockPPSTransactionServer *MockPPSTransactionServer
+	// PPSServer is only set by NewRealEnvWithPPS
+	PPSServer ppsapi.APIServer
 }
 
+// PPSServerFactory constructs the PPS API server used by NewRealEnvWithPPS.
+// It's passed in by the caller, rather than called directly, because packages
+// that the PPS server depends on use testpachd in their tests.
+type PPSServerFactory func(serviceenv.ServiceEnv, *txnenv.TransactionEnv) (ppsapi.APIServer, error)
+
 // NewRealEnv constructs a MockEnv, then forwards all API calls to go to API
-// server instances for supported operations. PPS requires a kubernetes
-// environment in order to spin up pipelines, which is not yet supported by this
-// package, but the other API servers work.
+// server instances for supported operations. PPS calls are not forwarded (see
+// NewRealEnvWithPPS), but the other API servers work.
 func NewRealEnv(t testing.TB, customOpts ...serviceenv.ConfigOption) *RealEnv {
+	return newRealEnv(t, nil, customOpts...)
+}
+
+// NewRealEnvWithPPS is like NewRealEnv, but also runs a real PPS API server,
+// constructed by 'newPPSServer'. Pipeline workers run as local processes under
+// the env's temporary directory, so the caller must set PPSLocalWorkerBinary
+// to the path of a worker binary.
+func NewRealEnvWithPPS(t testing.TB, newPPSServer PPSServerFactory, customOpts ...serviceenv.ConfigOption) *RealEnv {
+	return newRealEnv(t, newPPSServer, customOpts...)
+}
+
+// BuildWorkerBinary builds the worker binary into a temporary directory and
+// returns its path, to be used as PPSLocalWorkerBinary with NewRealEnvWithPPS.
+func BuildWorkerBinary(t testing.TB) string {
+	binary := filepath.Join(t.TempDir(), "worker")
+	cmd := exec.Command("go", "build", "-o", binary, "github.com/pachyderm/pachyderm/v2/src/server/cmd/worker")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "building worker: %s", out)
+	return binary
+}
+
+func newRealEnv(t testing.TB, newPPSServer PPSServerFactory, customOpts ...serviceenv.ConfigOption) *RealEnv {
 	mockEnv := NewMockEnv(t)
 
 	realEnv := &RealEnv{MockEnv: *mockEnv}
@@ -57,6 +88,12 @@ func NewRealEnv(t testing.TB, customOpts ...serviceenv.ConfigOption) *RealEnv {
 		serviceenv.WithEtcdHostPort(etcdClientURL.Hostname(), etcdClientURL.Port()),
 		serviceenv.WithPachdPeerPort(uint16(realEnv.MockPachd.Addr.(*net.TCPAddr).Port)),
 	}
+	if newPPSServer != nil {
+		opts = append(opts, func(config *serviceenv.Configuration) {
+			config.PPSWorkerBackend = "local"
+			config.PPSLocalWorkerRoot = path.Join(realEnv.Directory, "workers")
+		})
+	}
 	opts = append(opts, customOpts...) // Overwrite with any custom options
 	realEnv.ServiceEnv = serviceenv.InitServiceEnv(serviceenv.ConfigFromOptions(opts...))
 
@@ -100,13 +137,22 @@ func NewRealEnv(t testing.TB, customOpts ...serviceenv.ConfigOption) *RealEnv {
 
 	realEnv.ServiceEnv.(*serviceenv.NonblockingServiceEnv).SetAuthServer(realEnv.AuthServer)
 	realEnv.ServiceEnv.(*serviceenv.NonblockingServiceEnv).SetPfsServer(realEnv.PFSServer)
-	realEnv.ServiceEnv.(*serviceenv.NonblockingServiceEnv).SetPpsServer(&realEnv.MockPPSTransactionServer.api)
+	if newPPSServer != nil {
+		realEnv.PPSServer, err = newPPSServer(realEnv.ServiceEnv, txnEnv)
+		require.NoError(t, err)
+		realEnv.ServiceEnv.(*serviceenv.NonblockingServiceEnv).SetPpsServer(realEnv.PPSServer)
+	} else {
+		realEnv.ServiceEnv.(*serviceenv.NonblockingServiceEnv).SetPpsServer(&realEnv.MockPPSTransactionServer.api)
+	}
 
 	txnEnv.Initialize(realEnv.ServiceEnv, realEnv.TransactionServer)
 
 	linkServers(&realEnv.MockPachd.PFS, realEnv.PFSServer)
 	linkServers(&realEnv.MockPachd.Auth, realEnv.AuthServer)
 	linkServers(&realEnv.MockPachd.Transaction, realEnv.TransactionServer)
+	if realEnv.PPSServer != nil {
+		linkServers(&realEnv.MockPachd.PPS, realEnv.PPSServer)
+	}
 
 	return realEnv
 }
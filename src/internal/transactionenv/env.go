@@ -31,6 +31,8 @@ type PfsWrites interface {
 
 	CreateBranch(*pfs.CreateBranchRequest) error
 	DeleteBranch(*pfs.DeleteBranchRequest) error
+
+	AddFileset(*pfs.AddFilesetRequest) error
 }
 
 // PpsWrites is an interface providing a wrapper for each operation that
@@ -41,6 +43,9 @@ type PpsWrites interface {
 	StopJob(*pps.StopJobRequest) error
 	UpdateJobState(*pps.UpdateJobStateRequest) error
 	CreatePipeline(*pps.CreatePipelineRequest, *string, **pfs.Commit) error
+	DeletePipeline(*pps.DeletePipelineRequest) error
+	StartPipeline(*pps.StartPipelineRequest, *string, **pfs.Commit) error
+	StopPipeline(*pps.StopPipelineRequest, *string, **pfs.Commit) error
 }
 
 // AuthWrites is an interface providing a wrapper for each operation that
@@ -148,6 +153,11 @@ func (t *directTransaction) DeleteBranch(original *pfs.DeleteBranchRequest) erro
 	return t.txnEnv.serviceEnv.PfsServer().DeleteBranchInTransaction(t.txnCtx, req)
 }
 
+func (t *directTransaction) AddFileset(original *pfs.AddFilesetRequest) error {
+	req := proto.Clone(original).(*pfs.AddFilesetRequest)
+	return t.txnEnv.serviceEnv.PfsServer().AddFilesetInTransaction(t.txnCtx, req)
+}
+
 func (t *directTransaction) StopJob(original *pps.StopJobRequest) error {
 	req := proto.Clone(original).(*pps.StopJobRequest)
 	return t.txnEnv.serviceEnv.PpsServer().StopJobInTransaction(t.txnCtx, req)
@@ -168,6 +178,21 @@ func (t *directTransaction) CreatePipeline(original *pps.CreatePipelineRequest,
 	return t.txnEnv.serviceEnv.PpsServer().CreatePipelineInTransaction(t.txnCtx, req, filesetID, prevSpecCommit)
 }
 
+func (t *directTransaction) DeletePipeline(original *pps.DeletePipelineRequest) error {
+	req := proto.Clone(original).(*pps.DeletePipelineRequest)
+	return t.txnEnv.serviceEnv.PpsServer().DeletePipelineInTransaction(t.txnCtx, req)
+}
+
+func (t *directTransaction) StartPipeline(original *pps.StartPipelineRequest, filesetID *string, prevSpecCommit **pfs.Commit) error {
+	req := proto.Clone(original).(*pps.StartPipelineRequest)
+	return t.txnEnv.serviceEnv.PpsServer().StartPipelineInTransaction(t.txnCtx, req, filesetID, prevSpecCommit)
+}
+
+func (t *directTransaction) StopPipeline(original *pps.StopPipelineRequest, filesetID *string, prevSpecCommit **pfs.Commit) error {
+	req := proto.Clone(original).(*pps.StopPipelineRequest)
+	return t.txnEnv.serviceEnv.PpsServer().StopPipelineInTransaction(t.txnCtx, req, filesetID, prevSpecCommit)
+}
+
 func (t *directTransaction) DeleteRoleBinding(original *auth.Resource) error {
 	req := proto.Clone(original).(*auth.Resource)
 	return t.txnEnv.serviceEnv.AuthServer().DeleteRoleBindingInTransaction(t.txnCtx, req)
@@ -225,6 +250,11 @@ func (t *appendTransaction) DeleteBranch(req *pfs.DeleteBranchRequest) error {
 	return err
 }
 
+func (t *appendTransaction) AddFileset(req *pfs.AddFilesetRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{AddFileset: req})
+	return err
+}
+
 func (t *appendTransaction) StopJob(req *pps.StopJobRequest) error {
 	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{StopJob: req})
 	return err
@@ -240,8 +270,26 @@ func (t *appendTransaction) CreatePipeline(req *pps.CreatePipelineRequest, _ *st
 	return err
 }
 
-func (t *appendTransaction) ModifyRoleBinding(original *auth.ModifyRoleBindingRequest) (*auth.ModifyRoleBindingResponse, error) {
-	panic("ModifyRoleBinding not yet implemented in transactions")
+func (t *appendTransaction) DeletePipeline(req *pps.DeletePipelineRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{DeletePipeline: req})
+	return err
+}
+
+func (t *appendTransaction) StartPipeline(req *pps.StartPipelineRequest, _ *string, _ **pfs.Commit) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{StartPipeline: req})
+	return err
+}
+
+func (t *appendTransaction) StopPipeline(req *pps.StopPipelineRequest, _ *string, _ **pfs.Commit) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{StopPipeline: req})
+	return err
+}
+
+func (t *appendTransaction) ModifyRoleBinding(req *auth.ModifyRoleBindingRequest) (*auth.ModifyRoleBindingResponse, error) {
+	if _, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{ModifyRoleBinding: req}); err != nil {
+		return nil, err
+	}
+	return &auth.ModifyRoleBindingResponse{}, nil
 }
 
 func (t *appendTransaction) DeleteRoleBinding(original *auth.Resource) error {
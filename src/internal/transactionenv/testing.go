@@ -139,3 +139,18 @@ func (mpts *MockPpsTransactionServer) UpdateJobStateInTransaction(*txncontext.Tr
 func (mpts *MockPpsTransactionServer) CreatePipelineInTransaction(*txncontext.TransactionContext, *pps.CreatePipelineRequest, *string, **pfs.Commit) error {
 	return unimplementedError("PpsTransactionServer.CreatePipelineInTransaction")
 }
+
+// DeletePipelineInTransaction always errors
+func (mpts *MockPpsTransactionServer) DeletePipelineInTransaction(*txncontext.TransactionContext, *pps.DeletePipelineRequest) error {
+	return unimplementedError("PpsTransactionServer.DeletePipelineInTransaction")
+}
+
+// StartPipelineInTransaction always errors
+func (mpts *MockPpsTransactionServer) StartPipelineInTransaction(*txncontext.TransactionContext, *pps.StartPipelineRequest, *string, **pfs.Commit) error {
+	return unimplementedError("PpsTransactionServer.StartPipelineInTransaction")
+}
+
+// StopPipelineInTransaction always errors
+func (mpts *MockPpsTransactionServer) StopPipelineInTransaction(*txncontext.TransactionContext, *pps.StopPipelineRequest, *string, **pfs.Commit) error {
+	return unimplementedError("PpsTransactionServer.StopPipelineInTransaction")
+}
@@ -0,0 +1,205 @@
+package work
+
+import (
+	"context"
+	"path"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
+)
+
+const (
+	taskPrefix    = "/task"
+	subtaskPrefix = "/subtask"
+	claimPrefix   = "/claim"
+)
+
+// etcdTaskStore stores tasks, subtasks and claims in etcd collections. Claims
+// are kept alive with etcd leases.
+type etcdTaskStore struct {
+	etcdClient                    *etcd.Client
+	taskCol, subtaskCol, claimCol col.EtcdCollection
+}
+
+func newEtcdTaskStore(etcdClient *etcd.Client, etcdPrefix string, taskNamespace string) *etcdTaskStore {
+	return &etcdTaskStore{
+		etcdClient: etcdClient,
+		taskCol:    newCollection(etcdClient, path.Join(etcdPrefix, taskPrefix, taskNamespace), &Task{}),
+		subtaskCol: newCollection(etcdClient, path.Join(etcdPrefix, subtaskPrefix, taskNamespace), &TaskInfo{}),
+		claimCol:   newCollection(etcdClient, path.Join(etcdPrefix, claimPrefix, taskNamespace), &Claim{}),
+	}
+}
+
+func newCollection(etcdClient *etcd.Client, etcdPrefix string, template proto.Message) col.EtcdCollection {
+	return col.NewEtcdCollection(
+		etcdClient,
+		etcdPrefix,
+		nil,
+		template,
+		nil,
+		nil,
+	)
+}
+
+func (s *etcdTaskStore) createTask(ctx context.Context, task *Task) error {
+	_, err := col.NewSTM(ctx, s.etcdClient, func(stm col.STM) error {
+		return s.taskCol.ReadWrite(stm).Put(task.ID, task)
+	})
+	return err
+}
+
+func (s *etcdTaskStore) deleteTask(taskID string) error {
+	_, err := col.NewSTM(context.Background(), s.etcdClient, func(stm col.STM) error {
+		s.subtaskCol.ReadWrite(stm).DeleteAllPrefix(taskID)
+		return s.taskCol.ReadWrite(stm).Delete(taskID)
+	})
+	return err
+}
+
+func (s *etcdTaskStore) deleteAllTasks() error {
+	_, err := col.NewSTM(context.Background(), s.etcdClient, func(stm col.STM) error {
+		s.subtaskCol.ReadWrite(stm).DeleteAll()
+		s.taskCol.ReadWrite(stm).DeleteAll()
+		return nil
+	})
+	return err
+}
+
+func (s *etcdTaskStore) watchTasks(ctx context.Context, f func(string, *Task) error) error {
+	return s.taskCol.ReadOnly(ctx).WatchF(func(e *watch.Event) error {
+		taskID := string(e.Key)
+		if e.Type == watch.EventDelete {
+			return f(taskID, nil)
+		}
+		task := &Task{}
+		if err := e.Unmarshal(&taskID, task); err != nil {
+			return err
+		}
+		return f(taskID, task)
+	})
+}
+
+func (s *etcdTaskStore) createSubtask(ctx context.Context, taskID string, subtaskInfo *TaskInfo) error {
+	subtaskKey := path.Join(taskID, subtaskInfo.Task.ID)
+	if _, err := col.NewSTM(ctx, s.etcdClient, func(stm col.STM) error {
+		return s.subtaskCol.ReadWrite(stm).Put(subtaskKey, subtaskInfo)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *etcdTaskStore) deleteSubtasks(taskID string) error {
+	_, err := col.NewSTM(context.Background(), s.etcdClient, func(stm col.STM) error {
+		s.subtaskCol.ReadWrite(stm).DeleteAllPrefix(taskID)
+		return nil
+	})
+	return err
+}
+
+func (s *etcdTaskStore) watchSubtasks(ctx context.Context, taskID string, f func(*TaskInfo) error) error {
+	return s.subtaskCol.ReadOnly(ctx).WatchOneF(taskID, func(e *watch.Event) error {
+		var key string
+		subtaskInfo := &TaskInfo{}
+		if e.Type == watch.EventDelete {
+			return errors.New("task was deleted while waiting for results")
+		}
+		if err := e.Unmarshal(&key, subtaskInfo); err != nil {
+			return err
+		}
+		return f(subtaskInfo)
+	})
+}
+
+// watchClaimable reports each subtask that is created, and each subtask whose
+// claim is released (or expires).
+func (s *etcdTaskStore) watchClaimable(ctx context.Context, taskID string, f func(string)) error {
+	claimWatch, err := s.claimCol.ReadOnly(ctx).WatchOne(taskID, watch.IgnorePut)
+	if err != nil {
+		return err
+	}
+	defer claimWatch.Close()
+	subtaskWatch, err := s.subtaskCol.ReadOnly(ctx).WatchOne(taskID, watch.IgnoreDelete)
+	if err != nil {
+		return err
+	}
+	defer subtaskWatch.Close()
+	for {
+		select {
+		case e := <-claimWatch.Watch():
+			if e.Type == watch.EventError {
+				return e.Err
+			}
+			f(string(e.Key))
+		case e := <-subtaskWatch.Watch():
+			if e.Type == watch.EventError {
+				return e.Err
+			}
+			var subtaskKey string
+			if err := e.Unmarshal(&subtaskKey, &TaskInfo{}); err != nil {
+				return err
+			}
+			f(subtaskKey)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *etcdTaskStore) processSubtask(ctx context.Context, taskID, subtaskKey string, processFunc ProcessFunc) error {
+	// (bryce) this should be refactored to have the check and claim in the same stm.
+	// there is a rare race condition that does not affect correctness, but it is less
+	// than ideal because a subtask could get run once more than necessary.
+	subtaskInfo := &TaskInfo{}
+	if _, err := col.NewSTM(ctx, s.etcdClient, func(stm col.STM) error {
+		return s.subtaskCol.ReadWrite(stm).Get(subtaskKey, subtaskInfo)
+	}); err != nil {
+		return err
+	}
+	if subtaskInfo.State != State_RUNNING {
+		return nil
+	}
+	return s.claimCol.Claim(ctx, subtaskKey, &Claim{}, func(claimCtx context.Context) (retErr error) {
+		subtask := subtaskInfo.Task
+		var result *types.Any
+		defer func() {
+			// If the task context was canceled or the claim was lost, just return with no error.
+			if errors.Is(claimCtx.Err(), context.Canceled) {
+				retErr = nil
+				return
+			}
+			subtaskInfo := &TaskInfo{}
+			if _, err := col.NewSTM(claimCtx, s.etcdClient, func(stm col.STM) error {
+				return s.subtaskCol.ReadWrite(stm).Update(subtaskKey, subtaskInfo, func() error {
+					// (bryce) remove when check and claim are in the same stm.
+					if subtaskInfo.State != State_RUNNING {
+						return nil
+					}
+					setResult(subtaskInfo, subtask, result, retErr)
+					retErr = nil
+					return nil
+				})
+			}); retErr == nil {
+				retErr = err
+			}
+		}()
+		var err error
+		result, err = processFunc(claimCtx, subtask)
+		return err
+	})
+}
+
+// setResult records the outcome of processing 'subtask' in 'subtaskInfo'.
+func setResult(subtaskInfo *TaskInfo, subtask *Task, result *types.Any, err error) {
+	subtaskInfo.Task = subtask
+	subtaskInfo.State = State_SUCCESS
+	subtaskInfo.Result = result
+	if err != nil {
+		subtaskInfo.State = State_FAILURE
+		subtaskInfo.Reason = err.Error()
+	}
+}
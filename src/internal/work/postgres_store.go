@@ -0,0 +1,360 @@
+package work
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/jmoiron/sqlx"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
+)
+
+const (
+	tasksCollectionName    = "work_tasks"
+	subtasksCollectionName = "work_subtasks"
+	// claimTTL is how long a postgres claim lasts without being renewed.
+	claimTTL = 30 * time.Second
+)
+
+// tasksNamespaceIndex maps task namespaces to their tasks.
+var tasksNamespaceIndex = &col.Index{
+	Name: "namespace",
+	Extract: func(val proto.Message) string {
+		return val.(*StoredTask).Namespace
+	},
+}
+
+// subtasksTaskIndex maps tasks (by key) to their subtasks.
+var subtasksTaskIndex = &col.Index{
+	Name: "task",
+	Extract: func(val proto.Message) string {
+		return val.(*StoredSubtask).TaskKey
+	},
+}
+
+// subtasksStateIndex maps subtask states to subtasks, so that workers can
+// find the subtasks that are still running.
+var subtasksStateIndex = &col.Index{
+	Name: "state",
+	Extract: func(val proto.Message) string {
+		return val.(*StoredSubtask).Info.State.String()
+	},
+}
+
+var (
+	tasksIndexes    = []*col.Index{tasksNamespaceIndex}
+	subtasksIndexes = []*col.Index{subtasksTaskIndex, subtasksStateIndex}
+)
+
+func tasksCollection(db *sqlx.DB, listener *col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(tasksCollectionName, db, listener, &StoredTask{}, tasksIndexes, nil)
+}
+
+func subtasksCollection(db *sqlx.DB, listener *col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(subtasksCollectionName, db, listener, &StoredSubtask{}, subtasksIndexes, nil)
+}
+
+// AllCollections returns a list of all the work collections for
+// postgres-initialization purposes. These collections are not usable for
+// querying.
+func AllCollections() []col.PostgresCollection {
+	return []col.PostgresCollection{
+		col.NewPostgresCollection(tasksCollectionName, nil, nil, nil, tasksIndexes, nil),
+		col.NewPostgresCollection(subtasksCollectionName, nil, nil, nil, subtasksIndexes, nil),
+	}
+}
+
+// SetupPostgresTaskQueueV0 creates the collections and claims table used by
+// postgres task queues. The 'work' schema must already exist.
+func SetupPostgresTaskQueueV0(ctx context.Context, tx *sqlx.Tx) error {
+	if err := col.SetupPostgresCollections(ctx, tx, AllCollections()...); err != nil {
+		return err
+	}
+	_, err := tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS work.claims (
+		subtask TEXT NOT NULL PRIMARY KEY REFERENCES collections.`+subtasksCollectionName+`(key) ON DELETE CASCADE,
+		worker TEXT NOT NULL,
+		expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+	);
+`)
+	return errors.EnsureStack(err)
+}
+
+// postgresTaskStore stores tasks and subtasks in postgres collections, which
+// notify masters and workers of changes through LISTEN/NOTIFY. Subtasks are
+// claimed by inserting a row into work.claims with an expiry, which the
+// claiming worker renews until it has processed the subtask. Workers find
+// subtasks to claim with 'SELECT ... FOR UPDATE SKIP LOCKED', so concurrent
+// workers don't contend for the same subtask.
+type postgresTaskStore struct {
+	db          *sqlx.DB
+	namespace   string
+	workerID    string
+	tasks       col.PostgresCollection
+	subtasks    col.PostgresCollection
+	pollTimeout time.Duration
+}
+
+func newPostgresTaskStore(db *sqlx.DB, listener *col.PostgresListener, taskNamespace string) *postgresTaskStore {
+	return &postgresTaskStore{
+		db:          db,
+		namespace:   taskNamespace,
+		workerID:    uuid.NewWithoutDashes(),
+		tasks:       tasksCollection(db, listener),
+		subtasks:    subtasksCollection(db, listener),
+		pollTimeout: claimTTL / 2,
+	}
+}
+
+func (s *postgresTaskStore) taskKey(taskID string) string {
+	return s.namespace + "/" + taskID
+}
+
+func (s *postgresTaskStore) createTask(ctx context.Context, task *Task) error {
+	return col.NewSQLTx(ctx, s.db, func(tx *sqlx.Tx) error {
+		return s.tasks.ReadWrite(tx).Put(s.taskKey(task.ID), &StoredTask{
+			Namespace: s.namespace,
+			Task:      task,
+		})
+	})
+}
+
+func (s *postgresTaskStore) deleteTask(taskID string) error {
+	return col.NewSQLTx(context.Background(), s.db, func(tx *sqlx.Tx) error {
+		if err := s.subtasks.ReadWrite(tx).DeleteByIndex(subtasksTaskIndex, s.taskKey(taskID)); err != nil {
+			return err
+		}
+		return s.tasks.ReadWrite(tx).Delete(s.taskKey(taskID))
+	})
+}
+
+func (s *postgresTaskStore) deleteAllTasks() error {
+	return col.NewSQLTx(context.Background(), s.db, func(tx *sqlx.Tx) error {
+		// Subtask keys are prefixed with their task's key, and so with the namespace
+		if _, err := tx.Exec(fmt.Sprintf("DELETE FROM collections.%s WHERE key LIKE $1", subtasksCollectionName),
+			likePrefix(s.namespace+"/")); err != nil {
+			return errors.EnsureStack(err)
+		}
+		return s.tasks.ReadWrite(tx).DeleteByIndex(tasksNamespaceIndex, s.namespace)
+	})
+}
+
+// likePrefix returns a LIKE pattern matching strings that start with 'prefix'
+func likePrefix(prefix string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(prefix) + "%"
+}
+
+// watchTasks reports each task once, even though postgres watches may repeat
+// an event that races with the watch's initial listing.
+func (s *postgresTaskStore) watchTasks(ctx context.Context, f func(string, *Task) error) error {
+	seen := make(map[string]bool)
+	return s.tasks.ReadOnly(ctx).WatchByIndexF(tasksNamespaceIndex, s.namespace, func(e *watch.Event) error {
+		taskID := strings.TrimPrefix(string(e.Key), s.namespace+"/")
+		if e.Type == watch.EventDelete {
+			delete(seen, taskID)
+			return f(taskID, nil)
+		}
+		var key string
+		storedTask := &StoredTask{}
+		if err := e.Unmarshal(&key, storedTask); err != nil {
+			return err
+		}
+		if seen[taskID] {
+			return nil
+		}
+		seen[taskID] = true
+		return f(taskID, storedTask.Task)
+	})
+}
+
+func (s *postgresTaskStore) createSubtask(ctx context.Context, taskID string, subtaskInfo *TaskInfo) error {
+	taskKey := s.taskKey(taskID)
+	return col.NewSQLTx(ctx, s.db, func(tx *sqlx.Tx) error {
+		return s.subtasks.ReadWrite(tx).Put(taskKey+"/"+subtaskInfo.Task.ID, &StoredSubtask{
+			TaskKey: taskKey,
+			Info:    subtaskInfo,
+		})
+	})
+}
+
+func (s *postgresTaskStore) deleteSubtasks(taskID string) error {
+	return col.NewSQLTx(context.Background(), s.db, func(tx *sqlx.Tx) error {
+		return s.subtasks.ReadWrite(tx).DeleteByIndex(subtasksTaskIndex, s.taskKey(taskID))
+	})
+}
+
+// watchSubtasks reports each subtask's terminal state once (see watchTasks).
+func (s *postgresTaskStore) watchSubtasks(ctx context.Context, taskID string, f func(*TaskInfo) error) error {
+	done := make(map[string]bool)
+	return s.subtasks.ReadOnly(ctx).WatchByIndexF(subtasksTaskIndex, s.taskKey(taskID), func(e *watch.Event) error {
+		if e.Type == watch.EventDelete {
+			return errors.New("task was deleted while waiting for results")
+		}
+		var key string
+		storedSubtask := &StoredSubtask{}
+		if err := e.Unmarshal(&key, storedSubtask); err != nil {
+			return err
+		}
+		if done[key] {
+			return nil
+		}
+		if storedSubtask.Info.State != State_RUNNING {
+			done[key] = true
+		}
+		return f(storedSubtask.Info)
+	})
+}
+
+// watchClaimable reports each subtask that is created. Expired claims don't
+// generate notifications, so it also reports periodically, to pick up
+// subtasks whose worker died.
+func (s *postgresTaskStore) watchClaimable(ctx context.Context, taskID string, f func(string)) error {
+	watcher, err := s.subtasks.ReadOnly(ctx).WatchByIndex(subtasksTaskIndex, s.taskKey(taskID), watch.IgnoreDelete)
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+	ticker := time.NewTicker(s.pollTimeout)
+	defer ticker.Stop()
+	for {
+		select {
+		case e, ok := <-watcher.Watch():
+			if !ok {
+				return nil
+			}
+			if e.Type == watch.EventError {
+				return e.Err
+			}
+			var key string
+			storedSubtask := &StoredSubtask{}
+			if err := e.Unmarshal(&key, storedSubtask); err != nil {
+				return err
+			}
+			if storedSubtask.Info.State == State_RUNNING {
+				f(key)
+			}
+		case <-ticker.C:
+			f("")
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// processSubtask claims the oldest unclaimed subtask of 'taskID'. Postgres
+// picks the subtask rather than the caller, so 'subtaskKey' is ignored.
+func (s *postgresTaskStore) processSubtask(ctx context.Context, taskID, _ string, processFunc ProcessFunc) error {
+	subtaskKey, subtaskInfo, err := s.claimSubtask(ctx, taskID)
+	if err != nil || subtaskKey == "" {
+		return err
+	}
+	claimCtx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	// Cancel before waiting, so the claim renewal stops right away.
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.renewClaim(claimCtx, cancel, subtaskKey)
+	}()
+	result, processErr := processFunc(claimCtx, subtaskInfo.Task)
+	// If the task context was canceled or the claim was lost, release the
+	// claim (if it's still ours) and return with no error.
+	if errors.Is(claimCtx.Err(), context.Canceled) {
+		if _, err := s.db.Exec("DELETE FROM work.claims WHERE subtask = $1 AND worker = $2", subtaskKey, s.workerID); err != nil {
+			return errors.EnsureStack(err)
+		}
+		return nil
+	}
+	return col.NewSQLTx(claimCtx, s.db, func(tx *sqlx.Tx) error {
+		res, err := tx.Exec("DELETE FROM work.claims WHERE subtask = $1 AND worker = $2", subtaskKey, s.workerID)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return errors.EnsureStack(err)
+		} else if n == 0 {
+			return errors.EnsureStack(col.ErrNotClaimed)
+		}
+		storedSubtask := &StoredSubtask{}
+		return s.subtasks.ReadWrite(tx).Update(subtaskKey, storedSubtask, func() error {
+			if storedSubtask.Info.State != State_RUNNING {
+				return nil
+			}
+			setResult(storedSubtask.Info, subtaskInfo.Task, result, processErr)
+			return nil
+		})
+	})
+}
+
+// claimSubtask claims the oldest running subtask of 'taskID' that isn't
+// claimed, or whose claim has expired. It returns an empty key if there are
+// no subtasks to claim.
+func (s *postgresTaskStore) claimSubtask(ctx context.Context, taskID string) (string, *TaskInfo, error) {
+	var subtaskKey string
+	storedSubtask := &StoredSubtask{}
+	if err := col.NewSQLTx(ctx, s.db, func(tx *sqlx.Tx) error {
+		subtaskKey = ""
+		var data []byte
+		if err := tx.QueryRowContext(ctx, fmt.Sprintf(`
+		SELECT s.key, s.proto FROM collections.%s s
+		LEFT JOIN work.claims c ON c.subtask = s.key
+		WHERE s.idx_task = $1 AND s.idx_state = $2 AND (c.subtask IS NULL OR c.expires_at < CURRENT_TIMESTAMP)
+		ORDER BY s.createdat
+		LIMIT 1
+		FOR UPDATE OF s SKIP LOCKED`, subtasksCollectionName),
+			s.taskKey(taskID), State_RUNNING.String()).Scan(&subtaskKey, &data); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				subtaskKey = ""
+				return nil
+			}
+			return errors.EnsureStack(err)
+		}
+		if err := proto.Unmarshal(data, storedSubtask); err != nil {
+			return errors.EnsureStack(err)
+		}
+		_, err := tx.ExecContext(ctx, `
+		INSERT INTO work.claims (subtask, worker, expires_at) VALUES ($1, $2, CURRENT_TIMESTAMP + $3::FLOAT8 * INTERVAL '1 second')
+		ON CONFLICT (subtask) DO UPDATE SET worker = EXCLUDED.worker, expires_at = EXCLUDED.expires_at`,
+			subtaskKey, s.workerID, claimTTL.Seconds())
+		return errors.EnsureStack(err)
+	}); err != nil {
+		return "", nil, err
+	}
+	return subtaskKey, storedSubtask.Info, nil
+}
+
+// renewClaim extends this worker's claim on 'subtaskKey' until ctx is
+// cancelled, and calls 'cancel' if the claim is lost.
+func (s *postgresTaskStore) renewClaim(ctx context.Context, cancel context.CancelFunc, subtaskKey string) {
+	ticker := time.NewTicker(claimTTL / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			res, err := s.db.ExecContext(ctx, `
+			UPDATE work.claims SET expires_at = CURRENT_TIMESTAMP + $3::FLOAT8 * INTERVAL '1 second'
+			WHERE subtask = $1 AND worker = $2`, subtaskKey, s.workerID, claimTTL.Seconds())
+			if err != nil {
+				cancel()
+				return
+			}
+			if n, err := res.RowsAffected(); err != nil || n == 0 {
+				cancel()
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
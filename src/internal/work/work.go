@@ -3,101 +3,107 @@ package work
 import (
 	"context"
 	"fmt"
-	"path"
 	"sync/atomic"
 
 	etcd "github.com/coreos/etcd/clientv3"
-	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
+	"github.com/jmoiron/sqlx"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
-	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
 	"golang.org/x/sync/errgroup"
 )
 
 const (
-	taskPrefix    = "/task"
-	subtaskPrefix = "/subtask"
-	claimPrefix   = "/claim"
+	// EtcdBackend stores tasks in etcd.
+	EtcdBackend = "etcd"
+	// PostgresBackend stores tasks in postgres.
+	PostgresBackend = "postgres"
 )
 
 // TaskQueue manages a set of parallel tasks, and provides an interface for running tasks.
 // Priority of tasks (and therefore subtasks) is based on task creation time, so tasks created
 // earlier will be prioritized over tasks that were created later.
 type TaskQueue struct {
-	*taskEtcd
+	store     taskStore
 	taskQueue *taskQueue
 }
 
-type taskEtcd struct {
-	etcdClient                    *etcd.Client
-	taskCol, subtaskCol, claimCol col.EtcdCollection
+// taskStore stores the tasks, subtasks and claims that are shared between a
+// task queue's masters and workers.
+type taskStore interface {
+	createTask(ctx context.Context, task *Task) error
+	deleteTask(taskID string) error
+	deleteAllTasks() error
+	// watchTasks calls f each time a task is created, or deleted (with a nil
+	// task), starting with the tasks that already exist.
+	watchTasks(ctx context.Context, f func(taskID string, task *Task) error) error
+
+	createSubtask(ctx context.Context, taskID string, subtaskInfo *TaskInfo) error
+	deleteSubtasks(taskID string) error
+	// watchSubtasks calls f each time a subtask of a task is created or
+	// updated. It returns an error if the task's subtasks are deleted.
+	watchSubtasks(ctx context.Context, taskID string, f func(*TaskInfo) error) error
+
+	// watchClaimable calls f each time a subtask of a task may have become
+	// claimable, with the subtask's key if it's known.
+	watchClaimable(ctx context.Context, taskID string, f func(subtaskKey string)) error
+	// processSubtask claims a subtask of a task (preferring 'subtaskKey'),
+	// processes it with processFunc, and records the result. It's not an error
+	// if there is no subtask to claim.
+	processSubtask(ctx context.Context, taskID, subtaskKey string, processFunc ProcessFunc) error
 }
 
-// NewTaskQueue sets up a new task queue.
+// NewTaskQueue sets up a new task queue backed by etcd.
 func NewTaskQueue(ctx context.Context, etcdClient *etcd.Client, etcdPrefix string, taskNamespace string) (*TaskQueue, error) {
+	return newTaskQueueWithStore(ctx, newEtcdTaskStore(etcdClient, etcdPrefix, taskNamespace), taskNamespace)
+}
+
+// NewPostgresTaskQueue sets up a new task queue backed by postgres.
+func NewPostgresTaskQueue(ctx context.Context, db *sqlx.DB, listener *col.PostgresListener, taskNamespace string) (*TaskQueue, error) {
+	return newTaskQueueWithStore(ctx, newPostgresTaskStore(db, listener, taskNamespace), taskNamespace)
+}
+
+func newTaskQueueWithStore(ctx context.Context, store taskStore, taskNamespace string) (*TaskQueue, error) {
 	tq := &TaskQueue{
-		taskEtcd:  newTaskEtcd(etcdClient, etcdPrefix, taskNamespace),
+		store:     store,
 		taskQueue: newTaskQueue(ctx),
 	}
-	// Clear etcd key space.
+	// Clear the task namespace.
 	// TODO: Multiple storage task queues are setup, so deleting the existing tasks is problematic.
 	if taskNamespace != "storage" {
-		if err := tq.deleteAllTasks(); err != nil {
+		if err := tq.store.deleteAllTasks(); err != nil {
 			return nil, err
 		}
 	}
 	return tq, nil
 }
 
-func newTaskEtcd(etcdClient *etcd.Client, etcdPrefix string, taskNamespace string) *taskEtcd {
-	return &taskEtcd{
-		etcdClient: etcdClient,
-		taskCol:    newCollection(etcdClient, path.Join(etcdPrefix, taskPrefix, taskNamespace), &Task{}),
-		subtaskCol: newCollection(etcdClient, path.Join(etcdPrefix, subtaskPrefix, taskNamespace), &TaskInfo{}),
-		claimCol:   newCollection(etcdClient, path.Join(etcdPrefix, claimPrefix, taskNamespace), &Claim{}),
-	}
-}
-
-func newCollection(etcdClient *etcd.Client, etcdPrefix string, template proto.Message) col.EtcdCollection {
-	return col.NewEtcdCollection(
-		etcdClient,
-		etcdPrefix,
-		nil,
-		template,
-		nil,
-		nil,
-	)
-}
-
 // RunTask runs a task in the task queue.
 // The task code should be contained within the passed in callback.
 // The callback will receive a Master, which should be used for running subtasks in the task queue.
 // The task state will be cleaned up upon return of the callback.
 func (tq *TaskQueue) RunTask(ctx context.Context, f func(*Master)) (retErr error) {
 	task := &Task{ID: uuid.NewWithoutDashes()}
-	if _, err := col.NewSTM(ctx, tq.etcdClient, func(stm col.STM) error {
-		return tq.taskCol.ReadWrite(stm).Put(task.ID, task)
-	}); err != nil {
+	if err := tq.store.createTask(ctx, task); err != nil {
 		return err
 	}
 	defer func() {
 		if retErr != nil {
-			if err := tq.deleteTask(task.ID); err != nil {
+			if err := tq.store.deleteTask(task.ID); err != nil {
 				fmt.Printf("errored deleting task %v: %v\n", task.ID, err)
 			}
 		}
 	}()
 	return tq.taskQueue.runTask(ctx, task.ID, func(te *taskEntry) {
 		defer func() {
-			if err := tq.deleteTask(task.ID); err != nil {
+			if err := tq.store.deleteTask(task.ID); err != nil {
 				fmt.Printf("errored deleting task %v: %v\n", task.ID, err)
 			}
 		}()
 		f(&Master{
-			taskEtcd:  tq.taskEtcd,
+			store:     tq.store,
 			taskID:    task.ID,
 			taskEntry: te,
 		})
@@ -117,7 +123,7 @@ func (tq *TaskQueue) RunTaskBlock(ctx context.Context, f func(*Master) error) er
 
 // Master manages subtasks in the task queue, and provides an interface for running subtasks.
 type Master struct {
-	*taskEtcd
+	store     taskStore
 	taskID    string
 	taskEntry *taskEntry
 }
@@ -161,15 +167,7 @@ func (m *Master) RunSubtasksChan(subtaskChan chan *Task, collectFunc CollectFunc
 	done := make(chan struct{})
 	ctx, cancel := context.WithCancel(m.taskEntry.ctx)
 	eg.Go(func() error {
-		return m.subtaskCol.ReadOnly(ctx).WatchOneF(m.taskID, func(e *watch.Event) error {
-			var key string
-			subtaskInfo := &TaskInfo{}
-			if e.Type == watch.EventDelete {
-				return errors.New("task was deleted while waiting for results")
-			}
-			if err := e.Unmarshal(&key, subtaskInfo); err != nil {
-				return err
-			}
+		return m.store.watchSubtasks(ctx, m.taskID, func(subtaskInfo *TaskInfo) error {
 			// Check that the subtask state is terminal.
 			if subtaskInfo.State == State_RUNNING {
 				return nil
@@ -202,7 +200,7 @@ func (m *Master) RunSubtasksChan(subtaskChan chan *Task, collectFunc CollectFunc
 		if err := eg.Wait(); retErr == nil && !errors.Is(ctx.Err(), context.Canceled) {
 			retErr = err
 		}
-		if err := m.deleteSubtasks(); err != nil {
+		if err := m.store.deleteSubtasks(m.taskID); err != nil {
 			fmt.Printf("errored deleting subtasks for task %v: %v\n", m.taskID, err)
 		}
 	}()
@@ -220,39 +218,7 @@ func (m *Master) createSubtask(subtask *Task) error {
 	if subtask.ID == "" {
 		subtask.ID = uuid.NewWithoutDashes()
 	}
-	subtaskKey := path.Join(m.taskID, subtask.ID)
-	subtaskInfo := &TaskInfo{Task: subtask}
-	if _, err := col.NewSTM(m.taskEntry.ctx, m.etcdClient, func(stm col.STM) error {
-		return m.subtaskCol.ReadWrite(stm).Put(subtaskKey, subtaskInfo)
-	}); err != nil {
-		return err
-	}
-	return nil
-}
-
-func (m *Master) deleteSubtasks() error {
-	_, err := col.NewSTM(context.Background(), m.etcdClient, func(stm col.STM) error {
-		m.subtaskCol.ReadWrite(stm).DeleteAllPrefix(m.taskID)
-		return nil
-	})
-	return err
-}
-
-func (tq *TaskQueue) deleteTask(taskID string) error {
-	_, err := col.NewSTM(context.Background(), tq.etcdClient, func(stm col.STM) error {
-		tq.subtaskCol.ReadWrite(stm).DeleteAllPrefix(taskID)
-		return tq.taskCol.ReadWrite(stm).Delete(taskID)
-	})
-	return err
-}
-
-func (tq *TaskQueue) deleteAllTasks() error {
-	_, err := col.NewSTM(context.Background(), tq.etcdClient, func(stm col.STM) error {
-		tq.subtaskCol.ReadWrite(stm).DeleteAll()
-		tq.taskCol.ReadWrite(stm).DeleteAll()
-		return nil
-	})
-	return err
+	return m.store.createSubtask(m.taskEntry.ctx, m.taskID, &TaskInfo{Task: subtask})
 }
 
 // Worker is a worker that will process subtasks in a task.
@@ -262,12 +228,17 @@ func (tq *TaskQueue) deleteAllTasks() error {
 // The processFunc callback will be called for each subtask that needs to be processed
 // in the task.
 type Worker struct {
-	*taskEtcd
+	store taskStore
 }
 
-// NewWorker creates a new worker.
+// NewWorker creates a new worker backed by etcd.
 func NewWorker(etcdClient *etcd.Client, etcdPrefix string, taskNamespace string) *Worker {
-	return &Worker{taskEtcd: newTaskEtcd(etcdClient, etcdPrefix, taskNamespace)}
+	return &Worker{store: newEtcdTaskStore(etcdClient, etcdPrefix, taskNamespace)}
+}
+
+// NewPostgresWorker creates a new worker backed by postgres.
+func NewPostgresWorker(db *sqlx.DB, listener *col.PostgresListener, taskNamespace string) *Worker {
+	return &Worker{store: newPostgresTaskStore(db, listener, taskNamespace)}
 }
 
 // ProcessFunc is a callback that is used for processing a subtask in a task.
@@ -277,16 +248,11 @@ type ProcessFunc func(context.Context, *Task) (*types.Any, error)
 // The worker will continue to watch the task collection until the context is canceled.
 func (w *Worker) Run(ctx context.Context, processFunc ProcessFunc) error {
 	taskQueue := newTaskQueue(ctx)
-	return w.taskCol.ReadOnly(ctx).WatchF(func(e *watch.Event) error {
-		taskID := string(e.Key)
-		task := &Task{}
-		if e.Type == watch.EventDelete {
+	return w.store.watchTasks(ctx, func(taskID string, task *Task) error {
+		if task == nil {
 			taskQueue.deleteTask(taskID)
 			return nil
 		}
-		if err := e.Unmarshal(&taskID, task); err != nil {
-			return err
-		}
 		return taskQueue.runTask(ctx, taskID, func(taskEntry *taskEntry) {
 			if err := w.taskFunc(task, taskEntry, processFunc); err != nil && !errors.Is(taskEntry.ctx.Err(), context.Canceled) {
 				fmt.Printf("errored in task callback: %v\n", err)
@@ -296,89 +262,14 @@ func (w *Worker) Run(ctx context.Context, processFunc ProcessFunc) error {
 }
 
 func (w *Worker) taskFunc(task *Task, taskEntry *taskEntry, processFunc ProcessFunc) error {
-	claimWatch, err := w.claimCol.ReadOnly(taskEntry.ctx).WatchOne(task.ID, watch.IgnorePut)
-	if err != nil {
-		return err
-	}
-	defer claimWatch.Close()
-	subtaskWatch, err := w.subtaskCol.ReadOnly(taskEntry.ctx).WatchOne(task.ID, watch.IgnoreDelete)
-	if err != nil {
-		return err
-	}
-	defer subtaskWatch.Close()
-	for {
-		select {
-		case e := <-claimWatch.Watch():
-			if e.Type == watch.EventError {
-				return e.Err
-			}
-			subtaskKey := string(e.Key)
-			taskEntry.runSubtask(w.subtaskFunc(subtaskKey, processFunc))
-		case e := <-subtaskWatch.Watch():
-			if e.Type == watch.EventError {
-				return e.Err
-			}
-			var subtaskKey string
-			if err := e.Unmarshal(&subtaskKey, &TaskInfo{}); err != nil {
-				return err
-			}
-			taskEntry.runSubtask(w.subtaskFunc(subtaskKey, processFunc))
-		case <-taskEntry.ctx.Done():
-			return taskEntry.ctx.Err()
-		}
-	}
+	return w.store.watchClaimable(taskEntry.ctx, task.ID, func(subtaskKey string) {
+		taskEntry.runSubtask(w.subtaskFunc(task.ID, subtaskKey, processFunc))
+	})
 }
 
-func (w *Worker) subtaskFunc(subtaskKey string, processFunc ProcessFunc) subtaskFunc {
+func (w *Worker) subtaskFunc(taskID, subtaskKey string, processFunc ProcessFunc) subtaskFunc {
 	return func(ctx context.Context) {
-		if err := func() error {
-			// (bryce) this should be refactored to have the check and claim in the same stm.
-			// there is a rare race condition that does not affect correctness, but it is less
-			// than ideal because a subtask could get run once more than necessary.
-			subtaskInfo := &TaskInfo{}
-			if _, err := col.NewSTM(ctx, w.etcdClient, func(stm col.STM) error {
-				return w.subtaskCol.ReadWrite(stm).Get(subtaskKey, subtaskInfo)
-			}); err != nil {
-				return err
-			}
-			if subtaskInfo.State != State_RUNNING {
-				return nil
-			}
-			return w.claimCol.Claim(ctx, subtaskKey, &Claim{}, func(claimCtx context.Context) (retErr error) {
-				subtask := subtaskInfo.Task
-				var result *types.Any
-				defer func() {
-					// If the task context was canceled or the claim was lost, just return with no error.
-					if errors.Is(claimCtx.Err(), context.Canceled) {
-						retErr = nil
-						return
-					}
-					subtaskInfo := &TaskInfo{}
-					if _, err := col.NewSTM(claimCtx, w.etcdClient, func(stm col.STM) error {
-						return w.subtaskCol.ReadWrite(stm).Update(subtaskKey, subtaskInfo, func() error {
-							// (bryce) remove when check and claim are in the same stm.
-							if subtaskInfo.State != State_RUNNING {
-								return nil
-							}
-							subtaskInfo.Task = subtask
-							subtaskInfo.State = State_SUCCESS
-							subtaskInfo.Result = result
-							if retErr != nil {
-								subtaskInfo.State = State_FAILURE
-								subtaskInfo.Reason = retErr.Error()
-								retErr = nil
-							}
-							return nil
-						})
-					}); retErr == nil {
-						retErr = err
-					}
-				}()
-				var err error
-				result, err = processFunc(claimCtx, subtask)
-				return err
-			})
-		}(); err != nil {
+		if err := w.store.processSubtask(ctx, taskID, subtaskKey, processFunc); err != nil {
 			// If the task context was canceled or the subtask was deleted / not claimed, then no error should be logged.
 			if errors.Is(ctx.Err(), context.Canceled) ||
 				col.IsErrNotFound(err) || errors.Is(err, col.ErrNotClaimed) {
@@ -217,6 +217,118 @@ func (m *Claim) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Claim proto.InternalMessageInfo
 
+// StoredTask and StoredSubtask are the rows of the postgres task store, which
+// carry the keys they're indexed by alongside the task.
+type StoredTask struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Task                 *Task    `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StoredTask) Reset()         { *m = StoredTask{} }
+func (m *StoredTask) String() string { return proto.CompactTextString(m) }
+func (*StoredTask) ProtoMessage()    {}
+func (*StoredTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f2d069f3b08a810, []int{3}
+}
+func (m *StoredTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoredTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoredTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoredTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoredTask.Merge(m, src)
+}
+func (m *StoredTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoredTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoredTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoredTask proto.InternalMessageInfo
+
+func (m *StoredTask) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *StoredTask) GetTask() *Task {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+type StoredSubtask struct {
+	TaskKey              string    `protobuf:"bytes,1,opt,name=task_key,json=taskKey,proto3" json:"task_key,omitempty"`
+	Info                 *TaskInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *StoredSubtask) Reset()         { *m = StoredSubtask{} }
+func (m *StoredSubtask) String() string { return proto.CompactTextString(m) }
+func (*StoredSubtask) ProtoMessage()    {}
+func (*StoredSubtask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f2d069f3b08a810, []int{4}
+}
+func (m *StoredSubtask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoredSubtask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoredSubtask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoredSubtask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoredSubtask.Merge(m, src)
+}
+func (m *StoredSubtask) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoredSubtask) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoredSubtask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoredSubtask proto.InternalMessageInfo
+
+func (m *StoredSubtask) GetTaskKey() string {
+	if m != nil {
+		return m.TaskKey
+	}
+	return ""
+}
+
+func (m *StoredSubtask) GetInfo() *TaskInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
 type TestData struct {
 	Processed            bool     `protobuf:"varint,1,opt,name=processed,proto3" json:"processed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -228,7 +340,7 @@ func (m *TestData) Reset()         { *m = TestData{} }
 func (m *TestData) String() string { return proto.CompactTextString(m) }
 func (*TestData) ProtoMessage()    {}
 func (*TestData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6f2d069f3b08a810, []int{3}
+	return fileDescriptor_6f2d069f3b08a810, []int{5}
 }
 func (m *TestData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,35 +381,41 @@ func init() {
 	proto.RegisterType((*Task)(nil), "work.Task")
 	proto.RegisterType((*TaskInfo)(nil), "work.TaskInfo")
 	proto.RegisterType((*Claim)(nil), "work.Claim")
+	proto.RegisterType((*StoredTask)(nil), "work.StoredTask")
+	proto.RegisterType((*StoredSubtask)(nil), "work.StoredSubtask")
 	proto.RegisterType((*TestData)(nil), "work.TestData")
 }
 
 func init() { proto.RegisterFile("internal/work/work.proto", fileDescriptor_6f2d069f3b08a810) }
 
 var fileDescriptor_6f2d069f3b08a810 = []byte{
-	// 347 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0x4b, 0x6a, 0xe3, 0x30,
-	0x18, 0x1e, 0x79, 0x9c, 0x97, 0x0c, 0x43, 0x10, 0x21, 0x78, 0xc2, 0xe0, 0x49, 0xbd, 0x32, 0xa5,
-	0xd8, 0xe0, 0x9c, 0x20, 0xaf, 0xb6, 0x86, 0x92, 0x85, 0x9c, 0x6c, 0xba, 0x53, 0x6c, 0xc5, 0x31,
-	0x71, 0xac, 0x20, 0x29, 0x2d, 0x39, 0x47, 0x2f, 0xd5, 0x65, 0x4f, 0x50, 0x8a, 0x4f, 0x52, 0x24,
-	0xa7, 0xb4, 0xdd, 0x74, 0x23, 0xbe, 0x17, 0x1f, 0x9f, 0xf8, 0xa1, 0x9d, 0x97, 0x92, 0xf2, 0x92,
-	0x14, 0xc1, 0x23, 0xe3, 0x3b, 0xfd, 0xf8, 0x07, 0xce, 0x24, 0x43, 0xa6, 0xc2, 0x83, 0x5e, 0xc6,
-	0x32, 0xa6, 0x85, 0x40, 0xa1, 0xda, 0x1b, 0xfc, 0xcd, 0x18, 0xcb, 0x0a, 0x1a, 0x68, 0xb6, 0x3e,
-	0x6e, 0x02, 0x52, 0x9e, 0x6a, 0xcb, 0xbd, 0x85, 0xe6, 0x92, 0x88, 0x1d, 0xea, 0x43, 0x23, 0x4f,
-	0x6d, 0x30, 0x04, 0x5e, 0x67, 0xd2, 0xac, 0x5e, 0xff, 0x1b, 0xd1, 0x0c, 0x1b, 0x79, 0x8a, 0x3c,
-	0x68, 0xa6, 0x44, 0x12, 0xdb, 0x18, 0x02, 0xcf, 0x0a, 0x7b, 0x7e, 0xdd, 0xe4, 0x7f, 0x34, 0xf9,
-	0xe3, 0xf2, 0x84, 0x75, 0xc2, 0x7d, 0x02, 0xb0, 0xad, 0xaa, 0xa2, 0x72, 0xc3, 0x90, 0x03, 0x4d,
-	0x49, 0xc4, 0x4e, 0x17, 0x5a, 0x21, 0xf4, 0xf5, 0x50, 0xe5, 0x62, 0xad, 0xa3, 0x0b, 0xd8, 0x10,
-	0x92, 0x48, 0xaa, 0x7b, 0xff, 0x84, 0x56, 0x1d, 0x88, 0x95, 0x84, 0x6b, 0x07, 0xf5, 0x61, 0x93,
-	0x53, 0x22, 0x58, 0x69, 0xff, 0x56, 0xab, 0xf0, 0x99, 0xa1, 0x2b, 0xa5, 0x8b, 0x63, 0x21, 0x6d,
-	0xf3, 0x87, 0x4d, 0xe7, 0x8c, 0xdb, 0x82, 0x8d, 0x69, 0x41, 0xf2, 0xbd, 0xeb, 0xc1, 0xf6, 0x92,
-	0x0a, 0x39, 0x23, 0x92, 0xa0, 0x7f, 0xb0, 0x73, 0xe0, 0x2c, 0xa1, 0x42, 0xd0, 0xfa, 0xcf, 0x6d,
-	0xfc, 0x29, 0x5c, 0xfa, 0xb0, 0xa1, 0x87, 0x20, 0x0b, 0xb6, 0xf0, 0x6a, 0xb1, 0x88, 0x16, 0x37,
-	0xdd, 0x5f, 0x8a, 0xc4, 0xab, 0xe9, 0x74, 0x1e, 0xc7, 0x5d, 0xa0, 0xc8, 0xf5, 0x38, 0xba, 0x5b,
-	0xe1, 0x79, 0xd7, 0x98, 0x8c, 0x9f, 0x2b, 0x07, 0xbc, 0x54, 0x0e, 0x78, 0xab, 0x1c, 0x70, 0x3f,
-	0xca, 0x72, 0xb9, 0x3d, 0xae, 0xfd, 0x84, 0xed, 0x83, 0x03, 0x49, 0xb6, 0xa7, 0x94, 0xf2, 0xaf,
-	0xe8, 0x21, 0x0c, 0x04, 0x4f, 0x82, 0x6f, 0x77, 0x5c, 0x37, 0xf5, 0xf6, 0xd1, 0x7b, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x19, 0x0e, 0xac, 0xb8, 0xdf, 0x01, 0x00, 0x00,
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xdf, 0x8a, 0xd3, 0x40,
+	0x14, 0xc6, 0x9d, 0x98, 0xfe, 0xd9, 0x53, 0x5c, 0xca, 0xb0, 0x2c, 0xd9, 0x45, 0xea, 0x9a, 0xab,
+	0x22, 0x92, 0x40, 0xf7, 0x09, 0xba, 0xdd, 0x55, 0xab, 0xd2, 0x8b, 0xc9, 0xf6, 0xc6, 0x1b, 0x99,
+	0x26, 0xd3, 0x6c, 0x48, 0x3a, 0x13, 0x66, 0x26, 0x4a, 0x9e, 0xc3, 0x97, 0xf2, 0xd2, 0x27, 0x10,
+	0xc9, 0x93, 0xc8, 0xcc, 0x44, 0xab, 0x20, 0xde, 0x24, 0xe7, 0x7c, 0xdf, 0xe1, 0xc7, 0x77, 0x4e,
+	0x02, 0x41, 0xc1, 0x35, 0x93, 0x9c, 0x56, 0xf1, 0x67, 0x21, 0x4b, 0xfb, 0x88, 0x6a, 0x29, 0xb4,
+	0xc0, 0xbe, 0xa9, 0x2f, 0xcf, 0x72, 0x91, 0x0b, 0x2b, 0xc4, 0xa6, 0x72, 0xde, 0xe5, 0x45, 0x2e,
+	0x44, 0x5e, 0xb1, 0xd8, 0x76, 0xbb, 0x66, 0x1f, 0x53, 0xde, 0x3a, 0x2b, 0x7c, 0x03, 0xfe, 0x3d,
+	0x55, 0x25, 0x3e, 0x07, 0xaf, 0xc8, 0x02, 0x74, 0x85, 0xe6, 0x27, 0x37, 0xc3, 0xee, 0xfb, 0x33,
+	0x6f, 0x7d, 0x4b, 0xbc, 0x22, 0xc3, 0x73, 0xf0, 0x33, 0xaa, 0x69, 0xe0, 0x5d, 0xa1, 0xf9, 0x64,
+	0x71, 0x16, 0x39, 0x52, 0xf4, 0x8b, 0x14, 0x2d, 0x79, 0x4b, 0xec, 0x44, 0xf8, 0x05, 0xc1, 0xd8,
+	0xa0, 0xd6, 0x7c, 0x2f, 0xf0, 0x0c, 0x7c, 0x4d, 0x55, 0x69, 0x81, 0x93, 0x05, 0x44, 0x36, 0xa8,
+	0x71, 0x89, 0xd5, 0xf1, 0x73, 0x18, 0x28, 0x4d, 0x35, 0xb3, 0xdc, 0xd3, 0xc5, 0xc4, 0x0d, 0x24,
+	0x46, 0x22, 0xce, 0xc1, 0xe7, 0x30, 0x94, 0x8c, 0x2a, 0xc1, 0x83, 0xc7, 0x26, 0x15, 0xe9, 0x3b,
+	0xfc, 0xd2, 0xe8, 0xaa, 0xa9, 0x74, 0xe0, 0xff, 0x27, 0x53, 0x3f, 0x13, 0x8e, 0x60, 0xb0, 0xaa,
+	0x68, 0x71, 0x08, 0xdf, 0x02, 0x24, 0x5a, 0x48, 0x96, 0xd9, 0x75, 0x9f, 0xc2, 0x09, 0xa7, 0x07,
+	0xa6, 0x6a, 0x9a, 0x32, 0xb7, 0x35, 0x39, 0x0a, 0xbf, 0xd3, 0x7b, 0xff, 0x4e, 0x1f, 0x6e, 0xe0,
+	0x89, 0x63, 0x25, 0xcd, 0xce, 0xae, 0x73, 0x01, 0x63, 0xf3, 0xfe, 0x58, 0xb2, 0xb6, 0xa7, 0x8d,
+	0x4c, 0xff, 0x8e, 0xb5, 0x38, 0x04, 0xbf, 0xe0, 0x7b, 0xd1, 0xb3, 0x4e, 0x8f, 0x2c, 0x73, 0x27,
+	0x62, 0xbd, 0x70, 0x0e, 0xe3, 0x7b, 0xa6, 0xf4, 0x2d, 0xd5, 0xd4, 0x24, 0xab, 0xa5, 0x48, 0x99,
+	0x52, 0xcc, 0x7d, 0x8f, 0x31, 0x39, 0x0a, 0x2f, 0x22, 0x18, 0xd8, 0x23, 0xe1, 0x09, 0x8c, 0xc8,
+	0x76, 0xb3, 0x59, 0x6f, 0x5e, 0x4f, 0x1f, 0x99, 0x26, 0xd9, 0xae, 0x56, 0x77, 0x49, 0x32, 0x45,
+	0xa6, 0x79, 0xb5, 0x5c, 0xbf, 0xdf, 0x92, 0xbb, 0xa9, 0x77, 0xb3, 0xfc, 0xda, 0xcd, 0xd0, 0xb7,
+	0x6e, 0x86, 0x7e, 0x74, 0x33, 0xf4, 0xe1, 0x3a, 0x2f, 0xf4, 0x43, 0xb3, 0x8b, 0x52, 0x71, 0x88,
+	0x6b, 0x9a, 0x3e, 0xb4, 0x19, 0x93, 0x7f, 0x56, 0x9f, 0x16, 0xb1, 0x92, 0x69, 0xfc, 0xd7, 0x3f,
+	0xb6, 0x1b, 0xda, 0xbb, 0x5e, 0xff, 0x1c, 0x00, 0x96, 0x06, 0x7f, 0x86, 0x7b, 0x02, 0x00, 0x00,
 }
 
 func (m *Task) Marshal() (dAtA []byte, err error) {
@@ -436,6 +554,98 @@ func (m *Claim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StoredTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoredTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoredTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWork(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWork(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoredSubtask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoredSubtask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoredSubtask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWork(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskKey) > 0 {
+		i -= len(m.TaskKey)
+		copy(dAtA[i:], m.TaskKey)
+		i = encodeVarintWork(dAtA, i, uint64(len(m.TaskKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TestData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -543,6 +753,46 @@ func (m *Claim) Size() (n int) {
 	return n
 }
 
+func (m *StoredTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWork(uint64(l))
+	}
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovWork(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StoredSubtask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskKey)
+	if l > 0 {
+		n += 1 + l + sovWork(uint64(l))
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovWork(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *TestData) Size() (n int) {
 	if m == nil {
 		return 0
@@ -908,6 +1158,244 @@ func (m *Claim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StoredTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoredTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoredTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWork
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &Task{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoredSubtask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWork
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoredSubtask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoredSubtask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWork
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWork
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWork
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &TaskInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWork(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWork
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TestData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
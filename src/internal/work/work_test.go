@@ -10,9 +10,14 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
+	"github.com/jmoiron/sqlx"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testetcd"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testutil"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -75,11 +80,66 @@ func collectSubtask(subtaskInfo *TaskInfo, collected map[string]bool) error {
 	return nil
 }
 
-func test(t *testing.T, workerFailProb, taskCancelProb, subtaskFailProb float64) {
+// testBackend constructs the task queues and workers under test
+type testBackend struct {
+	newTaskQueue func(context.Context) (*TaskQueue, error)
+	newWorker    func() *Worker
+}
+
+func newEtcdBackend(t *testing.T) *testBackend {
+	env := testetcd.NewEnv(t)
+	return &testBackend{
+		newTaskQueue: func(ctx context.Context) (*TaskQueue, error) {
+			return NewTaskQueue(ctx, env.EtcdClient, "", "")
+		},
+		newWorker: func() *Worker {
+			return NewWorker(env.EtcdClient, "", "")
+		},
+	}
+}
+
+func newPostgresBackend(t *testing.T) *testBackend {
+	config := serviceenv.ConfigFromOptions(testutil.NewTestDBConfig(t))
+	options := []dbutil.Option{
+		dbutil.WithHostPort(config.PostgresServiceHost, config.PostgresServicePort),
+		dbutil.WithDBName(config.PostgresDBName),
+	}
+	db, err := dbutil.NewDB(options...)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	listener := col.NewPostgresListener(dbutil.GetDSN(options...))
+	t.Cleanup(func() {
+		require.NoError(t, listener.Close())
+	})
+	ctx := context.Background()
+	require.NoError(t, dbutil.WithTx(ctx, db, func(tx *sqlx.Tx) error {
+		if err := col.CreatePostgresSchema(ctx, tx); err != nil {
+			return err
+		}
+		if err := col.SetupPostgresV0(ctx, tx); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`CREATE SCHEMA work`); err != nil {
+			return err
+		}
+		return SetupPostgresTaskQueueV0(ctx, tx)
+	}))
+	return &testBackend{
+		newTaskQueue: func(ctx context.Context) (*TaskQueue, error) {
+			return NewPostgresTaskQueue(ctx, db, listener, "")
+		},
+		newWorker: func() *Worker {
+			return NewPostgresWorker(db, listener, "")
+		},
+	}
+}
+
+func test(t *testing.T, backend *testBackend, workerFailProb, taskCancelProb, subtaskFailProb float64) {
 	seed := time.Now().UTC().UnixNano()
 	rand.Seed(seed)
 	msg := seedStr(seed)
-	env := testetcd.NewEnv(t)
 
 	numTasks := 10
 	numSubtasks := 10
@@ -89,7 +149,7 @@ func test(t *testing.T, workerFailProb, taskCancelProb, subtaskFailProb float64)
 	workerEg, errCtx := errgroup.WithContext(workerCtx)
 	for i := 0; i < numWorkers; i++ {
 		workerEg.Go(func() error {
-			w := NewWorker(env.EtcdClient, "", "")
+			w := backend.newWorker()
 			for {
 				ctx, cancel := context.WithCancel(errCtx)
 				if err := w.Run(ctx, func(_ context.Context, subtask *Task) (*types.Any, error) {
@@ -116,7 +176,7 @@ func test(t *testing.T, workerFailProb, taskCancelProb, subtaskFailProb float64)
 			}
 		})
 	}
-	tq, err := NewTaskQueue(errCtx, env.EtcdClient, "", "")
+	tq, err := backend.newTaskQueue(errCtx)
 	require.NoError(t, err)
 	taskMapsFunc := func() []map[string]bool {
 		var taskMaps []map[string]bool
@@ -168,34 +228,41 @@ func test(t *testing.T, workerFailProb, taskCancelProb, subtaskFailProb float64)
 
 func TestBasic(t *testing.T) {
 	t.Parallel()
-	test(t, 0, 0, 0)
+	test(t, newEtcdBackend(t), 0, 0, 0)
 }
 
 func TestWorkerCrashes(t *testing.T) {
 	t.Parallel()
-	test(t, 0.1, 0, 0)
+	test(t, newEtcdBackend(t), 0.1, 0, 0)
 }
 
 func TestCancelTasks(t *testing.T) {
 	t.Parallel()
-	test(t, 0, 0.2, 0)
+	test(t, newEtcdBackend(t), 0, 0.2, 0)
 }
 
 func TestSubtaskFailures(t *testing.T) {
 	t.Parallel()
-	test(t, 0, 0, 0.1)
+	test(t, newEtcdBackend(t), 0, 0, 0.1)
 }
 
 func TestEverything(t *testing.T) {
 	t.Parallel()
-	test(t, 0.1, 0.2, 0.1)
+	test(t, newEtcdBackend(t), 0.1, 0.2, 0.1)
 }
 
-func TestRunZeroSubtasks(t *testing.T) {
+func TestPostgresBasic(t *testing.T) {
 	t.Parallel()
-	env := testetcd.NewEnv(t)
+	test(t, newPostgresBackend(t), 0, 0, 0)
+}
 
-	tq, err := NewTaskQueue(context.Background(), env.EtcdClient, "", "")
+func TestPostgresEverything(t *testing.T) {
+	t.Parallel()
+	test(t, newPostgresBackend(t), 0.1, 0.2, 0.1)
+}
+
+func testRunZeroSubtasks(t *testing.T, backend *testBackend) {
+	tq, err := backend.newTaskQueue(context.Background())
 	require.NoError(t, err)
 
 	err = tq.RunTaskBlock(context.Background(), func(m *Master) error {
@@ -205,3 +272,50 @@ func TestRunZeroSubtasks(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestRunZeroSubtasks(t *testing.T) {
+	t.Parallel()
+	testRunZeroSubtasks(t, newEtcdBackend(t))
+}
+
+func TestPostgresRunZeroSubtasks(t *testing.T) {
+	t.Parallel()
+	testRunZeroSubtasks(t, newPostgresBackend(t))
+}
+
+// TestPostgresSubtaskLatency checks that a worker moves on to the next subtask
+// as soon as it finishes one, rather than waiting for its claim renewal to
+// tick.
+func TestPostgresSubtaskLatency(t *testing.T) {
+	t.Parallel()
+	backend := newPostgresBackend(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var eg errgroup.Group
+	eg.Go(func() error {
+		w := backend.newWorker()
+		if err := w.Run(ctx, func(_ context.Context, subtask *Task) (*types.Any, error) {
+			return nil, processSubtask(t, subtask)
+		}); err != nil && !errors.Is(ctx.Err(), context.Canceled) {
+			return err
+		}
+		return nil
+	})
+	tq, err := backend.newTaskQueue(ctx)
+	require.NoError(t, err)
+	var subtasks []*Task
+	for i := 0; i < 3; i++ {
+		data, err := serializeTestData(&TestData{})
+		require.NoError(t, err)
+		subtasks = append(subtasks, &Task{ID: strconv.Itoa(i), Data: data})
+	}
+	start := time.Now()
+	require.NoError(t, tq.RunTaskBlock(ctx, func(m *Master) error {
+		return m.RunSubtasks(subtasks, func(_ context.Context, _ *TaskInfo) error {
+			return nil
+		})
+	}))
+	require.True(t, time.Since(start) < claimTTL/3, "subtasks took %v", time.Since(start))
+	cancel()
+	require.NoError(t, eg.Wait())
+}
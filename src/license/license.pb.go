@@ -5,6 +5,7 @@ package license
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -918,22 +919,110 @@ func (m *DeleteAllResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteAllResponse proto.InternalMessageInfo
 
-type HeartbeatRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Secret               string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
-	Version              string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	AuthEnabled          bool     `protobuf:"varint,4,opt,name=auth_enabled,json=authEnabled,proto3" json:"auth_enabled,omitempty"`
-	ClientId             string   `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+// ClusterUsage is a snapshot of a cluster's usage, sent with each heartbeat
+type ClusterUsage struct {
+	Repos int64 `protobuf:"varint,1,opt,name=repos,proto3" json:"repos,omitempty"`
+	// size_bytes is the total size of the master branch of every repo
+	SizeBytes int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Pipelines int64 `protobuf:"varint,3,opt,name=pipelines,proto3" json:"pipelines,omitempty"`
+	// workers is the number of workers requested by running pipelines
+	Workers int64 `protobuf:"varint,4,opt,name=workers,proto3" json:"workers,omitempty"`
+	// jobs_finished is the number of jobs that have ever finished on the
+	// cluster. It only increases (unless pipelines are deleted), so throughput
+	// is the difference between two reports.
+	JobsFinished         int64    `protobuf:"varint,5,opt,name=jobs_finished,json=jobsFinished,proto3" json:"jobs_finished,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *ClusterUsage) Reset()         { *m = ClusterUsage{} }
+func (m *ClusterUsage) String() string { return proto.CompactTextString(m) }
+func (*ClusterUsage) ProtoMessage()    {}
+func (*ClusterUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36c97486aaafd691, []int{17}
+}
+func (m *ClusterUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterUsage.Merge(m, src)
+}
+func (m *ClusterUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterUsage proto.InternalMessageInfo
+
+func (m *ClusterUsage) GetRepos() int64 {
+	if m != nil {
+		return m.Repos
+	}
+	return 0
+}
+
+func (m *ClusterUsage) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *ClusterUsage) GetPipelines() int64 {
+	if m != nil {
+		return m.Pipelines
+	}
+	return 0
+}
+
+func (m *ClusterUsage) GetWorkers() int64 {
+	if m != nil {
+		return m.Workers
+	}
+	return 0
+}
+
+func (m *ClusterUsage) GetJobsFinished() int64 {
+	if m != nil {
+		return m.JobsFinished
+	}
+	return 0
+}
+
+type HeartbeatRequest struct {
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Secret      string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	Version     string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	AuthEnabled bool   `protobuf:"varint,4,opt,name=auth_enabled,json=authEnabled,proto3" json:"auth_enabled,omitempty"`
+	ClientId    string `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// usage is unset if the cluster couldn't collect its usage, or is a
+	// standalone enterprise server
+	Usage                *ClusterUsage `protobuf:"bytes,6,opt,name=usage,proto3" json:"usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
 func (m *HeartbeatRequest) Reset()         { *m = HeartbeatRequest{} }
 func (m *HeartbeatRequest) String() string { return proto.CompactTextString(m) }
 func (*HeartbeatRequest) ProtoMessage()    {}
 func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36c97486aaafd691, []int{17}
+	return fileDescriptor_36c97486aaafd691, []int{18}
 }
 func (m *HeartbeatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -997,6 +1086,13 @@ func (m *HeartbeatRequest) GetClientId() string {
 	return ""
 }
 
+func (m *HeartbeatRequest) GetUsage() *ClusterUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 type HeartbeatResponse struct {
 	License              *enterprise.LicenseRecord `protobuf:"bytes,1,opt,name=license,proto3" json:"license,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
@@ -1008,7 +1104,7 @@ func (m *HeartbeatResponse) Reset()         { *m = HeartbeatResponse{} }
 func (m *HeartbeatResponse) String() string { return proto.CompactTextString(m) }
 func (*HeartbeatResponse) ProtoMessage()    {}
 func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36c97486aaafd691, []int{18}
+	return fileDescriptor_36c97486aaafd691, []int{19}
 }
 func (m *HeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1058,7 +1154,7 @@ func (m *UserClusterInfo) Reset()         { *m = UserClusterInfo{} }
 func (m *UserClusterInfo) String() string { return proto.CompactTextString(m) }
 func (*UserClusterInfo) ProtoMessage()    {}
 func (*UserClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36c97486aaafd691, []int{19}
+	return fileDescriptor_36c97486aaafd691, []int{20}
 }
 func (m *UserClusterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1125,7 +1221,7 @@ func (m *ListUserClustersRequest) Reset()         { *m = ListUserClustersRequest
 func (m *ListUserClustersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListUserClustersRequest) ProtoMessage()    {}
 func (*ListUserClustersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36c97486aaafd691, []int{20}
+	return fileDescriptor_36c97486aaafd691, []int{21}
 }
 func (m *ListUserClustersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1165,7 +1261,7 @@ func (m *ListUserClustersResponse) Reset()         { *m = ListUserClustersRespon
 func (m *ListUserClustersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListUserClustersResponse) ProtoMessage()    {}
 func (*ListUserClustersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36c97486aaafd691, []int{21}
+	return fileDescriptor_36c97486aaafd691, []int{22}
 }
 func (m *ListUserClustersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1201,6 +1297,255 @@ func (m *ListUserClustersResponse) GetClusters() []*UserClusterInfo {
 	return nil
 }
 
+type ListClusterUsageRequest struct {
+	// cluster_id restricts the results to a single cluster, if set
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// start and end bound the reports that are aggregated. end defaults to now
+	// and start defaults to 24 hours before end.
+	Start *types.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   *types.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// interval, if set, splits the range into buckets of this length and
+	// returns a summary per cluster per bucket
+	Interval             *types.Duration `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListClusterUsageRequest) Reset()         { *m = ListClusterUsageRequest{} }
+func (m *ListClusterUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*ListClusterUsageRequest) ProtoMessage()    {}
+func (*ListClusterUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36c97486aaafd691, []int{23}
+}
+func (m *ListClusterUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListClusterUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListClusterUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListClusterUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListClusterUsageRequest.Merge(m, src)
+}
+func (m *ListClusterUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListClusterUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListClusterUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListClusterUsageRequest proto.InternalMessageInfo
+
+func (m *ListClusterUsageRequest) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+func (m *ListClusterUsageRequest) GetStart() *types.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *ListClusterUsageRequest) GetEnd() *types.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *ListClusterUsageRequest) GetInterval() *types.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+// ClusterUsageSummary aggregates the usage reported by one cluster over a
+// time range
+type ClusterUsageSummary struct {
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty" db:"cluster_id"`
+	// start is the beginning of the bucket, or of the requested range if no
+	// interval was requested
+	Start      *time.Time `protobuf:"bytes,2,opt,name=start,proto3,stdtime" json:"start,omitempty" db:"start"`
+	Reports    int64      `protobuf:"varint,3,opt,name=reports,proto3" json:"reports,omitempty" db:"reports"`
+	LastReport *time.Time `protobuf:"bytes,4,opt,name=last_report,json=lastReport,proto3,stdtime" json:"last_report,omitempty" db:"last_report"`
+	// repos, size_bytes and pipelines are as of the last report
+	Repos      int64   `protobuf:"varint,5,opt,name=repos,proto3" json:"repos,omitempty" db:"repos"`
+	SizeBytes  int64   `protobuf:"varint,6,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty" db:"size_bytes"`
+	Pipelines  int64   `protobuf:"varint,7,opt,name=pipelines,proto3" json:"pipelines,omitempty" db:"pipelines"`
+	MaxWorkers int64   `protobuf:"varint,8,opt,name=max_workers,json=maxWorkers,proto3" json:"max_workers,omitempty" db:"max_workers"`
+	AvgWorkers float64 `protobuf:"fixed64,9,opt,name=avg_workers,json=avgWorkers,proto3" json:"avg_workers,omitempty" db:"avg_workers"`
+	// jobs_finished is the number of jobs that finished between the first and
+	// last report
+	JobsFinished         int64    `protobuf:"varint,10,opt,name=jobs_finished,json=jobsFinished,proto3" json:"jobs_finished,omitempty" db:"jobs_finished"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterUsageSummary) Reset()         { *m = ClusterUsageSummary{} }
+func (m *ClusterUsageSummary) String() string { return proto.CompactTextString(m) }
+func (*ClusterUsageSummary) ProtoMessage()    {}
+func (*ClusterUsageSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36c97486aaafd691, []int{24}
+}
+func (m *ClusterUsageSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterUsageSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterUsageSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterUsageSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterUsageSummary.Merge(m, src)
+}
+func (m *ClusterUsageSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterUsageSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterUsageSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterUsageSummary proto.InternalMessageInfo
+
+func (m *ClusterUsageSummary) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+func (m *ClusterUsageSummary) GetStart() *time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *ClusterUsageSummary) GetReports() int64 {
+	if m != nil {
+		return m.Reports
+	}
+	return 0
+}
+
+func (m *ClusterUsageSummary) GetLastReport() *time.Time {
+	if m != nil {
+		return m.LastReport
+	}
+	return nil
+}
+
+func (m *ClusterUsageSummary) GetRepos() int64 {
+	if m != nil {
+		return m.Repos
+	}
+	return 0
+}
+
+func (m *ClusterUsageSummary) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *ClusterUsageSummary) GetPipelines() int64 {
+	if m != nil {
+		return m.Pipelines
+	}
+	return 0
+}
+
+func (m *ClusterUsageSummary) GetMaxWorkers() int64 {
+	if m != nil {
+		return m.MaxWorkers
+	}
+	return 0
+}
+
+func (m *ClusterUsageSummary) GetAvgWorkers() float64 {
+	if m != nil {
+		return m.AvgWorkers
+	}
+	return 0
+}
+
+func (m *ClusterUsageSummary) GetJobsFinished() int64 {
+	if m != nil {
+		return m.JobsFinished
+	}
+	return 0
+}
+
+type ListClusterUsageResponse struct {
+	Usage                []*ClusterUsageSummary `protobuf:"bytes,1,rep,name=usage,proto3" json:"usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ListClusterUsageResponse) Reset()         { *m = ListClusterUsageResponse{} }
+func (m *ListClusterUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*ListClusterUsageResponse) ProtoMessage()    {}
+func (*ListClusterUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36c97486aaafd691, []int{25}
+}
+func (m *ListClusterUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListClusterUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListClusterUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListClusterUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListClusterUsageResponse.Merge(m, src)
+}
+func (m *ListClusterUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListClusterUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListClusterUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListClusterUsageResponse proto.InternalMessageInfo
+
+func (m *ListClusterUsageResponse) GetUsage() []*ClusterUsageSummary {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ActivateRequest)(nil), "license_v2.ActivateRequest")
 	proto.RegisterType((*ActivateResponse)(nil), "license_v2.ActivateResponse")
@@ -1219,83 +1564,112 @@ func init() {
 	proto.RegisterType((*ListClustersResponse)(nil), "license_v2.ListClustersResponse")
 	proto.RegisterType((*DeleteAllRequest)(nil), "license_v2.DeleteAllRequest")
 	proto.RegisterType((*DeleteAllResponse)(nil), "license_v2.DeleteAllResponse")
+	proto.RegisterType((*ClusterUsage)(nil), "license_v2.ClusterUsage")
 	proto.RegisterType((*HeartbeatRequest)(nil), "license_v2.HeartbeatRequest")
 	proto.RegisterType((*HeartbeatResponse)(nil), "license_v2.HeartbeatResponse")
 	proto.RegisterType((*UserClusterInfo)(nil), "license_v2.UserClusterInfo")
 	proto.RegisterType((*ListUserClustersRequest)(nil), "license_v2.ListUserClustersRequest")
 	proto.RegisterType((*ListUserClustersResponse)(nil), "license_v2.ListUserClustersResponse")
+	proto.RegisterType((*ListClusterUsageRequest)(nil), "license_v2.ListClusterUsageRequest")
+	proto.RegisterType((*ClusterUsageSummary)(nil), "license_v2.ClusterUsageSummary")
+	proto.RegisterType((*ListClusterUsageResponse)(nil), "license_v2.ListClusterUsageResponse")
 }
 
 func init() { proto.RegisterFile("license/license.proto", fileDescriptor_36c97486aaafd691) }
 
 var fileDescriptor_36c97486aaafd691 = []byte{
-	// 1050 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x72, 0xe3, 0x44,
-	0x10, 0x5e, 0x39, 0x9b, 0x38, 0x69, 0xe7, 0xcf, 0x13, 0x9b, 0x28, 0xf2, 0xae, 0xe5, 0x0c, 0x0b,
-	0xa4, 0x60, 0xb1, 0xab, 0xcc, 0x6f, 0xc1, 0x05, 0x7b, 0x43, 0x2d, 0x59, 0x76, 0xab, 0x28, 0x65,
-	0x97, 0x03, 0x07, 0x54, 0xb2, 0xd4, 0x71, 0x54, 0x28, 0x92, 0xd1, 0x8c, 0x5d, 0xec, 0x5b, 0x70,
-	0xa0, 0x8a, 0x1b, 0xcf, 0xc3, 0x91, 0x2b, 0x17, 0x43, 0xe5, 0x11, 0xfc, 0x02, 0x4b, 0x49, 0x1a,
-	0xfd, 0x5a, 0xf6, 0xb2, 0x27, 0x6b, 0xba, 0x7b, 0x7a, 0xbe, 0xf9, 0xfa, 0xeb, 0x1e, 0x43, 0xd3,
-	0xb1, 0x4d, 0x74, 0x19, 0xf6, 0xc4, 0x6f, 0x77, 0xe2, 0x7b, 0xdc, 0x23, 0x20, 0x96, 0xfa, 0xac,
-	0xaf, 0xa8, 0x63, 0xcf, 0x1b, 0x3b, 0xd8, 0x0b, 0x3d, 0xa3, 0xe9, 0x55, 0x8f, 0xdb, 0x37, 0xc8,
-	0xb8, 0x71, 0x33, 0x89, 0x82, 0x95, 0xc6, 0xd8, 0x1b, 0x7b, 0xe1, 0x67, 0x2f, 0xf8, 0x12, 0xd6,
-	0x16, 0xba, 0x1c, 0xfd, 0x89, 0x6f, 0x33, 0xec, 0xa5, 0x9f, 0x91, 0x93, 0x4e, 0xe0, 0x60, 0x60,
-	0x72, 0x7b, 0x66, 0x70, 0xd4, 0xf0, 0xe7, 0x29, 0x32, 0x4e, 0xde, 0x83, 0x03, 0x23, 0x32, 0xd9,
-	0x9e, 0xab, 0x9b, 0x9e, 0x85, 0xb2, 0xd4, 0x91, 0xce, 0x76, 0xb4, 0xfd, 0xd4, 0xfc, 0xc8, 0xb3,
-	0x90, 0x7c, 0x0c, 0x55, 0xfc, 0x65, 0x62, 0xfb, 0xc8, 0xe4, 0x4a, 0x47, 0x3a, 0xab, 0xf5, 0x95,
-	0x6e, 0x84, 0xb0, 0x1b, 0x23, 0xec, 0x3e, 0x8f, 0x11, 0x6a, 0x71, 0x28, 0xfd, 0x0a, 0x0e, 0xd3,
-	0x13, 0xd9, 0xc4, 0x73, 0x19, 0x92, 0x87, 0x70, 0xd7, 0x76, 0xaf, 0xbc, 0xf0, 0x9c, 0x5a, 0x5f,
-	0xee, 0xa6, 0x30, 0xf5, 0x59, 0xbf, 0xfb, 0xdc, 0xfb, 0x09, 0xdd, 0x0b, 0xf7, 0xca, 0xd3, 0xc2,
-	0x28, 0xaa, 0x80, 0xfc, 0x18, 0xf9, 0x20, 0x07, 0x46, 0x80, 0xa7, 0x7f, 0x48, 0x70, 0x52, 0xe2,
-	0x14, 0xe7, 0xbc, 0x0f, 0x9b, 0x8c, 0x1b, 0x3c, 0xba, 0xd0, 0x7e, 0xbf, 0x51, 0x38, 0xe8, 0x32,
-	0xf0, 0x69, 0x51, 0x48, 0x82, 0xa9, 0xf2, 0x7f, 0x30, 0x95, 0x91, 0xb6, 0x51, 0x46, 0x1a, 0x3d,
-	0x82, 0xfa, 0x39, 0x1a, 0x79, 0xca, 0x69, 0x03, 0x48, 0xd6, 0x18, 0xa1, 0xa5, 0x7f, 0x4b, 0x50,
-	0x1f, 0x58, 0xd6, 0x23, 0x67, 0xca, 0x38, 0xfa, 0x71, 0x79, 0xf6, 0xa1, 0x62, 0x5b, 0xa2, 0x22,
-	0x15, 0xdb, 0x22, 0x32, 0x54, 0x0d, 0xcb, 0xf2, 0x91, 0x45, 0x55, 0xd8, 0xd1, 0xe2, 0x25, 0x79,
-	0x0b, 0xb6, 0x18, 0x9a, 0x3e, 0x72, 0x01, 0x45, 0xac, 0xc8, 0x29, 0xec, 0x4e, 0x19, 0xfa, 0x7a,
-	0xbc, 0xed, 0x6e, 0xe8, 0xad, 0x05, 0xb6, 0x81, 0xd8, 0xda, 0x87, 0xa6, 0x19, 0x1d, 0xab, 0x5b,
-	0x38, 0x71, 0xbc, 0x97, 0x37, 0xe8, 0x72, 0xdd, 0xb6, 0xe4, 0xcd, 0x30, 0xf6, 0x48, 0x38, 0xcf,
-	0x13, 0xdf, 0x85, 0x45, 0x3e, 0x80, 0x7a, 0x86, 0x23, 0x86, 0xfe, 0x0c, 0x7d, 0x79, 0xab, 0x23,
-	0x9d, 0x6d, 0x6b, 0x87, 0xa9, 0xe3, 0x32, 0xb4, 0xd3, 0x87, 0x40, 0xb2, 0x57, 0x13, 0xf5, 0x49,
-	0x11, 0x4b, 0x59, 0xc4, 0xf4, 0x5d, 0x68, 0x9c, 0xa3, 0x83, 0x1c, 0xd7, 0x73, 0x41, 0x8f, 0xa1,
-	0x59, 0x88, 0x13, 0x54, 0x2e, 0x2a, 0xb0, 0x27, 0x6c, 0x41, 0x91, 0xa7, 0xec, 0x0d, 0x68, 0x94,
-	0xa1, 0x3a, 0x43, 0x9f, 0xd9, 0x9e, 0x2b, 0x78, 0x8c, 0x97, 0xe4, 0x73, 0xd8, 0x35, 0xa6, 0xfc,
-	0x5a, 0x47, 0xd7, 0x18, 0x39, 0x68, 0x85, 0x44, 0x6e, 0x0f, 0x9b, 0x8b, 0xb9, 0x5a, 0xb7, 0x46,
-	0x5f, 0xd0, 0xac, 0x8f, 0x6a, 0xb5, 0x60, 0xf9, 0x75, 0xb4, 0x22, 0x3d, 0xd8, 0x31, 0x1d, 0x5b,
-	0x70, 0x5a, 0x0d, 0xb2, 0x0e, 0xc9, 0x62, 0xae, 0xee, 0x07, 0xdb, 0x12, 0x07, 0xd5, 0xb6, 0xa3,
-	0xef, 0x0b, 0x8b, 0xfc, 0x08, 0xfb, 0x8e, 0xc1, 0xb8, 0x7e, 0x8d, 0x86, 0xcf, 0x47, 0x68, 0xf0,
-	0xb0, 0x12, 0x6b, 0x5b, 0x6e, 0xd8, 0x5a, 0xcc, 0xd5, 0xa3, 0x20, 0x63, 0x7e, 0x27, 0xfd, 0xf5,
-	0x1f, 0x55, 0xd2, 0xf6, 0x02, 0xe3, 0x37, 0xb1, 0x8d, 0x68, 0x00, 0xa6, 0x8f, 0x06, 0x47, 0x4b,
-	0x37, 0x78, 0x58, 0xb5, 0xf5, 0xb9, 0x8f, 0x17, 0x73, 0xf5, 0x20, 0x44, 0x9b, 0xec, 0x8a, 0xf2,
-	0xee, 0x08, 0xc3, 0x80, 0xd3, 0xdf, 0x25, 0x68, 0xbc, 0x98, 0x58, 0xc6, 0xeb, 0xca, 0xb6, 0x86,
-	0xfb, 0xa2, 0x54, 0x37, 0xde, 0x40, 0xaa, 0x77, 0x57, 0x4a, 0x35, 0xd0, 0x49, 0x01, 0x98, 0xd0,
-	0x49, 0x13, 0x8e, 0x9e, 0xda, 0x8c, 0x0b, 0x33, 0x8b, 0xfb, 0xf3, 0x19, 0x34, 0xf2, 0x66, 0xa1,
-	0xd7, 0x4f, 0x60, 0x5b, 0xa4, 0x67, 0xb2, 0xd4, 0xd9, 0x38, 0xab, 0xf5, 0x4f, 0xba, 0xe9, 0xc0,
-	0xee, 0xe6, 0x14, 0xa7, 0x25, 0xa1, 0x94, 0xc0, 0x61, 0x24, 0xd3, 0x81, 0xe3, 0xc4, 0x47, 0x84,
-	0x73, 0x21, 0xb1, 0x09, 0x38, 0xbf, 0x49, 0x70, 0x98, 0xd4, 0x68, 0x15, 0x7b, 0x69, 0xd3, 0x54,
-	0x72, 0x6d, 0xbe, 0x5a, 0xb7, 0xa7, 0x65, 0xba, 0xcd, 0x0b, 0xb4, 0x95, 0x15, 0x68, 0xd4, 0xf4,
-	0x89, 0x18, 0xe9, 0xb7, 0x50, 0xcf, 0xa0, 0x12, 0x5c, 0x7c, 0x0a, 0x55, 0x71, 0x75, 0x31, 0xc6,
-	0xef, 0x15, 0x46, 0xe6, 0xd3, 0xc8, 0xab, 0xa1, 0xe9, 0xf9, 0x96, 0x16, 0x07, 0xd3, 0x57, 0x12,
-	0x1c, 0xbc, 0x60, 0xe8, 0x0b, 0xb2, 0x82, 0x99, 0x4a, 0x5a, 0xe9, 0x15, 0x87, 0xb5, 0xc5, 0x5c,
-	0xad, 0x06, 0x4a, 0x0b, 0x1a, 0x22, 0xb8, 0xaf, 0xb6, 0xaa, 0xe0, 0xe1, 0xf5, 0x87, 0xed, 0xc5,
-	0x5c, 0x55, 0xa2, 0x3e, 0x2a, 0x09, 0xa2, 0xe5, 0xb3, 0xab, 0x97, 0x2a, 0x30, 0xe4, 0x2a, 0x6d,
-	0xe2, 0xac, 0xfc, 0x68, 0x2a, 0xcc, 0x27, 0x65, 0xc3, 0x2e, 0xea, 0xff, 0xfb, 0x8b, 0xb9, 0x7a,
-	0x12, 0x02, 0x66, 0xfa, 0x52, 0x0c, 0x2d, 0x99, 0x85, 0x27, 0x70, 0x1c, 0xa8, 0x2b, 0x43, 0x42,
-	0x22, 0xbc, 0x4b, 0x90, 0x97, 0x5d, 0x82, 0xf0, 0xcf, 0x96, 0xc4, 0xd7, 0xca, 0x8a, 0xaf, 0xc0,
-	0x69, 0x2a, 0xbf, 0xfe, 0xab, 0x4d, 0xd8, 0x18, 0x7c, 0x77, 0x41, 0x1e, 0xc3, 0x76, 0xfc, 0x12,
-	0x93, 0xdc, 0xd6, 0xc2, 0x3f, 0x02, 0xe5, 0x5e, 0xb9, 0x53, 0x88, 0xf4, 0x0e, 0x19, 0x41, 0x7d,
-	0xe9, 0xcd, 0x25, 0x0f, 0xb2, 0x9b, 0x56, 0xbd, 0xd7, 0xca, 0x3b, 0xaf, 0x89, 0x4a, 0xce, 0x78,
-	0x02, 0x3b, 0x49, 0x7f, 0x90, 0x1c, 0xa0, 0x62, 0x2b, 0x29, 0xf7, 0x57, 0x78, 0x93, 0x5c, 0xcf,
-	0x00, 0xd2, 0xc7, 0x87, 0xe4, 0xc2, 0x97, 0xde, 0x5b, 0xa5, 0xbd, 0xca, 0x9d, 0xa4, 0xfb, 0x1e,
-	0xf6, 0x72, 0xaf, 0x0e, 0xe9, 0x2c, 0x03, 0x28, 0x24, 0x3d, 0x5d, 0x13, 0x91, 0xe4, 0xbd, 0x84,
-	0xdd, 0xec, 0xd4, 0x21, 0x6a, 0x76, 0x53, 0xc9, 0x98, 0x52, 0x3a, 0xab, 0x03, 0xb2, 0x60, 0x73,
-	0xa3, 0x2f, 0x0f, 0xb6, 0x6c, 0x5c, 0xe7, 0xc1, 0x96, 0xcf, 0xcd, 0xb0, 0x3e, 0xe9, 0x6b, 0x92,
-	0xab, 0x4f, 0x71, 0x80, 0xe5, 0xeb, 0xb3, 0x34, 0x48, 0xe8, 0x1d, 0xa2, 0xc3, 0x61, 0x51, 0xf5,
-	0xe4, 0xed, 0xe2, 0xdd, 0x4a, 0xda, 0x45, 0x79, 0xb0, 0x3e, 0x28, 0x3e, 0x60, 0xf8, 0xe5, 0x9f,
-	0xb7, 0x6d, 0xe9, 0xaf, 0xdb, 0xb6, 0xf4, 0xef, 0x6d, 0x5b, 0xfa, 0xe1, 0xc3, 0xb1, 0xcd, 0xaf,
-	0xa7, 0xa3, 0xae, 0xe9, 0xdd, 0xf4, 0x26, 0x86, 0x79, 0xfd, 0xd2, 0x42, 0x3f, 0xfb, 0x35, 0xeb,
-	0xf7, 0x98, 0x6f, 0xc6, 0x7f, 0xcc, 0x47, 0x5b, 0xe1, 0x73, 0xf8, 0xd1, 0x7f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x65, 0xeb, 0xe8, 0x61, 0xb2, 0x0b, 0x00, 0x00,
+	// 1451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xcb, 0x72, 0x1b, 0x45,
+	0x17, 0xfe, 0xc7, 0xb2, 0x2d, 0xeb, 0xc8, 0x17, 0xb9, 0x2d, 0x27, 0xe3, 0x71, 0x6c, 0xc9, 0x1d,
+	0xff, 0xff, 0xef, 0x0a, 0x41, 0x4a, 0x09, 0x02, 0x14, 0xd9, 0x20, 0xc5, 0x10, 0x1c, 0x92, 0x2a,
+	0x18, 0xc7, 0x50, 0xc5, 0x82, 0xa9, 0x91, 0xa6, 0x2d, 0x0f, 0x91, 0x66, 0xc4, 0x74, 0x4b, 0xc4,
+	0x3c, 0x05, 0x3b, 0x76, 0x14, 0x0f, 0xc3, 0x02, 0x56, 0xb0, 0x65, 0x23, 0x52, 0x79, 0x04, 0xbd,
+	0x00, 0x54, 0x5f, 0xe6, 0x2a, 0xc9, 0x4e, 0x56, 0x9e, 0x3e, 0x97, 0xee, 0xd3, 0x5f, 0x9f, 0xf3,
+	0x9d, 0x63, 0xc1, 0x76, 0xcf, 0xed, 0x10, 0x8f, 0x92, 0xba, 0xfa, 0x5b, 0x1b, 0x04, 0x3e, 0xf3,
+	0x11, 0xa8, 0xa5, 0x35, 0x6a, 0x18, 0xfb, 0x5d, 0xdf, 0xef, 0xf6, 0x48, 0x5d, 0x68, 0xda, 0xc3,
+	0xf3, 0xba, 0x33, 0x0c, 0x6c, 0xe6, 0xfa, 0x9e, 0xb4, 0x35, 0x2a, 0x59, 0x3d, 0x73, 0xfb, 0x84,
+	0x32, 0xbb, 0x3f, 0x50, 0x06, 0xe5, 0xae, 0xdf, 0xf5, 0xc5, 0x67, 0x9d, 0x7f, 0x29, 0xe9, 0x2e,
+	0xf1, 0x18, 0x09, 0x06, 0x81, 0x4b, 0x49, 0x3d, 0xfe, 0x94, 0x4a, 0x3c, 0x80, 0x8d, 0x66, 0x87,
+	0xb9, 0x23, 0x9b, 0x11, 0x93, 0x7c, 0x37, 0x24, 0x94, 0xa1, 0xff, 0xc3, 0x86, 0x2d, 0x45, 0xae,
+	0xef, 0x59, 0x1d, 0xdf, 0x21, 0xba, 0x56, 0xd5, 0x8e, 0x0a, 0xe6, 0x7a, 0x2c, 0x7e, 0xe8, 0x3b,
+	0x04, 0xbd, 0x0b, 0x79, 0xf2, 0x62, 0xe0, 0x06, 0x84, 0xea, 0x0b, 0x55, 0xed, 0xa8, 0xd8, 0x30,
+	0x6a, 0x32, 0xc2, 0x5a, 0x18, 0x61, 0xed, 0x59, 0x18, 0xa1, 0x19, 0x9a, 0xe2, 0x8f, 0xa0, 0x14,
+	0x9f, 0x48, 0x07, 0xbe, 0x47, 0x09, 0xba, 0x0b, 0x8b, 0xae, 0x77, 0xee, 0x8b, 0x73, 0x8a, 0x0d,
+	0xbd, 0x16, 0x87, 0x69, 0x8d, 0x1a, 0xb5, 0x67, 0xfe, 0x73, 0xe2, 0x9d, 0x78, 0xe7, 0xbe, 0x29,
+	0xac, 0xb0, 0x01, 0xfa, 0x23, 0xc2, 0x9a, 0xa9, 0x60, 0x54, 0xf0, 0xf8, 0x67, 0x0d, 0x76, 0x66,
+	0x28, 0xd5, 0x39, 0x77, 0x60, 0x89, 0x32, 0x9b, 0xc9, 0x0b, 0xad, 0x37, 0xca, 0x99, 0x83, 0x4e,
+	0xb9, 0xce, 0x94, 0x26, 0x51, 0x4c, 0x0b, 0xaf, 0x13, 0xd3, 0x2c, 0xd0, 0x72, 0xb3, 0x40, 0xc3,
+	0x5b, 0xb0, 0x79, 0x4c, 0xec, 0x34, 0xe4, 0xb8, 0x0c, 0x28, 0x29, 0x94, 0xd1, 0xe2, 0xbf, 0x34,
+	0xd8, 0x6c, 0x3a, 0xce, 0xc3, 0xde, 0x90, 0x32, 0x12, 0x84, 0xcf, 0xb3, 0x0e, 0x0b, 0xae, 0xa3,
+	0x5e, 0x64, 0xc1, 0x75, 0x90, 0x0e, 0x79, 0xdb, 0x71, 0x02, 0x42, 0xe5, 0x2b, 0x14, 0xcc, 0x70,
+	0x89, 0x6e, 0xc0, 0x32, 0x25, 0x9d, 0x80, 0x30, 0x15, 0x8a, 0x5a, 0xa1, 0x03, 0x58, 0x1d, 0x52,
+	0x12, 0x58, 0xa1, 0xdb, 0xa2, 0xd0, 0x16, 0xb9, 0xac, 0xa9, 0x5c, 0x1b, 0xb0, 0xdd, 0x91, 0xc7,
+	0x5a, 0x0e, 0x19, 0xf4, 0xfc, 0xcb, 0x3e, 0xf1, 0x98, 0xe5, 0x3a, 0xfa, 0x92, 0xb0, 0xdd, 0x52,
+	0xca, 0xe3, 0x48, 0x77, 0xe2, 0xa0, 0xb7, 0x60, 0x33, 0x81, 0x11, 0x25, 0xc1, 0x88, 0x04, 0xfa,
+	0x72, 0x55, 0x3b, 0x5a, 0x31, 0x4b, 0xb1, 0xe2, 0x54, 0xc8, 0xf1, 0x5d, 0x40, 0xc9, 0xab, 0xa9,
+	0xf7, 0x89, 0x23, 0xd6, 0x92, 0x11, 0xe3, 0xff, 0x41, 0xf9, 0x98, 0xf4, 0x08, 0x23, 0x57, 0x63,
+	0x81, 0x6f, 0xc2, 0x76, 0xc6, 0x4e, 0x41, 0x39, 0x59, 0x80, 0x35, 0x25, 0xe3, 0x8f, 0x3c, 0xa4,
+	0x6f, 0x00, 0xa3, 0x0e, 0xf9, 0x11, 0x09, 0xa8, 0xeb, 0x7b, 0x0a, 0xc7, 0x70, 0x89, 0x3e, 0x80,
+	0x55, 0x7b, 0xc8, 0x2e, 0x2c, 0xe2, 0xd9, 0xed, 0x1e, 0x71, 0x04, 0x90, 0x2b, 0xad, 0xed, 0xc9,
+	0xb8, 0xb2, 0xe9, 0xb4, 0x3f, 0xc4, 0x49, 0x1d, 0x36, 0x8b, 0x7c, 0xf9, 0xb1, 0x5c, 0xa1, 0x3a,
+	0x14, 0x3a, 0x3d, 0x57, 0x61, 0x9a, 0xe7, 0xbb, 0xb6, 0xd0, 0x64, 0x5c, 0x59, 0xe7, 0x6e, 0x91,
+	0x02, 0x9b, 0x2b, 0xf2, 0xfb, 0xc4, 0x41, 0xdf, 0xc0, 0x7a, 0xcf, 0xa6, 0xcc, 0xba, 0x20, 0x76,
+	0xc0, 0xda, 0xc4, 0x66, 0xfa, 0xd2, 0x75, 0x25, 0xd7, 0xda, 0x9d, 0x8c, 0x2b, 0x5b, 0x7c, 0xc7,
+	0xb4, 0x27, 0xfe, 0xf1, 0xef, 0x8a, 0x66, 0xae, 0x71, 0xe1, 0xa7, 0xa1, 0x0c, 0x99, 0x00, 0x9d,
+	0x80, 0xd8, 0x8c, 0x38, 0x96, 0xcd, 0xf4, 0xe5, 0x6b, 0xf7, 0xbe, 0x39, 0x19, 0x57, 0x36, 0x44,
+	0xb4, 0x91, 0x97, 0xdc, 0xb7, 0xa0, 0x04, 0x4d, 0x86, 0x7f, 0xd2, 0xa0, 0x7c, 0x36, 0x70, 0xec,
+	0xeb, 0x9e, 0xed, 0x0a, 0xec, 0xb3, 0xa9, 0x9a, 0x7b, 0x83, 0x54, 0x5d, 0x9c, 0x9b, 0xaa, 0x3c,
+	0x4f, 0x32, 0x81, 0xa9, 0x3c, 0xd9, 0x86, 0xad, 0x27, 0x2e, 0x65, 0x4a, 0x4c, 0xc3, 0xfa, 0x7c,
+	0x0a, 0xe5, 0xb4, 0x58, 0xe5, 0xeb, 0x7d, 0x58, 0x51, 0xdb, 0x53, 0x5d, 0xab, 0xe6, 0x8e, 0x8a,
+	0x8d, 0x9d, 0x5a, 0x4c, 0xe8, 0xb5, 0x54, 0xc6, 0x99, 0x91, 0x29, 0x46, 0x50, 0x92, 0x69, 0xda,
+	0xec, 0xf5, 0xc2, 0x23, 0x04, 0x2f, 0x44, 0x32, 0x15, 0xce, 0x2f, 0x1a, 0xac, 0xaa, 0x4d, 0xce,
+	0xa8, 0xdd, 0x25, 0xa8, 0x0c, 0x4b, 0x01, 0x19, 0xf8, 0x54, 0x80, 0x97, 0x33, 0xe5, 0x02, 0xed,
+	0x01, 0x50, 0xf7, 0x07, 0x62, 0xb5, 0x2f, 0x99, 0xe2, 0xe2, 0x9c, 0x59, 0xe0, 0x92, 0x16, 0x17,
+	0xa0, 0x5b, 0x50, 0x18, 0xb8, 0x03, 0xd2, 0x73, 0x3d, 0x22, 0x11, 0xcc, 0x99, 0xb1, 0x80, 0x83,
+	0xff, 0xbd, 0x1f, 0x3c, 0xe7, 0x57, 0x58, 0x14, 0xba, 0x70, 0x89, 0x6e, 0xc3, 0xda, 0xb7, 0x7e,
+	0x9b, 0x5a, 0xe7, 0xae, 0xe7, 0xd2, 0x0b, 0x22, 0x8b, 0x3f, 0x67, 0xae, 0x72, 0xe1, 0x27, 0x4a,
+	0x86, 0x7f, 0xd5, 0xa0, 0x14, 0xa5, 0xd1, 0xbc, 0x07, 0x8e, 0xeb, 0x7a, 0x21, 0xc5, 0x44, 0xf3,
+	0x4b, 0xeb, 0x60, 0x56, 0x69, 0xa5, 0x6b, 0x68, 0x37, 0x59, 0x43, 0x92, 0x97, 0xe2, 0x7a, 0xa9,
+	0xc1, 0xd2, 0x90, 0x23, 0xa6, 0x52, 0x59, 0x9f, 0xf1, 0x2c, 0x02, 0x51, 0x53, 0x9a, 0xe1, 0xcf,
+	0x60, 0x33, 0x71, 0x0b, 0xf5, 0xbc, 0xef, 0x41, 0x5e, 0xb9, 0xa9, 0xce, 0x74, 0x2b, 0xd3, 0x05,
+	0x9e, 0x48, 0xad, 0x49, 0x3a, 0x7e, 0xe0, 0x98, 0xa1, 0x31, 0xfe, 0x47, 0x83, 0x8d, 0x33, 0x4a,
+	0x02, 0x75, 0x10, 0x6f, 0x13, 0x68, 0x37, 0x86, 0xa4, 0x55, 0x9c, 0x8c, 0x2b, 0x79, 0x5e, 0x3c,
+	0xbc, 0xc6, 0x39, 0x3e, 0xe6, 0xbc, 0x1c, 0x16, 0x70, 0xb5, 0xf6, 0x27, 0xe3, 0x8a, 0x21, 0xa9,
+	0x61, 0x86, 0x11, 0x9e, 0x4d, 0xc7, 0xf5, 0xb8, 0xa8, 0x04, 0xb6, 0x31, 0x2f, 0x25, 0x2b, 0x0a,
+	0xc7, 0xb5, 0xf6, 0x78, 0x16, 0x7f, 0x4b, 0x4a, 0xdb, 0x9b, 0x8c, 0x2b, 0x3b, 0x22, 0x60, 0x6a,
+	0x4d, 0xd9, 0xe0, 0x19, 0xf4, 0xbe, 0x03, 0x37, 0x79, 0xc1, 0x24, 0x40, 0x88, 0x6a, 0xe9, 0x14,
+	0xf4, 0x69, 0x95, 0x02, 0xfc, 0xfd, 0xa9, 0x7a, 0xda, 0x4d, 0x3e, 0x5c, 0x06, 0xd3, 0x44, 0x45,
+	0xfd, 0xa1, 0xc9, 0x03, 0x53, 0x4f, 0xab, 0x92, 0x71, 0x0f, 0x20, 0xc4, 0x2d, 0x4a, 0xca, 0x82,
+	0x92, 0x9c, 0x38, 0xe8, 0x9e, 0x98, 0x09, 0x02, 0xf6, 0x1a, 0x33, 0x8c, 0x34, 0x44, 0x77, 0x21,
+	0x47, 0x3c, 0x47, 0xcf, 0x5d, 0x6b, 0xcf, 0xcd, 0x38, 0x47, 0xb8, 0x1c, 0x9e, 0x91, 0xdd, 0x13,
+	0x68, 0x72, 0x8e, 0xc8, 0xba, 0x1c, 0xab, 0x41, 0xcf, 0x8c, 0x4c, 0xf1, 0xef, 0x8b, 0xb0, 0x95,
+	0xbc, 0xcd, 0xe9, 0xb0, 0xdf, 0xb7, 0x83, 0x4b, 0xd4, 0x98, 0xbe, 0x4d, 0x6b, 0x2b, 0x22, 0xe3,
+	0x48, 0x83, 0x93, 0x57, 0x3c, 0x7e, 0xed, 0x2b, 0x8a, 0x2e, 0x04, 0x7c, 0x2b, 0xe1, 0x20, 0x29,
+	0x5d, 0x5d, 0xfb, 0x0e, 0xe4, 0x39, 0xdd, 0x04, 0x4c, 0x91, 0x48, 0xab, 0x34, 0x19, 0x57, 0x56,
+	0xb9, 0xad, 0x12, 0x63, 0x33, 0x34, 0x40, 0x67, 0x50, 0x14, 0x4d, 0x47, 0xae, 0xf5, 0xc5, 0x6b,
+	0xcf, 0xd5, 0x27, 0xe3, 0x4a, 0x29, 0xea, 0x55, 0xd2, 0x4d, 0x9e, 0x0e, 0x5c, 0x62, 0x0a, 0x01,
+	0x3a, 0x0c, 0xe9, 0x4f, 0x30, 0x51, 0x6b, 0x3d, 0x0c, 0x56, 0x08, 0x71, 0x48, 0x87, 0x8d, 0x14,
+	0x1d, 0x2e, 0x0b, 0xd3, 0x08, 0xa2, 0x58, 0x83, 0x93, 0x1c, 0x79, 0x2f, 0xc9, 0x91, 0x79, 0xe1,
+	0x12, 0x35, 0xe4, 0x48, 0x81, 0x93, 0xbc, 0x79, 0x1f, 0x8a, 0x7d, 0xfb, 0x85, 0x15, 0x72, 0xe7,
+	0x8a, 0xf0, 0x29, 0x87, 0xd7, 0x48, 0xa8, 0xb0, 0x09, 0x7d, 0xfb, 0xc5, 0x57, 0x72, 0xc1, 0xdd,
+	0xec, 0x51, 0x37, 0x72, 0x2b, 0x54, 0xb5, 0x23, 0x2d, 0x76, 0x4b, 0xa8, 0xb0, 0x09, 0xf6, 0xa8,
+	0x1b, 0xba, 0x3d, 0xc8, 0x72, 0x31, 0x88, 0xf3, 0x6e, 0x4c, 0xc6, 0x15, 0xc4, 0x1d, 0x53, 0x4a,
+	0x9c, 0xe1, 0xe8, 0x2f, 0x64, 0xc9, 0xa5, 0x8b, 0x23, 0x6a, 0x61, 0x8a, 0x28, 0x65, 0xbd, 0x55,
+	0xe6, 0x11, 0xa5, 0xca, 0x3f, 0xc5, 0x97, 0x8d, 0x97, 0xcb, 0x90, 0x6b, 0x7e, 0x7e, 0x82, 0x1e,
+	0xc1, 0x4a, 0x38, 0xcd, 0xa3, 0x54, 0xad, 0x66, 0xfe, 0xab, 0x30, 0x6e, 0xcd, 0x56, 0xaa, 0x46,
+	0xf7, 0x1f, 0xd4, 0x86, 0xcd, 0xa9, 0xb9, 0x1d, 0x1d, 0x26, 0x9d, 0xe6, 0xcd, 0xfc, 0xc6, 0x7f,
+	0xaf, 0xb1, 0x8a, 0xce, 0x78, 0x0c, 0x85, 0xa8, 0xc7, 0xa2, 0x54, 0x40, 0xd9, 0x76, 0x6c, 0xec,
+	0xcd, 0xd1, 0x46, 0x7b, 0x3d, 0x05, 0x88, 0x07, 0x58, 0x94, 0x32, 0x9f, 0x9a, 0xd9, 0x8d, 0xfd,
+	0x79, 0xea, 0x68, 0xbb, 0x2f, 0x61, 0x2d, 0x35, 0xb9, 0xa2, 0xea, 0x74, 0x00, 0x99, 0x4d, 0x0f,
+	0xae, 0xb0, 0x88, 0xf6, 0x3d, 0x85, 0xd5, 0xe4, 0xe4, 0x82, 0x52, 0xef, 0x3b, 0x63, 0xd4, 0x31,
+	0xaa, 0xf3, 0x0d, 0x92, 0xc1, 0xa6, 0xc6, 0xa7, 0x74, 0xb0, 0xb3, 0x46, 0x3e, 0xe3, 0xe0, 0x0a,
+	0x8b, 0xe4, 0xfb, 0xc4, 0x13, 0x69, 0xea, 0x7d, 0xb2, 0x13, 0x86, 0xb1, 0x37, 0x47, 0x1b, 0xed,
+	0x65, 0x41, 0x29, 0xdb, 0x66, 0xd0, 0xed, 0xec, 0xdd, 0x66, 0xf4, 0x27, 0xe3, 0xf0, 0x6a, 0xa3,
+	0xec, 0x01, 0xa9, 0xf1, 0xec, 0xf6, 0x1c, 0xf0, 0x92, 0xfd, 0xc8, 0x38, 0xbc, 0xda, 0x28, 0x3c,
+	0xa0, 0xf5, 0xe0, 0xb7, 0x57, 0xfb, 0xda, 0x9f, 0xaf, 0xf6, 0xb5, 0x97, 0xaf, 0xf6, 0xb5, 0xaf,
+	0xdf, 0xee, 0xba, 0xec, 0x62, 0xd8, 0xae, 0x75, 0xfc, 0x7e, 0x7d, 0x60, 0x77, 0x2e, 0x2e, 0x1d,
+	0x12, 0x24, 0xbf, 0x46, 0x8d, 0x3a, 0x0d, 0x3a, 0xe1, 0xaf, 0x0b, 0xed, 0x65, 0xc1, 0xb1, 0xef,
+	0xfc, 0x3b, 0x00, 0xbe, 0x0d, 0x20, 0xed, 0x77, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1326,6 +1700,9 @@ type APIClient interface {
 	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
 	// Lists all clusters available to user
 	ListUserClusters(ctx context.Context, in *ListUserClustersRequest, opts ...grpc.CallOption) (*ListUserClustersResponse, error)
+	// ListClusterUsage aggregates the usage that registered clusters have
+	// reported in their heartbeats
+	ListClusterUsage(ctx context.Context, in *ListClusterUsageRequest, opts ...grpc.CallOption) (*ListClusterUsageResponse, error)
 }
 
 type aPIClient struct {
@@ -1417,6 +1794,15 @@ func (c *aPIClient) ListUserClusters(ctx context.Context, in *ListUserClustersRe
 	return out, nil
 }
 
+func (c *aPIClient) ListClusterUsage(ctx context.Context, in *ListClusterUsageRequest, opts ...grpc.CallOption) (*ListClusterUsageResponse, error) {
+	out := new(ListClusterUsageResponse)
+	err := c.cc.Invoke(ctx, "/license_v2.API/ListClusterUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // APIServer is the server API for API service.
 type APIServer interface {
 	// Activate enables the license service by setting the enterprise activation
@@ -1435,6 +1821,9 @@ type APIServer interface {
 	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
 	// Lists all clusters available to user
 	ListUserClusters(context.Context, *ListUserClustersRequest) (*ListUserClustersResponse, error)
+	// ListClusterUsage aggregates the usage that registered clusters have
+	// reported in their heartbeats
+	ListClusterUsage(context.Context, *ListClusterUsageRequest) (*ListClusterUsageResponse, error)
 }
 
 // UnimplementedAPIServer can be embedded to have forward compatible implementations.
@@ -1468,6 +1857,9 @@ func (*UnimplementedAPIServer) Heartbeat(ctx context.Context, req *HeartbeatRequ
 func (*UnimplementedAPIServer) ListUserClusters(ctx context.Context, req *ListUserClustersRequest) (*ListUserClustersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUserClusters not implemented")
 }
+func (*UnimplementedAPIServer) ListClusterUsage(ctx context.Context, req *ListClusterUsageRequest) (*ListClusterUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClusterUsage not implemented")
+}
 
 func RegisterAPIServer(s *grpc.Server, srv APIServer) {
 	s.RegisterService(&_API_serviceDesc, srv)
@@ -1635,6 +2027,24 @@ func _API_ListUserClusters_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_ListClusterUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClusterUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListClusterUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/license_v2.API/ListClusterUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListClusterUsage(ctx, req.(*ListClusterUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _API_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "license_v2.API",
 	HandlerType: (*APIServer)(nil),
@@ -1675,6 +2085,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListUserClusters",
 			Handler:    _API_ListUserClusters_Handler,
 		},
+		{
+			MethodName: "ListClusterUsage",
+			Handler:    _API_ListClusterUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "license/license.proto",
@@ -2353,7 +2767,7 @@ func (m *DeleteAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *HeartbeatRequest) Marshal() (dAtA []byte, err error) {
+func (m *ClusterUsage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2363,12 +2777,12 @@ func (m *HeartbeatRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClusterUsage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClusterUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2377,48 +2791,35 @@ func (m *HeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintLicense(dAtA, i, uint64(len(m.ClientId)))
+	if m.JobsFinished != 0 {
+		i = encodeVarintLicense(dAtA, i, uint64(m.JobsFinished))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x28
 	}
-	if m.AuthEnabled {
-		i--
-		if m.AuthEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.Workers != 0 {
+		i = encodeVarintLicense(dAtA, i, uint64(m.Workers))
 		i--
 		dAtA[i] = 0x20
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintLicense(dAtA, i, uint64(len(m.Version)))
+	if m.Pipelines != 0 {
+		i = encodeVarintLicense(dAtA, i, uint64(m.Pipelines))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if len(m.Secret) > 0 {
-		i -= len(m.Secret)
-		copy(dAtA[i:], m.Secret)
-		i = encodeVarintLicense(dAtA, i, uint64(len(m.Secret)))
+	if m.SizeBytes != 0 {
+		i = encodeVarintLicense(dAtA, i, uint64(m.SizeBytes))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintLicense(dAtA, i, uint64(len(m.Id)))
+	if m.Repos != 0 {
+		i = encodeVarintLicense(dAtA, i, uint64(m.Repos))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *HeartbeatResponse) Marshal() (dAtA []byte, err error) {
+func (m *HeartbeatRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2428,12 +2829,12 @@ func (m *HeartbeatResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *HeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2442,9 +2843,9 @@ func (m *HeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.License != nil {
+	if m.Usage != nil {
 		{
-			size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2452,11 +2853,88 @@ func (m *HeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintLicense(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x32
 	}
-	return len(dAtA) - i, nil
-}
-
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintLicense(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AuthEnabled {
+		i--
+		if m.AuthEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintLicense(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintLicense(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintLicense(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeartbeatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.License != nil {
+		{
+			size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLicense(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *UserClusterInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2583,6 +3061,207 @@ func (m *ListUserClustersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *ListClusterUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListClusterUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListClusterUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Interval != nil {
+		{
+			size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLicense(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.End != nil {
+		{
+			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLicense(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Start != nil {
+		{
+			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLicense(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintLicense(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterUsageSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterUsageSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterUsageSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.JobsFinished != 0 {
+		i = encodeVarintLicense(dAtA, i, uint64(m.JobsFinished))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.AvgWorkers != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AvgWorkers))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if m.MaxWorkers != 0 {
+		i = encodeVarintLicense(dAtA, i, uint64(m.MaxWorkers))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Pipelines != 0 {
+		i = encodeVarintLicense(dAtA, i, uint64(m.Pipelines))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SizeBytes != 0 {
+		i = encodeVarintLicense(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Repos != 0 {
+		i = encodeVarintLicense(dAtA, i, uint64(m.Repos))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastReport != nil {
+		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastReport, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastReport):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintLicense(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Reports != 0 {
+		i = encodeVarintLicense(dAtA, i, uint64(m.Reports))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Start != nil {
+		n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Start):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintLicense(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintLicense(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListClusterUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListClusterUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListClusterUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Usage) > 0 {
+		for iNdEx := len(m.Usage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLicense(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLicense(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLicense(v)
 	base := offset
@@ -2901,6 +3580,33 @@ func (m *DeleteAllResponse) Size() (n int) {
 	return n
 }
 
+func (m *ClusterUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repos != 0 {
+		n += 1 + sovLicense(uint64(m.Repos))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovLicense(uint64(m.SizeBytes))
+	}
+	if m.Pipelines != 0 {
+		n += 1 + sovLicense(uint64(m.Pipelines))
+	}
+	if m.Workers != 0 {
+		n += 1 + sovLicense(uint64(m.Workers))
+	}
+	if m.JobsFinished != 0 {
+		n += 1 + sovLicense(uint64(m.JobsFinished))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *HeartbeatRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2926,6 +3632,10 @@ func (m *HeartbeatRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLicense(uint64(l))
 	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovLicense(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3005,22 +3715,113 @@ func (m *ListUserClustersResponse) Size() (n int) {
 	return n
 }
 
-func sovLicense(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLicense(x uint64) (n int) {
-	return sovLicense(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *ActivateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLicense
-			}
+func (m *ListClusterUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovLicense(uint64(l))
+	}
+	if m.Start != nil {
+		l = m.Start.Size()
+		n += 1 + l + sovLicense(uint64(l))
+	}
+	if m.End != nil {
+		l = m.End.Size()
+		n += 1 + l + sovLicense(uint64(l))
+	}
+	if m.Interval != nil {
+		l = m.Interval.Size()
+		n += 1 + l + sovLicense(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterUsageSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovLicense(uint64(l))
+	}
+	if m.Start != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Start)
+		n += 1 + l + sovLicense(uint64(l))
+	}
+	if m.Reports != 0 {
+		n += 1 + sovLicense(uint64(m.Reports))
+	}
+	if m.LastReport != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastReport)
+		n += 1 + l + sovLicense(uint64(l))
+	}
+	if m.Repos != 0 {
+		n += 1 + sovLicense(uint64(m.Repos))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovLicense(uint64(m.SizeBytes))
+	}
+	if m.Pipelines != 0 {
+		n += 1 + sovLicense(uint64(m.Pipelines))
+	}
+	if m.MaxWorkers != 0 {
+		n += 1 + sovLicense(uint64(m.MaxWorkers))
+	}
+	if m.AvgWorkers != 0 {
+		n += 9
+	}
+	if m.JobsFinished != 0 {
+		n += 1 + sovLicense(uint64(m.JobsFinished))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListClusterUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Usage) > 0 {
+		for _, e := range m.Usage {
+			l = e.Size()
+			n += 1 + l + sovLicense(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovLicense(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLicense(x uint64) (n int) {
+	return sovLicense(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ActivateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLicense
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -4695,6 +5496,152 @@ func (m *DeleteAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClusterUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLicense
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+			}
+			m.Repos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Repos |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelines", wireType)
+			}
+			m.Pipelines = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pipelines |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+			}
+			m.Workers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Workers |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsFinished", wireType)
+			}
+			m.JobsFinished = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsFinished |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLicense(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *HeartbeatRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4872,6 +5819,42 @@ func (m *HeartbeatRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLicense
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = &ClusterUsage{}
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLicense(dAtA[iNdEx:])
@@ -5284,6 +6267,562 @@ func (m *ListUserClustersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ListClusterUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLicense
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListClusterUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListClusterUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLicense
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLicense
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Start == nil {
+				m.Start = &types.Timestamp{}
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLicense
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.End == nil {
+				m.End = &types.Timestamp{}
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLicense
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Interval == nil {
+				m.Interval = &types.Duration{}
+			}
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLicense(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterUsageSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLicense
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterUsageSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterUsageSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLicense
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLicense
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Start == nil {
+				m.Start = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			m.Reports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reports |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReport", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLicense
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastReport == nil {
+				m.LastReport = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastReport, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+			}
+			m.Repos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Repos |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelines", wireType)
+			}
+			m.Pipelines = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pipelines |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWorkers", wireType)
+			}
+			m.MaxWorkers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWorkers |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgWorkers", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AvgWorkers = float64(math.Float64frombits(v))
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsFinished", wireType)
+			}
+			m.JobsFinished = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsFinished |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLicense(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListClusterUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLicense
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListClusterUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListClusterUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLicense
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usage = append(m.Usage, &ClusterUsageSummary{})
+			if err := m.Usage[len(m.Usage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLicense(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLicense(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
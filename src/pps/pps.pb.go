@@ -812,10 +812,27 @@ type PFSInput struct {
 	S3 bool `protobuf:"varint,11,opt,name=s3,proto3" json:"s3,omitempty"`
 	// Trigger defines when this input is processed by the pipeline, if it's nil
 	// the input is processed anytime something is committed to the input branch.
-	Trigger              *pfs.Trigger `protobuf:"bytes,12,opt,name=trigger,proto3" json:"trigger,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Trigger *pfs.Trigger `protobuf:"bytes,12,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	// The following filters, if set, narrow the files matched by glob to those
+	// that pass every filter. Each filter is evaluated against a whole glob
+	// match, so for a directory the size is the size of its contents, and it's
+	// "changed" if anything under it changed.
+	//
+	// ExcludeGlob removes matches that also match this glob pattern.
+	ExcludeGlob string `protobuf:"bytes,14,opt,name=exclude_glob,json=excludeGlob,proto3" json:"exclude_glob,omitempty"`
+	// MinSizeBytes removes matches smaller than this size.
+	MinSizeBytes int64 `protobuf:"varint,15,opt,name=min_size_bytes,json=minSizeBytes,proto3" json:"min_size_bytes,omitempty"`
+	// MaxSizeBytes, if nonzero, removes matches larger than this size.
+	MaxSizeBytes int64 `protobuf:"varint,16,opt,name=max_size_bytes,json=maxSizeBytes,proto3" json:"max_size_bytes,omitempty"`
+	// PathRegex removes matches whose path doesn't match this regular
+	// expression.
+	PathRegex string `protobuf:"bytes,17,opt,name=path_regex,json=pathRegex,proto3" json:"path_regex,omitempty"`
+	// ChangedSinceParent removes matches that are unchanged between the input
+	// commit and its parent.
+	ChangedSinceParent   bool     `protobuf:"varint,18,opt,name=changed_since_parent,json=changedSinceParent,proto3" json:"changed_since_parent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PFSInput) Reset()         { *m = PFSInput{} }
@@ -942,6 +959,41 @@ func (m *PFSInput) GetTrigger() *pfs.Trigger {
 	return nil
 }
 
+func (m *PFSInput) GetExcludeGlob() string {
+	if m != nil {
+		return m.ExcludeGlob
+	}
+	return ""
+}
+
+func (m *PFSInput) GetMinSizeBytes() int64 {
+	if m != nil {
+		return m.MinSizeBytes
+	}
+	return 0
+}
+
+func (m *PFSInput) GetMaxSizeBytes() int64 {
+	if m != nil {
+		return m.MaxSizeBytes
+	}
+	return 0
+}
+
+func (m *PFSInput) GetPathRegex() string {
+	if m != nil {
+		return m.PathRegex
+	}
+	return ""
+}
+
+func (m *PFSInput) GetChangedSinceParent() bool {
+	if m != nil {
+		return m.ChangedSinceParent
+	}
+	return false
+}
+
 type CronInput struct {
 	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Repo     string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
@@ -1536,14 +1588,29 @@ func (m *Aggregate) GetNinetyFifthPercentile() float64 {
 }
 
 type ProcessStats struct {
-	DownloadTime         *types.Duration `protobuf:"bytes,1,opt,name=download_time,json=downloadTime,proto3" json:"download_time,omitempty"`
-	ProcessTime          *types.Duration `protobuf:"bytes,2,opt,name=process_time,json=processTime,proto3" json:"process_time,omitempty"`
-	UploadTime           *types.Duration `protobuf:"bytes,3,opt,name=upload_time,json=uploadTime,proto3" json:"upload_time,omitempty"`
-	DownloadBytes        uint64          `protobuf:"varint,4,opt,name=download_bytes,json=downloadBytes,proto3" json:"download_bytes,omitempty"`
-	UploadBytes          uint64          `protobuf:"varint,5,opt,name=upload_bytes,json=uploadBytes,proto3" json:"upload_bytes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	DownloadTime  *types.Duration `protobuf:"bytes,1,opt,name=download_time,json=downloadTime,proto3" json:"download_time,omitempty"`
+	ProcessTime   *types.Duration `protobuf:"bytes,2,opt,name=process_time,json=processTime,proto3" json:"process_time,omitempty"`
+	UploadTime    *types.Duration `protobuf:"bytes,3,opt,name=upload_time,json=uploadTime,proto3" json:"upload_time,omitempty"`
+	DownloadBytes uint64          `protobuf:"varint,4,opt,name=download_bytes,json=downloadBytes,proto3" json:"download_bytes,omitempty"`
+	UploadBytes   uint64          `protobuf:"varint,5,opt,name=upload_bytes,json=uploadBytes,proto3" json:"upload_bytes,omitempty"`
+	// The following are sampled from the user code process (and its children)
+	// while it runs. When stats are merged (e.g. for a job), peak_memory_bytes
+	// and scratch_bytes take the largest value, and the rest are summed.
+	//
+	// PeakMemoryBytes is the largest resident set size of the user code.
+	PeakMemoryBytes uint64 `protobuf:"varint,6,opt,name=peak_memory_bytes,json=peakMemoryBytes,proto3" json:"peak_memory_bytes,omitempty"`
+	// CPUTime is the user and system CPU time used by the user code.
+	CPUTime *types.Duration `protobuf:"bytes,7,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
+	// DiskReadBytes and DiskWriteBytes are the bytes that the user code read
+	// from and wrote to block storage.
+	DiskReadBytes  uint64 `protobuf:"varint,8,opt,name=disk_read_bytes,json=diskReadBytes,proto3" json:"disk_read_bytes,omitempty"`
+	DiskWriteBytes uint64 `protobuf:"varint,9,opt,name=disk_write_bytes,json=diskWriteBytes,proto3" json:"disk_write_bytes,omitempty"`
+	// ScratchBytes is the disk space used by the datum's scratch space when the
+	// user code finished, including the downloaded input.
+	ScratchBytes         uint64   `protobuf:"varint,10,opt,name=scratch_bytes,json=scratchBytes,proto3" json:"scratch_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ProcessStats) Reset()         { *m = ProcessStats{} }
@@ -1614,12 +1681,52 @@ func (m *ProcessStats) GetUploadBytes() uint64 {
 	return 0
 }
 
+func (m *ProcessStats) GetPeakMemoryBytes() uint64 {
+	if m != nil {
+		return m.PeakMemoryBytes
+	}
+	return 0
+}
+
+func (m *ProcessStats) GetCPUTime() *types.Duration {
+	if m != nil {
+		return m.CPUTime
+	}
+	return nil
+}
+
+func (m *ProcessStats) GetDiskReadBytes() uint64 {
+	if m != nil {
+		return m.DiskReadBytes
+	}
+	return 0
+}
+
+func (m *ProcessStats) GetDiskWriteBytes() uint64 {
+	if m != nil {
+		return m.DiskWriteBytes
+	}
+	return 0
+}
+
+func (m *ProcessStats) GetScratchBytes() uint64 {
+	if m != nil {
+		return m.ScratchBytes
+	}
+	return 0
+}
+
 type AggregateProcessStats struct {
 	DownloadTime         *Aggregate `protobuf:"bytes,1,opt,name=download_time,json=downloadTime,proto3" json:"download_time,omitempty"`
 	ProcessTime          *Aggregate `protobuf:"bytes,2,opt,name=process_time,json=processTime,proto3" json:"process_time,omitempty"`
 	UploadTime           *Aggregate `protobuf:"bytes,3,opt,name=upload_time,json=uploadTime,proto3" json:"upload_time,omitempty"`
 	DownloadBytes        *Aggregate `protobuf:"bytes,4,opt,name=download_bytes,json=downloadBytes,proto3" json:"download_bytes,omitempty"`
 	UploadBytes          *Aggregate `protobuf:"bytes,5,opt,name=upload_bytes,json=uploadBytes,proto3" json:"upload_bytes,omitempty"`
+	PeakMemoryBytes      *Aggregate `protobuf:"bytes,6,opt,name=peak_memory_bytes,json=peakMemoryBytes,proto3" json:"peak_memory_bytes,omitempty"`
+	CPUTime              *Aggregate `protobuf:"bytes,7,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
+	DiskReadBytes        *Aggregate `protobuf:"bytes,8,opt,name=disk_read_bytes,json=diskReadBytes,proto3" json:"disk_read_bytes,omitempty"`
+	DiskWriteBytes       *Aggregate `protobuf:"bytes,9,opt,name=disk_write_bytes,json=diskWriteBytes,proto3" json:"disk_write_bytes,omitempty"`
+	ScratchBytes         *Aggregate `protobuf:"bytes,10,opt,name=scratch_bytes,json=scratchBytes,proto3" json:"scratch_bytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -1693,6 +1800,41 @@ func (m *AggregateProcessStats) GetUploadBytes() *Aggregate {
 	return nil
 }
 
+func (m *AggregateProcessStats) GetPeakMemoryBytes() *Aggregate {
+	if m != nil {
+		return m.PeakMemoryBytes
+	}
+	return nil
+}
+
+func (m *AggregateProcessStats) GetCPUTime() *Aggregate {
+	if m != nil {
+		return m.CPUTime
+	}
+	return nil
+}
+
+func (m *AggregateProcessStats) GetDiskReadBytes() *Aggregate {
+	if m != nil {
+		return m.DiskReadBytes
+	}
+	return nil
+}
+
+func (m *AggregateProcessStats) GetDiskWriteBytes() *Aggregate {
+	if m != nil {
+		return m.DiskWriteBytes
+	}
+	return nil
+}
+
+func (m *AggregateProcessStats) GetScratchBytes() *Aggregate {
+	if m != nil {
+		return m.ScratchBytes
+	}
+	return nil
+}
+
 type WorkerStatus struct {
 	WorkerID             string       `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
 	JobID                string       `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
@@ -4261,25 +4403,28 @@ type CreatePipelineRequest struct {
 	EnableStats           bool          `protobuf:"varint,15,opt,name=enable_stats,json=enableStats,proto3" json:"enable_stats,omitempty"`
 	// Reprocess forces the pipeline to reprocess all datums.
 	// It only has meaning if Update is true
-	Reprocess            bool            `protobuf:"varint,16,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
-	MaxQueueSize         int64           `protobuf:"varint,17,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
-	Service              *Service        `protobuf:"bytes,18,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                *Spout          `protobuf:"bytes,19,opt,name=spout,proto3" json:"spout,omitempty"`
-	ChunkSpec            *ChunkSpec      `protobuf:"bytes,20,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
-	DatumTimeout         *types.Duration `protobuf:"bytes,21,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout           *types.Duration `protobuf:"bytes,22,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	Salt                 string          `protobuf:"bytes,23,opt,name=salt,proto3" json:"salt,omitempty"`
-	Standby              bool            `protobuf:"varint,24,opt,name=standby,proto3" json:"standby,omitempty"`
-	DatumTries           int64           `protobuf:"varint,25,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec       *SchedulingSpec `protobuf:"bytes,26,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec              string          `protobuf:"bytes,27,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch             string          `protobuf:"bytes,28,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	SpecCommit           *pfs.Commit     `protobuf:"bytes,29,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
-	Metadata             *Metadata       `protobuf:"bytes,30,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ReprocessSpec        string          `protobuf:"bytes,31,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Reprocess      bool            `protobuf:"varint,16,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
+	MaxQueueSize   int64           `protobuf:"varint,17,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
+	Service        *Service        `protobuf:"bytes,18,opt,name=service,proto3" json:"service,omitempty"`
+	Spout          *Spout          `protobuf:"bytes,19,opt,name=spout,proto3" json:"spout,omitempty"`
+	ChunkSpec      *ChunkSpec      `protobuf:"bytes,20,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
+	DatumTimeout   *types.Duration `protobuf:"bytes,21,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout     *types.Duration `protobuf:"bytes,22,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	Salt           string          `protobuf:"bytes,23,opt,name=salt,proto3" json:"salt,omitempty"`
+	Standby        bool            `protobuf:"varint,24,opt,name=standby,proto3" json:"standby,omitempty"`
+	DatumTries     int64           `protobuf:"varint,25,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec *SchedulingSpec `protobuf:"bytes,26,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec        string          `protobuf:"bytes,27,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch       string          `protobuf:"bytes,28,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	SpecCommit     *pfs.Commit     `protobuf:"bytes,29,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
+	Metadata       *Metadata       `protobuf:"bytes,30,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ReprocessSpec  string          `protobuf:"bytes,31,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
+	// dry_run, if set, validates the pipeline and computes the datums that it
+	// would process, without creating or updating anything
+	DryRun               bool     `protobuf:"varint,32,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CreatePipelineRequest) Reset()         { *m = CreatePipelineRequest{} }
@@ -4532,6 +4677,138 @@ func (m *CreatePipelineRequest) GetReprocessSpec() string {
 	return ""
 }
 
+func (m *CreatePipelineRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+// PipelineDryRun describes the datums that creating or updating a pipeline
+// would process, relative to the pipeline's current output.
+type PipelineDryRun struct {
+	// new_datums is the number of datums that would be processed
+	NewDatums int64 `protobuf:"varint,1,opt,name=new_datums,json=newDatums,proto3" json:"new_datums,omitempty"`
+	// skipped_datums is the number of datums whose output would be reused
+	SkippedDatums int64 `protobuf:"varint,2,opt,name=skipped_datums,json=skippedDatums,proto3" json:"skipped_datums,omitempty"`
+	// removed_datums is the number of datums whose output would be deleted
+	RemovedDatums int64 `protobuf:"varint,3,opt,name=removed_datums,json=removedDatums,proto3" json:"removed_datums,omitempty"`
+	// input_size_bytes is the total size of the input files of all datums
+	InputSizeBytes       int64    `protobuf:"varint,4,opt,name=input_size_bytes,json=inputSizeBytes,proto3" json:"input_size_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PipelineDryRun) Reset()         { *m = PipelineDryRun{} }
+func (m *PipelineDryRun) String() string { return proto.CompactTextString(m) }
+func (*PipelineDryRun) ProtoMessage()    {}
+func (*PipelineDryRun) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{46}
+}
+func (m *PipelineDryRun) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PipelineDryRun) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PipelineDryRun.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PipelineDryRun) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PipelineDryRun.Merge(m, src)
+}
+func (m *PipelineDryRun) XXX_Size() int {
+	return m.Size()
+}
+func (m *PipelineDryRun) XXX_DiscardUnknown() {
+	xxx_messageInfo_PipelineDryRun.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PipelineDryRun proto.InternalMessageInfo
+
+func (m *PipelineDryRun) GetNewDatums() int64 {
+	if m != nil {
+		return m.NewDatums
+	}
+	return 0
+}
+
+func (m *PipelineDryRun) GetSkippedDatums() int64 {
+	if m != nil {
+		return m.SkippedDatums
+	}
+	return 0
+}
+
+func (m *PipelineDryRun) GetRemovedDatums() int64 {
+	if m != nil {
+		return m.RemovedDatums
+	}
+	return 0
+}
+
+func (m *PipelineDryRun) GetInputSizeBytes() int64 {
+	if m != nil {
+		return m.InputSizeBytes
+	}
+	return 0
+}
+
+type CreatePipelineResponse struct {
+	// dry_run is only set if the request's dry_run field was set
+	DryRun               *PipelineDryRun `protobuf:"bytes,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CreatePipelineResponse) Reset()         { *m = CreatePipelineResponse{} }
+func (m *CreatePipelineResponse) String() string { return proto.CompactTextString(m) }
+func (*CreatePipelineResponse) ProtoMessage()    {}
+func (*CreatePipelineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{47}
+}
+func (m *CreatePipelineResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreatePipelineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreatePipelineResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreatePipelineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePipelineResponse.Merge(m, src)
+}
+func (m *CreatePipelineResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreatePipelineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePipelineResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePipelineResponse proto.InternalMessageInfo
+
+func (m *CreatePipelineResponse) GetDryRun() *PipelineDryRun {
+	if m != nil {
+		return m.DryRun
+	}
+	return nil
+}
+
 type InspectPipelineRequest struct {
 	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -4543,7 +4820,7 @@ func (m *InspectPipelineRequest) Reset()         { *m = InspectPipelineRequest{}
 func (m *InspectPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectPipelineRequest) ProtoMessage()    {}
 func (*InspectPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{46}
+	return fileDescriptor_beade573c128ccc7, []int{48}
 }
 func (m *InspectPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4605,7 +4882,7 @@ func (m *ListPipelineRequest) Reset()         { *m = ListPipelineRequest{} }
 func (m *ListPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPipelineRequest) ProtoMessage()    {}
 func (*ListPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{47}
+	return fileDescriptor_beade573c128ccc7, []int{49}
 }
 func (m *ListPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4676,7 +4953,7 @@ func (m *DeletePipelineRequest) Reset()         { *m = DeletePipelineRequest{} }
 func (m *DeletePipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*DeletePipelineRequest) ProtoMessage()    {}
 func (*DeletePipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{48}
+	return fileDescriptor_beade573c128ccc7, []int{50}
 }
 func (m *DeletePipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4744,7 +5021,7 @@ func (m *StartPipelineRequest) Reset()         { *m = StartPipelineRequest{} }
 func (m *StartPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*StartPipelineRequest) ProtoMessage()    {}
 func (*StartPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{49}
+	return fileDescriptor_beade573c128ccc7, []int{51}
 }
 func (m *StartPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4791,7 +5068,7 @@ func (m *StopPipelineRequest) Reset()         { *m = StopPipelineRequest{} }
 func (m *StopPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*StopPipelineRequest) ProtoMessage()    {}
 func (*StopPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{50}
+	return fileDescriptor_beade573c128ccc7, []int{52}
 }
 func (m *StopPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4840,7 +5117,7 @@ func (m *RunPipelineRequest) Reset()         { *m = RunPipelineRequest{} }
 func (m *RunPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*RunPipelineRequest) ProtoMessage()    {}
 func (*RunPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{51}
+	return fileDescriptor_beade573c128ccc7, []int{53}
 }
 func (m *RunPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4901,7 +5178,7 @@ func (m *RunCronRequest) Reset()         { *m = RunCronRequest{} }
 func (m *RunCronRequest) String() string { return proto.CompactTextString(m) }
 func (*RunCronRequest) ProtoMessage()    {}
 func (*RunCronRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{52}
+	return fileDescriptor_beade573c128ccc7, []int{54}
 }
 func (m *RunCronRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4948,7 +5225,7 @@ func (m *CreateSecretRequest) Reset()         { *m = CreateSecretRequest{} }
 func (m *CreateSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateSecretRequest) ProtoMessage()    {}
 func (*CreateSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{53}
+	return fileDescriptor_beade573c128ccc7, []int{55}
 }
 func (m *CreateSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4995,7 +5272,7 @@ func (m *DeleteSecretRequest) Reset()         { *m = DeleteSecretRequest{} }
 func (m *DeleteSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteSecretRequest) ProtoMessage()    {}
 func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{54}
+	return fileDescriptor_beade573c128ccc7, []int{56}
 }
 func (m *DeleteSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5042,7 +5319,7 @@ func (m *InspectSecretRequest) Reset()         { *m = InspectSecretRequest{} }
 func (m *InspectSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectSecretRequest) ProtoMessage()    {}
 func (*InspectSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{55}
+	return fileDescriptor_beade573c128ccc7, []int{57}
 }
 func (m *InspectSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5089,7 +5366,7 @@ func (m *Secret) Reset()         { *m = Secret{} }
 func (m *Secret) String() string { return proto.CompactTextString(m) }
 func (*Secret) ProtoMessage()    {}
 func (*Secret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{56}
+	return fileDescriptor_beade573c128ccc7, []int{58}
 }
 func (m *Secret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5138,7 +5415,7 @@ func (m *SecretInfo) Reset()         { *m = SecretInfo{} }
 func (m *SecretInfo) String() string { return proto.CompactTextString(m) }
 func (*SecretInfo) ProtoMessage()    {}
 func (*SecretInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{57}
+	return fileDescriptor_beade573c128ccc7, []int{59}
 }
 func (m *SecretInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5199,7 +5476,7 @@ func (m *SecretInfos) Reset()         { *m = SecretInfos{} }
 func (m *SecretInfos) String() string { return proto.CompactTextString(m) }
 func (*SecretInfos) ProtoMessage()    {}
 func (*SecretInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{58}
+	return fileDescriptor_beade573c128ccc7, []int{60}
 }
 func (m *SecretInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5245,7 +5522,7 @@ func (m *ActivateAuthRequest) Reset()         { *m = ActivateAuthRequest{} }
 func (m *ActivateAuthRequest) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthRequest) ProtoMessage()    {}
 func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{59}
+	return fileDescriptor_beade573c128ccc7, []int{61}
 }
 func (m *ActivateAuthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5284,7 +5561,7 @@ func (m *ActivateAuthResponse) Reset()         { *m = ActivateAuthResponse{} }
 func (m *ActivateAuthResponse) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthResponse) ProtoMessage()    {}
 func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{60}
+	return fileDescriptor_beade573c128ccc7, []int{62}
 }
 func (m *ActivateAuthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5370,6 +5647,8 @@ func init() {
 	proto.RegisterType((*SchedulingSpec)(nil), "pps_v2.SchedulingSpec")
 	proto.RegisterMapType((map[string]string)(nil), "pps_v2.SchedulingSpec.NodeSelectorEntry")
 	proto.RegisterType((*CreatePipelineRequest)(nil), "pps_v2.CreatePipelineRequest")
+	proto.RegisterType((*PipelineDryRun)(nil), "pps_v2.PipelineDryRun")
+	proto.RegisterType((*CreatePipelineResponse)(nil), "pps_v2.CreatePipelineResponse")
 	proto.RegisterType((*InspectPipelineRequest)(nil), "pps_v2.InspectPipelineRequest")
 	proto.RegisterType((*ListPipelineRequest)(nil), "pps_v2.ListPipelineRequest")
 	proto.RegisterType((*DeletePipelineRequest)(nil), "pps_v2.DeletePipelineRequest")
@@ -5390,304 +5669,324 @@ func init() {
 func init() { proto.RegisterFile("pps/pps.proto", fileDescriptor_beade573c128ccc7) }
 
 var fileDescriptor_beade573c128ccc7 = []byte{
-	// 4745 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7b, 0xbb, 0x73, 0x1c, 0xc7,
-	0x76, 0x37, 0xf7, 0x89, 0xdd, 0xb3, 0x0f, 0x2c, 0x1a, 0x0f, 0x2e, 0x97, 0x2f, 0x70, 0x28, 0xf1,
-	0x92, 0xbc, 0xba, 0x84, 0x44, 0xea, 0xe3, 0x27, 0xc9, 0x57, 0x92, 0xf1, 0x22, 0x2f, 0x28, 0x88,
-	0xc4, 0x9d, 0x05, 0x75, 0xcb, 0x4e, 0xb6, 0x66, 0x67, 0x7a, 0x17, 0x43, 0xcc, 0xce, 0x8c, 0xe6,
-	0x01, 0x0a, 0x2a, 0x57, 0xd9, 0x81, 0x13, 0x3b, 0x70, 0xe0, 0x9b, 0xba, 0x1c, 0x39, 0x71, 0xe0,
-	0x2a, 0xa7, 0x8e, 0x9c, 0x38, 0x70, 0x60, 0x57, 0x29, 0xb2, 0x33, 0x95, 0x8b, 0xe5, 0xc4, 0x81,
-	0xab, 0xfc, 0x07, 0x38, 0x70, 0xf5, 0xe9, 0xee, 0x79, 0xec, 0x0e, 0x76, 0x17, 0x80, 0xec, 0x08,
-	0xd3, 0xa7, 0x4f, 0xbf, 0x4e, 0x9f, 0xc7, 0xef, 0x9c, 0x5e, 0x40, 0xc3, 0x75, 0xfd, 0x0d, 0xd7,
-	0xf5, 0x1f, 0xb9, 0x9e, 0x13, 0x38, 0xa4, 0xec, 0xba, 0x7e, 0xef, 0xe4, 0x71, 0xe7, 0xfa, 0xd0,
-	0x71, 0x86, 0x16, 0xdd, 0x40, 0x6a, 0x3f, 0x1c, 0x6c, 0xd0, 0x91, 0x1b, 0x9c, 0x72, 0xa6, 0xce,
-	0xed, 0xf1, 0xce, 0xc0, 0x1c, 0x51, 0x3f, 0xd0, 0x46, 0xae, 0x60, 0xb8, 0x35, 0xce, 0x60, 0x84,
-	0x9e, 0x16, 0x98, 0x8e, 0x2d, 0xfa, 0x57, 0x86, 0xce, 0xd0, 0xc1, 0xcf, 0x0d, 0xf6, 0x25, 0xa8,
-	0x0d, 0x77, 0xe0, 0x6f, 0xb8, 0x03, 0xb1, 0x15, 0xe5, 0x18, 0x6a, 0x5d, 0xaa, 0x7b, 0x34, 0xf8,
-	0xda, 0x09, 0xed, 0x80, 0x10, 0x28, 0xda, 0xda, 0x88, 0xb6, 0x73, 0xeb, 0xb9, 0xfb, 0x55, 0x15,
-	0xbf, 0x49, 0x0b, 0x0a, 0xc7, 0xf4, 0xb4, 0x9d, 0x47, 0x12, 0xfb, 0x24, 0x37, 0x01, 0x46, 0x8c,
-	0xbd, 0xe7, 0x6a, 0xc1, 0x51, 0xbb, 0x80, 0x1d, 0x55, 0xa4, 0x1c, 0x68, 0xc1, 0x11, 0xb9, 0x0a,
-	0x0b, 0xd4, 0x3e, 0xe9, 0x9d, 0x68, 0x5e, 0xbb, 0x88, 0x7d, 0x65, 0x6a, 0x9f, 0x7c, 0xa3, 0x79,
-	0xca, 0x1f, 0x17, 0xa1, 0x7a, 0xe8, 0x69, 0xb6, 0x3f, 0x70, 0xbc, 0x11, 0x59, 0x81, 0x92, 0x39,
-	0xd2, 0x86, 0x72, 0x31, 0xde, 0x60, 0xab, 0xe9, 0x23, 0xa3, 0x9d, 0x5f, 0x2f, 0xb0, 0xd5, 0xf4,
-	0x91, 0x81, 0xd3, 0x79, 0x5e, 0x8f, 0x51, 0x0b, 0x48, 0x2d, 0x53, 0xcf, 0xdb, 0x1e, 0x19, 0xe4,
-	0x03, 0x28, 0x50, 0xfb, 0xa4, 0x5d, 0x5c, 0x2f, 0xdc, 0xaf, 0x3d, 0xee, 0x3c, 0xe2, 0x42, 0x7d,
-	0x14, 0x2d, 0xf0, 0x68, 0xd7, 0x3e, 0xd9, 0xb5, 0x03, 0xef, 0x54, 0x65, 0x6c, 0xe4, 0x17, 0xb0,
-	0xe0, 0xe3, 0x49, 0xfd, 0x76, 0x09, 0x47, 0x2c, 0xcb, 0x11, 0x09, 0x01, 0xa8, 0x92, 0x87, 0x7c,
-	0x00, 0x04, 0x37, 0xd4, 0x73, 0x43, 0xcb, 0xea, 0xc9, 0x91, 0x65, 0xdc, 0x40, 0x0b, 0x7b, 0x0e,
-	0x42, 0xcb, 0xea, 0x0a, 0xee, 0x15, 0x28, 0xf9, 0x81, 0x61, 0xda, 0xed, 0x05, 0x64, 0xe0, 0x0d,
-	0x72, 0x1d, 0xaa, 0x6c, 0xe7, 0xbc, 0xa7, 0x82, 0x3d, 0x15, 0xea, 0x79, 0x5d, 0xec, 0xfc, 0x00,
-	0x88, 0xa6, 0xeb, 0xd4, 0x0d, 0x7a, 0x1e, 0x0d, 0x42, 0xcf, 0xee, 0xe9, 0x8e, 0x41, 0xdb, 0xd5,
-	0xf5, 0xc2, 0xfd, 0x82, 0xda, 0xe2, 0x3d, 0x2a, 0x76, 0x6c, 0x3b, 0x06, 0x65, 0x0b, 0x18, 0xb4,
-	0x1f, 0x0e, 0xdb, 0xb0, 0x9e, 0xbb, 0x5f, 0x51, 0x79, 0x83, 0x5d, 0x57, 0xe8, 0x53, 0xaf, 0x5d,
-	0xe3, 0xd7, 0xc5, 0xbe, 0xc9, 0x6d, 0xa8, 0xbd, 0x75, 0xbc, 0x63, 0xd3, 0x1e, 0xf6, 0x0c, 0xd3,
-	0x6b, 0xd7, 0xb1, 0x0b, 0x04, 0x69, 0xc7, 0xf4, 0xc8, 0x2d, 0x00, 0xc3, 0xd1, 0x8f, 0xa9, 0x37,
-	0x30, 0x2d, 0xda, 0x6e, 0xf0, 0xfe, 0x98, 0x42, 0x7e, 0x06, 0xa5, 0x7e, 0x68, 0x5a, 0x46, 0xbb,
-	0xb9, 0x9e, 0xbb, 0x5f, 0x7b, 0xbc, 0x24, 0xc5, 0xb4, 0xc5, 0x88, 0x5d, 0x97, 0xea, 0x2a, 0xef,
-	0xef, 0x3c, 0x85, 0x8a, 0x14, 0xb1, 0x54, 0x92, 0x5c, 0xac, 0x24, 0x2b, 0x50, 0x3a, 0xd1, 0xac,
-	0x90, 0x0a, 0xc5, 0xe1, 0x8d, 0xcf, 0xf2, 0x9f, 0xe4, 0x94, 0x5f, 0x43, 0x35, 0x9a, 0x8b, 0x1d,
-	0x01, 0xb5, 0x48, 0x68, 0x1c, 0xfb, 0x26, 0x1d, 0xa8, 0x58, 0x9a, 0x3d, 0x0c, 0x99, 0x72, 0xf0,
-	0xd1, 0x51, 0x3b, 0xd6, 0x9a, 0x42, 0x42, 0x6b, 0x94, 0x07, 0x50, 0x3a, 0x7c, 0xf6, 0xc2, 0xe9,
-	0x93, 0x75, 0x28, 0x07, 0x83, 0xde, 0x1b, 0xa7, 0xcf, 0x27, 0xdc, 0xaa, 0xbe, 0xfb, 0xf1, 0x36,
-	0xef, 0x52, 0x4b, 0xc1, 0xe0, 0x85, 0xd3, 0x57, 0x3a, 0x50, 0xde, 0x1d, 0x7a, 0xd4, 0xf7, 0xd9,
-	0x9e, 0x5f, 0xab, 0xfb, 0x72, 0xcf, 0xaf, 0xd5, 0x7d, 0xe5, 0x26, 0x14, 0xd8, 0x24, 0x6b, 0x90,
-	0x37, 0x0d, 0x31, 0x41, 0xf9, 0xdd, 0x8f, 0xb7, 0xf3, 0x7b, 0x3b, 0x6a, 0xde, 0x34, 0x94, 0x3f,
-	0xca, 0x43, 0xe5, 0x6b, 0x1a, 0x68, 0x86, 0x16, 0x68, 0x64, 0x1b, 0x6a, 0x9a, 0x6d, 0x3b, 0x01,
-	0x9a, 0x9c, 0xdf, 0xce, 0xa1, 0x4e, 0xdd, 0x91, 0xc2, 0x92, 0x6c, 0x8f, 0x36, 0x63, 0x1e, 0xae,
-	0x8c, 0xc9, 0x51, 0xe4, 0x63, 0x28, 0x5b, 0x5a, 0x9f, 0x5a, 0x3e, 0x2a, 0x7c, 0xed, 0xf1, 0x8d,
-	0x89, 0xf1, 0xfb, 0xd8, 0xcd, 0x87, 0x0a, 0xde, 0xce, 0x17, 0xd0, 0x1a, 0x9f, 0xf6, 0x3c, 0x17,
-	0xd0, 0xf9, 0x14, 0x6a, 0x89, 0x69, 0xcf, 0x75, 0x77, 0x7f, 0x08, 0x0b, 0x5d, 0xea, 0x9d, 0x98,
-	0x3a, 0x25, 0x77, 0xa1, 0x61, 0xda, 0x01, 0xf5, 0x6c, 0xcd, 0xea, 0xb9, 0x8e, 0x17, 0xe0, 0x04,
-	0x25, 0xb5, 0x2e, 0x89, 0x07, 0x8e, 0x17, 0x30, 0x26, 0xfa, 0x5d, 0x92, 0x29, 0xcf, 0x99, 0x24,
-	0x11, 0x99, 0x98, 0xbc, 0x5d, 0x7e, 0xa1, 0x42, 0xde, 0x07, 0x6a, 0xde, 0x74, 0x99, 0x6e, 0x04,
-	0xa7, 0x2e, 0x15, 0x5e, 0x04, 0xbf, 0x95, 0xc7, 0x50, 0xea, 0xba, 0x4e, 0x18, 0x90, 0x07, 0xcc,
-	0x9e, 0x71, 0x27, 0xb8, 0x70, 0xed, 0xf1, 0x62, 0x6c, 0xcf, 0x48, 0x56, 0x65, 0xbf, 0xf2, 0x2f,
-	0x79, 0xa8, 0x1c, 0x3c, 0xeb, 0xee, 0xd9, 0x6e, 0x98, 0xed, 0xe2, 0x08, 0x14, 0x3d, 0xea, 0x3a,
-	0xe2, 0xb8, 0xf8, 0xcd, 0x8c, 0x97, 0xfd, 0xed, 0xe1, 0x0e, 0xb8, 0x95, 0x54, 0x18, 0xe1, 0xf0,
-	0xd4, 0xa5, 0x64, 0x0d, 0xca, 0x7d, 0x4f, 0xb3, 0x75, 0xe9, 0xfd, 0x44, 0x8b, 0xd1, 0x75, 0x67,
-	0x34, 0x32, 0x03, 0xe9, 0xf9, 0x78, 0x8b, 0x2d, 0x30, 0xb4, 0x9c, 0x7e, 0xbb, 0xc4, 0x17, 0x60,
-	0xdf, 0xcc, 0xaf, 0xbd, 0x71, 0x4c, 0xbb, 0xe7, 0xd8, 0xed, 0x32, 0x67, 0x66, 0xcd, 0x57, 0x36,
-	0x73, 0xaf, 0x4e, 0x18, 0x50, 0xaf, 0xc7, 0xda, 0xed, 0x05, 0x34, 0xf8, 0x2a, 0x52, 0x5e, 0x38,
-	0xa6, 0x4d, 0xae, 0x41, 0x65, 0xe8, 0x39, 0xa1, 0xdb, 0xeb, 0x9f, 0xb6, 0x2b, 0x38, 0x70, 0x01,
-	0xdb, 0x5b, 0xa7, 0x6c, 0x19, 0x4b, 0xfb, 0xfe, 0xb4, 0x5d, 0xc5, 0x31, 0xf8, 0xcd, 0xfc, 0x01,
-	0x86, 0x95, 0x1e, 0x33, 0x6e, 0x5f, 0xf8, 0x0f, 0x40, 0xd2, 0x33, 0x46, 0x21, 0x4d, 0xc8, 0xfb,
-	0x4f, 0xd0, 0x85, 0x54, 0xd4, 0xbc, 0xff, 0x84, 0x09, 0x36, 0xf0, 0xcc, 0xe1, 0x90, 0x72, 0xe7,
-	0x81, 0x82, 0x1d, 0x08, 0xd7, 0x8a, 0x64, 0x55, 0xf6, 0x2b, 0xff, 0x94, 0x83, 0xea, 0xb6, 0xe7,
-	0xd8, 0x3f, 0xad, 0x64, 0x85, 0x04, 0x0b, 0xe3, 0x12, 0xf4, 0x5d, 0xaa, 0x4b, 0x5d, 0x60, 0xdf,
-	0xe4, 0x06, 0x54, 0x9d, 0x13, 0xea, 0xbd, 0xf5, 0xcc, 0x80, 0xa2, 0x68, 0x99, 0x9c, 0x24, 0x81,
-	0x7c, 0xc8, 0x7c, 0xb2, 0xe6, 0x05, 0x28, 0x5d, 0x16, 0x20, 0x78, 0xbc, 0x7c, 0x24, 0xe3, 0xe5,
-	0xa3, 0x43, 0x19, 0x50, 0x55, 0xce, 0xa8, 0xfc, 0x7b, 0x0e, 0x4a, 0xfc, 0x28, 0x0a, 0x14, 0xdc,
-	0x81, 0x2f, 0x14, 0xab, 0x25, 0x15, 0x4b, 0xea, 0x90, 0xca, 0x3a, 0xc9, 0x1d, 0x28, 0xe2, 0x05,
-	0x71, 0xcb, 0x6d, 0x48, 0x26, 0xce, 0x81, 0x5d, 0xe4, 0x2e, 0x94, 0xf0, 0x6a, 0x30, 0x70, 0x4d,
-	0xf0, 0xf0, 0x3e, 0xc6, 0xa4, 0x7b, 0x8e, 0xef, 0x8b, 0x40, 0x36, 0xce, 0x84, 0x7d, 0x8c, 0x29,
-	0xb4, 0x4d, 0xc7, 0x16, 0xb1, 0x6b, 0x9c, 0x09, 0xfb, 0xc8, 0xfb, 0x50, 0xd4, 0x3d, 0xa1, 0x4e,
-	0x09, 0xc7, 0x1d, 0xdd, 0x90, 0x8a, 0xdd, 0x8a, 0x0d, 0x95, 0x17, 0x4e, 0xff, 0xec, 0x3b, 0xbb,
-	0x17, 0x5d, 0x41, 0x1e, 0x27, 0x6a, 0xca, 0xfb, 0xdf, 0x46, 0xea, 0x84, 0x52, 0x17, 0x12, 0x4a,
-	0x2d, 0x35, 0xb0, 0x18, 0x6b, 0xa0, 0xf2, 0x0a, 0x16, 0x0f, 0x34, 0x4f, 0xb3, 0x2c, 0x6a, 0x99,
-	0xfe, 0x08, 0xbd, 0x7e, 0x07, 0x2a, 0xba, 0x63, 0xfb, 0x81, 0x66, 0x73, 0xb7, 0x51, 0x54, 0xa3,
-	0x36, 0x59, 0x87, 0x9a, 0xee, 0xd0, 0xc1, 0xc0, 0xd4, 0x4d, 0x6a, 0xf3, 0x3d, 0xe4, 0xd4, 0x24,
-	0x49, 0x79, 0x02, 0x55, 0xdc, 0x3d, 0xd3, 0xdf, 0xcc, 0x00, 0x42, 0xa0, 0x78, 0xa4, 0xf9, 0x47,
-	0x38, 0xb6, 0xae, 0xe2, 0xb7, 0xf2, 0x05, 0x94, 0x76, 0xb4, 0x20, 0x1c, 0x9d, 0xe5, 0xdd, 0xc9,
-	0x4d, 0x28, 0xb0, 0xb8, 0xc1, 0xcf, 0x5c, 0x93, 0xc2, 0x63, 0x91, 0x83, 0xd1, 0x95, 0x7f, 0xcd,
-	0x41, 0x15, 0x27, 0xd8, 0xb3, 0x07, 0x0e, 0xbb, 0x0f, 0x83, 0x35, 0x84, 0x8a, 0x44, 0xf7, 0x81,
-	0x1c, 0x2a, 0xef, 0x23, 0xf7, 0x51, 0x03, 0x03, 0xee, 0x46, 0x9b, 0x8f, 0x49, 0x8a, 0xa9, 0xcb,
-	0x7a, 0x54, 0xce, 0x40, 0x1e, 0x72, 0x4e, 0x1f, 0x65, 0x59, 0x7b, 0xbc, 0x12, 0x69, 0x9c, 0xe7,
-	0xe8, 0xd4, 0xf7, 0x19, 0xaf, 0xcf, 0x79, 0x7d, 0xf2, 0x00, 0xaa, 0xec, 0x3e, 0xf8, 0xcc, 0x45,
-	0xe4, 0xaf, 0xcb, 0x1b, 0x62, 0x12, 0x51, 0x2b, 0xee, 0x00, 0x47, 0x50, 0xf2, 0x1e, 0x14, 0x59,
-	0x10, 0x11, 0x4a, 0xd3, 0x4a, 0x72, 0xb1, 0x53, 0xa8, 0xd8, 0xab, 0xfc, 0x6d, 0x0e, 0xaa, 0x9b,
-	0xc3, 0xa1, 0x47, 0x87, 0x6c, 0xcc, 0x0a, 0x94, 0x74, 0x06, 0x85, 0xf0, 0x64, 0x05, 0x95, 0x37,
-	0x98, 0x44, 0x47, 0x54, 0xb3, 0xc5, 0x6d, 0xe0, 0x37, 0x33, 0x55, 0x3f, 0x30, 0x0c, 0x7a, 0x82,
-	0xbb, 0xce, 0xa9, 0xa2, 0x45, 0x1e, 0x40, 0x6b, 0x60, 0x0e, 0x82, 0xa3, 0x9e, 0x4b, 0x3d, 0x9d,
-	0xda, 0x01, 0x83, 0x19, 0x45, 0xe4, 0x58, 0x44, 0xfa, 0x41, 0x44, 0x26, 0x4f, 0xe1, 0xaa, 0x6d,
-	0xda, 0x14, 0xbd, 0xd3, 0xd8, 0x88, 0x12, 0x8e, 0x58, 0xe5, 0xdd, 0xcf, 0xd2, 0xe3, 0x94, 0x3f,
-	0xcf, 0x43, 0x3d, 0x29, 0x1b, 0xf2, 0x05, 0x34, 0x0c, 0xe7, 0xad, 0x6d, 0x39, 0x9a, 0xd1, 0x63,
-	0x40, 0x59, 0xdc, 0xcb, 0xb5, 0x09, 0xa3, 0xdf, 0x11, 0x20, 0x59, 0xad, 0x4b, 0x7e, 0xe6, 0x06,
-	0xc8, 0x2f, 0xa1, 0xee, 0xf2, 0xf9, 0xf8, 0xf0, 0xfc, 0xac, 0xe1, 0x35, 0xc1, 0x8e, 0xa3, 0x3f,
-	0x83, 0x5a, 0xe8, 0xc6, 0x6b, 0x17, 0x66, 0x0d, 0x06, 0xce, 0x8d, 0x63, 0xdf, 0x87, 0x66, 0xb4,
-	0xf3, 0xfe, 0x69, 0x40, 0x7d, 0x94, 0x55, 0x51, 0x8d, 0xce, 0xb3, 0xc5, 0x88, 0xe4, 0x0e, 0xd4,
-	0xc5, 0x12, 0x9c, 0xa9, 0x84, 0x4c, 0x62, 0x59, 0x64, 0x51, 0xfe, 0x3a, 0x0f, 0xab, 0xd1, 0x3d,
-	0xa6, 0xa4, 0xf3, 0x34, 0x5b, 0x3a, 0x91, 0x87, 0x88, 0x46, 0x8d, 0x49, 0xe5, 0xe3, 0x4c, 0xa9,
-	0x64, 0x0c, 0x4b, 0x49, 0xe3, 0x71, 0x96, 0x34, 0x32, 0x06, 0x25, 0xa5, 0xf0, 0x49, 0xa6, 0x14,
-	0x32, 0x87, 0x8d, 0x09, 0xe6, 0xe3, 0x0c, 0xc1, 0x64, 0xef, 0x31, 0x29, 0xab, 0xdf, 0xe6, 0xa0,
-	0xfe, 0x1b, 0xc7, 0x3b, 0xa6, 0x1e, 0x93, 0x50, 0x88, 0x56, 0xf5, 0x16, 0xdb, 0xbd, 0xc8, 0x39,
-	0xd4, 0xdf, 0xfd, 0x78, 0xbb, 0xc2, 0x99, 0xf6, 0x76, 0xd4, 0x0a, 0xef, 0xde, 0x33, 0x18, 0xc6,
-	0x7c, 0xe3, 0xf4, 0x19, 0x5f, 0x3e, 0xc6, 0x98, 0xcc, 0xa3, 0xee, 0xa8, 0xa5, 0x37, 0x4e, 0x7f,
-	0xcf, 0x20, 0x4f, 0xa1, 0x8e, 0x1e, 0x00, 0x8d, 0x34, 0x94, 0x56, 0xbd, 0x3c, 0x61, 0xff, 0xa1,
-	0xaf, 0xd6, 0x8c, 0xb8, 0xa1, 0xbc, 0x81, 0x5a, 0xa2, 0x8f, 0x7c, 0x0c, 0x0b, 0x18, 0x98, 0xa8,
-	0x21, 0x2e, 0x6c, 0x5a, 0x0c, 0x93, 0xac, 0x2c, 0x0a, 0xa0, 0xd1, 0xf3, 0xb8, 0xb4, 0x94, 0x8a,
-	0x14, 0xe8, 0x1f, 0xb8, 0xd5, 0x3b, 0x50, 0x57, 0xa9, 0xef, 0x84, 0x9e, 0x4e, 0xd1, 0x25, 0xb3,
-	0xc4, 0xcb, 0x0d, 0x71, 0xa1, 0xbc, 0xca, 0x3e, 0x99, 0x7d, 0x8f, 0xe8, 0xc8, 0xf1, 0x64, 0xee,
-	0x27, 0x5a, 0xe4, 0x0e, 0x14, 0x86, 0x6e, 0x28, 0x0e, 0x15, 0xa1, 0xae, 0xe7, 0x07, 0xaf, 0x31,
-	0x39, 0x60, 0x7d, 0xcc, 0x5d, 0x18, 0xa6, 0x7f, 0x2c, 0xa3, 0x35, 0xfb, 0x56, 0xfe, 0x1f, 0x2c,
-	0x08, 0x9e, 0x08, 0xd8, 0xe5, 0x62, 0x60, 0xc7, 0x56, 0xb3, 0xc3, 0x51, 0x9f, 0x7a, 0xb8, 0x5a,
-	0x41, 0x15, 0x2d, 0xe5, 0x3f, 0x8a, 0xd0, 0xe8, 0x06, 0x8e, 0x47, 0x0d, 0x0c, 0x5a, 0x03, 0x47,
-	0x3a, 0xea, 0x5c, 0xb6, 0xa3, 0x26, 0x1f, 0x40, 0xc5, 0x35, 0x5d, 0x6a, 0x99, 0xb6, 0x54, 0xd8,
-	0x38, 0x80, 0x0b, 0xba, 0x1a, 0x71, 0x90, 0x27, 0xd0, 0x70, 0xc2, 0xc0, 0x0d, 0x83, 0x5e, 0x02,
-	0x76, 0x4c, 0xc6, 0xbc, 0x3a, 0x67, 0xe2, 0x2d, 0xd2, 0x86, 0x05, 0x8f, 0x72, 0x70, 0xc1, 0x8d,
-	0x55, 0x36, 0xd1, 0x9a, 0xb5, 0x40, 0xeb, 0x09, 0x7b, 0xa0, 0x06, 0xea, 0x63, 0x41, 0x6d, 0x30,
-	0xea, 0x81, 0x24, 0x32, 0x6b, 0x46, 0x36, 0xff, 0xd8, 0x74, 0x5d, 0x6a, 0x60, 0xc4, 0x2e, 0xa0,
-	0x2e, 0x68, 0x5d, 0x4e, 0x62, 0x28, 0x10, 0x59, 0x02, 0x27, 0xd0, 0x2c, 0x44, 0x81, 0x05, 0xb5,
-	0xca, 0x28, 0x87, 0x8c, 0xc0, 0x60, 0x1d, 0x76, 0x0f, 0x34, 0xd3, 0xa2, 0x06, 0x02, 0xc1, 0x82,
-	0x8a, 0x23, 0x9e, 0x21, 0x25, 0xda, 0x89, 0x47, 0x75, 0x86, 0x89, 0xa8, 0x81, 0xa8, 0x50, 0xec,
-	0x44, 0x95, 0xc4, 0x38, 0xf2, 0xc0, 0xec, 0xc8, 0xf3, 0x11, 0xd4, 0xf1, 0x43, 0x8a, 0xaa, 0x96,
-	0x29, 0xaa, 0x1a, 0xf2, 0x08, 0x49, 0xdd, 0x93, 0x21, 0xb0, 0x8e, 0x21, 0xb0, 0x95, 0xb8, 0xad,
-	0x54, 0x00, 0x5c, 0x83, 0xb2, 0x47, 0x35, 0xdf, 0xb1, 0x05, 0x20, 0x14, 0xad, 0xa4, 0x09, 0x34,
-	0xe7, 0x37, 0x81, 0xa7, 0x50, 0x19, 0x98, 0xb6, 0xe9, 0x1f, 0x51, 0xa3, 0xbd, 0x38, 0x73, 0x58,
-	0xc4, 0xab, 0xfc, 0x59, 0x13, 0x16, 0xe6, 0xd4, 0xb2, 0x0d, 0xa8, 0x06, 0xb2, 0xd2, 0x30, 0xee,
-	0x17, 0xa3, 0x12, 0x84, 0x1a, 0xf3, 0xa4, 0xd4, 0xb2, 0x30, 0x53, 0x2d, 0x1f, 0x40, 0x4b, 0x7e,
-	0xf7, 0x4e, 0xa8, 0xe7, 0x33, 0xe8, 0xc7, 0x55, 0x6d, 0x51, 0xd2, 0xbf, 0xe1, 0x64, 0xb2, 0x01,
-	0x35, 0x86, 0x86, 0xe5, 0xa5, 0x94, 0x32, 0x2f, 0x05, 0x18, 0x8b, 0xb8, 0x93, 0x2d, 0x68, 0xb9,
-	0x31, 0x1e, 0xeb, 0x21, 0xac, 0xe6, 0x90, 0xf1, 0x6a, 0xb4, 0xa3, 0x34, 0x5e, 0x53, 0x17, 0xdd,
-	0x31, 0x00, 0x77, 0x0f, 0xca, 0x14, 0xb3, 0x68, 0xd4, 0x4c, 0x5c, 0x8f, 0x8f, 0xe4, 0xb9, 0xb5,
-	0x2a, 0x7a, 0xc9, 0x43, 0x00, 0x57, 0xf3, 0xa8, 0x1d, 0x60, 0x4e, 0x5e, 0x99, 0x14, 0x66, 0x95,
-	0x77, 0xb3, 0xb4, 0x3b, 0x71, 0xd7, 0xd5, 0x8b, 0xdd, 0x35, 0xcc, 0x7f, 0xd7, 0x93, 0x86, 0x5f,
-	0x9b, 0xc3, 0xf0, 0x2f, 0xab, 0xce, 0x89, 0xa4, 0xb5, 0x39, 0x3d, 0x69, 0x65, 0x08, 0xd3, 0x67,
-	0x89, 0xae, 0x50, 0xe0, 0x08, 0x61, 0x62, 0xf6, 0xab, 0xf2, 0x3e, 0xf2, 0x0b, 0xa8, 0x89, 0x43,
-	0x60, 0x96, 0xd5, 0x4a, 0xa3, 0x41, 0x95, 0xba, 0x8e, 0x0a, 0x9c, 0x81, 0x7d, 0xb3, 0x6c, 0x5c,
-	0xb0, 0x8b, 0xec, 0x75, 0x09, 0x77, 0x27, 0xce, 0xb8, 0xc5, 0x73, 0xd8, 0x84, 0x73, 0x23, 0xb3,
-	0x9c, 0xdb, 0xf2, 0x3c, 0xce, 0x6d, 0x65, 0xd2, 0xb9, 0x8d, 0x79, 0xaf, 0xd5, 0x39, 0xbc, 0xd7,
-	0x5a, 0x96, 0xf7, 0x4a, 0x3b, 0xc9, 0xab, 0xe3, 0x4e, 0x32, 0x72, 0x6e, 0xed, 0xd9, 0xce, 0xed,
-	0x53, 0x68, 0x08, 0x00, 0x20, 0x82, 0xf6, 0x35, 0x8c, 0x9f, 0xd1, 0x98, 0x24, 0x5a, 0x50, 0xeb,
-	0x6f, 0x93, 0xd8, 0x61, 0x13, 0x96, 0x3c, 0x11, 0x4a, 0x7b, 0x1e, 0xfd, 0x36, 0xa4, 0x7e, 0xe0,
-	0xb7, 0x3b, 0xe9, 0x25, 0x93, 0xb1, 0x56, 0x6d, 0x49, 0x76, 0x55, 0x70, 0x93, 0xcf, 0x61, 0x31,
-	0x9a, 0xc2, 0x32, 0x47, 0x66, 0xe0, 0xb7, 0xaf, 0x4f, 0x99, 0xa0, 0x29, 0x99, 0xf7, 0x91, 0x97,
-	0xec, 0xc3, 0x55, 0xdf, 0x34, 0xa8, 0xae, 0x79, 0xbd, 0xf1, 0x69, 0x6e, 0x4c, 0x99, 0x66, 0x55,
-	0x0c, 0x52, 0xd3, 0xb3, 0xdd, 0x85, 0x92, 0xc9, 0xd0, 0x42, 0xfb, 0x66, 0x5a, 0xf5, 0x44, 0xb2,
-	0x89, 0x7d, 0xe4, 0x23, 0x00, 0x9b, 0xbe, 0x95, 0x8a, 0x74, 0x0b, 0x39, 0x89, 0xd4, 0x3c, 0xae,
-	0x4a, 0x98, 0x63, 0x54, 0x6d, 0xfa, 0x56, 0x68, 0xd6, 0x78, 0xfc, 0xb8, 0x3d, 0x3b, 0x7e, 0xdc,
-	0x81, 0x3a, 0xb5, 0xb5, 0xbe, 0x45, 0x7b, 0xfc, 0x22, 0xd7, 0x31, 0xaf, 0xac, 0x71, 0x1a, 0x07,
-	0xb7, 0x04, 0x8a, 0xbe, 0x66, 0x05, 0xed, 0x3b, 0xa2, 0x32, 0xa0, 0x59, 0x01, 0xf9, 0x10, 0x40,
-	0x3f, 0x0a, 0xed, 0x63, 0xee, 0xdc, 0x94, 0xb1, 0x7c, 0x98, 0xf5, 0xe0, 0xf9, 0xab, 0xba, 0xfc,
-	0xc4, 0x04, 0x02, 0x21, 0x1b, 0xc3, 0xac, 0xcc, 0xec, 0xee, 0xce, 0x4e, 0x20, 0x18, 0xff, 0x21,
-	0x67, 0x67, 0x29, 0x00, 0x03, 0x85, 0x72, 0xf4, 0x7b, 0x33, 0x53, 0x80, 0x37, 0x4e, 0x5f, 0x8e,
-	0xe5, 0xd6, 0xc0, 0xd6, 0xf6, 0x4c, 0xea, 0xb7, 0xdf, 0x8f, 0xac, 0x21, 0x1c, 0x1d, 0x32, 0x0a,
-	0xf9, 0x12, 0x16, 0x7d, 0xfd, 0x88, 0x1a, 0xa1, 0x65, 0xda, 0x43, 0x7e, 0xa6, 0x7b, 0xb8, 0xc0,
-	0x5a, 0xe4, 0x15, 0xa2, 0x6e, 0xae, 0x1f, 0x7e, 0xaa, 0x4d, 0xae, 0x41, 0xc5, 0x75, 0x0c, 0x3e,
-	0xf2, 0x67, 0xbc, 0x66, 0xe4, 0x3a, 0xbc, 0x00, 0x7b, 0x1d, 0xaa, 0xac, 0xcb, 0xd5, 0x02, 0xfd,
-	0xa8, 0x7d, 0x9f, 0x57, 0x63, 0x5c, 0xc7, 0x38, 0x60, 0x6d, 0xe5, 0x39, 0x94, 0xb9, 0xde, 0x67,
-	0x16, 0x0a, 0x1e, 0xa4, 0xf3, 0xdb, 0xe5, 0x49, 0x53, 0x91, 0x0e, 0x51, 0xb9, 0x05, 0x15, 0x19,
-	0xe5, 0xb2, 0xa6, 0x52, 0xfe, 0xae, 0x00, 0x84, 0xa3, 0x3c, 0xc9, 0x86, 0x41, 0xf8, 0xe7, 0x72,
-	0x85, 0x1c, 0xae, 0xb0, 0x3a, 0x1e, 0x31, 0xcf, 0x70, 0xba, 0xf9, 0x94, 0xd3, 0x1d, 0x0b, 0x90,
-	0x85, 0x99, 0x01, 0xf2, 0x57, 0xc0, 0x6e, 0xa7, 0x87, 0x99, 0xaf, 0x2c, 0xcb, 0x3c, 0x88, 0x24,
-	0x3d, 0xb1, 0x4b, 0xe6, 0xfd, 0xb7, 0x91, 0x97, 0x97, 0x69, 0xab, 0x6f, 0x64, 0x9b, 0xf9, 0x27,
-	0x2d, 0x0c, 0x8e, 0x7a, 0x81, 0x73, 0x4c, 0x6d, 0x51, 0xfd, 0xab, 0x32, 0xca, 0x21, 0x23, 0x90,
-	0xa7, 0xd0, 0xb4, 0x34, 0x1f, 0x63, 0xa3, 0xc8, 0xe7, 0xcb, 0x67, 0xc4, 0x95, 0x3a, 0xe3, 0x93,
-	0x2d, 0xb2, 0x0e, 0xb5, 0x44, 0x40, 0xc6, 0x10, 0x5c, 0x54, 0x93, 0xa4, 0x14, 0xda, 0xa8, 0xcc,
-	0x42, 0x1b, 0x9d, 0x5f, 0x42, 0x33, 0x7d, 0x86, 0x64, 0x4d, 0xb8, 0x94, 0x51, 0x13, 0x2e, 0x25,
-	0x6b, 0xc2, 0xff, 0xdd, 0x80, 0x7a, 0xea, 0xd6, 0x92, 0x8b, 0xe7, 0x66, 0x42, 0x9d, 0x36, 0x2c,
-	0x48, 0x84, 0x93, 0xe7, 0xf1, 0xe6, 0x24, 0x42, 0x36, 0x09, 0x8c, 0x55, 0x98, 0x03, 0x63, 0x6d,
-	0x44, 0xd5, 0xff, 0x62, 0xda, 0x73, 0xe1, 0x0b, 0xc0, 0xe4, 0x63, 0x40, 0x26, 0x14, 0x2a, 0x5d,
-	0x18, 0x0a, 0x95, 0xa7, 0x42, 0xa1, 0x4f, 0x01, 0x74, 0x8f, 0x6a, 0x01, 0x35, 0x7a, 0x5a, 0x20,
-	0x60, 0xd3, 0x34, 0xa8, 0x52, 0x15, 0xdc, 0x9b, 0x41, 0x6c, 0x06, 0x95, 0x39, 0xcc, 0xa0, 0xcd,
-	0x60, 0x94, 0x83, 0x91, 0x97, 0xd7, 0x81, 0x65, 0x93, 0x39, 0x53, 0x8f, 0xea, 0x0c, 0x8c, 0x51,
-	0xcf, 0x73, 0x3c, 0x84, 0x4b, 0x55, 0xb5, 0xc6, 0x69, 0xbb, 0x8c, 0x44, 0x7e, 0x0e, 0x4b, 0x3c,
-	0xb4, 0xf9, 0x32, 0x92, 0x51, 0x03, 0x91, 0x51, 0x41, 0x6d, 0x89, 0x0e, 0x55, 0xd2, 0x93, 0xcc,
-	0xda, 0x89, 0x66, 0x5a, 0xcc, 0x27, 0x23, 0x32, 0x8a, 0x99, 0x37, 0x25, 0x9d, 0x6c, 0xa5, 0x8c,
-	0xaa, 0x81, 0x46, 0x75, 0x77, 0xfc, 0x20, 0x33, 0xcc, 0x69, 0xd2, 0x5e, 0x9a, 0x73, 0xd9, 0xcb,
-	0x04, 0xee, 0x59, 0xcc, 0xc0, 0x3d, 0x99, 0x51, 0xbc, 0x75, 0xd9, 0x28, 0xbe, 0xf4, 0xd3, 0x44,
-	0x71, 0x72, 0x89, 0x28, 0xbe, 0x3c, 0x25, 0x8a, 0xaf, 0x43, 0xcd, 0xa0, 0xbe, 0xee, 0x99, 0x2e,
-	0x8b, 0x4a, 0x08, 0xd5, 0xaa, 0x6a, 0x92, 0xc4, 0x5c, 0x98, 0xae, 0xe9, 0x47, 0xb4, 0xe7, 0x9b,
-	0xdf, 0x53, 0x44, 0x6a, 0x55, 0xb5, 0x8a, 0x94, 0xae, 0xf9, 0x3d, 0x9d, 0x08, 0xd0, 0x6b, 0x67,
-	0x07, 0xe8, 0xab, 0x89, 0x00, 0x1d, 0xfb, 0xea, 0x76, 0xca, 0x57, 0xbf, 0x07, 0xcd, 0x91, 0xf6,
-	0x5d, 0xef, 0xdb, 0x90, 0x86, 0x62, 0xc5, 0x6b, 0xa8, 0x4f, 0xf5, 0x91, 0xf6, 0xdd, 0xaf, 0x19,
-	0x11, 0x17, 0x4d, 0xc0, 0xe8, 0xce, 0xbc, 0x30, 0xfa, 0xfa, 0x14, 0x18, 0x9d, 0x86, 0x0b, 0x37,
-	0x2e, 0x02, 0x17, 0x6e, 0x5e, 0x0a, 0x2e, 0xdc, 0x3a, 0x0f, 0x5c, 0x18, 0x8b, 0x67, 0xeb, 0x33,
-	0xe3, 0x19, 0x7a, 0x04, 0xcd, 0x36, 0xfa, 0xa7, 0x08, 0x92, 0xd0, 0x23, 0x60, 0x73, 0x1c, 0x79,
-	0x28, 0xf3, 0x20, 0x8f, 0xbb, 0x17, 0x46, 0x1e, 0xef, 0x4d, 0x41, 0x1e, 0xef, 0xa7, 0x91, 0x07,
-	0x59, 0x85, 0xb2, 0xff, 0xa4, 0xc7, 0x64, 0x73, 0x8f, 0xbf, 0x78, 0xfb, 0x4f, 0x5e, 0x85, 0x01,
-	0x0b, 0x2d, 0x23, 0xf1, 0x34, 0x8a, 0x40, 0x26, 0x11, 0x5a, 0xe4, 0x93, 0xa9, 0x1a, 0x71, 0xb0,
-	0x2c, 0xc2, 0xa3, 0xb2, 0x82, 0x89, 0x5b, 0xe0, 0x00, 0xa7, 0x11, 0x51, 0xd9, 0x46, 0x2e, 0x19,
-	0xfe, 0x5e, 0x40, 0x23, 0xe9, 0xbe, 0x30, 0x93, 0x88, 0x72, 0x77, 0xd3, 0x1e, 0x38, 0xe2, 0x6d,
-	0x78, 0x25, 0xcb, 0xd9, 0xa9, 0x75, 0x37, 0xd1, 0x52, 0xfe, 0xb9, 0x08, 0xad, 0x6d, 0x74, 0xfb,
-	0x2c, 0x48, 0x71, 0xb7, 0x72, 0xce, 0x70, 0x3a, 0x91, 0xd7, 0xe6, 0xcf, 0x57, 0xd0, 0x2a, 0xcc,
-	0xca, 0xf9, 0x8a, 0xf3, 0xe4, 0x7c, 0xa5, 0x59, 0x05, 0xad, 0xf2, 0x8c, 0x82, 0xd6, 0xc2, 0x1c,
-	0x29, 0x61, 0x65, 0x6a, 0x41, 0xab, 0x7a, 0xfe, 0x82, 0x16, 0x9c, 0xa3, 0xa0, 0x55, 0x9b, 0xb7,
-	0x02, 0x50, 0x3f, 0xab, 0xa0, 0xd5, 0xb8, 0x58, 0x91, 0xa3, 0x79, 0x8e, 0x82, 0xd6, 0x5f, 0xe4,
-	0x60, 0x69, 0xcf, 0x66, 0x9a, 0x1f, 0x24, 0x14, 0x6a, 0x46, 0x69, 0xeb, 0x42, 0x1a, 0x74, 0x1b,
-	0x6a, 0x7d, 0xcb, 0xd1, 0x8f, 0x45, 0x5c, 0x2e, 0xf0, 0x67, 0x66, 0x24, 0xf1, 0x18, 0x4c, 0xa0,
-	0x38, 0x08, 0x2d, 0x4b, 0xbe, 0x0c, 0xb2, 0x6f, 0xe5, 0xbf, 0x72, 0xd0, 0xdc, 0x37, 0xfd, 0xe0,
-	0xc2, 0xca, 0xfe, 0x11, 0xd4, 0x31, 0x8e, 0xc5, 0x3b, 0x2d, 0x64, 0x5d, 0x20, 0xf2, 0x88, 0x8d,
-	0x5e, 0xb4, 0xe0, 0x7b, 0x64, 0xfa, 0x81, 0xe3, 0x9d, 0x0a, 0xf5, 0x97, 0xcd, 0xe8, 0x58, 0xa5,
-	0xf8, 0x58, 0xa4, 0x03, 0x95, 0x37, 0xdf, 0x3e, 0x33, 0xad, 0x80, 0x7a, 0xe2, 0x69, 0x3f, 0x6a,
-	0x2b, 0x2e, 0x2c, 0x3e, 0xb3, 0x42, 0xff, 0x28, 0x71, 0xe4, 0xfb, 0xb0, 0xc0, 0xb7, 0x22, 0x7f,
-	0x45, 0x32, 0xbe, 0x17, 0xd9, 0x4d, 0x9e, 0x40, 0x3d, 0x70, 0x7a, 0xf2, 0xf4, 0xf2, 0x47, 0x23,
-	0x93, 0x02, 0xaa, 0x05, 0x8e, 0xfc, 0xf6, 0x95, 0x8f, 0xa0, 0xb5, 0x43, 0x2d, 0x9a, 0x72, 0x29,
-	0xd3, 0x35, 0x40, 0xf9, 0x03, 0x68, 0x76, 0x03, 0xc7, 0xfd, 0x5f, 0x56, 0x99, 0xd8, 0x44, 0x0a,
-	0x49, 0x13, 0x51, 0xfe, 0x33, 0x0f, 0xab, 0xaf, 0x5d, 0x83, 0x3b, 0x41, 0x6e, 0x54, 0xf3, 0xed,
-	0xe2, 0x5e, 0x3a, 0x1f, 0x9d, 0xc3, 0x36, 0x53, 0x0b, 0xff, 0x9f, 0x94, 0xf5, 0x7f, 0x2a, 0x37,
-	0x97, 0xf6, 0xa6, 0xd5, 0x33, 0x2b, 0x5f, 0xb3, 0xcb, 0xfa, 0xca, 0x3f, 0xe4, 0xa1, 0xf9, 0x9c,
-	0x06, 0xfb, 0xce, 0xd0, 0xbf, 0x98, 0x15, 0x4e, 0x7f, 0x39, 0x8f, 0xa4, 0x32, 0x40, 0x0b, 0xf0,
-	0xc5, 0xaf, 0xf8, 0x50, 0x0c, 0xdc, 0x28, 0xfc, 0xf8, 0x39, 0xbd, 0x38, 0xe5, 0x39, 0x7d, 0x0d,
-	0xca, 0x23, 0xcd, 0x67, 0x46, 0xc5, 0x8d, 0x4d, 0xb4, 0x18, 0x7d, 0xe0, 0x58, 0x96, 0xf3, 0x16,
-	0xe5, 0x5d, 0x51, 0x45, 0x0b, 0x5f, 0x99, 0x34, 0x53, 0xbe, 0x9d, 0xe0, 0x37, 0xb9, 0x0f, 0xad,
-	0xd0, 0xa7, 0x3d, 0xcb, 0x39, 0x36, 0x7b, 0x7d, 0x4d, 0x3f, 0xa6, 0x36, 0x97, 0x6f, 0x45, 0x6d,
-	0x86, 0x3e, 0xdd, 0x77, 0x8e, 0xcd, 0x2d, 0x4e, 0x25, 0x1b, 0x50, 0xf2, 0x4d, 0x5b, 0xa7, 0x22,
-	0x8e, 0x4c, 0xc1, 0x66, 0x9c, 0x4f, 0xf9, 0xfb, 0x3c, 0xc0, 0xbe, 0x33, 0xfc, 0x9a, 0xfa, 0xbe,
-	0x36, 0xc4, 0x9c, 0x23, 0x42, 0x01, 0x89, 0x72, 0x47, 0x14, 0xef, 0x5f, 0x6a, 0x23, 0x3a, 0xc7,
-	0x53, 0x62, 0xea, 0x5d, 0xb2, 0x30, 0xf5, 0x5d, 0xf2, 0x1e, 0x54, 0x38, 0x98, 0x33, 0x79, 0x90,
-	0xae, 0x6e, 0xd5, 0xde, 0xfd, 0x78, 0x7b, 0x81, 0xff, 0x68, 0x61, 0x47, 0x5d, 0xc0, 0xce, 0x3d,
-	0xe3, 0x4c, 0x39, 0xca, 0x87, 0xc3, 0xf2, 0xd4, 0x87, 0xc3, 0xe8, 0x47, 0x87, 0xfc, 0x87, 0x49,
-	0xfc, 0x47, 0x87, 0x0f, 0x21, 0x1f, 0xf8, 0xa2, 0xd0, 0x30, 0x2d, 0x32, 0xe5, 0x03, 0x9f, 0x59,
-	0xd9, 0x88, 0xcb, 0x08, 0x45, 0x5b, 0x55, 0x65, 0x53, 0xf9, 0x0d, 0x2c, 0xab, 0xdc, 0xe0, 0xf8,
-	0xbd, 0xcf, 0x67, 0xf5, 0xe3, 0xea, 0x95, 0x9f, 0x50, 0x2f, 0xe5, 0x33, 0x58, 0x16, 0x51, 0x30,
-	0x35, 0xf1, 0x3c, 0x3f, 0xe2, 0x50, 0xbe, 0x81, 0x16, 0x0b, 0x51, 0xe7, 0xd9, 0x51, 0x94, 0x79,
-	0xe5, 0xcf, 0xce, 0xbc, 0x94, 0x2d, 0xa8, 0x46, 0x99, 0x45, 0xe2, 0xf1, 0x33, 0x97, 0x7c, 0xfc,
-	0x64, 0x56, 0xce, 0x92, 0x20, 0xf1, 0xb4, 0xcd, 0x1f, 0x46, 0xab, 0x8c, 0xc2, 0x5f, 0xb1, 0x7f,
-	0xc8, 0x41, 0x33, 0x8d, 0xbf, 0xc9, 0xd7, 0xd0, 0xb0, 0x1d, 0x83, 0xf6, 0x7c, 0x6a, 0x51, 0x3d,
-	0x70, 0x3c, 0x11, 0x52, 0xee, 0x67, 0xc3, 0xf5, 0x47, 0x2f, 0x1d, 0x83, 0x76, 0x05, 0x2b, 0x4f,
-	0xb7, 0xeb, 0x76, 0x82, 0x44, 0x1e, 0xc1, 0xb2, 0xeb, 0x99, 0x8e, 0x67, 0x06, 0xa7, 0x3d, 0xdd,
-	0xd2, 0x7c, 0x9f, 0xeb, 0x32, 0x2f, 0xb0, 0x2d, 0xc9, 0xae, 0x6d, 0xd6, 0xc3, 0x14, 0xba, 0xf3,
-	0x25, 0x2c, 0x4d, 0x4c, 0x79, 0xae, 0x1f, 0x18, 0xfe, 0x00, 0xb0, 0xca, 0x11, 0x70, 0xe4, 0x68,
-	0x2e, 0xe4, 0x93, 0xe2, 0x52, 0x50, 0x7e, 0xbe, 0x52, 0xd0, 0xb9, 0x8b, 0x4d, 0x59, 0xb5, 0xa3,
-	0xe2, 0x85, 0x6b, 0x47, 0xa5, 0xa9, 0xb5, 0xa3, 0x35, 0x28, 0x87, 0x18, 0x11, 0xa5, 0x8b, 0xe3,
-	0xad, 0xc9, 0xc2, 0xc6, 0x42, 0x46, 0x61, 0x23, 0x4e, 0xa5, 0x2a, 0xc9, 0x54, 0x2a, 0xb3, 0xde,
-	0x51, 0xbd, 0x6c, 0xbd, 0x03, 0x7e, 0x9a, 0x7a, 0x47, 0xed, 0x12, 0xf5, 0x8e, 0xfa, 0xfc, 0xf5,
-	0x8e, 0xc6, 0xac, 0x7a, 0x47, 0x73, 0x56, 0xbd, 0x63, 0x71, 0xb2, 0xde, 0x71, 0x03, 0x7f, 0xdf,
-	0xc8, 0x03, 0x2d, 0x16, 0x90, 0x2a, 0x6a, 0x4c, 0xc8, 0xa8, 0x70, 0x2c, 0x4d, 0xaf, 0x70, 0x90,
-	0x79, 0x2b, 0x1c, 0xcb, 0x73, 0x57, 0x38, 0x56, 0x2e, 0x52, 0xe1, 0x58, 0xbd, 0x54, 0x85, 0x63,
-	0xed, 0x3c, 0x15, 0x8e, 0xac, 0x8a, 0x51, 0xa2, 0x88, 0xd1, 0x9e, 0x5a, 0xc4, 0xb8, 0x36, 0x4f,
-	0x11, 0xa3, 0x73, 0xe1, 0x22, 0xc6, 0xf5, 0x29, 0x45, 0x8c, 0x1b, 0x63, 0x45, 0x8c, 0xb1, 0x4a,
-	0xcd, 0xcd, 0x99, 0x95, 0x9a, 0x64, 0x79, 0xe3, 0xd6, 0x05, 0xca, 0x1b, 0xb7, 0x33, 0xca, 0x1b,
-	0xca, 0x33, 0x58, 0x13, 0xd1, 0xef, 0x52, 0x2e, 0x55, 0xf9, 0xab, 0x1c, 0x2c, 0xb3, 0x50, 0x78,
-	0x39, 0xc7, 0x9c, 0x48, 0xa5, 0xf2, 0xe9, 0x54, 0xea, 0x01, 0xb4, 0x34, 0x06, 0xdc, 0x7a, 0xa6,
-	0xad, 0x3b, 0x23, 0x97, 0xa5, 0x2c, 0x22, 0x8f, 0x5c, 0x44, 0xfa, 0x5e, 0x44, 0x4e, 0x65, 0x58,
-	0xc5, 0xb1, 0x0c, 0xeb, 0x4f, 0x73, 0xb0, 0xca, 0x13, 0x9e, 0xcb, 0x6d, 0xb4, 0x05, 0x05, 0xcd,
-	0xb2, 0x70, 0x93, 0x15, 0x95, 0x7d, 0xb2, 0xa8, 0x35, 0x70, 0x3c, 0x5d, 0xee, 0x8a, 0x37, 0x98,
-	0x06, 0x1c, 0x53, 0xea, 0xf2, 0x17, 0x78, 0x9e, 0xdd, 0x56, 0x18, 0x41, 0xa5, 0xae, 0xa3, 0xec,
-	0xc0, 0x4a, 0x97, 0x01, 0x9a, 0xcb, 0x49, 0x7e, 0x1b, 0x96, 0x59, 0x3e, 0x76, 0xb9, 0x49, 0xfe,
-	0x32, 0x07, 0x44, 0x0d, 0xed, 0xcb, 0x09, 0xe5, 0x13, 0x00, 0xd7, 0x73, 0x4e, 0xa8, 0xad, 0x31,
-	0x68, 0xcc, 0xf3, 0xcf, 0x76, 0x5a, 0xa1, 0x0f, 0xa2, 0x7e, 0x35, 0xc1, 0x9b, 0x80, 0xba, 0x85,
-	0x6c, 0xa8, 0xab, 0x7c, 0x01, 0x4d, 0x35, 0xb4, 0xb7, 0x3d, 0xc7, 0xbe, 0xd8, 0x01, 0x1f, 0xc0,
-	0x32, 0x47, 0x0e, 0xfc, 0xbf, 0x72, 0xe4, 0x24, 0x2c, 0x43, 0x37, 0x2d, 0x3e, 0x41, 0x5d, 0xc5,
-	0x6f, 0xe5, 0x73, 0x58, 0xe6, 0x2a, 0x92, 0x66, 0xbd, 0x07, 0x65, 0xfe, 0x9f, 0x3e, 0x62, 0xb5,
-	0x66, 0xfa, 0x5f, 0x84, 0x54, 0xd1, 0xab, 0x7c, 0x01, 0x2b, 0xc2, 0xa2, 0x2e, 0x36, 0xfe, 0x06,
-	0x94, 0x39, 0x25, 0xf3, 0x2d, 0xf4, 0xb7, 0x39, 0x00, 0xde, 0x8d, 0xaf, 0x69, 0x73, 0x4e, 0x1a,
-	0xfd, 0xa8, 0x2e, 0x9f, 0xf8, 0x51, 0xdd, 0x1e, 0x10, 0x7c, 0x45, 0x32, 0x1d, 0xbb, 0x17, 0xfd,
-	0xff, 0x98, 0x40, 0x37, 0xd3, 0x70, 0xfa, 0x92, 0x1c, 0x15, 0x91, 0x94, 0x2d, 0xf9, 0x9f, 0x62,
-	0xbc, 0xc8, 0xf9, 0x04, 0x6a, 0x7c, 0xdd, 0x64, 0x89, 0x93, 0xa4, 0xb7, 0x86, 0x05, 0x4e, 0xf0,
-	0xa3, 0x6f, 0x65, 0x15, 0x96, 0x37, 0xf5, 0xc0, 0x3c, 0xd1, 0x02, 0xba, 0x19, 0x06, 0x47, 0x42,
-	0x6c, 0xca, 0x1a, 0xac, 0xa4, 0xc9, 0xbe, 0xeb, 0xd8, 0x3e, 0x7d, 0xe8, 0xe1, 0x0f, 0xd5, 0x79,
-	0xf9, 0xa8, 0x05, 0xf5, 0x17, 0xaf, 0xb6, 0x7a, 0xdd, 0xc3, 0x4d, 0xf5, 0x70, 0xef, 0xe5, 0xf3,
-	0xd6, 0x15, 0xb2, 0x08, 0x35, 0x46, 0x51, 0x5f, 0xbf, 0x7c, 0xc9, 0x08, 0x39, 0x49, 0x78, 0xb6,
-	0xb9, 0xb7, 0xff, 0x5a, 0xdd, 0x6d, 0xe5, 0x25, 0xa1, 0xfb, 0x7a, 0x7b, 0x7b, 0xb7, 0xdb, 0x6d,
-	0x15, 0x48, 0x13, 0x80, 0x11, 0xbe, 0xda, 0xdb, 0xdf, 0xdf, 0xdd, 0x69, 0x15, 0xc9, 0x12, 0x34,
-	0x58, 0x7b, 0xf7, 0xb9, 0xba, 0xdb, 0xed, 0xb2, 0x49, 0x4a, 0x0f, 0x5f, 0x01, 0xc4, 0x3f, 0xcf,
-	0x26, 0x00, 0x65, 0x36, 0xdd, 0xee, 0x4e, 0xeb, 0x0a, 0xa9, 0xc1, 0x82, 0x9c, 0x29, 0x87, 0x8d,
-	0xaf, 0xf6, 0x0e, 0x0e, 0x76, 0x77, 0x5a, 0x79, 0x52, 0x87, 0x4a, 0xb4, 0xaf, 0x02, 0x69, 0x40,
-	0x55, 0xdd, 0xdd, 0x7e, 0xf5, 0xcd, 0xae, 0xca, 0xd6, 0x78, 0xf8, 0x25, 0xd4, 0x12, 0xef, 0xe1,
-	0x6c, 0x4f, 0x07, 0xaf, 0x76, 0xa2, 0x5d, 0x5f, 0x91, 0x84, 0x78, 0xea, 0x26, 0x00, 0x23, 0x88,
-	0x75, 0xf3, 0x0f, 0xff, 0x26, 0x17, 0x17, 0x98, 0xf9, 0x1c, 0xab, 0xb0, 0x74, 0xb0, 0x77, 0xb0,
-	0xbb, 0xbf, 0xf7, 0x72, 0x37, 0x29, 0x90, 0x15, 0x68, 0x45, 0xe4, 0x58, 0x2a, 0x57, 0x61, 0x39,
-	0xa6, 0xee, 0x46, 0xec, 0xf9, 0x14, 0xbb, 0x94, 0x59, 0x81, 0x2c, 0xc3, 0x62, 0x44, 0x3d, 0xd8,
-	0x7c, 0xdd, 0x45, 0x39, 0x25, 0x59, 0xbb, 0x87, 0x9b, 0x2f, 0x77, 0xb6, 0x7e, 0xaf, 0x55, 0x4a,
-	0x6d, 0x63, 0x5b, 0xdd, 0xec, 0xfe, 0x8a, 0xcd, 0x5b, 0x7e, 0xfc, 0x27, 0x0d, 0x28, 0x6c, 0x1e,
-	0xec, 0x91, 0x8f, 0xa1, 0x1a, 0x95, 0xb2, 0x49, 0x3b, 0xfe, 0x67, 0x84, 0x74, 0x75, 0xbb, 0x93,
-	0x4c, 0x9f, 0x94, 0x2b, 0xe4, 0x33, 0x80, 0xb8, 0x60, 0x49, 0xae, 0xc5, 0x20, 0x6e, 0xac, 0x88,
-	0xd9, 0x59, 0x4c, 0x8c, 0x43, 0xe5, 0xba, 0x42, 0x9e, 0xc2, 0x82, 0xa8, 0x26, 0x92, 0x28, 0xb2,
-	0xa7, 0xcb, 0x8b, 0x19, 0xa3, 0x3e, 0xcc, 0x91, 0x4f, 0xa0, 0x22, 0x6b, 0x72, 0x24, 0xc2, 0xee,
-	0x63, 0x55, 0xba, 0xec, 0x91, 0x5f, 0x42, 0x35, 0xaa, 0xad, 0xc5, 0x67, 0x1c, 0x2f, 0xb7, 0x75,
-	0xd6, 0x26, 0x4c, 0x6d, 0x77, 0xe4, 0x06, 0xa7, 0xca, 0x15, 0xf2, 0x3b, 0xb0, 0x20, 0x2a, 0x6d,
-	0xf1, 0x96, 0xd3, 0xa5, 0xb7, 0x29, 0x83, 0x7f, 0x17, 0xea, 0xc9, 0xb4, 0x96, 0x5c, 0x1f, 0x93,
-	0x56, 0x32, 0x67, 0xed, 0x2c, 0xa5, 0xb2, 0x5b, 0x21, 0xb1, 0x5f, 0x42, 0x35, 0x4a, 0x6e, 0xe3,
-	0xfd, 0x8f, 0xe7, 0xbb, 0x99, 0x63, 0x3f, 0xcc, 0x91, 0x5d, 0xfc, 0x09, 0x71, 0x94, 0xaf, 0xc7,
-	0xeb, 0x67, 0x64, 0xf1, 0x53, 0x8e, 0xb1, 0x07, 0xcd, 0x74, 0xc6, 0x47, 0x6e, 0xa6, 0xb5, 0x65,
-	0x2c, 0x64, 0x4d, 0x9d, 0x6a, 0x71, 0x0c, 0xea, 0x90, 0x5b, 0x63, 0x42, 0x19, 0x9f, 0x2c, 0xf3,
-	0x59, 0x46, 0xb9, 0x42, 0x76, 0xa0, 0x9e, 0x04, 0x3b, 0xf1, 0xe1, 0x32, 0x20, 0x50, 0x67, 0x35,
-	0x6b, 0x12, 0x9f, 0x9f, 0x2d, 0x8d, 0x45, 0xe2, 0xb3, 0x65, 0x62, 0x94, 0x29, 0x67, 0x7b, 0x0e,
-	0x8d, 0x14, 0x94, 0x20, 0x37, 0x62, 0x85, 0x99, 0x44, 0x18, 0x53, 0x26, 0xda, 0x85, 0x7a, 0x12,
-	0x4d, 0xc4, 0x27, 0xcb, 0xc0, 0x18, 0x53, 0xa6, 0xd9, 0x86, 0x5a, 0x02, 0x4e, 0x90, 0xe8, 0x1f,
-	0x70, 0x27, 0x31, 0xc6, 0x74, 0xfd, 0x17, 0x31, 0x3f, 0xd6, 0xff, 0x34, 0x08, 0x98, 0x7e, 0x90,
-	0x64, 0xc0, 0x8f, 0x0f, 0x92, 0x01, 0x03, 0xa6, 0x4f, 0x93, 0x04, 0x03, 0xf1, 0x34, 0x19, 0x10,
-	0x61, 0xea, 0x51, 0x80, 0xa9, 0x86, 0x98, 0xe4, 0x0c, 0xbe, 0xce, 0xf2, 0x64, 0x88, 0xf4, 0x51,
-	0x98, 0x8d, 0x14, 0xa2, 0x88, 0x2f, 0x37, 0x0b, 0x68, 0x74, 0x32, 0x02, 0xad, 0x72, 0x85, 0x7c,
-	0x2e, 0xbd, 0xd1, 0xa6, 0x65, 0x9d, 0xb9, 0x81, 0xb3, 0x0f, 0xf0, 0x29, 0x2c, 0x88, 0x32, 0x70,
-	0x7c, 0x17, 0xe9, 0xba, 0x70, 0xbc, 0x6e, 0x5c, 0xe8, 0x44, 0x4f, 0xf0, 0x15, 0xd4, 0x93, 0x11,
-	0x3c, 0x16, 0x61, 0x46, 0xb8, 0xef, 0xdc, 0xc8, 0xee, 0xe4, 0x41, 0x9f, 0xdb, 0x4c, 0xba, 0xfc,
-	0x1f, 0xdb, 0x4c, 0xe6, 0xb3, 0xc0, 0xd9, 0x47, 0xda, 0xfa, 0xff, 0xff, 0xf8, 0xee, 0x56, 0xee,
-	0x87, 0x77, 0xb7, 0x72, 0xff, 0xf6, 0xee, 0x56, 0xee, 0xf7, 0x1f, 0x0c, 0xcd, 0xe0, 0x28, 0xec,
-	0x3f, 0xd2, 0x9d, 0xd1, 0x86, 0xab, 0xe9, 0x47, 0xa7, 0x06, 0xf5, 0x92, 0x5f, 0x27, 0x8f, 0x37,
-	0x7c, 0x4f, 0xdf, 0x70, 0x5d, 0xbf, 0x5f, 0xc6, 0xa9, 0x9e, 0xfc, 0x4f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xeb, 0x11, 0x27, 0x36, 0xba, 0x3f, 0x00, 0x00,
+	// 5068 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5c, 0x3b, 0x6c, 0x1c, 0x49,
+	0x7a, 0xd6, 0x4c, 0xcf, 0x0c, 0x67, 0xfe, 0x79, 0x70, 0x58, 0x7c, 0xa8, 0x45, 0xbd, 0xa8, 0xd6,
+	0xae, 0x8e, 0xd2, 0xed, 0x49, 0xbb, 0xd2, 0x5a, 0x5e, 0xed, 0xdd, 0xee, 0x9a, 0x2f, 0xe9, 0xa8,
+	0xe3, 0x4a, 0xbc, 0x1e, 0x69, 0x0f, 0x76, 0xd2, 0x68, 0x76, 0xd7, 0x0c, 0x5b, 0xec, 0xe9, 0xee,
+	0xed, 0x07, 0x25, 0x2e, 0x0c, 0xd8, 0x81, 0x23, 0x07, 0x06, 0x8c, 0x03, 0x1c, 0x19, 0x8e, 0x0c,
+	0x38, 0x32, 0xe0, 0xd4, 0x91, 0x13, 0x07, 0x0e, 0x6c, 0xe0, 0x00, 0x07, 0xce, 0x16, 0x86, 0xe0,
+	0xe4, 0x02, 0x03, 0x0e, 0x1d, 0x38, 0x30, 0xfe, 0xaa, 0xea, 0xd7, 0x4c, 0x73, 0x66, 0x48, 0xae,
+	0x1d, 0xb1, 0xeb, 0xaf, 0xbf, 0xde, 0xff, 0xf3, 0xab, 0x1a, 0x42, 0xdb, 0xf3, 0x82, 0x07, 0x9e,
+	0x17, 0xdc, 0xf7, 0x7c, 0x37, 0x74, 0x49, 0xcd, 0xf3, 0x02, 0xed, 0xf8, 0xe1, 0xea, 0xd5, 0x81,
+	0xeb, 0x0e, 0x6c, 0xfa, 0x80, 0x51, 0x0f, 0xa2, 0xfe, 0x03, 0x3a, 0xf4, 0xc2, 0x13, 0xce, 0xb4,
+	0x7a, 0x73, 0xb4, 0x32, 0xb4, 0x86, 0x34, 0x08, 0xf5, 0xa1, 0x27, 0x18, 0x6e, 0x8c, 0x32, 0x98,
+	0x91, 0xaf, 0x87, 0x96, 0xeb, 0x88, 0xfa, 0xa5, 0x81, 0x3b, 0x70, 0xd9, 0xe7, 0x03, 0xfc, 0x12,
+	0xd4, 0xb6, 0xd7, 0x0f, 0x1e, 0x78, 0x7d, 0x31, 0x15, 0xe5, 0x08, 0x9a, 0x3d, 0x6a, 0xf8, 0x34,
+	0xfc, 0xda, 0x8d, 0x9c, 0x90, 0x10, 0xa8, 0x38, 0xfa, 0x90, 0xca, 0xa5, 0xb5, 0xd2, 0x7a, 0x43,
+	0x65, 0xdf, 0xa4, 0x0b, 0xd2, 0x11, 0x3d, 0x91, 0xcb, 0x8c, 0x84, 0x9f, 0xe4, 0x3a, 0xc0, 0x10,
+	0xd9, 0x35, 0x4f, 0x0f, 0x0f, 0x65, 0x89, 0x55, 0x34, 0x18, 0x65, 0x5f, 0x0f, 0x0f, 0xc9, 0x65,
+	0x98, 0xa3, 0xce, 0xb1, 0x76, 0xac, 0xfb, 0x72, 0x85, 0xd5, 0xd5, 0xa8, 0x73, 0xfc, 0x8d, 0xee,
+	0x2b, 0x7f, 0x52, 0x81, 0xc6, 0x2b, 0x5f, 0x77, 0x82, 0xbe, 0xeb, 0x0f, 0xc9, 0x12, 0x54, 0xad,
+	0xa1, 0x3e, 0x88, 0x07, 0xe3, 0x05, 0x1c, 0xcd, 0x18, 0x9a, 0x72, 0x79, 0x4d, 0xc2, 0xd1, 0x8c,
+	0xa1, 0xc9, 0xba, 0xf3, 0x7d, 0x0d, 0xa9, 0x12, 0xa3, 0xd6, 0xa8, 0xef, 0x6f, 0x0d, 0x4d, 0xf2,
+	0x11, 0x48, 0xd4, 0x39, 0x96, 0x2b, 0x6b, 0xd2, 0x7a, 0xf3, 0xe1, 0xea, 0x7d, 0xbe, 0xa9, 0xf7,
+	0x93, 0x01, 0xee, 0xef, 0x38, 0xc7, 0x3b, 0x4e, 0xe8, 0x9f, 0xa8, 0xc8, 0x46, 0x7e, 0x02, 0x73,
+	0x01, 0x5b, 0x69, 0x20, 0x57, 0x59, 0x8b, 0xc5, 0xb8, 0x45, 0x66, 0x03, 0xd4, 0x98, 0x87, 0x7c,
+	0x04, 0x84, 0x4d, 0x48, 0xf3, 0x22, 0xdb, 0xd6, 0xe2, 0x96, 0x35, 0x36, 0x81, 0x2e, 0xab, 0xd9,
+	0x8f, 0x6c, 0xbb, 0x27, 0xb8, 0x97, 0xa0, 0x1a, 0x84, 0xa6, 0xe5, 0xc8, 0x73, 0x8c, 0x81, 0x17,
+	0xc8, 0x55, 0x68, 0xe0, 0xcc, 0x79, 0x4d, 0x9d, 0xd5, 0xd4, 0xa9, 0xef, 0xf7, 0x58, 0xe5, 0x47,
+	0x40, 0x74, 0xc3, 0xa0, 0x5e, 0xa8, 0xf9, 0x34, 0x8c, 0x7c, 0x47, 0x33, 0x5c, 0x93, 0xca, 0x8d,
+	0x35, 0x69, 0x5d, 0x52, 0xbb, 0xbc, 0x46, 0x65, 0x15, 0x5b, 0xae, 0x49, 0x71, 0x00, 0x93, 0x1e,
+	0x44, 0x03, 0x19, 0xd6, 0x4a, 0xeb, 0x75, 0x95, 0x17, 0xf0, 0xb8, 0xa2, 0x80, 0xfa, 0x72, 0x93,
+	0x1f, 0x17, 0x7e, 0x93, 0x9b, 0xd0, 0x7c, 0xeb, 0xfa, 0x47, 0x96, 0x33, 0xd0, 0x4c, 0xcb, 0x97,
+	0x5b, 0xac, 0x0a, 0x04, 0x69, 0xdb, 0xf2, 0xc9, 0x0d, 0x00, 0xd3, 0x35, 0x8e, 0xa8, 0xdf, 0xb7,
+	0x6c, 0x2a, 0xb7, 0x79, 0x7d, 0x4a, 0x21, 0x3f, 0x82, 0xea, 0x41, 0x64, 0xd9, 0xa6, 0xdc, 0x59,
+	0x2b, 0xad, 0x37, 0x1f, 0x2e, 0xc4, 0xdb, 0xb4, 0x89, 0xc4, 0x9e, 0x47, 0x0d, 0x95, 0xd7, 0xaf,
+	0x3e, 0x86, 0x7a, 0xbc, 0xc5, 0xb1, 0x90, 0x94, 0x52, 0x21, 0x59, 0x82, 0xea, 0xb1, 0x6e, 0x47,
+	0x54, 0x08, 0x0e, 0x2f, 0x7c, 0x5e, 0xfe, 0xac, 0xa4, 0xfc, 0x12, 0x1a, 0x49, 0x5f, 0xb8, 0x04,
+	0x26, 0x45, 0x42, 0xe2, 0xf0, 0x9b, 0xac, 0x42, 0xdd, 0xd6, 0x9d, 0x41, 0xa4, 0x0f, 0xe2, 0xd6,
+	0x49, 0x39, 0x95, 0x1a, 0x29, 0x23, 0x35, 0xca, 0x5d, 0xa8, 0xbe, 0x7a, 0xfa, 0xdc, 0x3d, 0x20,
+	0x6b, 0x50, 0x0b, 0xfb, 0xda, 0x1b, 0xf7, 0x80, 0x77, 0xb8, 0xd9, 0x78, 0xff, 0xfd, 0x4d, 0x5e,
+	0xa5, 0x56, 0xc3, 0xfe, 0x73, 0xf7, 0x40, 0x59, 0x85, 0xda, 0xce, 0xc0, 0xa7, 0x41, 0x80, 0x73,
+	0x7e, 0xad, 0xee, 0xc5, 0x73, 0x7e, 0xad, 0xee, 0x29, 0xd7, 0x41, 0xc2, 0x4e, 0x56, 0xa0, 0x6c,
+	0x99, 0xa2, 0x83, 0xda, 0xfb, 0xef, 0x6f, 0x96, 0x77, 0xb7, 0xd5, 0xb2, 0x65, 0x2a, 0x7f, 0x5c,
+	0x86, 0xfa, 0xd7, 0x34, 0xd4, 0x4d, 0x3d, 0xd4, 0xc9, 0x16, 0x34, 0x75, 0xc7, 0x71, 0x43, 0xa6,
+	0x72, 0x81, 0x5c, 0x62, 0x32, 0x75, 0x2b, 0xde, 0xac, 0x98, 0xed, 0xfe, 0x46, 0xca, 0xc3, 0x85,
+	0x31, 0xdb, 0x8a, 0x7c, 0x0a, 0x35, 0x5b, 0x3f, 0xa0, 0x76, 0xc0, 0x04, 0xbe, 0xf9, 0xf0, 0xda,
+	0x58, 0xfb, 0x3d, 0x56, 0xcd, 0x9b, 0x0a, 0xde, 0xd5, 0x2f, 0xa1, 0x3b, 0xda, 0xed, 0x59, 0x0e,
+	0x60, 0xf5, 0x09, 0x34, 0x33, 0xdd, 0x9e, 0xe9, 0xec, 0xfe, 0x08, 0xe6, 0x7a, 0xd4, 0x3f, 0xb6,
+	0x0c, 0x4a, 0x6e, 0x43, 0xdb, 0x72, 0x42, 0xea, 0x3b, 0xba, 0xad, 0x79, 0xae, 0x1f, 0xb2, 0x0e,
+	0xaa, 0x6a, 0x2b, 0x26, 0xee, 0xbb, 0x7e, 0x88, 0x4c, 0xf4, 0x5d, 0x96, 0xa9, 0xcc, 0x99, 0xe8,
+	0xbb, 0x0c, 0x13, 0xee, 0xb7, 0x27, 0x4b, 0x99, 0xfd, 0xde, 0x57, 0xcb, 0x96, 0x87, 0xb2, 0x11,
+	0x9e, 0x78, 0x54, 0x58, 0x11, 0xf6, 0xad, 0x3c, 0x84, 0x6a, 0xcf, 0x73, 0xa3, 0x90, 0xdc, 0x45,
+	0x7d, 0x66, 0x33, 0x61, 0x03, 0x37, 0x1f, 0xce, 0xa7, 0xfa, 0xcc, 0xc8, 0x6a, 0x5c, 0xaf, 0xfc,
+	0x45, 0x05, 0xea, 0xfb, 0x4f, 0x7b, 0xbb, 0x8e, 0x17, 0x15, 0x9b, 0x38, 0x02, 0x15, 0x9f, 0x7a,
+	0xae, 0x58, 0x2e, 0xfb, 0x46, 0xe5, 0xc5, 0xbf, 0x1a, 0x9b, 0x01, 0xd7, 0x92, 0x3a, 0x12, 0x5e,
+	0x9d, 0x78, 0x94, 0xac, 0x40, 0xed, 0xc0, 0xd7, 0x1d, 0x23, 0xb6, 0x7e, 0xa2, 0x84, 0x74, 0xc3,
+	0x1d, 0x0e, 0xad, 0x30, 0xb6, 0x7c, 0xbc, 0x84, 0x03, 0x0c, 0x6c, 0xf7, 0x40, 0xae, 0xf2, 0x01,
+	0xf0, 0x1b, 0xed, 0xda, 0x1b, 0xd7, 0x72, 0x34, 0xd7, 0x91, 0x6b, 0x9c, 0x19, 0x8b, 0x2f, 0x1d,
+	0x34, 0xaf, 0x6e, 0x14, 0x52, 0x5f, 0xc3, 0xb2, 0x3c, 0xc7, 0x14, 0xbe, 0xc1, 0x28, 0xcf, 0x5d,
+	0xcb, 0x21, 0x57, 0xa0, 0x3e, 0xf0, 0xdd, 0xc8, 0xd3, 0x0e, 0x4e, 0xe4, 0x3a, 0x6b, 0x38, 0xc7,
+	0xca, 0x9b, 0x27, 0x38, 0x8c, 0xad, 0x7f, 0x77, 0x22, 0x37, 0x58, 0x1b, 0xf6, 0x8d, 0xf6, 0x80,
+	0xb9, 0x15, 0x0d, 0x95, 0x3b, 0x10, 0xf6, 0x03, 0x18, 0xe9, 0x29, 0x52, 0x48, 0x07, 0xca, 0xc1,
+	0x23, 0x66, 0x42, 0xea, 0x6a, 0x39, 0x78, 0x84, 0x1b, 0x1b, 0xfa, 0xd6, 0x60, 0x40, 0xb9, 0xf1,
+	0x60, 0x1b, 0xdb, 0x17, 0xa6, 0x95, 0x91, 0xd5, 0xb8, 0x9e, 0xdc, 0x82, 0x16, 0x7d, 0x67, 0xd8,
+	0x91, 0x49, 0x35, 0xb6, 0xbc, 0x0e, 0x9b, 0x4e, 0x53, 0xd0, 0x9e, 0xe1, 0x2a, 0x3f, 0x80, 0xce,
+	0xd0, 0x72, 0xb4, 0xc0, 0xfa, 0x8e, 0x6a, 0x07, 0x27, 0x21, 0x0d, 0xe4, 0xf9, 0xb5, 0xd2, 0xba,
+	0xa4, 0xb6, 0x86, 0x96, 0xd3, 0xb3, 0xbe, 0xa3, 0x9b, 0x48, 0x63, 0x5c, 0xfa, 0xbb, 0x2c, 0x57,
+	0x57, 0x70, 0xe9, 0xef, 0x52, 0xae, 0xeb, 0x00, 0x68, 0x1f, 0x34, 0x9f, 0x0e, 0xe8, 0x3b, 0x79,
+	0x81, 0xfb, 0x1d, 0xa4, 0xa8, 0x48, 0x20, 0x1f, 0xc3, 0x92, 0x71, 0xa8, 0x3b, 0x03, 0x6a, 0x6a,
+	0x81, 0xe5, 0x18, 0x54, 0xf3, 0x74, 0x9f, 0x3a, 0xa1, 0x4c, 0xd8, 0xd2, 0x88, 0xa8, 0xeb, 0x61,
+	0xd5, 0x3e, 0xab, 0x51, 0xfe, 0xb9, 0x04, 0x8d, 0x2d, 0xdf, 0x75, 0x7e, 0x58, 0xc9, 0x10, 0x12,
+	0x20, 0x8d, 0x4a, 0x40, 0xe0, 0x51, 0x23, 0x96, 0x65, 0xfc, 0x26, 0xd7, 0xa0, 0xe1, 0x1e, 0x53,
+	0xff, 0xad, 0x6f, 0x85, 0x54, 0xae, 0x8a, 0x73, 0x8e, 0x09, 0xe4, 0x63, 0xf4, 0x29, 0xba, 0x1f,
+	0x32, 0xe9, 0x40, 0x07, 0xc7, 0xfd, 0xfd, 0xfd, 0xd8, 0xdf, 0xdf, 0x7f, 0x15, 0x07, 0x04, 0x2a,
+	0x67, 0x54, 0xfe, 0xa3, 0x04, 0x55, 0xbe, 0x14, 0x05, 0x24, 0xaf, 0x1f, 0x08, 0xc5, 0xe8, 0xc6,
+	0x8a, 0x11, 0xeb, 0x80, 0x8a, 0x95, 0xe4, 0x16, 0x54, 0x98, 0x80, 0x71, 0xcb, 0xd3, 0x8e, 0x99,
+	0x38, 0x07, 0xab, 0x22, 0xb7, 0xa1, 0xca, 0x44, 0x4b, 0x96, 0x8a, 0x78, 0x78, 0x1d, 0x32, 0x19,
+	0xbe, 0x1b, 0x04, 0x72, 0xa5, 0x90, 0x89, 0xd5, 0x21, 0x53, 0xe4, 0x58, 0xae, 0x23, 0x57, 0x0b,
+	0x99, 0x58, 0x1d, 0xf9, 0x10, 0x2a, 0x86, 0x2f, 0xd4, 0x21, 0xe3, 0x78, 0x92, 0x13, 0x52, 0x59,
+	0xb5, 0xe2, 0x40, 0xfd, 0xb9, 0x7b, 0x70, 0xfa, 0x99, 0xdd, 0x49, 0x8e, 0xa0, 0xcc, 0x3a, 0xea,
+	0xc4, 0xf2, 0xbb, 0xc5, 0xa8, 0x63, 0x4a, 0x29, 0x65, 0x94, 0x32, 0xd6, 0xa0, 0x4a, 0xaa, 0x41,
+	0xca, 0x4b, 0x98, 0xdf, 0xd7, 0x7d, 0xdd, 0xb6, 0xa9, 0x6d, 0x05, 0x43, 0xe6, 0xb5, 0x56, 0xa1,
+	0x6e, 0xb8, 0x4e, 0x10, 0xea, 0x0e, 0x37, 0x7b, 0x15, 0x35, 0x29, 0x93, 0x35, 0x68, 0x1a, 0x2e,
+	0xed, 0xf7, 0x2d, 0xc3, 0xa2, 0x0e, 0x9f, 0x43, 0x49, 0xcd, 0x92, 0x94, 0x47, 0xd0, 0x60, 0xb3,
+	0x47, 0xfd, 0x2b, 0x74, 0x80, 0x04, 0x2a, 0x87, 0x7a, 0x70, 0xc8, 0xda, 0xb6, 0x54, 0xf6, 0xad,
+	0x7c, 0x09, 0xd5, 0x6d, 0x3d, 0x8c, 0x86, 0xa7, 0x79, 0x27, 0x72, 0x1d, 0x24, 0xf4, 0x7b, 0x7c,
+	0xcd, 0xcd, 0x78, 0xf3, 0xd0, 0xf3, 0x21, 0x5d, 0xf9, 0xb7, 0x12, 0x34, 0x58, 0x07, 0xbb, 0x4e,
+	0xdf, 0xc5, 0xf3, 0x30, 0xb1, 0x20, 0x44, 0x24, 0x39, 0x0f, 0xc6, 0xa1, 0xf2, 0x3a, 0xb2, 0xce,
+	0x24, 0x30, 0xe4, 0x6e, 0xa0, 0xf3, 0x90, 0xe4, 0x98, 0x7a, 0x58, 0xa3, 0x72, 0x06, 0x72, 0x8f,
+	0x73, 0x06, 0x6c, 0x2f, 0x9b, 0x0f, 0x97, 0x12, 0x89, 0xf3, 0x5d, 0x83, 0x06, 0x01, 0xf2, 0x06,
+	0x9c, 0x37, 0x20, 0x77, 0xa1, 0x81, 0xe7, 0xc1, 0x7b, 0xae, 0x30, 0xfe, 0x56, 0x7c, 0x42, 0xb8,
+	0x23, 0x6a, 0xdd, 0xeb, 0xb3, 0x16, 0x94, 0x7c, 0x00, 0x15, 0x74, 0x82, 0x42, 0x68, 0xba, 0x59,
+	0x2e, 0x5c, 0x85, 0xca, 0x6a, 0x95, 0xbf, 0x2b, 0x41, 0x63, 0x63, 0x30, 0xf0, 0xe9, 0x00, 0xdb,
+	0x2c, 0x41, 0xd5, 0xc0, 0x50, 0x8e, 0xad, 0x4c, 0x52, 0x79, 0x01, 0x77, 0x74, 0x48, 0x75, 0x47,
+	0x9c, 0x06, 0xfb, 0x46, 0x55, 0x0d, 0x42, 0xd3, 0xa4, 0xc7, 0x6c, 0xd6, 0x25, 0x55, 0x94, 0xc8,
+	0x5d, 0xe8, 0xf6, 0xad, 0x7e, 0x78, 0xa8, 0x79, 0xd4, 0x37, 0xa8, 0x13, 0x5a, 0x36, 0x9f, 0x67,
+	0x49, 0x9d, 0x67, 0xf4, 0xfd, 0x84, 0x4c, 0x1e, 0xc3, 0x65, 0xc7, 0x72, 0x28, 0xb3, 0xae, 0x23,
+	0x2d, 0xaa, 0xac, 0xc5, 0x32, 0xaf, 0x7e, 0x9a, 0x6f, 0xa7, 0xfc, 0xb7, 0x04, 0xad, 0xec, 0xde,
+	0x90, 0x2f, 0xa1, 0x6d, 0xba, 0x6f, 0x1d, 0xdb, 0xd5, 0x4d, 0x0d, 0x03, 0x7d, 0x71, 0x2e, 0x57,
+	0xc6, 0x94, 0x7e, 0x5b, 0x04, 0xf9, 0x6a, 0x2b, 0xe6, 0x47, 0x33, 0x40, 0x7e, 0x06, 0x2d, 0x8f,
+	0xf7, 0xc7, 0x9b, 0x97, 0xa7, 0x35, 0x6f, 0x0a, 0x76, 0xd6, 0xfa, 0x73, 0x68, 0x46, 0x5e, 0x3a,
+	0xb6, 0x34, 0xad, 0x31, 0x70, 0x6e, 0xd6, 0xf6, 0x43, 0xe8, 0x24, 0x33, 0xe7, 0xa6, 0xbb, 0xc2,
+	0x14, 0x22, 0x59, 0x0f, 0xb7, 0xdd, 0xb7, 0xa0, 0x15, 0x79, 0x19, 0xa6, 0x2a, 0x63, 0x12, 0xc3,
+	0x72, 0x96, 0x7b, 0xb0, 0xe0, 0x51, 0xfd, 0x48, 0x1b, 0xd2, 0xa1, 0xeb, 0x9f, 0x08, 0xbe, 0x1a,
+	0xe3, 0x9b, 0xc7, 0x8a, 0xaf, 0x19, 0x9d, 0xf3, 0x6e, 0x40, 0xdd, 0xf0, 0x22, 0x3e, 0xdd, 0xb9,
+	0x29, 0xd3, 0xdd, 0x6c, 0xbe, 0xff, 0xfe, 0xe6, 0xdc, 0xd6, 0xfe, 0x6b, 0x9c, 0xaf, 0x3a, 0x67,
+	0x78, 0x11, 0x9b, 0xf8, 0x1d, 0x98, 0x37, 0xad, 0xe0, 0x48, 0xf3, 0x69, 0x32, 0xa9, 0xba, 0x98,
+	0xb9, 0x15, 0x1c, 0xa9, 0x34, 0x9e, 0xd6, 0x3a, 0x74, 0x19, 0x1f, 0xb3, 0xca, 0x82, 0xb1, 0xc1,
+	0x18, 0x3b, 0x48, 0xff, 0x15, 0x92, 0x39, 0xe7, 0x6d, 0x68, 0x07, 0x86, 0xaf, 0x87, 0xc6, 0xa1,
+	0x60, 0x03, 0xc6, 0xd6, 0x12, 0x44, 0xc6, 0xa4, 0xfc, 0x6b, 0x05, 0x96, 0x13, 0x69, 0xcd, 0xc9,
+	0xc0, 0xe3, 0x62, 0x19, 0x48, 0xec, 0x60, 0xd2, 0x6a, 0xe4, 0xec, 0x3f, 0x2d, 0x3c, 0xfb, 0x82,
+	0x66, 0xb9, 0x33, 0x7f, 0x58, 0x74, 0xe6, 0x05, 0x8d, 0xb2, 0x67, 0xfd, 0x59, 0xe1, 0x59, 0x17,
+	0x36, 0x1b, 0x39, 0xfe, 0x4f, 0x0b, 0x8e, 0xbf, 0x78, 0x8e, 0x59, 0x89, 0xf8, 0xe2, 0x34, 0x89,
+	0x28, 0x6c, 0x3a, 0x26, 0x24, 0x4f, 0xc6, 0x84, 0x64, 0xbc, 0xd5, 0x29, 0xc2, 0xf1, 0xa4, 0x58,
+	0x38, 0x4e, 0x59, 0x6a, 0x4e, 0x5e, 0x7e, 0x7a, 0x8a, 0xbc, 0x14, 0xb6, 0x1d, 0x15, 0xa1, 0xc7,
+	0x45, 0x22, 0x54, 0x2c, 0x03, 0x39, 0xa9, 0xfa, 0x75, 0x09, 0x5a, 0xbf, 0x72, 0xfd, 0x23, 0xea,
+	0xa3, 0x2c, 0x45, 0xcc, 0xca, 0xbe, 0x65, 0x65, 0x2d, 0x71, 0x16, 0xad, 0xf7, 0xdf, 0xdf, 0xac,
+	0x73, 0xa6, 0xdd, 0x6d, 0xb5, 0xce, 0xab, 0x77, 0x4d, 0xcc, 0x99, 0xde, 0xb8, 0x07, 0xc8, 0x57,
+	0x4e, 0x73, 0x26, 0xf4, 0xb0, 0xdb, 0x6a, 0xf5, 0x8d, 0x7b, 0xb0, 0x6b, 0x92, 0xc7, 0xd0, 0x62,
+	0x1e, 0x81, 0x19, 0xed, 0x28, 0xb6, 0xf2, 0x8b, 0x63, 0xfe, 0x20, 0x0a, 0xd4, 0xa6, 0x99, 0x16,
+	0x94, 0x37, 0xd0, 0xcc, 0xd4, 0x91, 0x4f, 0x61, 0x8e, 0x05, 0x2a, 0xd4, 0x94, 0x4b, 0x53, 0x63,
+	0x9a, 0x98, 0x15, 0xa3, 0x02, 0xe6, 0x04, 0x78, 0x9c, 0xb2, 0x90, 0x8b, 0x1c, 0x98, 0xbf, 0xe0,
+	0x5e, 0xc0, 0x85, 0x96, 0x4a, 0x03, 0x37, 0xf2, 0x0d, 0xca, 0x5c, 0x34, 0x02, 0x09, 0x5e, 0xc4,
+	0x06, 0x2a, 0xab, 0xf8, 0x89, 0xf6, 0x9e, 0x0b, 0x92, 0x88, 0xe6, 0x44, 0x89, 0xdc, 0x02, 0x69,
+	0xe0, 0x45, 0xb2, 0x94, 0xcf, 0x22, 0x9e, 0xed, 0xbf, 0xc6, 0x7e, 0x54, 0xac, 0x43, 0xf7, 0x81,
+	0x07, 0x15, 0x47, 0x6f, 0xf8, 0xad, 0xfc, 0x0e, 0xcc, 0x09, 0x9e, 0x24, 0x51, 0x29, 0xa5, 0x89,
+	0x0a, 0x8e, 0xe6, 0x44, 0xc3, 0x03, 0xea, 0xb3, 0xd1, 0x24, 0x55, 0x94, 0x94, 0xdf, 0x56, 0xa0,
+	0xdd, 0x0b, 0x5d, 0x9f, 0x9a, 0x2c, 0x88, 0xe9, 0xbb, 0xb1, 0xe3, 0x2e, 0x15, 0x3b, 0x6e, 0xf2,
+	0x11, 0xd4, 0x3d, 0xcb, 0xa3, 0xb6, 0xe5, 0xc4, 0xaa, 0x9d, 0x06, 0x74, 0x82, 0xae, 0x26, 0x1c,
+	0xe4, 0x11, 0xb4, 0xdd, 0x28, 0xf4, 0xa2, 0x50, 0xcb, 0x84, 0xa1, 0xe3, 0x31, 0x50, 0x8b, 0x33,
+	0xf1, 0x12, 0x91, 0x61, 0xce, 0xa7, 0x3c, 0xd8, 0xe4, 0xc6, 0x3b, 0x2e, 0x32, 0xeb, 0xae, 0x87,
+	0xba, 0x26, 0x2c, 0x07, 0x35, 0x99, 0xe6, 0x4a, 0x6a, 0x1b, 0xa9, 0xfb, 0x31, 0x11, 0xad, 0x3b,
+	0x63, 0x0b, 0x8e, 0x2c, 0xcf, 0xa3, 0x26, 0xd3, 0x51, 0x89, 0xc9, 0x82, 0xde, 0xe3, 0x24, 0x0c,
+	0xde, 0x19, 0x4b, 0xe8, 0x86, 0xba, 0xcd, 0xd4, 0x51, 0x52, 0x1b, 0x48, 0x79, 0x85, 0x04, 0x4c,
+	0x53, 0x58, 0x75, 0x5f, 0xb7, 0x6c, 0x6a, 0x32, 0x65, 0x93, 0x54, 0xd6, 0xe2, 0x29, 0xa3, 0x24,
+	0x33, 0xf1, 0xa9, 0x81, 0x31, 0x32, 0x35, 0xe5, 0x46, 0x3a, 0x13, 0x35, 0x26, 0xa6, 0x91, 0x08,
+	0x4c, 0x8f, 0x44, 0x3e, 0x81, 0x16, 0xfb, 0x88, 0xb7, 0xaa, 0x59, 0xb8, 0x55, 0x4d, 0xc6, 0xc3,
+	0x0b, 0xe4, 0x4e, 0x1c, 0x12, 0xb5, 0x58, 0x48, 0xd4, 0xcd, 0x9c, 0x56, 0x2e, 0x20, 0x5a, 0x81,
+	0x9a, 0x4f, 0xf5, 0xc0, 0x75, 0x44, 0x82, 0x20, 0x4a, 0x59, 0x15, 0xe8, 0xcc, 0xae, 0x02, 0x8f,
+	0xa1, 0xde, 0xb7, 0x1c, 0x2b, 0x38, 0xa4, 0xa6, 0x3c, 0x3f, 0xb5, 0x59, 0xc2, 0xab, 0xfc, 0x59,
+	0x07, 0xe6, 0x66, 0x94, 0xb2, 0x07, 0xd0, 0x08, 0x63, 0xe4, 0x6c, 0xd4, 0x83, 0x24, 0x90, 0x9a,
+	0x9a, 0xf2, 0xe4, 0xc4, 0x52, 0x9a, 0x2a, 0x96, 0x77, 0xa1, 0x1b, 0x7f, 0x6b, 0xc7, 0xd4, 0x0f,
+	0x30, 0x15, 0xa8, 0x08, 0xd7, 0x2e, 0xe8, 0xdf, 0x70, 0x32, 0x79, 0x00, 0x4d, 0xcc, 0x8e, 0xe2,
+	0x43, 0xa9, 0x16, 0x1e, 0x0a, 0x20, 0x0b, 0xff, 0x26, 0x9b, 0xd0, 0xf5, 0xd2, 0xf8, 0x5c, 0xc3,
+	0x1a, 0xe1, 0x24, 0x2e, 0x27, 0x33, 0xca, 0xc7, 0xef, 0xea, 0xbc, 0x97, 0x27, 0x60, 0xce, 0x40,
+	0x19, 0x2a, 0x24, 0x1c, 0x45, 0x27, 0x6e, 0xc9, 0xb1, 0x22, 0x55, 0xd4, 0x92, 0x7b, 0x98, 0x82,
+	0x62, 0xee, 0xc8, 0x30, 0xa6, 0xfa, 0xf8, 0x66, 0x36, 0x78, 0x35, 0xc2, 0x48, 0x99, 0xb3, 0x6e,
+	0x9c, 0xef, 0xac, 0x61, 0xf6, 0xb3, 0x1e, 0x57, 0xfc, 0xe6, 0x0c, 0x8a, 0x7f, 0x51, 0x71, 0xce,
+	0x80, 0x30, 0x9d, 0xc9, 0x20, 0x0c, 0x66, 0x1c, 0x81, 0xe7, 0x46, 0xa1, 0x3c, 0x9f, 0xcf, 0x38,
+	0x18, 0x9a, 0xa3, 0xf2, 0x3a, 0xf2, 0x13, 0x68, 0x8a, 0x45, 0xb0, 0xac, 0xbb, 0x9b, 0xcf, 0x0e,
+	0x54, 0xea, 0xb9, 0x2a, 0x70, 0x06, 0xfc, 0xc6, 0x80, 0x4b, 0xb0, 0x0b, 0x34, 0x86, 0x63, 0x02,
+	0x62, 0x8d, 0x9b, 0x8c, 0x96, 0x35, 0x6e, 0x64, 0x9a, 0x71, 0x5b, 0x9c, 0xc5, 0xb8, 0x2d, 0x8d,
+	0x1b, 0xb7, 0x11, 0xeb, 0xb5, 0x3c, 0x83, 0xf5, 0x5a, 0x29, 0xb2, 0x5e, 0x79, 0x23, 0x79, 0x79,
+	0xd4, 0x48, 0x26, 0xc6, 0x4d, 0x9e, 0x6e, 0xdc, 0x9e, 0x40, 0x5b, 0x04, 0x00, 0xc2, 0x69, 0x5f,
+	0x59, 0x93, 0xb2, 0x6d, 0xb2, 0xd1, 0x82, 0xda, 0x7a, 0x9b, 0x29, 0x91, 0x0d, 0x58, 0xf0, 0x85,
+	0x2b, 0xd5, 0x7c, 0xfa, 0x6d, 0x44, 0x83, 0x30, 0x90, 0x57, 0xf3, 0x43, 0x66, 0x7d, 0xad, 0xda,
+	0x8d, 0xd9, 0x55, 0xc1, 0x4d, 0xbe, 0x80, 0xf9, 0xa4, 0x0b, 0xdb, 0x1a, 0x5a, 0x61, 0x20, 0x5f,
+	0x9d, 0xd0, 0x41, 0x27, 0x66, 0xde, 0x63, 0xbc, 0x64, 0x0f, 0x2e, 0x07, 0x96, 0x49, 0x0d, 0xdd,
+	0xd7, 0x46, 0xbb, 0xb9, 0x36, 0xa1, 0x9b, 0x65, 0xd1, 0x48, 0xcd, 0xf7, 0x76, 0x1b, 0xaa, 0x16,
+	0x46, 0x0b, 0xf2, 0xf5, 0xbc, 0xe8, 0x09, 0xf0, 0x81, 0xd5, 0x91, 0x4f, 0x00, 0x1c, 0xfa, 0x36,
+	0x16, 0xa4, 0x1b, 0x8c, 0x93, 0xc4, 0x92, 0xc7, 0x45, 0x89, 0xe5, 0x9c, 0x0d, 0x87, 0xbe, 0xe5,
+	0xc5, 0x31, 0xff, 0x71, 0x73, 0xba, 0xff, 0x40, 0xc4, 0xcc, 0xd1, 0x0f, 0x6c, 0xaa, 0xf1, 0x83,
+	0x5c, 0x63, 0x38, 0x43, 0x93, 0xd3, 0x78, 0x1a, 0x80, 0x48, 0x91, 0x6e, 0x87, 0xf2, 0x2d, 0x81,
+	0x14, 0xe9, 0x76, 0x48, 0x3e, 0x06, 0x30, 0x0e, 0x23, 0xe7, 0x88, 0x1b, 0x37, 0x65, 0x04, 0x1f,
+	0xc1, 0x1a, 0xb6, 0xfe, 0x86, 0x11, 0x7f, 0xb2, 0x84, 0x92, 0x85, 0x6c, 0x18, 0xfd, 0xa2, 0xda,
+	0xdd, 0x9e, 0x9e, 0x50, 0x22, 0xff, 0x2b, 0xce, 0x8e, 0x29, 0x21, 0x06, 0x85, 0x71, 0xeb, 0x0f,
+	0xa6, 0xb5, 0x86, 0x37, 0xee, 0x41, 0xdc, 0x96, 0x6b, 0x03, 0x8e, 0xed, 0x5b, 0x34, 0x90, 0x3f,
+	0x4c, 0xb4, 0x21, 0x1a, 0xbe, 0x42, 0x0a, 0xf9, 0x0a, 0xe6, 0x03, 0xe3, 0x90, 0x9a, 0x91, 0x8d,
+	0xd7, 0x14, 0x6c, 0x4d, 0x77, 0xd8, 0x00, 0x2b, 0x89, 0x55, 0x48, 0xaa, 0xb9, 0x7c, 0x04, 0xb9,
+	0x32, 0x62, 0xa0, 0x9e, 0x6b, 0xf2, 0x96, 0x3f, 0xe2, 0x18, 0xa8, 0xe7, 0xf2, 0x0b, 0x85, 0xab,
+	0xd0, 0xc0, 0x2a, 0x0f, 0x63, 0x63, 0x79, 0x9d, 0xd5, 0x21, 0xef, 0x3e, 0x96, 0x95, 0x67, 0x50,
+	0xe3, 0x72, 0x5f, 0x08, 0x1c, 0xdd, 0xcd, 0xe3, 0x1d, 0x8b, 0xe3, 0xaa, 0x12, 0x1b, 0x44, 0xe5,
+	0x06, 0xd4, 0x63, 0x2f, 0x57, 0xd4, 0x95, 0xf2, 0xf7, 0x12, 0x10, 0x1e, 0xe5, 0xc5, 0x6c, 0xcc,
+	0x09, 0xff, 0x38, 0x1e, 0xa1, 0xc4, 0x46, 0x58, 0x1e, 0xf5, 0x98, 0xa7, 0x18, 0xdd, 0x72, 0xce,
+	0xe8, 0x8e, 0x38, 0x48, 0x69, 0xaa, 0x83, 0xfc, 0x39, 0xe0, 0xe9, 0x68, 0x0c, 0x09, 0x89, 0x61,
+	0xba, 0xbb, 0xc9, 0x4e, 0x8f, 0xcd, 0x12, 0xad, 0xff, 0x16, 0xe3, 0xe5, 0xd7, 0x0e, 0x8d, 0x37,
+	0x71, 0x19, 0xed, 0x93, 0x1e, 0x85, 0x87, 0x5a, 0xe8, 0x1e, 0x51, 0x47, 0xa0, 0xd9, 0x0d, 0xa4,
+	0xbc, 0x42, 0x02, 0x79, 0x0c, 0x1d, 0x5b, 0x0f, 0x98, 0x6f, 0x14, 0xf8, 0x4e, 0xed, 0x14, 0xbf,
+	0xd2, 0x42, 0xbe, 0xb8, 0x84, 0x90, 0x59, 0xc6, 0x21, 0x33, 0x17, 0x5c, 0x51, 0xb3, 0xa4, 0x5c,
+	0xb4, 0x51, 0x9f, 0x16, 0x6d, 0xac, 0xfe, 0x0c, 0x3a, 0xf9, 0x35, 0x64, 0xef, 0x38, 0xaa, 0x05,
+	0x77, 0x1c, 0xd5, 0xec, 0x1d, 0xc7, 0xff, 0xb4, 0xa1, 0x95, 0x3b, 0xb5, 0xec, 0xe0, 0xa5, 0x69,
+	0x83, 0xa3, 0xbf, 0x89, 0x23, 0x9c, 0x32, 0xf7, 0x37, 0xc7, 0x49, 0x64, 0x93, 0x89, 0xb1, 0xa4,
+	0x19, 0x62, 0xac, 0x07, 0xc9, 0x6d, 0x56, 0x25, 0x6f, 0xb9, 0xd8, 0x8d, 0xd6, 0xf8, 0xe5, 0x56,
+	0x61, 0x28, 0x54, 0x3d, 0x77, 0x28, 0x54, 0x9b, 0x18, 0x0a, 0x3d, 0x01, 0x30, 0x7c, 0xaa, 0x87,
+	0xd4, 0xd4, 0xf4, 0x50, 0x9e, 0x9b, 0x1a, 0xaa, 0x34, 0x04, 0xf7, 0x46, 0x98, 0xaa, 0x41, 0x7d,
+	0x06, 0x35, 0x90, 0x31, 0x8c, 0x72, 0x3d, 0x4f, 0x84, 0x51, 0x75, 0x35, 0x2e, 0xa2, 0x31, 0xf5,
+	0x29, 0x42, 0x6a, 0x1a, 0xf5, 0x7d, 0xd7, 0x67, 0xe1, 0x52, 0x43, 0x6d, 0x72, 0xda, 0x0e, 0x92,
+	0xc8, 0x8f, 0x61, 0x81, 0xbb, 0xb6, 0x20, 0xf6, 0x64, 0xd4, 0x64, 0x91, 0x91, 0xa4, 0x76, 0x45,
+	0x85, 0x1a, 0xd3, 0xb3, 0xcc, 0xfa, 0xb1, 0x6e, 0xd9, 0x68, 0x93, 0xe5, 0x56, 0x8e, 0x79, 0x23,
+	0xa6, 0x93, 0xcd, 0x9c, 0x52, 0xb5, 0x99, 0x52, 0xdd, 0x1e, 0x5d, 0xc8, 0x14, 0x75, 0x1a, 0xd7,
+	0x97, 0xce, 0x4c, 0xfa, 0x32, 0x16, 0xf7, 0xcc, 0x17, 0xc4, 0x3d, 0x85, 0x5e, 0xbc, 0x7b, 0x51,
+	0x2f, 0xbe, 0xf0, 0xc3, 0x78, 0x71, 0x72, 0x01, 0x2f, 0xbe, 0x38, 0xc1, 0x8b, 0xaf, 0x41, 0xd3,
+	0xa4, 0x81, 0xe1, 0x5b, 0x1e, 0x7a, 0x25, 0x16, 0xaa, 0x35, 0xd4, 0x2c, 0x09, 0x4d, 0x98, 0xa1,
+	0x1b, 0x87, 0x94, 0x5d, 0x36, 0xb1, 0x48, 0xad, 0xa1, 0x36, 0x18, 0x05, 0x2f, 0x9a, 0xc6, 0x1c,
+	0xf4, 0xca, 0xe9, 0x0e, 0xfa, 0x72, 0xc6, 0x41, 0xa7, 0xb6, 0x5a, 0xce, 0xd9, 0x6a, 0x71, 0xb1,
+	0xf5, 0x6d, 0x44, 0x23, 0x31, 0xe2, 0x95, 0xe4, 0x62, 0xeb, 0x97, 0x48, 0x64, 0x83, 0x66, 0xc2,
+	0xe8, 0xd5, 0x59, 0xc3, 0xe8, 0xab, 0x13, 0xc2, 0xe8, 0x7c, 0xb8, 0x70, 0xed, 0x3c, 0xe1, 0xc2,
+	0xf5, 0x0b, 0x85, 0x0b, 0x37, 0xce, 0x12, 0x2e, 0x8c, 0xf8, 0xb3, 0xb5, 0xa9, 0xfe, 0x8c, 0x59,
+	0x04, 0xdd, 0x31, 0x0f, 0x4e, 0xe4, 0x5b, 0xb1, 0x45, 0x60, 0xc5, 0xd1, 0xc8, 0x43, 0x99, 0x25,
+	0xf2, 0xb8, 0x7d, 0xee, 0xc8, 0xe3, 0x83, 0x09, 0x91, 0xc7, 0x87, 0xf9, 0xc8, 0x83, 0x2c, 0x43,
+	0x2d, 0x78, 0xa4, 0xe1, 0xde, 0xdc, 0xe1, 0x2f, 0x38, 0x82, 0x47, 0x2f, 0xa3, 0x10, 0x5d, 0xcb,
+	0x50, 0x5c, 0xf5, 0xcb, 0x3f, 0xca, 0xbb, 0x96, 0xf8, 0x09, 0x80, 0x9a, 0x70, 0x60, 0x16, 0xe1,
+	0xd3, 0x18, 0xeb, 0x65, 0x53, 0xe0, 0x01, 0x4e, 0x3b, 0xa1, 0xe2, 0x44, 0x2e, 0xe8, 0xfe, 0x9e,
+	0x43, 0x3b, 0x6b, 0xbe, 0x58, 0x26, 0x91, 0xe4, 0xee, 0x96, 0xd3, 0x77, 0xc5, 0x5b, 0x87, 0xa5,
+	0x22, 0x63, 0xa7, 0xb6, 0xbc, 0x4c, 0x49, 0xf9, 0x97, 0x0a, 0x74, 0xb7, 0x98, 0xd9, 0x47, 0x27,
+	0xc5, 0xcd, 0xca, 0x19, 0xdd, 0xe9, 0x58, 0x5e, 0x5b, 0x3e, 0x1b, 0xa0, 0x25, 0x4d, 0xcb, 0xf9,
+	0x2a, 0xb3, 0xe4, 0x7c, 0xd5, 0x69, 0x80, 0x56, 0x6d, 0x0a, 0xa0, 0x35, 0x37, 0x43, 0x4a, 0x58,
+	0x9f, 0x08, 0x68, 0x35, 0xce, 0x0e, 0x68, 0xc1, 0x19, 0x00, 0xad, 0xe6, 0xac, 0x08, 0x40, 0xeb,
+	0x34, 0x40, 0xab, 0x7d, 0x3e, 0x90, 0xa3, 0x73, 0x06, 0x40, 0xeb, 0x2f, 0x4b, 0xb0, 0xb0, 0xeb,
+	0xa0, 0xe4, 0x87, 0x19, 0x81, 0x9a, 0x02, 0x6d, 0x9d, 0x4b, 0x82, 0x6e, 0x42, 0xf3, 0xc0, 0x76,
+	0x8d, 0x23, 0xe1, 0x97, 0x25, 0xfe, 0x6c, 0x82, 0x91, 0xb8, 0x0f, 0x26, 0x50, 0xe9, 0x47, 0xb6,
+	0x1d, 0xdf, 0x14, 0xe3, 0xb7, 0xf2, 0x5f, 0x25, 0xe8, 0xec, 0x59, 0x41, 0x78, 0x6e, 0x61, 0xff,
+	0x04, 0x5a, 0x96, 0x93, 0x9b, 0xa9, 0x54, 0x74, 0x80, 0x8c, 0x47, 0x4c, 0xf4, 0xbc, 0x80, 0xef,
+	0xa1, 0x15, 0x84, 0x88, 0x85, 0x73, 0xf1, 0x8f, 0x8b, 0xc9, 0xb2, 0xaa, 0xe9, 0xb2, 0xf0, 0xb6,
+	0xfb, 0xcd, 0xb7, 0x4f, 0x2d, 0x3b, 0xa4, 0xbe, 0x78, 0xaa, 0x92, 0x94, 0x15, 0x0f, 0xe6, 0x9f,
+	0xda, 0x51, 0x70, 0x98, 0x59, 0xf2, 0x3a, 0xcc, 0xf1, 0xa9, 0xc4, 0xaf, 0xa2, 0x46, 0xe7, 0x12,
+	0x57, 0x93, 0x47, 0xd0, 0x0a, 0x5d, 0x2d, 0x5e, 0x7d, 0xfc, 0x08, 0x6a, 0x7c, 0x83, 0x9a, 0xa1,
+	0x1b, 0x7f, 0x07, 0xca, 0x27, 0xd0, 0xdd, 0xa6, 0x36, 0x0d, 0xe9, 0xcc, 0x12, 0xa0, 0xfc, 0x21,
+	0x74, 0x7a, 0xa1, 0xeb, 0xfd, 0x1f, 0x8b, 0x4c, 0xaa, 0x22, 0x52, 0x56, 0x45, 0x94, 0xff, 0x2c,
+	0xc3, 0xf2, 0x6b, 0xcf, 0xe4, 0x46, 0x90, 0x2b, 0xd5, 0x6c, 0xb3, 0xb8, 0x93, 0xcf, 0x47, 0x67,
+	0xd0, 0xcd, 0xdc, 0xc0, 0xff, 0x2f, 0xb0, 0xfe, 0x0f, 0x65, 0xe6, 0xf2, 0xd6, 0xb4, 0x71, 0x2a,
+	0xf2, 0x35, 0x1d, 0xd6, 0x57, 0xfe, 0xb1, 0x0c, 0x9d, 0x67, 0x34, 0xdc, 0x73, 0x07, 0xc1, 0xf9,
+	0xb4, 0x70, 0xf2, 0x4b, 0x8a, 0x64, 0x57, 0xfa, 0x4c, 0x03, 0x02, 0xf1, 0x2a, 0x95, 0x6d, 0x03,
+	0x57, 0x8a, 0x20, 0x7d, 0x5e, 0x51, 0x99, 0xf0, 0xbc, 0x02, 0xef, 0xa3, 0xf4, 0x00, 0x95, 0x8a,
+	0x2b, 0x9b, 0x28, 0x21, 0xbd, 0xef, 0xda, 0xb6, 0xfb, 0x96, 0xed, 0x77, 0x5d, 0x15, 0x25, 0x76,
+	0xcb, 0xa4, 0x5b, 0xf1, 0xdd, 0x09, 0xfb, 0xc6, 0xcb, 0xe9, 0x28, 0xa0, 0x9a, 0xed, 0x1e, 0x59,
+	0xda, 0x81, 0x6e, 0x1c, 0x51, 0x87, 0xef, 0x6f, 0x5d, 0xed, 0x44, 0x01, 0xdd, 0x73, 0x8f, 0xac,
+	0x4d, 0x4e, 0x25, 0x0f, 0xa0, 0xca, 0x5e, 0x45, 0xc9, 0x8d, 0x69, 0xb1, 0x19, 0xe7, 0x53, 0xfe,
+	0xa1, 0x0c, 0xb0, 0xe7, 0x0e, 0xbe, 0xa6, 0x41, 0x80, 0x0f, 0x2f, 0x6f, 0x67, 0xa2, 0x80, 0x0c,
+	0xdc, 0x91, 0xf8, 0xfb, 0x17, 0x88, 0xa0, 0x4c, 0xbf, 0x4a, 0xcc, 0xdd, 0x4b, 0x4a, 0x13, 0xef,
+	0x25, 0xef, 0x40, 0x9d, 0x07, 0x73, 0x16, 0x77, 0xd2, 0x0d, 0x7e, 0x57, 0xcb, 0x1f, 0xb1, 0x6c,
+	0xab, 0x73, 0xac, 0x72, 0xd7, 0x3c, 0x75, 0x1f, 0xe3, 0x8b, 0xc3, 0xda, 0xc4, 0x8b, 0xc3, 0xe4,
+	0x11, 0x2d, 0x7f, 0x68, 0xc7, 0xbe, 0xc9, 0x3d, 0x28, 0x87, 0xf1, 0x8d, 0xef, 0x24, 0xcf, 0x54,
+	0x0e, 0x03, 0xd4, 0xb2, 0x21, 0xdf, 0x23, 0xb6, 0xb5, 0x0d, 0x35, 0x2e, 0x2a, 0xbf, 0x82, 0x45,
+	0x95, 0x2b, 0x1c, 0x3f, 0xf7, 0xd9, 0xb4, 0x7e, 0x54, 0xbc, 0xca, 0x63, 0xe2, 0xa5, 0x7c, 0x0e,
+	0x8b, 0xc2, 0x0b, 0xe6, 0x3a, 0x9e, 0xe5, 0x51, 0x8f, 0xf2, 0x0d, 0x74, 0xd1, 0x45, 0x9d, 0x65,
+	0x46, 0x49, 0xe6, 0x55, 0x3e, 0x3d, 0xf3, 0x52, 0x36, 0xa1, 0x91, 0x64, 0x16, 0x99, 0xcb, 0xcf,
+	0x52, 0xf6, 0xf2, 0x13, 0xb5, 0x3c, 0xf3, 0xc6, 0x8f, 0x5f, 0x8c, 0x36, 0x82, 0xf8, 0x81, 0x9f,
+	0xf2, 0x9b, 0x12, 0x74, 0xf2, 0xf1, 0x37, 0xf9, 0x1a, 0xda, 0x8e, 0x6b, 0x52, 0x2d, 0xa0, 0x36,
+	0x35, 0x42, 0xd7, 0x17, 0x2e, 0x65, 0xbd, 0x38, 0x5c, 0xbf, 0xff, 0xc2, 0x35, 0x69, 0x4f, 0xb0,
+	0xf2, 0x74, 0xbb, 0xe5, 0x64, 0x48, 0xe4, 0x3e, 0x2c, 0x7a, 0xbe, 0xe5, 0xfa, 0x56, 0x78, 0xa2,
+	0x19, 0xb6, 0x1e, 0x04, 0x5c, 0x96, 0x39, 0xc0, 0xb6, 0x10, 0x57, 0x6d, 0x61, 0x0d, 0x0a, 0xf4,
+	0xea, 0x57, 0xb0, 0x30, 0xd6, 0xe5, 0x99, 0x1e, 0xcc, 0xfe, 0x16, 0x60, 0x99, 0x47, 0xc0, 0x89,
+	0xa1, 0x39, 0x97, 0x4d, 0x4a, 0xa1, 0xa0, 0xf2, 0x6c, 0x50, 0xd0, 0x99, 0xc1, 0xa6, 0x22, 0xec,
+	0xa8, 0x72, 0x6e, 0xec, 0xa8, 0x3a, 0x11, 0x3b, 0x5a, 0x81, 0x5a, 0xc4, 0x3c, 0x62, 0x6c, 0xe2,
+	0x78, 0x69, 0x1c, 0xd8, 0x98, 0x2b, 0x00, 0x36, 0xd2, 0x54, 0xaa, 0x9e, 0x4d, 0xa5, 0x0a, 0xf1,
+	0x8e, 0xc6, 0x45, 0xf1, 0x0e, 0xf8, 0x61, 0xf0, 0x8e, 0xe6, 0x05, 0xf0, 0x8e, 0xd6, 0xec, 0x78,
+	0x47, 0x7b, 0x1a, 0xde, 0xd1, 0x99, 0x86, 0x77, 0xcc, 0x8f, 0xe3, 0x1d, 0xd7, 0xd8, 0x7b, 0x57,
+	0xee, 0x68, 0x19, 0x80, 0x54, 0x57, 0x53, 0x42, 0x01, 0xc2, 0xb1, 0x30, 0x19, 0xe1, 0x20, 0xb3,
+	0x22, 0x1c, 0x8b, 0x33, 0x23, 0x1c, 0x4b, 0xe7, 0x41, 0x38, 0x96, 0x2f, 0x84, 0x70, 0xac, 0x9c,
+	0x05, 0xe1, 0x28, 0x42, 0x8c, 0x32, 0x20, 0x86, 0x3c, 0x11, 0xc4, 0xb8, 0x32, 0x0b, 0x88, 0xb1,
+	0x7a, 0x6e, 0x10, 0xe3, 0xea, 0x04, 0x10, 0xe3, 0xda, 0x08, 0x88, 0x31, 0x82, 0xd4, 0x5c, 0x9f,
+	0x8a, 0xd4, 0x64, 0xe1, 0x8d, 0x1b, 0xe7, 0x80, 0x37, 0x6e, 0x16, 0xc0, 0x1b, 0xf8, 0x70, 0xde,
+	0xf4, 0x4f, 0x34, 0x3f, 0x72, 0xc4, 0xf5, 0x59, 0xcd, 0xf4, 0x4f, 0xd4, 0xc8, 0x51, 0xfe, 0xa6,
+	0x04, 0x9d, 0xd8, 0x74, 0x6e, 0x33, 0x12, 0xb9, 0xce, 0x6f, 0xf5, 0xd8, 0xe6, 0x05, 0xc2, 0x19,
+	0xe1, 0x0d, 0x1e, 0x73, 0x7f, 0x01, 0x8e, 0x28, 0x62, 0xdb, 0x98, 0x85, 0xfb, 0xa4, 0xb6, 0xa0,
+	0xa6, 0x6c, 0x3e, 0x1d, 0xba, 0xc7, 0x29, 0x9b, 0xc4, 0xd9, 0x04, 0x55, 0xb0, 0xad, 0x43, 0x97,
+	0x67, 0x6f, 0x19, 0x1f, 0xc7, 0xd3, 0xab, 0x0e, 0xa3, 0x27, 0x2f, 0xd9, 0x95, 0x5d, 0x58, 0x19,
+	0x75, 0x0a, 0x81, 0xe7, 0x3a, 0x01, 0xda, 0xf9, 0x64, 0x71, 0xa5, 0xfc, 0x99, 0xe6, 0x57, 0x96,
+	0x2c, 0xfa, 0x29, 0xac, 0x88, 0x58, 0xe0, 0x42, 0x0e, 0x46, 0xf9, 0xeb, 0x12, 0x2c, 0x62, 0x60,
+	0x70, 0x31, 0x37, 0x95, 0x49, 0x2c, 0xcb, 0xf9, 0xc4, 0xf2, 0x2e, 0x74, 0x75, 0x0c, 0x63, 0x35,
+	0xcb, 0x31, 0xdc, 0xa1, 0x87, 0x09, 0x9c, 0xc8, 0xaa, 0xe7, 0x19, 0x7d, 0x37, 0x21, 0xe7, 0xf2,
+	0xcd, 0xca, 0x48, 0xbe, 0xf9, 0xa7, 0x25, 0x58, 0xe6, 0xe9, 0xdf, 0xc5, 0x26, 0xda, 0x05, 0x49,
+	0xb7, 0x6d, 0x36, 0xc9, 0xba, 0x8a, 0x9f, 0xe8, 0xc3, 0xfb, 0xae, 0x6f, 0xc4, 0xb3, 0xe2, 0x05,
+	0xd4, 0x87, 0x23, 0x4a, 0x3d, 0xfe, 0x1e, 0x81, 0xe7, 0xfa, 0x75, 0x24, 0xa8, 0xd4, 0x73, 0x95,
+	0x6d, 0x58, 0xea, 0x61, 0x78, 0x77, 0xb1, 0x9d, 0xdf, 0x82, 0x45, 0xcc, 0x4e, 0x2f, 0xd6, 0xc9,
+	0x5f, 0x95, 0x80, 0xa8, 0x91, 0x73, 0xb1, 0x4d, 0xf9, 0x0c, 0xc0, 0xf3, 0xdd, 0x63, 0xea, 0xe8,
+	0x98, 0x28, 0xf0, 0x6c, 0x5c, 0xce, 0xab, 0xf7, 0x7e, 0x52, 0xaf, 0x66, 0x78, 0x33, 0x81, 0xbf,
+	0x54, 0x1c, 0xf8, 0x2b, 0x5f, 0x42, 0x47, 0x8d, 0x1c, 0x7c, 0xcb, 0x7f, 0xbe, 0x05, 0xde, 0x85,
+	0x45, 0xae, 0x32, 0xfc, 0x37, 0x77, 0x71, 0x27, 0x88, 0x57, 0x58, 0x36, 0xef, 0xa0, 0xa5, 0xb2,
+	0x6f, 0xe5, 0x0b, 0x58, 0xe4, 0x22, 0x92, 0x67, 0xbd, 0x03, 0x35, 0xfe, 0x3b, 0x3e, 0xb9, 0x94,
+	0x0f, 0x4e, 0x04, 0x9b, 0xa8, 0x55, 0xbe, 0x84, 0x25, 0xa1, 0x51, 0xe7, 0x6b, 0x7f, 0x0d, 0x6a,
+	0x9c, 0x52, 0x78, 0x33, 0xfc, 0xeb, 0x12, 0x00, 0xaf, 0x66, 0x77, 0x8b, 0x33, 0x76, 0x9a, 0x3c,
+	0x31, 0x2c, 0x67, 0x9e, 0x18, 0xee, 0x02, 0x61, 0x77, 0x6a, 0x96, 0xeb, 0x68, 0xc9, 0xaf, 0x43,
+	0x65, 0x69, 0x6a, 0xd6, 0xb2, 0x10, 0xb7, 0x4a, 0x48, 0xca, 0x26, 0x34, 0xd3, 0x49, 0x21, 0x30,
+	0xd3, 0xe4, 0xe3, 0x66, 0x01, 0x5f, 0x92, 0x9f, 0x1a, 0x72, 0xaa, 0x10, 0x24, 0xdf, 0xca, 0x32,
+	0x2c, 0x6e, 0x18, 0xa1, 0x75, 0xac, 0x87, 0x74, 0x23, 0x0a, 0x0f, 0xc5, 0xb6, 0x29, 0x2b, 0xb0,
+	0x94, 0x27, 0x73, 0x4b, 0x77, 0xcf, 0x67, 0x3f, 0xe3, 0xe0, 0x60, 0x5a, 0x17, 0x5a, 0xcf, 0x5f,
+	0x6e, 0x6a, 0xbd, 0x57, 0x1b, 0xea, 0xab, 0xdd, 0x17, 0xcf, 0xba, 0x97, 0xc8, 0x3c, 0x34, 0x91,
+	0xa2, 0xbe, 0x7e, 0xf1, 0x02, 0x09, 0xa5, 0x98, 0xf0, 0x74, 0x63, 0x77, 0xef, 0xb5, 0xba, 0xd3,
+	0x2d, 0xc7, 0x84, 0xde, 0xeb, 0xad, 0xad, 0x9d, 0x5e, 0xaf, 0x2b, 0x91, 0x0e, 0x00, 0x12, 0x7e,
+	0xb1, 0xbb, 0xb7, 0xb7, 0xb3, 0xdd, 0xad, 0x90, 0x05, 0x68, 0x63, 0x79, 0xe7, 0x99, 0xba, 0xd3,
+	0xeb, 0x61, 0x27, 0xd5, 0x7b, 0x2f, 0x01, 0xd2, 0x1f, 0x2f, 0x10, 0x80, 0x1a, 0x76, 0xb7, 0xb3,
+	0xdd, 0xbd, 0x44, 0x9a, 0x30, 0x17, 0xf7, 0x54, 0x62, 0x85, 0x5f, 0xec, 0xee, 0xef, 0xef, 0x6c,
+	0x77, 0xcb, 0xa4, 0x05, 0xf5, 0x64, 0x5e, 0x12, 0x69, 0x43, 0x43, 0xdd, 0xd9, 0x7a, 0xf9, 0xcd,
+	0x8e, 0x8a, 0x63, 0xdc, 0xfb, 0x0a, 0x9a, 0x99, 0xd7, 0x01, 0x38, 0xa7, 0xfd, 0x97, 0xdb, 0xc9,
+	0xac, 0x2f, 0xc5, 0x84, 0xb4, 0xeb, 0x0e, 0x00, 0x12, 0xc4, 0xb8, 0xe5, 0x7b, 0x7f, 0x5b, 0x4a,
+	0xe1, 0x76, 0xde, 0xc7, 0x32, 0x2c, 0xec, 0xef, 0xee, 0xef, 0xec, 0xed, 0xbe, 0xd8, 0xc9, 0x6e,
+	0xc8, 0x12, 0x74, 0x13, 0x72, 0xba, 0x2b, 0x97, 0x61, 0x31, 0xa5, 0xee, 0x24, 0xec, 0xe5, 0x1c,
+	0x7b, 0xbc, 0x67, 0x12, 0x59, 0x84, 0xf9, 0x84, 0xba, 0xbf, 0xf1, 0xba, 0xc7, 0xf6, 0x29, 0xcb,
+	0xda, 0x7b, 0xb5, 0xf1, 0x62, 0x7b, 0xf3, 0xf7, 0xbb, 0xd5, 0xdc, 0x34, 0xb6, 0xd4, 0x8d, 0xde,
+	0xcf, 0xb1, 0xdf, 0xda, 0xc3, 0x3f, 0x6f, 0x83, 0xb4, 0xb1, 0xbf, 0x4b, 0x3e, 0x85, 0x06, 0x57,
+	0x47, 0xcc, 0x35, 0xe4, 0xf4, 0xa7, 0x3a, 0x79, 0xac, 0x7f, 0x35, 0x9b, 0x4c, 0x2a, 0x97, 0xc8,
+	0xe7, 0x00, 0x29, 0x7c, 0x4b, 0xae, 0xa4, 0x21, 0xed, 0x08, 0xa4, 0xbb, 0x3a, 0x9f, 0x69, 0xc7,
+	0x84, 0xeb, 0x12, 0x79, 0x0c, 0x73, 0x02, 0x5b, 0x25, 0x89, 0x4f, 0xcc, 0x83, 0xad, 0x05, 0xad,
+	0x3e, 0x2e, 0x91, 0xcf, 0xa0, 0x1e, 0x23, 0x94, 0x24, 0xc9, 0x64, 0x46, 0x30, 0xcb, 0xe2, 0x96,
+	0x5f, 0x41, 0x23, 0x41, 0x1a, 0xd3, 0x35, 0x8e, 0x82, 0x8f, 0xab, 0x2b, 0x63, 0xaa, 0xb6, 0x83,
+	0xbf, 0xae, 0x53, 0x2e, 0x91, 0x9f, 0xc2, 0x9c, 0xc0, 0x1d, 0xd3, 0x29, 0xe7, 0x81, 0xc8, 0x09,
+	0x8d, 0x7f, 0x0f, 0x5a, 0xd9, 0x24, 0x9f, 0x5c, 0x1d, 0xd9, 0xad, 0x6c, 0x06, 0xbf, 0xba, 0x90,
+	0xcb, 0xf5, 0xc5, 0x8e, 0xfd, 0x0c, 0x1a, 0x49, 0xaa, 0x9f, 0xce, 0x7f, 0x34, 0xfb, 0x2f, 0x6c,
+	0xfb, 0x71, 0x89, 0xec, 0xb0, 0x07, 0xd5, 0x09, 0x7a, 0x91, 0x8e, 0x5f, 0x80, 0x69, 0x4c, 0x58,
+	0xc6, 0x2f, 0xa1, 0x93, 0x0f, 0x75, 0xc8, 0xf5, 0xbc, 0xb4, 0x8c, 0xb8, 0xac, 0xd5, 0x1b, 0xa7,
+	0x55, 0x73, 0xbb, 0xa1, 0x5c, 0x22, 0xbb, 0x30, 0x3f, 0x12, 0xf2, 0x90, 0x1b, 0x23, 0x9b, 0x33,
+	0xda, 0x69, 0xe1, 0x65, 0x95, 0x72, 0x89, 0x6c, 0x43, 0x2b, 0x1b, 0xf4, 0xa4, 0x8b, 0x2c, 0x08,
+	0x85, 0x56, 0x97, 0x8b, 0x3a, 0x09, 0xd8, 0x84, 0x3a, 0xf9, 0x98, 0x24, 0x5d, 0x63, 0x61, 0xac,
+	0x32, 0x61, 0xbb, 0x9e, 0x41, 0x3b, 0x17, 0x52, 0x90, 0x6b, 0xa9, 0xe0, 0x8c, 0x47, 0x1a, 0x13,
+	0x3a, 0xda, 0x81, 0x56, 0x36, 0xaa, 0x48, 0x57, 0x56, 0x10, 0x6b, 0x4c, 0xe8, 0x66, 0x0b, 0x9a,
+	0x99, 0xb0, 0x82, 0x24, 0x3f, 0xb3, 0x1f, 0x8f, 0x35, 0x26, 0xeb, 0x81, 0xf0, 0xfd, 0xa9, 0x1e,
+	0xe4, 0x83, 0x81, 0xc9, 0x0b, 0xc9, 0x3a, 0xfe, 0x74, 0x21, 0x05, 0xe1, 0xc0, 0xe4, 0x6e, 0xb2,
+	0x41, 0x41, 0xda, 0x4d, 0x41, 0xa8, 0x30, 0x71, 0x29, 0x80, 0xa2, 0x21, 0x3a, 0x39, 0x85, 0x6f,
+	0x75, 0x71, 0xdc, 0x55, 0x06, 0x6c, 0x33, 0xdb, 0xb9, 0xc8, 0x22, 0x3d, 0xdc, 0xa2, 0x80, 0x63,
+	0xb5, 0xc0, 0xe1, 0x2a, 0x97, 0xc8, 0x17, 0xb1, 0x55, 0xda, 0xb0, 0xed, 0x53, 0x27, 0x70, 0xfa,
+	0x02, 0x9e, 0xc0, 0x9c, 0x00, 0xc7, 0xd3, 0xb3, 0xc8, 0xa3, 0xe5, 0xe9, 0xb8, 0x29, 0xfc, 0xcb,
+	0x2c, 0xc2, 0x2f, 0xa0, 0x95, 0xf5, 0xe4, 0xe9, 0x16, 0x16, 0xb8, 0xfd, 0xd5, 0x6b, 0xc5, 0x95,
+	0x19, 0x25, 0xee, 0xe4, 0x2f, 0x45, 0x52, 0x9d, 0x29, 0xbc, 0x2c, 0x39, 0x7d, 0x49, 0x9b, 0xbf,
+	0xfb, 0x4f, 0xef, 0x6f, 0x94, 0x7e, 0xf3, 0xfe, 0x46, 0xe9, 0xdf, 0xdf, 0xdf, 0x28, 0xfd, 0xc1,
+	0xdd, 0x81, 0x15, 0x1e, 0x46, 0x07, 0xf7, 0x0d, 0x77, 0xf8, 0xc0, 0xd3, 0x8d, 0xc3, 0x13, 0x93,
+	0xfa, 0xd9, 0xaf, 0xe3, 0x87, 0x0f, 0x02, 0xdf, 0xc0, 0x7f, 0xc7, 0x71, 0x50, 0x63, 0x5d, 0x3d,
+	0xfa, 0xdf, 0x01, 0x00, 0x8e, 0xe8, 0x34, 0xae, 0xa0, 0x43, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5713,7 +6012,7 @@ type APIClient interface {
 	// ListDatum returns information about each datum fed to a Pachyderm job
 	ListDatum(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (API_ListDatumClient, error)
 	RestartDatum(ctx context.Context, in *RestartDatumRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*CreatePipelineResponse, error)
 	InspectPipeline(ctx context.Context, in *InspectPipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error)
 	ListPipeline(ctx context.Context, in *ListPipelineRequest, opts ...grpc.CallOption) (*PipelineInfos, error)
 	DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
@@ -5893,8 +6192,8 @@ func (c *aPIClient) RestartDatum(ctx context.Context, in *RestartDatumRequest, o
 	return out, nil
 }
 
-func (c *aPIClient) CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
+func (c *aPIClient) CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*CreatePipelineResponse, error) {
+	out := new(CreatePipelineResponse)
 	err := c.cc.Invoke(ctx, "/pps_v2.API/CreatePipeline", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -6073,7 +6372,7 @@ type APIServer interface {
 	// ListDatum returns information about each datum fed to a Pachyderm job
 	ListDatum(*ListDatumRequest, API_ListDatumServer) error
 	RestartDatum(context.Context, *RestartDatumRequest) (*types.Empty, error)
-	CreatePipeline(context.Context, *CreatePipelineRequest) (*types.Empty, error)
+	CreatePipeline(context.Context, *CreatePipelineRequest) (*CreatePipelineResponse, error)
 	InspectPipeline(context.Context, *InspectPipelineRequest) (*PipelineInfo, error)
 	ListPipeline(context.Context, *ListPipelineRequest) (*PipelineInfos, error)
 	DeletePipeline(context.Context, *DeletePipelineRequest) (*types.Empty, error)
@@ -6126,7 +6425,7 @@ func (*UnimplementedAPIServer) ListDatum(req *ListDatumRequest, srv API_ListDatu
 func (*UnimplementedAPIServer) RestartDatum(ctx context.Context, req *RestartDatumRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RestartDatum not implemented")
 }
-func (*UnimplementedAPIServer) CreatePipeline(ctx context.Context, req *CreatePipelineRequest) (*types.Empty, error) {
+func (*UnimplementedAPIServer) CreatePipeline(ctx context.Context, req *CreatePipelineRequest) (*CreatePipelineResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePipeline not implemented")
 }
 func (*UnimplementedAPIServer) InspectPipeline(ctx context.Context, req *InspectPipelineRequest) (*PipelineInfo, error) {
@@ -7313,6 +7612,46 @@ func (m *PFSInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ChangedSinceParent {
+		i--
+		if m.ChangedSinceParent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.PathRegex) > 0 {
+		i -= len(m.PathRegex)
+		copy(dAtA[i:], m.PathRegex)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PathRegex)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.MaxSizeBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxSizeBytes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MinSizeBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.MinSizeBytes))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.ExcludeGlob) > 0 {
+		i -= len(m.ExcludeGlob)
+		copy(dAtA[i:], m.ExcludeGlob)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ExcludeGlob)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if len(m.RepoType) > 0 {
 		i -= len(m.RepoType)
 		copy(dAtA[i:], m.RepoType)
@@ -7965,6 +8304,38 @@ func (m *ProcessStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ScratchBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.ScratchBytes))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DiskWriteBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DiskWriteBytes))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DiskReadBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DiskReadBytes))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CPUTime != nil {
+		{
+			size, err := m.CPUTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PeakMemoryBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.PeakMemoryBytes))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.UploadBytes != 0 {
 		i = encodeVarintPps(dAtA, i, uint64(m.UploadBytes))
 		i--
@@ -8038,9 +8409,9 @@ func (m *AggregateProcessStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UploadBytes != nil {
+	if m.ScratchBytes != nil {
 		{
-			size, err := m.UploadBytes.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ScratchBytes.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8048,7 +8419,67 @@ func (m *AggregateProcessStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x52
+	}
+	if m.DiskWriteBytes != nil {
+		{
+			size, err := m.DiskWriteBytes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DiskReadBytes != nil {
+		{
+			size, err := m.DiskReadBytes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.CPUTime != nil {
+		{
+			size, err := m.CPUTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PeakMemoryBytes != nil {
+		{
+			size, err := m.PeakMemoryBytes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.UploadBytes != nil {
+		{
+			size, err := m.UploadBytes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.DownloadBytes != nil {
 		{
@@ -10603,6 +11034,18 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
 	if len(m.ReprocessSpec) > 0 {
 		i -= len(m.ReprocessSpec)
 		copy(dAtA[i:], m.ReprocessSpec)
@@ -10951,6 +11394,92 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PipelineDryRun) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PipelineDryRun) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PipelineDryRun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.InputSizeBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.InputSizeBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RemovedDatums != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.RemovedDatums))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SkippedDatums != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.SkippedDatums))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NewDatums != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.NewDatums))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreatePipelineResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatePipelineResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreatePipelineResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DryRun != nil {
+		{
+			size, err := m.DryRun.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *InspectPipelineRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11917,6 +12446,23 @@ func (m *PFSInput) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	l = len(m.ExcludeGlob)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.MinSizeBytes != 0 {
+		n += 1 + sovPps(uint64(m.MinSizeBytes))
+	}
+	if m.MaxSizeBytes != 0 {
+		n += 2 + sovPps(uint64(m.MaxSizeBytes))
+	}
+	l = len(m.PathRegex)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.ChangedSinceParent {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12175,6 +12721,22 @@ func (m *ProcessStats) Size() (n int) {
 	if m.UploadBytes != 0 {
 		n += 1 + sovPps(uint64(m.UploadBytes))
 	}
+	if m.PeakMemoryBytes != 0 {
+		n += 1 + sovPps(uint64(m.PeakMemoryBytes))
+	}
+	if m.CPUTime != nil {
+		l = m.CPUTime.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.DiskReadBytes != 0 {
+		n += 1 + sovPps(uint64(m.DiskReadBytes))
+	}
+	if m.DiskWriteBytes != 0 {
+		n += 1 + sovPps(uint64(m.DiskWriteBytes))
+	}
+	if m.ScratchBytes != 0 {
+		n += 1 + sovPps(uint64(m.ScratchBytes))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12207,6 +12769,26 @@ func (m *AggregateProcessStats) Size() (n int) {
 		l = m.UploadBytes.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.PeakMemoryBytes != nil {
+		l = m.PeakMemoryBytes.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.CPUTime != nil {
+		l = m.CPUTime.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.DiskReadBytes != nil {
+		l = m.DiskReadBytes.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.DiskWriteBytes != nil {
+		l = m.DiskWriteBytes.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ScratchBytes != nil {
+		l = m.ScratchBytes.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13342,6 +13924,49 @@ func (m *CreatePipelineRequest) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.DryRun {
+		n += 3
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PipelineDryRun) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewDatums != 0 {
+		n += 1 + sovPps(uint64(m.NewDatums))
+	}
+	if m.SkippedDatums != 0 {
+		n += 1 + sovPps(uint64(m.SkippedDatums))
+	}
+	if m.RemovedDatums != 0 {
+		n += 1 + sovPps(uint64(m.RemovedDatums))
+	}
+	if m.InputSizeBytes != 0 {
+		n += 1 + sovPps(uint64(m.InputSizeBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreatePipelineResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DryRun != nil {
+		l = m.DryRun.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -15778,60 +16403,9 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 			}
 			m.RepoType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CronInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CronInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeGlob", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15859,13 +16433,13 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ExcludeGlob = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSizeBytes", wireType)
 			}
-			var stringLen uint64
+			m.MinSizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15875,16 +16449,189 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MinSizeBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSizeBytes", wireType)
 			}
-			postIndex := iNdEx + intStringLen
+			m.MaxSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathRegex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathRegex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedSinceParent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChangedSinceParent = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
@@ -17315,6 +18062,118 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeakMemoryBytes", wireType)
+			}
+			m.PeakMemoryBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeakMemoryBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CPUTime == nil {
+				m.CPUTime = &types.Duration{}
+			}
+			if err := m.CPUTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskReadBytes", wireType)
+			}
+			m.DiskReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskReadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskWriteBytes", wireType)
+			}
+			m.DiskWriteBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskWriteBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScratchBytes", wireType)
+			}
+			m.ScratchBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScratchBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -17356,19 +18215,199 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownloadTime == nil {
+				m.DownloadTime = &Aggregate{}
+			}
+			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProcessTime == nil {
+				m.ProcessTime = &Aggregate{}
+			}
+			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UploadTime == nil {
+				m.UploadTime = &Aggregate{}
+			}
+			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownloadBytes == nil {
+				m.DownloadBytes = &Aggregate{}
+			}
+			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UploadBytes == nil {
+				m.UploadBytes = &Aggregate{}
+			}
+			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeakMemoryBytes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17395,16 +18434,16 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadTime == nil {
-				m.DownloadTime = &Aggregate{}
+			if m.PeakMemoryBytes == nil {
+				m.PeakMemoryBytes = &Aggregate{}
 			}
-			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PeakMemoryBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17431,16 +18470,16 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProcessTime == nil {
-				m.ProcessTime = &Aggregate{}
+			if m.CPUTime == nil {
+				m.CPUTime = &Aggregate{}
 			}
-			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CPUTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskReadBytes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17467,16 +18506,16 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadTime == nil {
-				m.UploadTime = &Aggregate{}
+			if m.DiskReadBytes == nil {
+				m.DiskReadBytes = &Aggregate{}
 			}
-			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DiskReadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskWriteBytes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17503,16 +18542,16 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadBytes == nil {
-				m.DownloadBytes = &Aggregate{}
+			if m.DiskWriteBytes == nil {
+				m.DiskWriteBytes = &Aggregate{}
 			}
-			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DiskWriteBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScratchBytes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17539,10 +18578,10 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadBytes == nil {
-				m.UploadBytes = &Aggregate{}
+			if m.ScratchBytes == nil {
+				m.ScratchBytes = &Aggregate{}
 			}
-			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScratchBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25514,6 +26553,240 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineDryRun) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineDryRun: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineDryRun: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDatums", wireType)
+			}
+			m.NewDatums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewDatums |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedDatums", wireType)
+			}
+			m.SkippedDatums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedDatums |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedDatums", wireType)
+			}
+			m.RemovedDatums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemovedDatums |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputSizeBytes", wireType)
+			}
+			m.InputSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InputSizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreatePipelineResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreatePipelineResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreatePipelineResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DryRun == nil {
+				m.DryRun = &PipelineDryRun{}
+			}
+			if err := m.DryRun.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
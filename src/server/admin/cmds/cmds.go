@@ -2,13 +2,31 @@ package cmds
 
 import (
 	"fmt"
+	"os"
 
+	"github.com/pachyderm/pachyderm/v2/src/admin"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pretty"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
 
 	"github.com/spf13/cobra"
 )
 
+const migrationHeader = "NUMBER\tNAME\tSTATUS\tSTARTED\tFINISHED\t\n"
+
+func printMigration(w *tabwriter.Writer, m *admin.Migration, status string) {
+	started, finished := "-", "-"
+	if m.Started != nil {
+		started = pretty.Ago(m.Started)
+	}
+	if m.Finished != nil {
+		finished = pretty.Ago(m.Finished)
+	}
+	fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t\n", m.Number, m.Name, status, started, finished)
+}
+
 // Cmds returns a slice containing admin commands.
 func Cmds() []*cobra.Command {
 	var commands []*cobra.Command
@@ -32,5 +50,76 @@ func Cmds() []*cobra.Command {
 	}
 	commands = append(commands, cmdutil.CreateAlias(inspectCluster, "inspect cluster"))
 
+	var dryRun, verify, raw bool
+	var output string
+	migrationStatus := &cobra.Command{
+		Short: "List the database migrations that have been applied and that are pending.",
+		Long: `List the database migrations known to pachd, and whether each has been applied to the cluster's database.
+
+With --dry-run, any pending migrations are applied in a transaction that is
+rolled back, and the first error is reported. With --verify, the database
+is checked for the migrations and tables that pachd expects.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			resp, err := c.MigrationStatus(dryRun, verify)
+			if err != nil {
+				return err
+			}
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				if err := cmdutil.PrintAll(printer, resp); err != nil {
+					return err
+				}
+			} else {
+				writer := tabwriter.NewWriter(os.Stdout, migrationHeader)
+				for _, m := range resp.Migrations {
+					status := "pending"
+					if m.Applied && m.Finished == nil {
+						status = "unfinished"
+					} else if m.Applied {
+						status = "applied"
+					}
+					printMigration(writer, m, status)
+				}
+				for _, m := range resp.Unknown {
+					printMigration(writer, m, "unknown")
+				}
+				if err := writer.Flush(); err != nil {
+					return err
+				}
+			}
+			if resp.DryRunError != "" {
+				return errors.Errorf("dry run failed: %s", resp.DryRunError)
+			}
+			if resp.VerifyError != "" {
+				return errors.Errorf("verification failed: %s", resp.VerifyError)
+			}
+			return nil
+		}),
+	}
+	migrationStatus.Flags().BoolVar(&dryRun, "dry-run", false, "Apply pending migrations in a transaction that is rolled back, and report any errors.")
+	migrationStatus.Flags().BoolVar(&verify, "verify", false, "Check that all migrations finished and that the expected tables exist.")
+	migrationStatus.Flags().AddFlagSet(cmdutil.OutputFlags(&raw, &output))
+	commands = append(commands, cmdutil.CreateAlias(migrationStatus, "admin migrations status"))
+
+	migrations := &cobra.Command{
+		Short: "Commands for inspecting the cluster's database migrations.",
+		Long:  "Commands for inspecting the cluster's database migrations.",
+	}
+	commands = append(commands, cmdutil.CreateAlias(migrations, "admin migrations"))
+
+	adminDocs := &cobra.Command{
+		Short: "Administrative commands for operating a cluster.",
+		Long:  "Administrative commands for operating a cluster.",
+	}
+	commands = append(commands, cmdutil.CreateAlias(adminDocs, "admin"))
+
 	return commands
 }
@@ -1,18 +1,86 @@
 package server
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/admin"
+	"github.com/pachyderm/pachyderm/v2/src/internal/clusterstate"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 
 	"golang.org/x/net/context"
 )
 
 type apiServer struct {
 	log.Logger
+	env         serviceenv.ServiceEnv
 	clusterInfo *admin.ClusterInfo
 }
 
 func (a *apiServer) InspectCluster(ctx context.Context, request *types.Empty) (*admin.ClusterInfo, error) {
 	return a.clusterInfo, nil
 }
+
+// MigrationStatus implements the protobuf admin.MigrationStatus RPC
+func (a *apiServer) MigrationStatus(ctx context.Context, request *admin.MigrationStatusRequest) (response *admin.MigrationStatusResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	db := a.env.GetDBClient()
+	applied, err := migrations.ListApplied(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	response = &admin.MigrationStatusResponse{}
+	for _, state := range migrations.States(clusterstate.DesiredClusterState) {
+		m := &admin.Migration{
+			Number: int64(state.Number()),
+			Name:   state.Name(),
+		}
+		if state.Number() < len(applied) && applied[state.Number()].Name == state.Name() {
+			if err := setApplied(m, applied[state.Number()]); err != nil {
+				return nil, err
+			}
+		}
+		response.Migrations = append(response.Migrations, m)
+	}
+	for i, as := range applied {
+		if i < len(response.Migrations) && response.Migrations[i].Applied {
+			continue
+		}
+		m := &admin.Migration{
+			Number: int64(as.Number),
+			Name:   as.Name,
+		}
+		if err := setApplied(m, as); err != nil {
+			return nil, err
+		}
+		response.Unknown = append(response.Unknown, m)
+	}
+	if request.DryRun {
+		if err := migrations.DryRun(ctx, db, migrations.Env{}, clusterstate.DesiredClusterState); err != nil {
+			response.DryRunError = err.Error()
+		}
+	}
+	if request.Verify {
+		if err := clusterstate.Verify(ctx, db); err != nil {
+			response.VerifyError = err.Error()
+		}
+	}
+	return response, nil
+}
+
+func setApplied(m *admin.Migration, a migrations.AppliedState) error {
+	var err error
+	m.Applied = true
+	if m.Started, err = types.TimestampProto(a.StartTime); err != nil {
+		return err
+	}
+	if a.EndTime != nil {
+		if m.Finished, err = types.TimestampProto(*a.EndTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
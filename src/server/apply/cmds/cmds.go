@@ -0,0 +1,97 @@
+package cmds
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+// Cmds returns a slice containing the apply command.
+func Cmds() []*cobra.Command {
+	var commands []*cobra.Command
+
+	var files []string
+	var prune bool
+	var dryRun bool
+	apply := &cobra.Command{
+		Short: "Make the cluster match a manifest of repos, branches, pipelines and role bindings.",
+		Long: `Make the cluster match a manifest of repos, branches, pipelines and role bindings.
+
+A manifest is one or more JSON or YAML documents, each of which has a 'kind' of
+'repo', 'branch', 'pipeline' or 'role_binding'. The rest of a 'repo', 'branch'
+or 'pipeline' document is the request that creates it (e.g. a pipeline spec),
+and a 'role_binding' document has a 'resource' and a map of 'principals' to
+their roles. For example:
+
+  kind: repo
+  repo:
+    name: images
+  description: raw images
+  ---
+  kind: branch
+  branch:
+    repo:
+      name: images
+    name: staging
+  ---
+  kind: role_binding
+  resource:
+    type: repo
+    name: images
+  principals:
+    user:alice@example.com: [repoReader]
+
+apply compares the manifest to the cluster, prints the changes that it will
+make, and then makes them. All of the changes are made in a single transaction,
+so either all of them are made or none are.
+
+Only the fields that are set in a pipeline spec are compared to the existing
+pipeline, as pachd fills in defaults for many of the others.`,
+		Example: `
+# Apply every manifest in a directory
+$ {{alias}} -f manifests/
+
+# Print the changes that would be made, without making them
+$ {{alias}} -f manifests/ --dry-run
+
+# Also delete the repos, branches, pipelines and role bindings that aren't in the manifest
+$ {{alias}} -f manifests/ --prune`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			if len(files) == 0 {
+				return errors.New("no manifest specified, use -f")
+			}
+			m, err := readManifest(files)
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			p, err := computePlan(c, m, prune)
+			if err != nil {
+				return err
+			}
+			p.print(os.Stdout)
+			if dryRun || p.empty() {
+				return nil
+			}
+			if err := p.apply(c); err != nil {
+				return err
+			}
+			fmt.Println("Applied.")
+			return nil
+		}),
+	}
+	apply.Flags().StringSliceVarP(&files, "file", "f", nil, "A file or directory of manifests to apply, or '-' to read from stdin. May be repeated.")
+	apply.Flags().BoolVar(&prune, "prune", false, "Delete the repos, pipelines, branches and role bindings that aren't in the manifest. Branches are only deleted from repos for which the manifest declares branches, and role bindings only from resources for which it declares role bindings.")
+	apply.Flags().BoolVar(&dryRun, "dry-run", false, "Print the changes that would be made, without making them.")
+	commands = append(commands, cmdutil.CreateAlias(apply, "apply"))
+
+	return commands
+}
@@ -0,0 +1,117 @@
+package cmds
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestReadManifest(t *testing.T) {
+	m := &manifest{}
+	require.NoError(t, m.read([]byte(`
+kind: repo
+repo:
+  name: images
+description: raw images
+---
+kind: branch
+branch:
+  repo:
+    name: images
+  name: staging
+provenance:
+  - repo:
+      name: labels
+    name: master
+---
+kind: pipeline
+pipeline:
+  name: edges
+transform:
+  cmd: [python3, /edges.py]
+input:
+  pfs:
+    repo: images
+    glob: /*
+---
+kind: role_binding
+resource:
+  type: repo
+  name: images
+principals:
+  user:alice: [repoReader]
+`)))
+	require.NoError(t, m.validate())
+	require.Equal(t, 1, len(m.repos))
+	require.Equal(t, "images", m.repos[0].Repo.Name)
+	require.Equal(t, pfs.UserRepoType, m.repos[0].Repo.Type)
+	require.Equal(t, "raw images", m.repos[0].Description)
+	require.Equal(t, 1, len(m.branches))
+	require.Equal(t, "staging", m.branches[0].Branch.Name)
+	require.Equal(t, pfs.UserRepoType, m.branches[0].Provenance[0].Repo.Type)
+	require.Equal(t, 1, len(m.pipelines))
+	require.Equal(t, "images", m.pipelines[0].Input.Pfs.Repo)
+	require.Equal(t, 1, len(m.roleBindings))
+	resource, err := m.roleBindings[0].resource()
+	require.NoError(t, err)
+	require.Equal(t, auth.ResourceType_REPO, resource.Type)
+	require.Equal(t, []string{"repoReader"}, m.roleBindings[0].Principals["user:alice"])
+
+	// JSON manifests may contain several documents too
+	m = &manifest{}
+	require.NoError(t, m.read([]byte(`{"kind": "repo", "repo": {"name": "a"}} {"kind": "repo", "repo": {"name": "b"}}`)))
+	require.Equal(t, 2, len(m.repos))
+
+	m = &manifest{}
+	require.YesError(t, m.read([]byte(`{"kind": "volume"}`)))
+	require.YesError(t, m.read([]byte(`{"repo": {"name": "a"}}`)))
+	require.YesError(t, m.read([]byte(`{"kind": "repo", "repo": {"name": "a"}, "size": 1}`)))
+
+	// resources may only be declared once
+	m = &manifest{}
+	require.NoError(t, m.read([]byte(`{"kind": "repo", "repo": {"name": "a"}} {"kind": "repo", "repo": {"name": "a"}}`)))
+	require.YesError(t, m.validate())
+}
+
+func TestTopologicalSort(t *testing.T) {
+	order, err := topologicalSort([]string{"c", "b", "a"}, map[string][]string{
+		"a": {"b"},
+		"b": {"c", "external"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "b", "a"}, order)
+
+	_, err = topologicalSort([]string{"a", "b"}, map[string][]string{
+		"a": {"b"},
+		"b": {"a"},
+	})
+	require.YesError(t, err)
+}
+
+func TestPipelineDrifted(t *testing.T) {
+	pipelineInfo := &pps.PipelineInfo{
+		Pipeline:     &pps.Pipeline{Name: "edges"},
+		Transform:    &pps.Transform{Image: "pachyderm/opencv", Cmd: []string{"python3", "/edges.py"}},
+		OutputBranch: "master",
+		CacheSize:    "64M",
+		Input:        &pps.Input{Pfs: &pps.PFSInput{Name: "images", Repo: "images", Branch: "master", Glob: "/*"}},
+	}
+	req := &pps.CreatePipelineRequest{
+		Pipeline:  &pps.Pipeline{Name: "edges"},
+		Transform: &pps.Transform{Image: "pachyderm/opencv", Cmd: []string{"python3", "/edges.py"}},
+		Input:     &pps.Input{Pfs: &pps.PFSInput{Repo: "images", Glob: "/*"}},
+		Update:    true,
+	}
+	// Defaults that pachd fills in aren't drift
+	drifted, err := pipelineDrifted(req, pipelineInfo)
+	require.NoError(t, err)
+	require.False(t, drifted)
+
+	req.Transform.Cmd = []string{"python3", "/edges2.py"}
+	drifted, err = pipelineDrifted(req, pipelineInfo)
+	require.NoError(t, err)
+	require.True(t, drifted)
+}
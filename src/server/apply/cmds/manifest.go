@@ -0,0 +1,271 @@
+package cmds
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serde"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	pfspretty "github.com/pachyderm/pachyderm/v2/src/server/pfs/pretty"
+)
+
+// Each document in a manifest has a 'kind', and the rest of the document is
+// the request that creates the resource:
+//
+//	kind: repo            -> pfs.CreateRepoRequest
+//	kind: branch          -> pfs.CreateBranchRequest
+//	kind: pipeline        -> pps.CreatePipelineRequest (i.e. a pipeline spec)
+//	kind: role_binding    -> roleBinding
+const (
+	repoKind        = "repo"
+	branchKind      = "branch"
+	pipelineKind    = "pipeline"
+	roleBindingKind = "role_binding"
+)
+
+// roleBinding is the set of roles that principals have on a resource. Unlike
+// auth.RoleBinding, roles are given as a list, e.g.
+//
+//	resource: {type: repo, name: images}
+//	principals: {"user:alice": [repoReader]}
+type roleBinding struct {
+	Resource struct {
+		Type string `json:"type"`
+		Name string `json:"name"`
+	} `json:"resource"`
+	Principals map[string][]string `json:"principals"`
+}
+
+func (rb *roleBinding) resource() (*auth.Resource, error) {
+	t, ok := auth.ResourceType_value[strings.ToUpper(rb.Resource.Type)]
+	if !ok || t == int32(auth.ResourceType_RESOURCE_TYPE_UNKNOWN) {
+		return nil, errors.Errorf("unknown resource type %q in role binding", rb.Resource.Type)
+	}
+	resource := &auth.Resource{Type: auth.ResourceType(t), Name: rb.Resource.Name}
+	if resource.Type == auth.ResourceType_REPO && resource.Name == "" {
+		return nil, errors.New("repo role bindings must specify a repo name")
+	}
+	return resource, nil
+}
+
+// manifest is the desired state of the resources that it declares.
+type manifest struct {
+	repos        []*pfs.CreateRepoRequest
+	branches     []*pfs.CreateBranchRequest
+	pipelines    []*pps.CreatePipelineRequest
+	roleBindings []*roleBinding
+}
+
+// readManifest reads every document in the files at 'paths'. A path may be a
+// directory, in which case every .json, .yaml and .yml file under it is read.
+func readManifest(paths []string) (*manifest, error) {
+	m := &manifest{}
+	for _, path := range paths {
+		var files []string
+		if path == "-" {
+			files = append(files, path)
+		} else if err := filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				return nil
+			}
+			switch filepath.Ext(file) {
+			case ".json", ".yaml", ".yml":
+				files = append(files, file)
+			default:
+				if file == path {
+					// Read files that are named explicitly regardless of
+					// their extension.
+					files = append(files, file)
+				}
+			}
+			return nil
+		}); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		sort.Strings(files)
+		for _, file := range files {
+			if err := m.readFile(file); err != nil {
+				return nil, errors.Wrapf(err, "error reading %s", file)
+			}
+		}
+	}
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *manifest) readFile(file string) error {
+	var data []byte
+	var err error
+	if file == "-" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(file)
+	}
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	return m.read(data)
+}
+
+// read reads every document in 'data', which is either JSON or YAML.
+func (m *manifest) read(data []byte) error {
+	var decoder serde.Decoder
+	idx := bytes.IndexFunc(data, func(r rune) bool {
+		return !unicode.IsSpace(r)
+	})
+	if idx >= 0 && data[idx] == '{' {
+		decoder = serde.NewJSONDecoder(bytes.NewReader(data))
+	} else {
+		decoder = serde.NewYAMLDecoder(bytes.NewReader(data))
+	}
+	for {
+		holder := make(map[string]interface{})
+		if err := decoder.Decode(&holder); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if len(holder) == 0 {
+			continue // empty YAML document
+		}
+		if err := m.add(holder); err != nil {
+			return err
+		}
+	}
+}
+
+// add adds a single decoded document to the manifest.
+func (m *manifest) add(holder map[string]interface{}) error {
+	kind, ok := holder["kind"].(string)
+	if !ok {
+		return errors.New("every document must have a 'kind'")
+	}
+	delete(holder, "kind")
+	data, err := json.Marshal(holder)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	unmarshal := func(v proto.Message) error {
+		if err := jsonpb.Unmarshal(bytes.NewReader(data), v); err != nil {
+			return errors.Wrapf(err, "malformed %s", kind)
+		}
+		return nil
+	}
+	switch kind {
+	case repoKind:
+		req := &pfs.CreateRepoRequest{}
+		if err := unmarshal(req); err != nil {
+			return err
+		}
+		m.repos = append(m.repos, req)
+	case branchKind:
+		req := &pfs.CreateBranchRequest{}
+		if err := unmarshal(req); err != nil {
+			return err
+		}
+		m.branches = append(m.branches, req)
+	case pipelineKind:
+		req := &pps.CreatePipelineRequest{}
+		if err := unmarshal(req); err != nil {
+			return err
+		}
+		m.pipelines = append(m.pipelines, req)
+	case roleBindingKind:
+		rb := &roleBinding{}
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(rb); err != nil {
+			return errors.Wrapf(err, "malformed %s", kind)
+		}
+		m.roleBindings = append(m.roleBindings, rb)
+	default:
+		return errors.Errorf("unknown kind %q (must be one of %q, %q, %q or %q)", kind, repoKind, branchKind, pipelineKind, roleBindingKind)
+	}
+	return nil
+}
+
+// validate checks that every resource in the manifest is named, and that no
+// resource is declared twice. It also fills in default repo types.
+func (m *manifest) validate() error {
+	seen := make(map[string]bool)
+	declare := func(key string) error {
+		if seen[key] {
+			return errors.Errorf("%s is declared more than once", key)
+		}
+		seen[key] = true
+		return nil
+	}
+	for _, req := range m.repos {
+		if req.Repo == nil || req.Repo.Name == "" {
+			return errors.New("repos must specify a name")
+		}
+		setRepoDefaults(req.Repo)
+		if err := declare("repo " + pfspretty.CompactPrintRepo(req.Repo)); err != nil {
+			return err
+		}
+	}
+	for _, req := range m.branches {
+		if req.Branch == nil || req.Branch.Repo == nil || req.Branch.Name == "" || req.Branch.Repo.Name == "" {
+			return errors.New("branches must specify a repo and a name")
+		}
+		setRepoDefaults(req.Branch.Repo)
+		for _, prov := range req.Provenance {
+			setRepoDefaults(prov.Repo)
+		}
+		if req.Head != nil && req.Head.Branch != nil {
+			setRepoDefaults(req.Head.Branch.Repo)
+		}
+		if err := declare("branch " + pfspretty.CompactPrintBranch(req.Branch)); err != nil {
+			return err
+		}
+	}
+	for _, req := range m.pipelines {
+		if req.Pipeline == nil || req.Pipeline.Name == "" {
+			return errors.New("pipelines must specify a name")
+		}
+		if err := declare("pipeline " + req.Pipeline.Name); err != nil {
+			return err
+		}
+	}
+	for _, rb := range m.roleBindings {
+		resource, err := rb.resource()
+		if err != nil {
+			return err
+		}
+		if err := declare("role binding on " + resourceKey(resource)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setRepoDefaults(repo *pfs.Repo) {
+	if repo != nil && repo.Type == "" {
+		repo.Type = pfs.UserRepoType
+	}
+}
+
+func resourceKey(resource *auth.Resource) string {
+	if resource.Type == auth.ResourceType_CLUSTER {
+		return "cluster"
+	}
+	return strings.ToLower(resource.Type.String()) + " " + resource.Name
+}
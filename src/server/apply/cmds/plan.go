@@ -0,0 +1,595 @@
+package cmds
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serde"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	pfspretty "github.com/pachyderm/pachyderm/v2/src/server/pfs/pretty"
+)
+
+// change is a single change to the cluster, which is added to the
+// transaction that applies a plan.
+type change struct {
+	description string
+	batch       func(*client.TransactionBuilder) error
+}
+
+// plan is the set of changes that make the cluster match a manifest, in the
+// order in which they're applied. All of the changes are made in a single
+// transaction.
+type plan struct {
+	// deletes are the pipeline deletions, which are made first, so that the
+	// pipelines' input repos and branches can be deleted afterwards.
+	deletes []*change
+	// batch are the changes to repos, branches and pipelines.
+	batch []*change
+	// bindings are the role binding changes, which are made last, so that
+	// they may refer to repos that are created earlier in the transaction.
+	bindings []*change
+}
+
+func (p *plan) empty() bool {
+	return len(p.deletes) == 0 && len(p.batch) == 0 && len(p.bindings) == 0
+}
+
+func (p *plan) print(w io.Writer) {
+	if p.empty() {
+		fmt.Fprintln(w, "No changes. The cluster matches the manifest.")
+		return
+	}
+	for _, changes := range [][]*change{p.deletes, p.batch, p.bindings} {
+		for _, c := range changes {
+			fmt.Fprintln(w, c.description)
+		}
+	}
+}
+
+// apply makes the changes in 'p'.
+func (p *plan) apply(c *client.APIClient) error {
+	if p.empty() {
+		return nil
+	}
+	if _, err := c.RunBatchInTransaction(func(builder *client.TransactionBuilder) error {
+		for _, changes := range [][]*change{p.deletes, p.batch, p.bindings} {
+			for _, ch := range changes {
+				if err := ch.batch(builder); err != nil {
+					return errors.Wrapf(err, "could not %s", ch.description)
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrapf(grpcutil.ScrubGRPC(err), "could not apply transaction")
+	}
+	return nil
+}
+
+// computePlan compares 'm' to the state of the cluster, and returns the changes
+// that make the cluster match it. If 'prune' is set, resources that aren't in
+// the manifest are deleted:
+//   - user repos, except the output repos of pipelines,
+//   - pipelines,
+//   - branches, but only in repos for which the manifest declares branches,
+//   - role bindings, but only on resources for which the manifest declares
+//     role bindings, and not those of pipelines or internal users.
+func computePlan(c *client.APIClient, m *manifest, prune bool) (*plan, error) {
+	p := &plan{}
+	pipelineInfos, err := c.ListPipeline()
+	if err != nil {
+		return nil, err
+	}
+	pipelineRepos := make(map[string]bool)
+	for _, pipelineInfo := range pipelineInfos {
+		addPipelineRepos(pipelineRepos, pipelineInfo.Pipeline.Name, pipelineInfo.Input)
+	}
+	for _, req := range m.pipelines {
+		addPipelineRepos(pipelineRepos, req.Pipeline.Name, req.Input)
+	}
+
+	// Repos
+	repoInfos, err := c.ListRepo()
+	if err != nil {
+		return nil, err
+	}
+	existingRepos := make(map[string]*pfs.RepoInfo)
+	for _, repoInfo := range repoInfos {
+		existingRepos[pfspretty.CompactPrintRepo(repoInfo.Repo)] = repoInfo
+	}
+	managedRepos := make(map[string]bool)
+	for _, req := range m.repos {
+		req := req
+		key := pfspretty.CompactPrintRepo(req.Repo)
+		managedRepos[key] = true
+		repoInfo, ok := existingRepos[key]
+		if ok && repoInfo.Description == req.Description {
+			continue
+		}
+		description := "+ create repo " + key
+		if ok {
+			req.Update = true
+			description = "~ update repo " + key + " (description)"
+		}
+		p.batch = append(p.batch, &change{
+			description: description,
+			batch: func(builder *client.TransactionBuilder) error {
+				_, err := builder.PfsAPIClient.CreateRepo(builder.Ctx(), req)
+				return err
+			},
+		})
+	}
+
+	// Branches
+	branchChanges, err := planBranches(c, m, existingRepos, prune)
+	if err != nil {
+		return nil, err
+	}
+	p.batch = append(p.batch, branchChanges...)
+
+	// Pipelines
+	pipelineChanges, err := planPipelines(m, pipelineInfos)
+	if err != nil {
+		return nil, err
+	}
+	p.batch = append(p.batch, pipelineChanges...)
+	if prune {
+		managedPipelines := make(map[string]bool)
+		for _, req := range m.pipelines {
+			managedPipelines[req.Pipeline.Name] = true
+		}
+		var unmanaged []*pps.PipelineInfo
+		for _, pipelineInfo := range pipelineInfos {
+			if !managedPipelines[pipelineInfo.Pipeline.Name] {
+				unmanaged = append(unmanaged, pipelineInfo)
+			}
+		}
+		// Delete downstream pipelines before the pipelines that they read from.
+		order, err := sortPipelines(unmanaged)
+		if err != nil {
+			return nil, err
+		}
+		for i := len(order) - 1; i >= 0; i-- {
+			name := order[i]
+			p.deletes = append(p.deletes, &change{
+				description: "- delete pipeline " + name,
+				batch: func(builder *client.TransactionBuilder) error {
+					_, err := builder.PpsAPIClient.DeletePipeline(builder.Ctx(), &pps.DeletePipelineRequest{
+						Pipeline: client.NewPipeline(name),
+					})
+					return err
+				},
+			})
+		}
+		for _, repoInfo := range repoInfos {
+			key := pfspretty.CompactPrintRepo(repoInfo.Repo)
+			if managedRepos[key] || pipelineRepos[repoInfo.Repo.Name] {
+				continue
+			}
+			repo := repoInfo.Repo
+			p.batch = append(p.batch, &change{
+				description: "- delete repo " + key,
+				batch: func(builder *client.TransactionBuilder) error {
+					_, err := builder.PfsAPIClient.DeleteRepo(builder.Ctx(), &pfs.DeleteRepoRequest{Repo: repo})
+					return err
+				},
+			})
+		}
+	}
+
+	// Role bindings
+	if len(m.roleBindings) > 0 {
+		bindingChanges, err := planRoleBindings(c, m, prune)
+		if err != nil {
+			return nil, err
+		}
+		p.bindings = bindingChanges
+	}
+	return p, nil
+}
+
+// addPipelineRepos adds the repos that are created by a pipeline, its output
+// repo and the repos of its cron inputs, to 'repos'.
+func addPipelineRepos(repos map[string]bool, pipeline string, input *pps.Input) {
+	repos[pipeline] = true
+	pps.VisitInput(input, func(input *pps.Input) error {
+		if input.Cron != nil {
+			repo := input.Cron.Repo
+			if repo == "" {
+				repo = fmt.Sprintf("%s_%s", pipeline, input.Cron.Name)
+			}
+			repos[repo] = true
+		}
+		return nil
+	})
+}
+
+func planBranches(c *client.APIClient, m *manifest, existingRepos map[string]*pfs.RepoInfo, prune bool) ([]*change, error) {
+	var changes []*change
+	existingBranches := make(map[string]*pfs.BranchInfo)
+	managedBranchRepos := make(map[string]*pfs.Repo)
+	for _, req := range m.branches {
+		key := pfspretty.CompactPrintRepo(req.Branch.Repo)
+		if _, ok := managedBranchRepos[key]; ok {
+			continue
+		}
+		managedBranchRepos[key] = req.Branch.Repo
+		if _, ok := existingRepos[key]; !ok {
+			continue // the repo will be created
+		}
+		branchInfos, err := c.ListBranch(req.Branch.Repo.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, branchInfo := range branchInfos {
+			existingBranches[pfspretty.CompactPrintBranch(branchInfo.Branch)] = branchInfo
+		}
+	}
+	order, err := sortBranches(m.branches)
+	if err != nil {
+		return nil, err
+	}
+	managedBranches := make(map[string]bool)
+	for _, req := range order {
+		req := req
+		key := pfspretty.CompactPrintBranch(req.Branch)
+		managedBranches[key] = true
+		branchInfo, ok := existingBranches[key]
+		description := "+ create branch " + key
+		if ok {
+			var diffs []string
+			if !sameBranches(branchInfo.DirectProvenance, req.Provenance) {
+				diffs = append(diffs, "provenance")
+			}
+			if !proto.Equal(branchInfo.Trigger, req.Trigger) {
+				diffs = append(diffs, "trigger")
+			}
+			if len(diffs) == 0 {
+				continue
+			}
+			description = fmt.Sprintf("~ update branch %s (%s)", key, strings.Join(diffs, ", "))
+			// Don't move the head of an existing branch.
+			req = proto.Clone(req).(*pfs.CreateBranchRequest)
+			req.Head = nil
+		}
+		changes = append(changes, &change{
+			description: description,
+			batch: func(builder *client.TransactionBuilder) error {
+				_, err := builder.PfsAPIClient.CreateBranch(builder.Ctx(), req)
+				return err
+			},
+		})
+	}
+	if prune {
+		var keys []string
+		for key := range existingBranches {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if managedBranches[key] {
+				continue
+			}
+			branch := existingBranches[key].Branch
+			changes = append(changes, &change{
+				description: "- delete branch " + key,
+				batch: func(builder *client.TransactionBuilder) error {
+					_, err := builder.PfsAPIClient.DeleteBranch(builder.Ctx(), &pfs.DeleteBranchRequest{Branch: branch})
+					return err
+				},
+			})
+		}
+	}
+	return changes, nil
+}
+
+// sameBranches returns true if 'a' and 'b' contain the same branches, in any
+// order.
+func sameBranches(a, b []*pfs.Branch) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	keys := make(map[string]bool)
+	for _, branch := range a {
+		keys[pfspretty.CompactPrintBranch(branch)] = true
+	}
+	for _, branch := range b {
+		if !keys[pfspretty.CompactPrintBranch(branch)] {
+			return false
+		}
+	}
+	return true
+}
+
+// sortBranches orders 'reqs' so that each branch comes after the branches in
+// its provenance that are also in 'reqs'.
+func sortBranches(reqs []*pfs.CreateBranchRequest) ([]*pfs.CreateBranchRequest, error) {
+	byKey := make(map[string]*pfs.CreateBranchRequest)
+	deps := make(map[string][]string)
+	var keys []string
+	for _, req := range reqs {
+		key := pfspretty.CompactPrintBranch(req.Branch)
+		byKey[key] = req
+		keys = append(keys, key)
+		for _, prov := range req.Provenance {
+			deps[key] = append(deps[key], pfspretty.CompactPrintBranch(prov))
+		}
+	}
+	order, err := topologicalSort(keys, deps)
+	if err != nil {
+		return nil, errors.Wrapf(err, "branch provenance")
+	}
+	result := make([]*pfs.CreateBranchRequest, len(order))
+	for i, key := range order {
+		result[i] = byKey[key]
+	}
+	return result, nil
+}
+
+func planPipelines(m *manifest, pipelineInfos []*pps.PipelineInfo) ([]*change, error) {
+	existing := make(map[string]*pps.PipelineInfo)
+	for _, pipelineInfo := range pipelineInfos {
+		existing[pipelineInfo.Pipeline.Name] = pipelineInfo
+	}
+	byName := make(map[string]*pps.CreatePipelineRequest)
+	deps := make(map[string][]string)
+	var names []string
+	for _, req := range m.pipelines {
+		name := req.Pipeline.Name
+		byName[name] = req
+		names = append(names, name)
+		deps[name] = inputRepos(req.Input)
+	}
+	order, err := topologicalSort(names, deps)
+	if err != nil {
+		return nil, errors.Wrapf(err, "pipeline inputs")
+	}
+	var changes []*change
+	for _, name := range order {
+		req := byName[name]
+		description := "+ create pipeline " + name
+		if pipelineInfo, ok := existing[name]; ok {
+			drifted, err := pipelineDrifted(req, pipelineInfo)
+			if err != nil {
+				return nil, err
+			}
+			if !drifted {
+				continue
+			}
+			req.Update = true
+			description = "~ update pipeline " + name
+		}
+		changes = append(changes, &change{
+			description: description,
+			batch: func(builder *client.TransactionBuilder) error {
+				_, err := builder.PpsAPIClient.CreatePipeline(builder.Ctx(), req)
+				return err
+			},
+		})
+	}
+	return changes, nil
+}
+
+func inputRepos(input *pps.Input) []string {
+	var repos []string
+	pps.VisitInput(input, func(input *pps.Input) error {
+		if input.Pfs != nil {
+			repos = append(repos, input.Pfs.Repo)
+		}
+		return nil
+	})
+	return repos
+}
+
+// sortPipelines orders 'pipelineInfos' so that each pipeline comes after the
+// pipelines that it reads from.
+func sortPipelines(pipelineInfos []*pps.PipelineInfo) ([]string, error) {
+	deps := make(map[string][]string)
+	var names []string
+	for _, pipelineInfo := range pipelineInfos {
+		names = append(names, pipelineInfo.Pipeline.Name)
+		deps[pipelineInfo.Pipeline.Name] = inputRepos(pipelineInfo.Input)
+	}
+	return topologicalSort(names, deps)
+}
+
+// topologicalSort orders 'keys' so that each key comes after its dependencies
+// in 'deps'. Dependencies that aren't in 'keys' are ignored.
+func topologicalSort(keys []string, deps map[string][]string) ([]string, error) {
+	sort.Strings(keys)
+	inKeys := make(map[string]bool)
+	for _, key := range keys {
+		inKeys[key] = true
+	}
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int)
+	var result []string
+	var visit func(key string) error
+	visit = func(key string) error {
+		switch state[key] {
+		case visiting:
+			return errors.Errorf("cycle through %q", key)
+		case visited:
+			return nil
+		}
+		state[key] = visiting
+		for _, dep := range deps[key] {
+			if !inKeys[dep] {
+				continue
+			}
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		state[key] = visited
+		result = append(result, key)
+		return nil
+	}
+	for _, key := range keys {
+		if err := visit(key); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// pipelineDrifted returns true if any field that's set in 'req' differs from
+// the existing pipeline. Fields that aren't set in 'req' are ignored, as pachd
+// fills in defaults for many of them.
+func pipelineDrifted(req *pps.CreatePipelineRequest, pipelineInfo *pps.PipelineInfo) (bool, error) {
+	req = proto.Clone(req).(*pps.CreatePipelineRequest)
+	req.Update = false
+	req.Reprocess = false
+	req.DryRun = false
+	desired, err := toGeneric(req)
+	if err != nil {
+		return false, err
+	}
+	actual, err := toGeneric(ppsutil.PipelineReqFromInfo(pipelineInfo))
+	if err != nil {
+		return false, err
+	}
+	return !isSubset(desired, actual), nil
+}
+
+// toGeneric converts 'msg' to the maps and slices that its JSON encoding
+// decodes to.
+func toGeneric(msg proto.Message) (interface{}, error) {
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	data, err := marshaler.MarshalToString(msg)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var result interface{}
+	if err := serde.DecodeJSON([]byte(data), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// isSubset returns true if every field that's set in 'desired' has the same
+// value in 'actual'. Lists must have the same length, and each element of
+// 'desired' must be a subset of the corresponding element of 'actual'.
+func isSubset(desired, actual interface{}) bool {
+	switch desired := desired.(type) {
+	case map[string]interface{}:
+		actual, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range desired {
+			if !isSubset(v, actual[k]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		actual, ok := actual.([]interface{})
+		if !ok || len(desired) != len(actual) {
+			return false
+		}
+		for i := range desired {
+			if !isSubset(desired[i], actual[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(desired, actual)
+	}
+}
+
+// internalPrincipal returns true for principals whose role bindings are
+// managed by pachd rather than by users, and so are never pruned.
+func internalPrincipal(principal string) bool {
+	return strings.HasPrefix(principal, auth.PachPrefix) || strings.HasPrefix(principal, auth.PipelinePrefix)
+}
+
+func planRoleBindings(c *client.APIClient, m *manifest, prune bool) ([]*change, error) {
+	var changes []*change
+	for _, rb := range m.roleBindings {
+		resource, err := rb.resource()
+		if err != nil {
+			return nil, err
+		}
+		existing := make(map[string][]string)
+		resp, err := c.GetRoleBinding(c.Ctx(), &auth.GetRoleBindingRequest{Resource: resource})
+		// Repos that don't exist yet have no role bindings.
+		if err != nil && !(resource.Type == auth.ResourceType_REPO && pfsserver.IsRepoNotFoundErr(err)) {
+			return nil, grpcutil.ScrubGRPC(err)
+		}
+		if resp != nil && resp.Binding != nil {
+			for principal, roles := range resp.Binding.Entries {
+				for role := range roles.Roles {
+					existing[principal] = append(existing[principal], role)
+				}
+			}
+		}
+		var principals []string
+		for principal := range rb.Principals {
+			principals = append(principals, principal)
+		}
+		if prune {
+			for principal := range existing {
+				if _, ok := rb.Principals[principal]; !ok && !internalPrincipal(principal) {
+					principals = append(principals, principal)
+				}
+			}
+		}
+		sort.Strings(principals)
+		for _, principal := range principals {
+			roles := rb.Principals[principal]
+			if sameRoles(existing[principal], roles) {
+				continue
+			}
+			principal := principal
+			description := fmt.Sprintf("~ set roles of %s on %s to [%s]", principal, resourceKey(resource), strings.Join(roles, ","))
+			if len(roles) == 0 {
+				description = fmt.Sprintf("- delete roles of %s on %s", principal, resourceKey(resource))
+			}
+			changes = append(changes, &change{
+				description: description,
+				batch: func(builder *client.TransactionBuilder) error {
+					_, err := builder.AuthAPIClient.ModifyRoleBinding(builder.Ctx(), &auth.ModifyRoleBindingRequest{
+						Resource:  resource,
+						Principal: principal,
+						Roles:     roles,
+					})
+					return err
+				},
+			})
+		}
+	}
+	return changes, nil
+}
+
+func sameRoles(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	roles := make(map[string]bool)
+	for _, role := range a {
+		roles[role] = true
+	}
+	for _, role := range b {
+		if !roles[role] {
+			return false
+		}
+	}
+	return true
+}
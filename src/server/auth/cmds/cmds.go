@@ -3,10 +3,12 @@ package cmds
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
 
+	units "github.com/docker/go-units"
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/identity"
@@ -14,8 +16,10 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/config"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
+	txncmds "github.com/pachyderm/pachyderm/v2/src/server/transaction/cmds"
 	"github.com/pkg/browser"
 
 	"github.com/spf13/cobra"
@@ -56,13 +60,57 @@ func printRoleBinding(b *auth.RoleBinding) {
 	}
 }
 
+// parseScope parses a token scope of the form
+// "<repo|cluster>[:<name>]=<role or permission>[,<role or permission>...]".
+func parseScope(s string) (*auth.TokenScope, error) {
+	parts := strings.SplitN(s, "=", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, errors.Errorf("invalid scope %q, scopes must have the form <repo|cluster>[:<name>]=<role or permission>[,...]", s)
+	}
+	resource := strings.SplitN(parts[0], ":", 2)
+	scope := &auth.TokenScope{Resource: &auth.Resource{}}
+	switch strings.ToLower(resource[0]) {
+	case "cluster":
+		scope.Resource.Type = auth.ResourceType_CLUSTER
+	case "repo":
+		scope.Resource.Type = auth.ResourceType_REPO
+		if len(resource) != 2 || resource[1] == "" {
+			return nil, errors.Errorf("invalid scope %q, repo scopes must specify a repo name", s)
+		}
+		scope.Resource.Name = resource[1]
+	default:
+		return nil, errors.Errorf("invalid scope %q, unknown resource type %q", s, resource[0])
+	}
+	for _, grant := range strings.Split(parts[1], ",") {
+		if p, ok := auth.Permission_value[grant]; ok {
+			scope.Permissions = append(scope.Permissions, auth.Permission(p))
+		} else {
+			scope.Roles = append(scope.Roles, grant)
+		}
+	}
+	return scope, nil
+}
+
+// formatScope formats a token scope the same way that parseScope parses it.
+func formatScope(scope *auth.TokenScope) string {
+	resource := strings.ToLower(scope.Resource.Type.String())
+	if scope.Resource.Name != "" {
+		resource += ":" + scope.Resource.Name
+	}
+	grants := append([]string{}, scope.Roles...)
+	for _, p := range scope.Permissions {
+		grants = append(grants, p.String())
+	}
+	return resource + "=" + strings.Join(grants, ",")
+}
+
 func newClient(enterprise bool) (*client.APIClient, error) {
 	if enterprise {
 		c, err := client.NewEnterpriseClientOnUserMachine("user")
 		if err != nil {
 			return nil, err
 		}
-		fmt.Printf("Using enterprise context: %v\n", c.ClientContextName())
+		fmt.Fprintf(os.Stderr, "Using enterprise context: %v\n", c.ClientContextName())
 		return c, nil
 	}
 	return client.NewOnUserMachine("user")
@@ -385,6 +433,8 @@ func LogoutCmd() *cobra.Command {
 // credential, logging you out of your cluster. Note that this is not necessary
 // to do before logging in as another user, but is useful for testing.
 func WhoamiCmd() *cobra.Command {
+	var raw bool
+	var output string
 	var enterprise bool
 	whoami := &cobra.Command{
 		Short: "Print your Pachyderm identity",
@@ -399,14 +449,25 @@ func WhoamiCmd() *cobra.Command {
 			if err != nil {
 				return errors.Wrapf(grpcutil.ScrubGRPC(err), "error")
 			}
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				return cmdutil.PrintAll(printer, resp)
+			}
 			fmt.Printf("You are \"%s\"\n", resp.Username)
 			if resp.Expiration != nil {
 				fmt.Printf("session expires: %v\n", resp.Expiration.Format(time.RFC822))
 			}
+			for _, scope := range resp.Scopes {
+				fmt.Printf("scope: %s\n", formatScope(scope))
+			}
 			return nil
 		}),
 	}
 	whoami.PersistentFlags().BoolVar(&enterprise, "enterprise", false, "")
+	whoami.Flags().AddFlagSet(cmdutil.OutputFlags(&raw, &output))
 	return cmdutil.CreateAlias(whoami, "auth whoami")
 }
 
@@ -416,6 +477,7 @@ func GetRobotTokenCmd() *cobra.Command {
 	var enterprise bool
 	var quiet bool
 	var ttl string
+	var scopes []string
 	getAuthToken := &cobra.Command{
 		Use:   "{{alias}} [username]",
 		Short: "Get an auth token for a robot user with the specified name.",
@@ -437,6 +499,13 @@ func GetRobotTokenCmd() *cobra.Command {
 				}
 				req.TTL = int64(d.Seconds())
 			}
+			for _, s := range scopes {
+				scope, err := parseScope(s)
+				if err != nil {
+					return err
+				}
+				req.Scopes = append(req.Scopes, scope)
+			}
 			resp, err := c.GetRobotToken(c.Ctx(), req)
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
@@ -455,6 +524,11 @@ func GetRobotTokenCmd() *cobra.Command {
 	getAuthToken.PersistentFlags().StringVar(&ttl, "ttl", "", "if set, the "+
 		"resulting auth token will have the given lifetime. If not set, the token does not expire."+
 		" This flag should be a golang duration (e.g. \"30s\" or \"1h2m3s\").")
+	getAuthToken.PersistentFlags().StringSliceVar(&scopes, "scope", nil, "if "+
+		"set, the resulting auth token is restricted to the given roles or "+
+		"permissions on a resource, in addition to the robot's role bindings. "+
+		"This flag has the form <repo|cluster>[:<name>]=<role or permission>[,...] "+
+		"(e.g. \"repo:raw=repoWriter\" or \"cluster=REPO_READ\"), and may be repeated.")
 	getAuthToken.PersistentFlags().BoolVar(&enterprise, "enterprise", false, "Get a robot token for the enterprise context")
 	return cmdutil.CreateAlias(getAuthToken, "auth get-robot-token")
 }
@@ -569,8 +643,9 @@ func SetRepoRoleBindingCmd() *cobra.Command {
 				return errors.Wrapf(err, "could not connect")
 			}
 			defer c.Close()
-			err = c.ModifyRepoRoleBinding(repo, subject, roles)
-			return grpcutil.ScrubGRPC(err)
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				return grpcutil.ScrubGRPC(c.ModifyRepoRoleBinding(repo, subject, roles))
+			})
 		}),
 	}
 	return cmdutil.CreateAlias(setScope, "auth set repo")
@@ -578,6 +653,8 @@ func SetRepoRoleBindingCmd() *cobra.Command {
 
 // GetRepoRoleBindingCmd returns a cobra command that gets the role bindings for a resource
 func GetRepoRoleBindingCmd() *cobra.Command {
+	var raw bool
+	var output string
 	get := &cobra.Command{
 		Use:   "{{alias}} <repo>",
 		Short: "Get the role bindings for 'repo'",
@@ -593,10 +670,18 @@ func GetRepoRoleBindingCmd() *cobra.Command {
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
 			}
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				return cmdutil.PrintAll(printer, resp)
+			}
 			printRoleBinding(resp)
 			return nil
 		}),
 	}
+	get.Flags().AddFlagSet(cmdutil.OutputFlags(&raw, &output))
 	return cmdutil.CreateAlias(get, "auth get repo")
 }
 
@@ -620,8 +705,9 @@ func SetClusterRoleBindingCmd() *cobra.Command {
 				return errors.Wrapf(err, "could not connect")
 			}
 			defer c.Close()
-			err = c.ModifyClusterRoleBinding(subject, roles)
-			return grpcutil.ScrubGRPC(err)
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				return grpcutil.ScrubGRPC(c.ModifyClusterRoleBinding(subject, roles))
+			})
 		}),
 	}
 	return cmdutil.CreateAlias(setScope, "auth set cluster")
@@ -629,6 +715,8 @@ func SetClusterRoleBindingCmd() *cobra.Command {
 
 // GetClusterRoleBindingCmd returns a cobra command that gets the role bindings for a resource
 func GetClusterRoleBindingCmd() *cobra.Command {
+	var raw bool
+	var output string
 	get := &cobra.Command{
 		Use:   "{{alias}}",
 		Short: "Get the role bindings for 'repo'",
@@ -643,11 +731,19 @@ func GetClusterRoleBindingCmd() *cobra.Command {
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
 			}
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				return cmdutil.PrintAll(printer, resp)
+			}
 
 			printRoleBinding(resp)
 			return nil
 		}),
 	}
+	get.Flags().AddFlagSet(cmdutil.OutputFlags(&raw, &output))
 	return cmdutil.CreateAlias(get, "auth get cluster")
 }
 
@@ -680,6 +776,8 @@ func SetEnterpriseRoleBindingCmd() *cobra.Command {
 
 // GetEnterpriseRoleBindingCmd returns a cobra command that gets the role bindings for a resource
 func GetEnterpriseRoleBindingCmd() *cobra.Command {
+	var raw bool
+	var output string
 	get := &cobra.Command{
 		Use:   "{{alias}}",
 		Short: "Get the role bindings for the enterprise server",
@@ -694,11 +792,19 @@ func GetEnterpriseRoleBindingCmd() *cobra.Command {
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
 			}
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				return cmdutil.PrintAll(printer, resp)
+			}
 
 			printRoleBinding(resp)
 			return nil
 		}),
 	}
+	get.Flags().AddFlagSet(cmdutil.OutputFlags(&raw, &output))
 	return cmdutil.CreateAlias(get, "auth get enterprise")
 }
 
@@ -735,6 +841,170 @@ func RotateRootToken() *cobra.Command {
 	return cmdutil.CreateAlias(rotateRootToken, "auth rotate-root-token")
 }
 
+const tokenHeader = "ID\tSUBJECT\tCREATED\tEXPIRES\tLAST USED\tSCOPES\n"
+
+// formatTokenTime formats a token timestamp relative to now, or returns
+// 'unset' if it's nil.
+func formatTokenTime(t *time.Time, unset string) string {
+	if t == nil {
+		return unset
+	}
+	if d := time.Until(*t); d > 0 {
+		return "in " + units.HumanDuration(d)
+	}
+	return units.HumanDuration(time.Since(*t)) + " ago"
+}
+
+func printTokenInfo(w io.Writer, tokenInfo *auth.TokenInfo) {
+	var scopes []string
+	for _, scope := range tokenInfo.Scopes {
+		scopes = append(scopes, formatScope(scope))
+	}
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+		tokenInfo.HashedToken,
+		tokenInfo.Subject,
+		formatTokenTime(tokenInfo.CreatedAt, "-"),
+		formatTokenTime(tokenInfo.Expiration, "never"),
+		formatTokenTime(tokenInfo.LastUsed, "never"),
+		strings.Join(scopes, " "))
+}
+
+// ListAuthTokensCmd returns a cobra command that lists the auth tokens that
+// have been issued
+func ListAuthTokensCmd() *cobra.Command {
+	var enterprise bool
+	var raw bool
+	var output string
+	var subject string
+	listTokens := &cobra.Command{
+		Short: "List the auth tokens that have been issued.",
+		Long: "List the auth tokens that have been issued. Tokens are identified " +
+			"by their hash, which can be passed to 'pachctl auth revoke --id'.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := newClient(enterprise)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+
+			resp, err := c.ListAuthTokens(c.Ctx(), &auth.ListAuthTokensRequest{Subject: subject})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				for _, tokenInfo := range resp.Tokens {
+					if err := printer.Print(tokenInfo); err != nil {
+						return err
+					}
+				}
+				return printer.Flush()
+			}
+			w := tabwriter.NewWriter(os.Stdout, tokenHeader)
+			for _, tokenInfo := range resp.Tokens {
+				printTokenInfo(w, tokenInfo)
+			}
+			return w.Flush()
+		}),
+	}
+	listTokens.PersistentFlags().StringVar(&subject, "subject", "", "only list the tokens of the given subject (e.g. \"robot:ci\")")
+	listTokens.Flags().AddFlagSet(cmdutil.OutputFlags(&raw, &output))
+	listTokens.PersistentFlags().BoolVar(&enterprise, "enterprise", false, "List the tokens issued by the enterprise server")
+	return cmdutil.CreateAlias(listTokens, "auth list-tokens")
+}
+
+// InspectAuthTokenCmd returns a cobra command that prints the details of an
+// auth token, given its hash
+func InspectAuthTokenCmd() *cobra.Command {
+	var enterprise bool
+	var raw bool
+	var output string
+	inspectToken := &cobra.Command{
+		Use:   "{{alias}} <id>",
+		Short: "Inspect an auth token, given its ID from 'list-tokens'.",
+		Long:  "Inspect an auth token, given its ID from 'list-tokens'.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := newClient(enterprise)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+
+			resp, err := c.InspectAuthToken(c.Ctx(), &auth.InspectAuthTokenRequest{HashedToken: args[0]})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			tokenInfo := resp.Token
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				return cmdutil.PrintAll(printer, tokenInfo)
+			}
+			fmt.Printf("ID: %s\n", tokenInfo.HashedToken)
+			fmt.Printf("Subject: %s\n", tokenInfo.Subject)
+			fmt.Printf("Created: %s\n", formatTokenTime(tokenInfo.CreatedAt, "-"))
+			fmt.Printf("Expires: %s\n", formatTokenTime(tokenInfo.Expiration, "never"))
+			fmt.Printf("Last used: %s\n", formatTokenTime(tokenInfo.LastUsed, "never"))
+			for _, scope := range tokenInfo.Scopes {
+				fmt.Printf("Scope: %s\n", formatScope(scope))
+			}
+			return nil
+		}),
+	}
+	inspectToken.Flags().AddFlagSet(cmdutil.OutputFlags(&raw, &output))
+	inspectToken.PersistentFlags().BoolVar(&enterprise, "enterprise", false, "Inspect a token issued by the enterprise server")
+	return cmdutil.CreateAlias(inspectToken, "auth inspect-token")
+}
+
+// RevokeCmd returns a cobra command that revokes an auth token, either given
+// the token itself or its ID, or all of the tokens of a user
+func RevokeCmd() *cobra.Command {
+	var enterprise bool
+	var token, id, user string
+	revoke := &cobra.Command{
+		Short: "Revoke an auth token.",
+		Long: "Revoke an auth token. Exactly one of --token (the token itself), " +
+			"--id (the token's ID, from 'list-tokens') or --user (to revoke all " +
+			"of a user's tokens) must be set.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			var set int
+			for _, flag := range []string{token, id, user} {
+				if flag != "" {
+					set++
+				}
+			}
+			if set != 1 {
+				return errors.New("exactly one of --token, --id or --user must be set")
+			}
+			c, err := newClient(enterprise)
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+
+			switch {
+			case token != "":
+				_, err = c.RevokeAuthToken(c.Ctx(), &auth.RevokeAuthTokenRequest{Token: token})
+			case id != "":
+				_, err = c.RevokeAuthTokenByHash(c.Ctx(), &auth.RevokeAuthTokenByHashRequest{HashedToken: id})
+			default:
+				_, err = c.RevokeAuthTokensForUser(c.Ctx(), &auth.RevokeAuthTokensForUserRequest{Username: user})
+			}
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	revoke.PersistentFlags().StringVar(&token, "token", "", "the token to revoke")
+	revoke.PersistentFlags().StringVar(&id, "id", "", "the ID of the token to revoke, as shown by 'list-tokens'")
+	revoke.PersistentFlags().StringVar(&user, "user", "", "revoke all of the tokens of the given user (e.g. \"robot:ci\")")
+	revoke.PersistentFlags().BoolVar(&enterprise, "enterprise", false, "Revoke a token issued by the enterprise server")
+	return cmdutil.CreateAlias(revoke, "auth revoke")
+}
+
 // Cmds returns a list of cobra commands for authenticating and authorizing
 // users in an auth-enabled Pachyderm cluster.
 func Cmds() []*cobra.Command {
@@ -782,5 +1052,8 @@ func Cmds() []*cobra.Command {
 	commands = append(commands, GetEnterpriseRoleBindingCmd())
 	commands = append(commands, SetEnterpriseRoleBindingCmd())
 	commands = append(commands, RotateRootToken())
+	commands = append(commands, ListAuthTokensCmd())
+	commands = append(commands, InspectAuthTokenCmd())
+	commands = append(commands, RevokeCmd())
 	return commands
 }
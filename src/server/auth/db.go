@@ -20,3 +20,23 @@ ON auth.auth_tokens (subject);
 `)
 	return err
 }
+
+// AddScopesToAuthTokensTable adds a column for the scopes of scoped tokens,
+// which is NULL for tokens without scopes.
+func AddScopesToAuthTokensTable(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+ALTER TABLE auth.auth_tokens
+ADD COLUMN scopes JSONB
+;`)
+	return err
+}
+
+// AddLastUsedToAuthTokensTable adds a column for the approximate time at which
+// each token was last used, which is NULL for tokens that haven't been used.
+func AddLastUsedToAuthTokensTable(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+ALTER TABLE auth.auth_tokens
+ADD COLUMN last_used_at TIMESTAMP
+;`)
+	return err
+}
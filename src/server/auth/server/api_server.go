@@ -2,6 +2,7 @@ package server
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"path"
@@ -11,6 +12,7 @@ import (
 	"github.com/jackc/pgerrcode"
 	"github.com/lib/pq"
 	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/client"
 	enterpriseclient "github.com/pachyderm/pachyderm/v2/src/enterprise"
 	internalauth "github.com/pachyderm/pachyderm/v2/src/internal/auth"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
@@ -50,6 +52,11 @@ const (
 
 	// the length of interval between expired auth token cleanups
 	cleanupIntervalHours = 24
+
+	// lastUsedInterval is how stale a token's last-used time may be before
+	// it's updated, so that authenticating doesn't write to postgres on every
+	// request.
+	lastUsedInterval = time.Minute
 )
 
 // DefaultOIDCConfig is the default config for the auth API server
@@ -71,6 +78,8 @@ type apiServer struct {
 	members col.PostgresCollection
 	// groups is a collection of group -> usernames mappings.
 	groups col.PostgresCollection
+	// groupProviders is a collection of group provider -> managed groups mappings.
+	groupProviders col.PostgresCollection
 	// collection containing the auth config (under the key configKey)
 	authConfig col.PostgresCollection
 	// oidcStates  contains the set of OIDC nonces for requests that are in progress
@@ -139,6 +148,7 @@ func NewAuthServer(
 		roleBindings:   roleBindingsCollection(env.GetDBClient(), env.GetPostgresListener()),
 		members:        membersCollection(env.GetDBClient(), env.GetPostgresListener()),
 		groups:         groupsCollection(env.GetDBClient(), env.GetPostgresListener()),
+		groupProviders: groupProvidersCollection(env.GetDBClient(), env.GetPostgresListener()),
 		oidcStates:     oidcStates,
 		public:         public,
 		watchesEnabled: watchesEnabled,
@@ -162,6 +172,18 @@ func NewAuthServer(
 
 	s.deleteExpiredTokensRoutine()
 
+	// Only the public auth server syncs groups, so that each pachd runs a
+	// single sync loop
+	if public {
+		if path := env.Config().GroupSyncConfig; path != "" {
+			providers, interval, err := loadGroupSyncConfig(path)
+			if err != nil {
+				return nil, err
+			}
+			go s.groupSyncRoutine(env.Context(), providers, interval)
+		}
+	}
+
 	return s, nil
 }
 
@@ -306,7 +328,7 @@ func (a *apiServer) Activate(ctx context.Context, req *auth.ActivateRequest) (re
 		}); err != nil {
 			return err
 		}
-		return a.insertAuthTokenNoTTLInTransaction(txCtx, auth.HashToken(pachToken), auth.RootUser)
+		return a.insertAuthTokenNoTTLInTransaction(txCtx, auth.HashToken(pachToken), auth.RootUser, nil)
 	}); err != nil {
 		return nil, err
 	}
@@ -343,7 +365,7 @@ func (a *apiServer) RotateRootToken(ctx context.Context, req *auth.RotateRootTok
 		if rootToken == "" {
 			rootToken = uuid.NewWithoutDashes()
 		}
-		if err := a.insertAuthTokenNoTTLInTransaction(txCtx, auth.HashToken(rootToken), auth.RootUser); err != nil {
+		if err := a.insertAuthTokenNoTTLInTransaction(txCtx, auth.HashToken(rootToken), auth.RootUser, nil); err != nil {
 			return err
 		}
 		return nil
@@ -364,6 +386,7 @@ func (a *apiServer) Deactivate(ctx context.Context, req *auth.DeactivateRequest)
 		a.deleteAllAuthTokens(ctx, sqlTx)
 		a.members.ReadWrite(sqlTx).DeleteAll()
 		a.groups.ReadWrite(sqlTx).DeleteAll()
+		a.groupProviders.ReadWrite(sqlTx).DeleteAll()
 		a.authConfig.ReadWrite(sqlTx).DeleteAll()
 		return nil
 	}); err != nil {
@@ -412,7 +435,7 @@ func (a *apiServer) Authenticate(ctx context.Context, req *auth.AuthenticateRequ
 		}
 
 		// Generate a new Pachyderm token and write it
-		t, err := a.generateAndInsertAuthToken(ctx, username, defaultSessionTTLSecs)
+		t, err := a.generateAndInsertAuthToken(ctx, username, defaultSessionTTLSecs, nil)
 		if err != nil {
 			return nil, errors.Wrapf(err, "error storing auth token for user \"%s\"", username)
 		}
@@ -445,7 +468,7 @@ func (a *apiServer) Authenticate(ctx context.Context, req *auth.AuthenticateRequ
 			expirationSecs = defaultSessionTTLSecs
 		}
 
-		t, err := a.generateAndInsertAuthToken(ctx, username, expirationSecs)
+		t, err := a.generateAndInsertAuthToken(ctx, username, expirationSecs, nil)
 		if err != nil {
 			return nil, errors.Wrapf(err, "error storing auth token for user \"%s\"", username)
 		}
@@ -527,12 +550,16 @@ func (a *apiServer) AuthorizeInTransaction(
 		return nil, err
 	}
 
-	return &auth.AuthorizeResponse{
+	resp = &auth.AuthorizeResponse{
 		Principal:  callerInfo.Subject,
 		Authorized: request.isSatisfied(),
 		Missing:    request.missing(),
 		Satisfied:  request.satisfiedPermissions,
-	}, nil
+	}
+	if err := restrictToScopes(callerInfo.Scopes, req.Resource, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
 // Authorize implements the protobuf auth.Authorize RPC
@@ -589,7 +616,24 @@ func (a *apiServer) GetPermissions(ctx context.Context, req *auth.GetPermissions
 		return nil, err
 	}
 
-	return a.GetPermissionsForPrincipal(ctx, &auth.GetPermissionsForPrincipalRequest{Principal: callerInfo.Subject, Resource: req.Resource})
+	resp, err = a.GetPermissionsForPrincipal(ctx, &auth.GetPermissionsForPrincipalRequest{Principal: callerInfo.Subject, Resource: req.Resource})
+	if err != nil {
+		return nil, err
+	}
+	if len(callerInfo.Scopes) > 0 {
+		allowed, err := scopePermissions(callerInfo.Scopes, req.Resource)
+		if err != nil {
+			return nil, err
+		}
+		permissions := make([]auth.Permission, 0, len(resp.Permissions))
+		for _, p := range resp.Permissions {
+			if allowed[p] {
+				permissions = append(permissions, p)
+			}
+		}
+		resp.Permissions = permissions
+	}
+	return resp, nil
 }
 
 // WhoAmI implements the protobuf auth.WhoAmI RPC
@@ -609,6 +653,7 @@ func (a *apiServer) WhoAmI(ctx context.Context, req *auth.WhoAmIRequest) (resp *
 	return &auth.WhoAmIResponse{
 		Username:   callerInfo.Subject,
 		Expiration: callerInfo.Expiration,
+		Scopes:     callerInfo.Scopes,
 	}, nil
 }
 
@@ -809,8 +854,13 @@ func (a *apiServer) ModifyRoleBinding(ctx context.Context, req *auth.ModifyRoleB
 		return nil, err
 	}
 
-	// If the request is not in a transaction, block until the cache is updated
-	if req.Resource.Type == auth.ResourceType_CLUSTER {
+	// If the request is not in a transaction, block until the cache is updated.
+	// A request in a transaction isn't applied until the transaction finishes.
+	activeTxn, err := client.GetTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if activeTxn == nil && req.Resource.Type == auth.ResourceType_CLUSTER {
 		expected, err := rolesFromRoleSlice(req.Roles)
 		if err != nil {
 			return nil, err
@@ -884,13 +934,17 @@ func (a *apiServer) GetRobotToken(ctx context.Context, req *auth.GetRobotTokenRe
 
 	subject = auth.RobotPrefix + subject
 
+	if err := validateScopes(req.Scopes); err != nil {
+		return nil, err
+	}
+
 	// generate new token, and write to postgres
 	var token string
 	var err error
 	if req.TTL > 0 {
-		token, err = a.generateAndInsertAuthToken(ctx, subject, req.TTL)
+		token, err = a.generateAndInsertAuthToken(ctx, subject, req.TTL, req.Scopes)
 	} else {
-		token, err = a.generateAndInsertAuthTokenNoTTL(ctx, subject)
+		token, err = a.generateAndInsertAuthTokenNoTTL(ctx, subject, req.Scopes)
 	}
 	if err != nil {
 		return nil, err
@@ -908,7 +962,7 @@ func (a *apiServer) GetPipelineAuthTokenInTransaction(txnCtx *txncontext.Transac
 	}
 
 	token := uuid.NewWithoutDashes()
-	if err := a.insertAuthTokenNoTTLInTransaction(txnCtx, auth.HashToken(token), auth.PipelinePrefix+pipeline); err != nil {
+	if err := a.insertAuthTokenNoTTLInTransaction(txnCtx, auth.HashToken(token), auth.PipelinePrefix+pipeline, nil); err != nil {
 		return "", errors.Wrapf(err, "error storing token")
 	} else {
 		return token, nil
@@ -957,14 +1011,29 @@ func (a *apiServer) RevokeAuthTokenInTransaction(txnCtx *txncontext.TransactionC
 // setGroupsForUserInternal is a helper function used by SetGroupsForUser, and
 // also by handleSAMLResponse and handleOIDCExchangeInternal (which updates
 // group membership information based on signed SAML assertions or JWT claims).
+// Groups managed by a GroupProvider are left as they are, since the provider
+// is the source of truth for them: the user is neither added to nor removed
+// from them.
 // This does no auth checks, so the caller must do all relevant authorization.
 func (a *apiServer) setGroupsForUserInternal(ctx context.Context, subject string, groups []string) error {
+	synced, err := a.syncedGroups(ctx)
+	if err != nil {
+		return err
+	}
+	var unsynced []string
+	for _, group := range groups {
+		if !synced[group] {
+			unsynced = append(unsynced, group)
+		}
+	}
+	groups = unsynced
 	return col.NewSQLTx(ctx, a.env.GetDBClient(), func(sqlTx *sqlx.Tx) error {
 		members := a.members.ReadWrite(sqlTx)
 
 		// Get groups to remove/add user from/to
 		var removeGroups auth.Groups
 		addGroups := addToSet(nil, groups...)
+		newGroups := addToSet(nil, groups...)
 		if err := members.Get(subject, &removeGroups); err == nil {
 			for _, group := range groups {
 				if removeGroups.Groups[group] {
@@ -972,11 +1041,17 @@ func (a *apiServer) setGroupsForUserInternal(ctx context.Context, subject string
 					addGroups = removeFromSet(addGroups, group)
 				}
 			}
+			for group := range removeGroups.Groups {
+				if synced[group] {
+					removeGroups.Groups = removeFromSet(removeGroups.Groups, group)
+					newGroups = addToSet(newGroups, group)
+				}
+			}
 		}
 
 		// Set groups for user
 		if err := members.Put(subject, &auth.Groups{
-			Groups: addToSet(nil, groups...),
+			Groups: newGroups,
 		}); err != nil {
 			return err
 		}
@@ -1095,17 +1170,20 @@ func removeFromSet(set map[string]bool, elems ...string) map[string]bool {
 }
 
 // getGroups is a helper function used primarily by the GRPC API GetGroups, but
-// also by Authorize() and isAdmin().
+// also by Authorize() and isAdmin(). It includes groups that 'subject' belongs
+// to through nested groups.
 func (a *apiServer) getGroups(ctx context.Context, subject string) ([]string, error) {
 	members := a.members.ReadOnly(ctx)
-	var groupsProto auth.Groups
-	if err := members.Get(subject, &groupsProto); err != nil {
-		if col.IsErrNotFound(err) {
-			return []string{}, nil
+	return expandGroups(subject, func(s string) (map[string]bool, error) {
+		var groupsProto auth.Groups
+		if err := members.Get(s, &groupsProto); err != nil {
+			if col.IsErrNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
 		}
-		return nil, err
-	}
-	return setToList(groupsProto.Groups), nil
+		return groupsProto.Groups, nil
+	})
 }
 
 // GetGroups implements the protobuf auth.GetGroups RPC
@@ -1215,6 +1293,12 @@ func (a *apiServer) getAuthenticatedUser(ctx context.Context) (*auth.TokenInfo,
 	if tokenInfo.Expiration != nil && time.Now().After(*tokenInfo.Expiration) {
 		return nil, auth.ErrExpiredToken
 	}
+
+	if tokenInfo.LastUsed == nil || time.Since(*tokenInfo.LastUsed) > lastUsedInterval {
+		if err := a.touchAuthToken(ctx, tokenInfo.HashedToken); err != nil {
+			logrus.Errorf("error updating last used time of auth token: %v", err)
+		}
+	}
 	return tokenInfo, nil
 }
 
@@ -1366,9 +1450,9 @@ func (a *apiServer) RestoreAuthToken(ctx context.Context, req *auth.RestoreAuthT
 
 	if err := func() error {
 		if ttl > 0 {
-			return a.insertAuthToken(ctx, req.Token.HashedToken, req.Token.Subject, ttl)
+			return a.insertAuthToken(ctx, req.Token.HashedToken, req.Token.Subject, ttl, req.Token.Scopes)
 		} else {
-			return a.insertAuthTokenNoTTL(ctx, req.Token.HashedToken, req.Token.Subject)
+			return a.insertAuthTokenNoTTL(ctx, req.Token.HashedToken, req.Token.Subject, req.Token.Scopes)
 		}
 	}(); err != nil {
 		return nil, errors.Wrapf(err, "error restoring auth token")
@@ -1400,6 +1484,61 @@ func (a *apiServer) RevokeAuthTokensForUser(ctx context.Context, req *auth.Revok
 	return &auth.RevokeAuthTokensForUserResponse{}, nil
 }
 
+// ListAuthTokens implements the protobuf auth.ListAuthTokens RPC
+func (a *apiServer) ListAuthTokens(ctx context.Context, req *auth.ListAuthTokensRequest) (resp *auth.ListAuthTokensResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if err := a.isActive(ctx); err != nil {
+		return nil, err
+	}
+
+	tokens, err := a.listAuthTokens(ctx, req.Subject)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.ListAuthTokensResponse{Tokens: tokens}, nil
+}
+
+// InspectAuthToken implements the protobuf auth.InspectAuthToken RPC
+func (a *apiServer) InspectAuthToken(ctx context.Context, req *auth.InspectAuthTokenRequest) (resp *auth.InspectAuthTokenResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if err := a.isActive(ctx); err != nil {
+		return nil, err
+	}
+
+	tokenInfo, err := a.lookupAuthTokenInfo(ctx, req.HashedToken)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.InspectAuthTokenResponse{Token: tokenInfo}, nil
+}
+
+// RevokeAuthTokenByHash implements the protobuf auth.RevokeAuthTokenByHash RPC
+func (a *apiServer) RevokeAuthTokenByHash(ctx context.Context, req *auth.RevokeAuthTokenByHashRequest) (resp *auth.RevokeAuthTokenByHashResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if err := a.isActive(ctx); err != nil {
+		return nil, err
+	}
+
+	tokenInfo, err := a.lookupAuthTokenInfo(ctx, req.HashedToken)
+	if err != nil {
+		return nil, err
+	}
+	// Like RevokeAuthTokensForUser, don't allow revoking the root token or
+	// the PPS token
+	if strings.HasPrefix(tokenInfo.Subject, auth.PachPrefix) {
+		return nil, errors.New("cannot revoke tokens for pach: users")
+	}
+	if err := a.processInTransaction(ctx, func(sqlTx *sqlx.Tx) error {
+		return a.deleteAuthToken(ctx, sqlTx, req.HashedToken)
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.RevokeAuthTokenByHashResponse{}, nil
+}
+
 func (a *apiServer) deleteExpiredTokensRoutine() {
 	go func(ctx context.Context) {
 		for {
@@ -1409,42 +1548,121 @@ func (a *apiServer) deleteExpiredTokensRoutine() {
 	}(context.Background())
 }
 
+// tokenRow is a row of the auth.auth_tokens table. Scopes are stored as JSON,
+// and are NULL for tokens without scopes.
+type tokenRow struct {
+	TokenHash  string     `db:"token_hash"`
+	Subject    string     `db:"subject"`
+	Expiration *time.Time `db:"expiration"`
+	Scopes     []byte     `db:"scopes"`
+	CreatedAt  *time.Time `db:"created_at"`
+	LastUsed   *time.Time `db:"last_used_at"`
+}
+
+func (r *tokenRow) tokenInfo() (*auth.TokenInfo, error) {
+	tokenInfo := &auth.TokenInfo{
+		HashedToken: r.TokenHash,
+		Subject:     r.Subject,
+		Expiration:  r.Expiration,
+		CreatedAt:   r.CreatedAt,
+		LastUsed:    r.LastUsed,
+	}
+	if r.Scopes != nil {
+		if err := json.Unmarshal(r.Scopes, &tokenInfo.Scopes); err != nil {
+			return nil, errors.Wrapf(err, "error parsing token scopes")
+		}
+	}
+	return tokenInfo, nil
+}
+
+// encodeScopes returns the value to store in the scopes column for 'scopes'.
+func encodeScopes(scopes []*auth.TokenScope) (interface{}, error) {
+	if len(scopes) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(scopes)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return string(data), nil
+}
+
 // we interpret an expiration value of NULL as "lives forever".
 func (a *apiServer) lookupAuthTokenInfo(ctx context.Context, tokenHash string) (*auth.TokenInfo, error) {
-	var tokenInfo auth.TokenInfo
+	var row tokenRow
 
-	err := a.env.GetDBClient().GetContext(ctx, &tokenInfo, `SELECT subject, expiration FROM auth.auth_tokens WHERE token_hash = $1`, tokenHash)
+	err := a.env.GetDBClient().GetContext(ctx, &row, `SELECT token_hash, subject, expiration, scopes, created_at, last_used_at FROM auth.auth_tokens WHERE token_hash = $1`, tokenHash)
 
 	if err != nil {
 		return nil, col.ErrNotFound{Type: "auth_tokens", Key: tokenHash}
 	}
 
-	return &tokenInfo, nil
+	return row.tokenInfo()
 }
 
 // we will sometimes have expiration values set in the passed, since we only remove those values in the deleteExpiredTokensRoutine() goroutine
 func (a *apiServer) listRobotTokens(ctx context.Context) ([]*auth.TokenInfo, error) {
-	robotTokens := make([]*auth.TokenInfo, 0)
-	if err := a.env.GetDBClient().SelectContext(ctx, &robotTokens,
-		`SELECT token_hash, subject, expiration
+	var rows []*tokenRow
+	if err := a.env.GetDBClient().SelectContext(ctx, &rows,
+		`SELECT token_hash, subject, expiration, scopes
 		FROM auth.auth_tokens 
 		WHERE subject LIKE $1 || '%'`, auth.RobotPrefix); err != nil {
 		return nil, errors.Wrapf(err, "error querying token")
 	}
+	robotTokens := make([]*auth.TokenInfo, 0, len(rows))
+	for _, row := range rows {
+		tokenInfo, err := row.tokenInfo()
+		if err != nil {
+			return nil, err
+		}
+		robotTokens = append(robotTokens, tokenInfo)
+	}
 	return robotTokens, nil
 }
 
-func (a *apiServer) generateAndInsertAuthToken(ctx context.Context, subject string, ttlSeconds int64) (string, error) {
+// listAuthTokens returns all the tokens that have been issued, or only those
+// of 'subject' if it's set. Like listRobotTokens, this may include tokens that
+// have expired but haven't been cleaned up yet.
+func (a *apiServer) listAuthTokens(ctx context.Context, subject string) ([]*auth.TokenInfo, error) {
+	var rows []*tokenRow
+	if err := a.env.GetDBClient().SelectContext(ctx, &rows,
+		`SELECT token_hash, subject, expiration, scopes, created_at, last_used_at
+		FROM auth.auth_tokens
+		WHERE $1 = '' OR subject = $1
+		ORDER BY created_at`, subject); err != nil {
+		return nil, errors.Wrapf(err, "error querying tokens")
+	}
+	tokens := make([]*auth.TokenInfo, 0, len(rows))
+	for _, row := range rows {
+		tokenInfo, err := row.tokenInfo()
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, tokenInfo)
+	}
+	return tokens, nil
+}
+
+// touchAuthToken sets the last-used time of a token to now.
+func (a *apiServer) touchAuthToken(ctx context.Context, tokenHash string) error {
+	if _, err := a.env.GetDBClient().ExecContext(ctx,
+		`UPDATE auth.auth_tokens SET last_used_at = NOW() WHERE token_hash = $1`, tokenHash); err != nil {
+		return errors.Wrapf(err, "error updating token")
+	}
+	return nil
+}
+
+func (a *apiServer) generateAndInsertAuthToken(ctx context.Context, subject string, ttlSeconds int64, scopes []*auth.TokenScope) (string, error) {
 	token := uuid.NewWithoutDashes()
-	if err := a.insertAuthToken(ctx, auth.HashToken(token), subject, ttlSeconds); err != nil {
+	if err := a.insertAuthToken(ctx, auth.HashToken(token), subject, ttlSeconds, scopes); err != nil {
 		return "", err
 	}
 	return token, nil
 }
 
-func (a *apiServer) generateAndInsertAuthTokenNoTTL(ctx context.Context, subject string) (string, error) {
+func (a *apiServer) generateAndInsertAuthTokenNoTTL(ctx context.Context, subject string, scopes []*auth.TokenScope) (string, error) {
 	token := uuid.NewWithoutDashes()
-	if err := a.insertAuthTokenNoTTL(ctx, auth.HashToken(token), subject); err != nil {
+	if err := a.insertAuthTokenNoTTL(ctx, auth.HashToken(token), subject, scopes); err != nil {
 		return "", err
 	}
 
@@ -1452,10 +1670,14 @@ func (a *apiServer) generateAndInsertAuthTokenNoTTL(ctx context.Context, subject
 }
 
 // generates a token, and stores it's hash and supporting data in postgres
-func (a *apiServer) insertAuthToken(ctx context.Context, tokenHash string, subject string, ttlSeconds int64) error {
+func (a *apiServer) insertAuthToken(ctx context.Context, tokenHash string, subject string, ttlSeconds int64, scopes []*auth.TokenScope) error {
+	encodedScopes, err := encodeScopes(scopes)
+	if err != nil {
+		return err
+	}
 	if _, err := a.env.GetDBClient().ExecContext(ctx,
-		`INSERT INTO auth.auth_tokens (token_hash, subject, expiration) 
-		VALUES ($1, $2, NOW() + $3 * interval '1 sec')`, tokenHash, subject, ttlSeconds); err != nil {
+		`INSERT INTO auth.auth_tokens (token_hash, subject, expiration, scopes) 
+		VALUES ($1, $2, NOW() + $3 * interval '1 sec', $4)`, tokenHash, subject, ttlSeconds, encodedScopes); err != nil {
 		if pgErr, ok := err.(*pq.Error); ok {
 			if pgErr.Code == pq.ErrorCode(pgerrcode.UniqueViolation) {
 				return errors.New("cannot overwrite existing token with same hash")
@@ -1467,17 +1689,21 @@ func (a *apiServer) insertAuthToken(ctx context.Context, tokenHash string, subje
 }
 
 // TODO(acohen4): replace this function with what's implemented in postgres-integration once it lands
-func (a *apiServer) insertAuthTokenNoTTL(ctx context.Context, tokenHash string, subject string) error {
+func (a *apiServer) insertAuthTokenNoTTL(ctx context.Context, tokenHash string, subject string, scopes []*auth.TokenScope) error {
 	return a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		err := a.insertAuthTokenNoTTLInTransaction(txnCtx, tokenHash, subject)
+		err := a.insertAuthTokenNoTTLInTransaction(txnCtx, tokenHash, subject, scopes)
 		return err
 	})
 }
 
-func (a *apiServer) insertAuthTokenNoTTLInTransaction(txnCtx *txncontext.TransactionContext, tokenHash string, subject string) error {
+func (a *apiServer) insertAuthTokenNoTTLInTransaction(txnCtx *txncontext.TransactionContext, tokenHash string, subject string, scopes []*auth.TokenScope) error {
+	encodedScopes, err := encodeScopes(scopes)
+	if err != nil {
+		return err
+	}
 	if _, err := txnCtx.SqlTx.ExecContext(txnCtx.ClientContext,
-		`INSERT INTO auth.auth_tokens (token_hash, subject) 
-		VALUES ($1, $2)`, tokenHash, subject); err != nil {
+		`INSERT INTO auth.auth_tokens (token_hash, subject, scopes) 
+		VALUES ($1, $2, $3)`, tokenHash, subject, encodedScopes); err != nil {
 		if pgErr, ok := err.(*pq.Error); ok {
 			if pgErr.Code == pq.ErrorCode(pgerrcode.UniqueViolation) {
 				return errors.New("cannot overwrite existing token with same hash")
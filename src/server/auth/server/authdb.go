@@ -8,10 +8,11 @@ import (
 )
 
 const (
-	authConfigCollectionName   = "auth_config"
-	roleBindingsCollectionName = "role_bindings"
-	membersCollectionName      = "members"
-	groupsCollectionName       = "groups"
+	authConfigCollectionName     = "auth_config"
+	roleBindingsCollectionName   = "role_bindings"
+	membersCollectionName        = "members"
+	groupsCollectionName         = "groups"
+	groupProvidersCollectionName = "group_providers"
 )
 
 var authConfigIndexes = []*col.Index{}
@@ -66,6 +67,31 @@ func groupsCollection(db *sqlx.DB, listener *col.PostgresListener) col.PostgresC
 	)
 }
 
+var groupProvidersIndexes = []*col.Index{}
+
+// groupProvidersCollection maps the name of each GroupProvider to the set of
+// groups it manages, so that groups a provider stops reporting can be
+// reconciled.
+func groupProvidersCollection(db *sqlx.DB, listener *col.PostgresListener) col.PostgresCollection {
+	return col.NewPostgresCollection(
+		groupProvidersCollectionName,
+		db,
+		listener,
+		&auth.Groups{},
+		groupProvidersIndexes,
+		nil,
+	)
+}
+
+// GroupSyncCollections returns the collections used to sync groups from
+// GroupProviders, for postgres-initialization purposes. They were added after
+// AllCollections was first set up, so they're created by a separate migration.
+func GroupSyncCollections() []col.PostgresCollection {
+	return []col.PostgresCollection{
+		col.NewPostgresCollection(groupProvidersCollectionName, nil, nil, nil, groupProvidersIndexes, nil),
+	}
+}
+
 // AllCollections returns a list of all the PPS API collections for
 // postgres-initialization purposes. These collections are not usable for
 // querying.
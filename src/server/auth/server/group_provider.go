@@ -0,0 +1,303 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+
+	ldap "gopkg.in/ldap.v2"
+	"sigs.k8s.io/yaml"
+)
+
+const defaultGroupSyncInterval = 5 * time.Minute
+
+// GroupProvider is an external source of group membership, which the auth
+// service periodically syncs into its members and groups collections.
+// Unlike groups from an OIDC groups claim, which are only updated when a user
+// logs in, synced groups also apply to robot users and long-lived tokens.
+type GroupProvider interface {
+	// Name uniquely identifies the provider. It's used to track which groups
+	// the provider manages, so that groups it stops reporting are emptied.
+	Name() string
+	// Groups returns the members of every group managed by the provider, keyed
+	// by group subject (e.g. "group:eng"). Members are subjects such as
+	// "user:alice@example.com" or "robot:ci", or other groups, which are
+	// expanded when looking up the groups a subject belongs to.
+	Groups(ctx context.Context) (map[string][]string, error)
+}
+
+// GroupSyncConfig is the format of the file referenced by GROUP_SYNC_CONFIG.
+type GroupSyncConfig struct {
+	// Interval is how often groups are synced, e.g. "10m". Defaults to 5m.
+	Interval string                      `json:"interval,omitempty"`
+	Static   []StaticGroupProviderConfig `json:"static,omitempty"`
+	LDAP     []LDAPGroupProviderConfig   `json:"ldap,omitempty"`
+}
+
+// StaticGroupProviderConfig configures a GroupProvider that reads groups from
+// a file.
+type StaticGroupProviderConfig struct {
+	Name string `json:"name"`
+	// Path is a YAML or JSON file mapping group names to their members, e.g.
+	//   eng: ["user:alice@example.com", "robot:ci", "group:eng-leads"]
+	Path string `json:"path"`
+}
+
+// LDAPGroupProviderConfig configures a GroupProvider that reads groups from an
+// LDAP directory.
+type LDAPGroupProviderConfig struct {
+	Name string `json:"name"`
+	// URL is the address of the directory, e.g. "ldaps://ldap.example.com:636"
+	URL                string `json:"url"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+	BindDN             string `json:"bindDN,omitempty"`
+	BindPassword       string `json:"bindPassword,omitempty"`
+	// BaseDN is the root of the subtree searched for groups and users.
+	BaseDN string `json:"baseDN"`
+	// GroupFilter selects group entries. Defaults to "(objectClass=groupOfNames)".
+	GroupFilter string `json:"groupFilter,omitempty"`
+	// GroupNameAttr holds the name of a group. Defaults to "cn".
+	GroupNameAttr string `json:"groupNameAttr,omitempty"`
+	// MemberAttr holds the DNs of a group's members. Defaults to "member".
+	MemberAttr string `json:"memberAttr,omitempty"`
+	// UserFilter selects user entries. Defaults to "(objectClass=person)".
+	UserFilter string `json:"userFilter,omitempty"`
+	// UserNameAttr holds the name that a user logs in with, which becomes
+	// their "user:" subject. Defaults to "mail".
+	UserNameAttr string `json:"userNameAttr,omitempty"`
+}
+
+// loadGroupSyncConfig parses the group sync config file at 'path' and
+// returns the configured providers and sync interval.
+func loadGroupSyncConfig(path string) ([]GroupProvider, time.Duration, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, 0, errors.EnsureStack(err)
+	}
+	var config GroupSyncConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, 0, errors.Wrapf(err, "could not parse group sync config %q", path)
+	}
+	interval := defaultGroupSyncInterval
+	if config.Interval != "" {
+		if interval, err = time.ParseDuration(config.Interval); err != nil {
+			return nil, 0, errors.Wrapf(err, "invalid group sync interval")
+		}
+	}
+	var providers []GroupProvider
+	names := make(map[string]bool)
+	addProvider := func(p GroupProvider) error {
+		if p.Name() == "" {
+			return errors.Errorf("every group provider must have a name")
+		}
+		if names[p.Name()] {
+			return errors.Errorf("duplicate group provider name %q", p.Name())
+		}
+		names[p.Name()] = true
+		providers = append(providers, p)
+		return nil
+	}
+	for _, c := range config.Static {
+		if err := addProvider(NewStaticGroupProvider(c.Name, c.Path)); err != nil {
+			return nil, 0, err
+		}
+	}
+	for _, c := range config.LDAP {
+		if err := addProvider(NewLDAPGroupProvider(c)); err != nil {
+			return nil, 0, err
+		}
+	}
+	return providers, interval, nil
+}
+
+type staticGroupProvider struct {
+	name, path string
+}
+
+// NewStaticGroupProvider returns a GroupProvider that reads groups from the
+// YAML or JSON file at 'path', which maps group names to lists of members.
+// The file is re-read on every sync, so it can be updated in place (e.g. as a
+// mounted ConfigMap).
+func NewStaticGroupProvider(name, path string) GroupProvider {
+	return &staticGroupProvider{name: name, path: path}
+}
+
+func (p *staticGroupProvider) Name() string {
+	return p.name
+}
+
+func (p *staticGroupProvider) Groups(ctx context.Context) (map[string][]string, error) {
+	data, err := ioutil.ReadFile(p.path)
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	var groups map[string][]string
+	if err := yaml.Unmarshal(data, &groups); err != nil {
+		return nil, errors.Wrapf(err, "could not parse groups file %q", p.path)
+	}
+	result := make(map[string][]string, len(groups))
+	for group, members := range groups {
+		result[groupSubject(group)] = members
+	}
+	return result, nil
+}
+
+// groupSubject adds the group prefix to 'name', if it doesn't have one already
+func groupSubject(name string) string {
+	if strings.HasPrefix(name, auth.GroupPrefix) {
+		return name
+	}
+	return auth.GroupPrefix + name
+}
+
+// ldapConn is the subset of *ldap.Conn used by ldapGroupProvider, so that an
+// in-process directory can be substituted in tests.
+type ldapConn interface {
+	Bind(username, password string) error
+	Search(*ldap.SearchRequest) (*ldap.SearchResult, error)
+	Close()
+}
+
+type ldapGroupProvider struct {
+	config LDAPGroupProviderConfig
+	dial   func() (ldapConn, error)
+}
+
+// NewLDAPGroupProvider returns a GroupProvider that reads groups from an LDAP
+// directory. Members of each group are resolved by DN to either another group
+// (which becomes a nested group) or a user, whose UserNameAttr becomes their
+// "user:" subject. Members that match neither are ignored.
+func NewLDAPGroupProvider(config LDAPGroupProviderConfig) GroupProvider {
+	p := &ldapGroupProvider{config: config}
+	p.dial = p.dialURL
+	return p
+}
+
+func (p *ldapGroupProvider) Name() string {
+	return p.config.Name
+}
+
+func (p *ldapGroupProvider) dialURL() (ldapConn, error) {
+	u, err := url.Parse(p.config.URL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid LDAP URL %q", p.config.URL)
+	}
+	var conn *ldap.Conn
+	switch u.Scheme {
+	case "ldap":
+		conn, err = ldap.Dial("tcp", hostWithDefaultPort(u.Host, "389"))
+	case "ldaps":
+		conn, err = ldap.DialTLS("tcp", hostWithDefaultPort(u.Host, "636"), &tls.Config{
+			ServerName:         u.Hostname(),
+			InsecureSkipVerify: p.config.InsecureSkipVerify,
+		})
+	default:
+		return nil, errors.Errorf("unsupported LDAP URL scheme %q", u.Scheme)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not connect to LDAP directory %q", p.config.URL)
+	}
+	return conn, nil
+}
+
+func hostWithDefaultPort(host, port string) string {
+	if _, _, err := net.SplitHostPort(host); err == nil {
+		return host
+	}
+	return net.JoinHostPort(host, port)
+}
+
+func (p *ldapGroupProvider) search(conn ldapConn, filter string, attrs ...string) ([]*ldap.Entry, error) {
+	res, err := conn.Search(ldap.NewSearchRequest(
+		p.config.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false,
+		filter, attrs, nil,
+	))
+	if err != nil {
+		return nil, errors.Wrapf(err, "LDAP search for %q failed", filter)
+	}
+	return res.Entries, nil
+}
+
+func (p *ldapGroupProvider) Groups(ctx context.Context) (map[string][]string, error) {
+	groupFilter := defaultString(p.config.GroupFilter, "(objectClass=groupOfNames)")
+	groupNameAttr := defaultString(p.config.GroupNameAttr, "cn")
+	memberAttr := defaultString(p.config.MemberAttr, "member")
+	userFilter := defaultString(p.config.UserFilter, "(objectClass=person)")
+	userNameAttr := defaultString(p.config.UserNameAttr, "mail")
+
+	conn, err := p.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if p.config.BindDN != "" {
+		if err := conn.Bind(p.config.BindDN, p.config.BindPassword); err != nil {
+			return nil, errors.Wrapf(err, "could not bind to LDAP directory as %q", p.config.BindDN)
+		}
+	}
+	groupEntries, err := p.search(conn, groupFilter, groupNameAttr, memberAttr)
+	if err != nil {
+		return nil, err
+	}
+	userEntries, err := p.search(conn, userFilter, userNameAttr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map each DN to the subject it corresponds to
+	subjects := make(map[string]string)
+	for _, e := range userEntries {
+		if name := e.GetAttributeValue(userNameAttr); name != "" {
+			subjects[normalizeDN(e.DN)] = auth.UserPrefix + name
+		}
+	}
+	for _, e := range groupEntries {
+		if name := e.GetAttributeValue(groupNameAttr); name != "" {
+			subjects[normalizeDN(e.DN)] = groupSubject(name)
+		}
+	}
+
+	groups := make(map[string][]string)
+	for _, e := range groupEntries {
+		group, ok := subjects[normalizeDN(e.DN)]
+		if !ok {
+			continue
+		}
+		members := []string{}
+		for _, dn := range e.GetAttributeValues(memberAttr) {
+			if subject, ok := subjects[normalizeDN(dn)]; ok {
+				members = append(members, subject)
+			}
+		}
+		groups[group] = members
+	}
+	return groups, nil
+}
+
+// normalizeDN makes DNs that differ only in case or whitespace around
+// separators compare equal.
+func normalizeDN(dn string) string {
+	parts := strings.Split(strings.ToLower(dn), ",")
+	for i, part := range parts {
+		kv := strings.SplitN(part, "=", 2)
+		for j := range kv {
+			kv[j] = strings.TrimSpace(kv[j])
+		}
+		parts[i] = strings.Join(kv, "=")
+	}
+	return strings.Join(parts, ",")
+}
+
+func defaultString(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+)
+
+// groupSyncRoutine periodically syncs groups from every provider until ctx is
+// cancelled. Errors are logged rather than returned, so that one unreachable
+// provider doesn't prevent the others from being synced.
+func (a *apiServer) groupSyncRoutine(ctx context.Context, providers []GroupProvider, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := a.isActive(ctx); err == nil {
+			for _, p := range providers {
+				if err := a.syncGroups(ctx, p); err != nil {
+					logrus.Errorf("error syncing groups from provider %q: %v", p.Name(), err)
+				}
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// syncGroups makes the membership of every group reported by 'p' match the
+// provider exactly, and removes all members from groups that 'p' reported
+// previously but no longer does. Invalid groups and members are logged and
+// skipped, so that one bad entry doesn't prevent the rest from being synced.
+func (a *apiServer) syncGroups(ctx context.Context, p GroupProvider) error {
+	reported, err := p.Groups(ctx)
+	if err != nil {
+		return err
+	}
+	groups := make(map[string][]string, len(reported))
+	for group, members := range reported {
+		if err := a.checkCanonicalSubject(group); err != nil {
+			logrus.Errorf("skipping invalid group %q from provider %q: %v", group, p.Name(), err)
+			continue
+		}
+		valid := []string{}
+		for _, member := range members {
+			if err := a.checkCanonicalSubject(member); err != nil {
+				logrus.Errorf("skipping invalid member %q of group %q from provider %q: %v", member, group, p.Name(), err)
+				continue
+			}
+			valid = append(valid, member)
+		}
+		groups[group] = valid
+	}
+	return col.NewSQLTx(ctx, a.env.GetDBClient(), func(sqlTx *sqlx.Tx) error {
+		providers := a.groupProviders.ReadWrite(sqlTx)
+		var managed auth.Groups
+		if err := providers.Get(p.Name(), &managed); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+		for group := range managed.Groups {
+			if _, ok := groups[group]; !ok {
+				if err := a.setMembersForGroup(sqlTx, group, nil); err != nil {
+					return err
+				}
+			}
+		}
+		managed.Groups = nil
+		for group, members := range groups {
+			if err := a.setMembersForGroup(sqlTx, group, members); err != nil {
+				return err
+			}
+			managed.Groups = addToSet(managed.Groups, group)
+		}
+		return providers.Put(p.Name(), &managed)
+	})
+}
+
+// setMembersForGroup replaces the members of 'group', updating both the
+// groups and members collections.
+func (a *apiServer) setMembersForGroup(sqlTx *sqlx.Tx, group string, members []string) error {
+	groups := a.groups.ReadWrite(sqlTx)
+	var oldMembers auth.Users
+	if err := groups.Get(group, &oldMembers); err != nil && !col.IsErrNotFound(err) {
+		return err
+	}
+	newMembers := addToSet(nil, members...)
+
+	membersCol := a.members.ReadWrite(sqlTx)
+	var groupsProto auth.Groups
+	for member := range oldMembers.Usernames {
+		if newMembers[member] {
+			continue
+		}
+		if err := membersCol.Upsert(member, &groupsProto, func() error {
+			groupsProto.Groups = removeFromSet(groupsProto.Groups, group)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	for member := range newMembers {
+		if oldMembers.Usernames[member] {
+			continue
+		}
+		if err := membersCol.Upsert(member, &groupsProto, func() error {
+			groupsProto.Groups = addToSet(groupsProto.Groups, group)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return groups.Put(group, &auth.Users{Usernames: newMembers})
+}
+
+// syncedGroups returns the set of groups managed by any GroupProvider.
+func (a *apiServer) syncedGroups(ctx context.Context) (map[string]bool, error) {
+	result := make(map[string]bool)
+	managed := &auth.Groups{}
+	if err := a.groupProviders.ReadOnly(ctx).List(managed, col.DefaultOptions(), func(string) error {
+		for group := range managed.Groups {
+			result[group] = true
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// expandGroups returns every group that 'subject' belongs to, either directly
+// or through a group that is itself a member of another group. 'direct'
+// returns the groups that a subject or group belongs to directly. Cycles
+// between groups are tolerated.
+func expandGroups(subject string, direct func(string) (map[string]bool, error)) ([]string, error) {
+	seen := map[string]bool{subject: true}
+	var result []string
+	queue := []string{subject}
+	for len(queue) > 0 {
+		groups, err := direct(queue[0])
+		if err != nil {
+			return nil, err
+		}
+		queue = queue[1:]
+		for group := range groups {
+			if !seen[group] {
+				seen[group] = true
+				result = append(result, group)
+				queue = append(queue, group)
+			}
+		}
+	}
+	if result == nil {
+		return []string{}, nil
+	}
+	return result, nil
+}
@@ -0,0 +1,265 @@
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+
+	ldap "gopkg.in/ldap.v2"
+)
+
+func TestExpandGroups(t *testing.T) {
+	direct := map[string]map[string]bool{
+		"user:alice":  {"group:eng": true},
+		"group:eng":   {"group:staff": true, "group:oncall": true},
+		"group:staff": {"group:eng": true}, // cycle
+	}
+	lookup := func(s string) (map[string]bool, error) { return direct[s], nil }
+
+	groups, err := expandGroups("user:alice", lookup)
+	require.NoError(t, err)
+	sort.Strings(groups)
+	require.Equal(t, []string{"group:eng", "group:oncall", "group:staff"}, groups)
+
+	groups, err = expandGroups("user:bob", lookup)
+	require.NoError(t, err)
+	require.Equal(t, []string{}, groups)
+}
+
+func TestStaticGroupProvider(t *testing.T) {
+	dir := t.TempDir()
+	groupsPath := filepath.Join(dir, "groups.yaml")
+	require.NoError(t, ioutil.WriteFile(groupsPath, []byte(`
+eng: ["user:alice@example.com", "group:eng-leads"]
+group:eng-leads: ["robot:ci"]
+`), 0644))
+	configPath := filepath.Join(dir, "config.yaml")
+	require.NoError(t, ioutil.WriteFile(configPath, []byte(`
+interval: 1m
+static:
+- name: file
+  path: `+groupsPath+`
+`), 0644))
+
+	providers, interval, err := loadGroupSyncConfig(configPath)
+	require.NoError(t, err)
+	require.Equal(t, "1m0s", interval.String())
+	require.Equal(t, 1, len(providers))
+	groups, err := providers[0].Groups(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{
+		"group:eng":       {"user:alice@example.com", "group:eng-leads"},
+		"group:eng-leads": {"robot:ci"},
+	}, groups)
+}
+
+// fakeDirectory is an in-process stand-in for an LDAP server. It supports
+// equality filters of the form "(attr=value)".
+type fakeDirectory struct {
+	entries  []*ldap.Entry
+	password string
+}
+
+func (d *fakeDirectory) Bind(username, password string) error {
+	if password != d.password {
+		return errors.New("invalid credentials")
+	}
+	return nil
+}
+
+func (d *fakeDirectory) Search(req *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	kv := strings.SplitN(strings.Trim(req.Filter, "()"), "=", 2)
+	result := &ldap.SearchResult{}
+	for _, e := range d.entries {
+		if !strings.HasSuffix(e.DN, req.BaseDN) {
+			continue
+		}
+		for _, v := range e.GetAttributeValues(kv[0]) {
+			if v == kv[1] {
+				result.Entries = append(result.Entries, e)
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+func (d *fakeDirectory) Close() {}
+
+func TestLDAPGroupProvider(t *testing.T) {
+	entry := func(dn string, attrs map[string][]string) *ldap.Entry {
+		e := &ldap.Entry{DN: dn}
+		for k, v := range attrs {
+			e.Attributes = append(e.Attributes, &ldap.EntryAttribute{Name: k, Values: v})
+		}
+		return e
+	}
+	dir := &fakeDirectory{
+		password: "secret",
+		entries: []*ldap.Entry{
+			entry("uid=alice,ou=people,dc=example,dc=org", map[string][]string{
+				"objectClass": {"person"}, "mail": {"alice@example.com"},
+			}),
+			entry("uid=bob,ou=people,dc=example,dc=org", map[string][]string{
+				"objectClass": {"person"}, "mail": {"bob@example.com"},
+			}),
+			entry("cn=eng,ou=groups,dc=example,dc=org", map[string][]string{
+				"objectClass": {"groupOfNames"}, "cn": {"eng"},
+				"member": {"uid=alice,ou=people,dc=example,dc=org", "CN=leads, OU=groups, DC=example, DC=org"},
+			}),
+			entry("cn=leads,ou=groups,dc=example,dc=org", map[string][]string{
+				"objectClass": {"groupOfNames"}, "cn": {"leads"},
+				"member": {"uid=bob,ou=people,dc=example,dc=org", "uid=unknown,ou=people,dc=example,dc=org"},
+			}),
+		},
+	}
+	p := NewLDAPGroupProvider(LDAPGroupProviderConfig{
+		Name:         "corp",
+		BaseDN:       "dc=example,dc=org",
+		BindDN:       "cn=pachyderm,dc=example,dc=org",
+		BindPassword: "secret",
+	}).(*ldapGroupProvider)
+	p.dial = func() (ldapConn, error) { return dir, nil }
+
+	groups, err := p.Groups(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{
+		"group:eng":   {"user:alice@example.com", "group:leads"},
+		"group:leads": {"user:bob@example.com"},
+	}, groups)
+
+	dir.password = "rotated"
+	_, err = p.Groups(context.Background())
+	require.YesError(t, err)
+}
+
+// dbEnv is a ServiceEnv that only provides a database client
+type dbEnv struct {
+	serviceenv.ServiceEnv
+	db *sqlx.DB
+}
+
+func (e *dbEnv) GetDBClient() *sqlx.DB {
+	return e.db
+}
+
+// newGroupSyncServer returns an apiServer with just the collections needed to
+// sync groups, backed by a fresh database.
+func newGroupSyncServer(t *testing.T) *apiServer {
+	db := testutil.NewTestDB(t)
+	ctx := context.Background()
+	require.NoError(t, dbutil.WithTx(ctx, db, func(tx *sqlx.Tx) error {
+		if err := col.CreatePostgresSchema(ctx, tx); err != nil {
+			return err
+		}
+		if err := col.SetupPostgresV0(ctx, tx); err != nil {
+			return err
+		}
+		return col.SetupPostgresCollections(ctx, tx, append(AllCollections(), GroupSyncCollections()...)...)
+	}))
+	return &apiServer{
+		env:            &dbEnv{db: db},
+		members:        membersCollection(db, nil),
+		groups:         groupsCollection(db, nil),
+		groupProviders: groupProvidersCollection(db, nil),
+	}
+}
+
+func TestSyncGroupsRemovesMembers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	a := newGroupSyncServer(t)
+	ctx := context.Background()
+	groupsPath := filepath.Join(t.TempDir(), "groups.yaml")
+	p := NewStaticGroupProvider("file", groupsPath)
+	syncFile := func(groups string) {
+		require.NoError(t, ioutil.WriteFile(groupsPath, []byte(groups), 0644))
+		require.NoError(t, a.syncGroups(ctx, p))
+	}
+	requireGroups := func(subject string, expected ...string) {
+		groups, err := a.getGroups(ctx, subject)
+		require.NoError(t, err)
+		require.ElementsEqual(t, expected, groups)
+	}
+
+	syncFile(`
+eng: ["user:alice@example.com", "user:bob@example.com", "group:leads"]
+leads: ["robot:ci"]
+`)
+	requireGroups("user:alice@example.com", "group:eng")
+	requireGroups("user:bob@example.com", "group:eng")
+	requireGroups("robot:ci", "group:leads", "group:eng")
+
+	// bob is dropped from eng, and leads is dropped entirely
+	syncFile(`
+eng: ["user:alice@example.com"]
+`)
+	requireGroups("user:alice@example.com", "group:eng")
+	requireGroups("user:bob@example.com")
+	requireGroups("robot:ci")
+	var members auth.Users
+	require.NoError(t, a.groups.ReadOnly(ctx).Get("group:leads", &members))
+	require.Equal(t, 0, len(members.Usernames))
+}
+
+func TestSyncGroupsSkipsInvalidMembers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	a := newGroupSyncServer(t)
+	ctx := context.Background()
+	groupsPath := filepath.Join(t.TempDir(), "groups.yaml")
+	require.NoError(t, ioutil.WriteFile(groupsPath, []byte(`
+eng: ["user:alice@example.com", "alice", "bogus:bob"]
+leads: ["robot:ci"]
+`), 0644))
+	require.NoError(t, a.syncGroups(ctx, NewStaticGroupProvider("file", groupsPath)))
+
+	groups, err := a.getGroups(ctx, "user:alice@example.com")
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{"group:eng"}, groups)
+	groups, err = a.getGroups(ctx, "robot:ci")
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{"group:leads"}, groups)
+	var members auth.Users
+	require.NoError(t, a.groups.ReadOnly(ctx).Get("group:eng", &members))
+	require.Equal(t, map[string]bool{"user:alice@example.com": true}, members.Usernames)
+}
+
+func TestSetGroupsForUserIgnoresSyncedGroups(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	a := newGroupSyncServer(t)
+	ctx := context.Background()
+	groupsPath := filepath.Join(t.TempDir(), "groups.yaml")
+	require.NoError(t, ioutil.WriteFile(groupsPath, []byte(`
+eng: ["user:alice@example.com"]
+leads: ["user:bob@example.com"]
+`), 0644))
+	require.NoError(t, a.syncGroups(ctx, NewStaticGroupProvider("file", groupsPath)))
+
+	// Claims can't add alice to leads or remove her from eng, since both are
+	// synced, but can add her to other groups
+	require.NoError(t, a.setGroupsForUserInternal(ctx, "user:alice@example.com", []string{"group:leads", "group:oncall"}))
+	groups, err := a.getGroups(ctx, "user:alice@example.com")
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{"group:eng", "group:oncall"}, groups)
+	var members auth.Users
+	require.NoError(t, a.groups.ReadOnly(ctx).Get("group:leads", &members))
+	require.Equal(t, map[string]bool{"user:bob@example.com": true}, members.Usernames)
+}
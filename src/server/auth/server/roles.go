@@ -22,20 +22,23 @@ var (
 	}
 
 	// repoWriter has the ability to create and delete commits,
-	// write files to a repo and create pipelines that write to a repo,
-	// plus all the permissions of repoReader.
+	// write files to a repo, create tags and create pipelines that
+	// write to a repo, plus all the permissions of repoReader.
 	repoWriterRole = combinePermissions(repoReaderRole, []auth.Permission{
 		auth.Permission_REPO_WRITE,
 		auth.Permission_REPO_DELETE_COMMIT,
 		auth.Permission_REPO_CREATE_BRANCH,
 		auth.Permission_REPO_DELETE_BRANCH,
+		auth.Permission_REPO_CREATE_TAG,
 		auth.Permission_REPO_ADD_PIPELINE_WRITER,
 	})
 
 	// repoWriter has the ability to modify the role bindings for
-	// a repo and delete it, plus all the permissions of repoWriter.
+	// a repo, delete its tags and delete it, plus all the permissions
+	// of repoWriter.
 	repoOwnerRole = combinePermissions(repoWriterRole, []auth.Permission{
 		auth.Permission_REPO_MODIFY_BINDINGS,
+		auth.Permission_REPO_DELETE_TAG,
 		auth.Permission_REPO_DELETE,
 	})
 
@@ -50,13 +53,17 @@ var (
 	}
 
 	// idpAdmin has the ability to create, update and delete
-	// identity providers.
+	// identity providers, and to manage the users that have
+	// logged in through them.
 	idpAdminRole = []auth.Permission{
 		auth.Permission_CLUSTER_IDENTITY_CREATE_IDP,
 		auth.Permission_CLUSTER_IDENTITY_UPDATE_IDP,
 		auth.Permission_CLUSTER_IDENTITY_LIST_IDPS,
 		auth.Permission_CLUSTER_IDENTITY_GET_IDP,
 		auth.Permission_CLUSTER_IDENTITY_DELETE_IDP,
+		auth.Permission_CLUSTER_IDENTITY_LIST_USERS,
+		auth.Permission_CLUSTER_IDENTITY_GET_USER,
+		auth.Permission_CLUSTER_IDENTITY_DELETE_USER,
 	}
 
 	// identityAdmin has the ability to modify the identity
@@ -69,6 +76,7 @@ var (
 	// debugger has the ability to produce debug dumps
 	debuggerRole = []auth.Permission{
 		auth.Permission_CLUSTER_DEBUG_DUMP,
+		auth.Permission_CLUSTER_ADMIN_MIGRATION_STATUS,
 	}
 
 	// robotUser has the ability to create tokens for any robot user
@@ -120,11 +128,14 @@ var (
 			auth.Permission_CLUSTER_AUTH_DELETE_EXPIRED_TOKENS,
 			auth.Permission_CLUSTER_AUTH_GET_PERMISSIONS_FOR_PRINCIPAL,
 			auth.Permission_CLUSTER_AUTH_REVOKE_USER_TOKENS,
+			auth.Permission_CLUSTER_AUTH_LIST_TOKENS,
+			auth.Permission_CLUSTER_AUTH_REVOKE_TOKEN,
 			auth.Permission_CLUSTER_ENTERPRISE_ACTIVATE,
 			auth.Permission_CLUSTER_ENTERPRISE_HEARTBEAT,
 			auth.Permission_CLUSTER_ENTERPRISE_GET_CODE,
 			auth.Permission_CLUSTER_ENTERPRISE_DEACTIVATE,
 			auth.Permission_CLUSTER_DELETE_ALL,
+			auth.Permission_CLUSTER_ADMIN_VERIFY_REPLICA,
 		})
 )
 
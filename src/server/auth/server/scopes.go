@@ -0,0 +1,82 @@
+package server
+
+import (
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+)
+
+// validateScopes returns an error if any of 'scopes' is malformed.
+func validateScopes(scopes []*auth.TokenScope) error {
+	for _, scope := range scopes {
+		if scope.Resource == nil {
+			return errors.New("token scopes must specify a resource")
+		}
+		switch scope.Resource.Type {
+		case auth.ResourceType_CLUSTER:
+		case auth.ResourceType_REPO:
+			if scope.Resource.Name == "" {
+				return errors.New("repo token scopes must specify a repo name")
+			}
+		default:
+			return errors.Errorf("unknown resource type %v in token scope", scope.Resource.Type)
+		}
+		if len(scope.Roles) == 0 && len(scope.Permissions) == 0 {
+			return errors.Errorf("token scope on %v %q must grant at least one role or permission", scope.Resource.Type, scope.Resource.Name)
+		}
+		for _, role := range scope.Roles {
+			if _, err := permissionsForRole(role); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// scopePermissions returns the set of permissions that 'scopes' allow on
+// 'resource'.
+func scopePermissions(scopes []*auth.TokenScope, resource *auth.Resource) (map[auth.Permission]bool, error) {
+	allowed := make(map[auth.Permission]bool)
+	for _, scope := range scopes {
+		if scope.Resource.Type != auth.ResourceType_CLUSTER &&
+			(scope.Resource.Type != resource.Type || scope.Resource.Name != resource.Name) {
+			continue
+		}
+		for _, role := range scope.Roles {
+			permissions, err := permissionsForRole(role)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range permissions {
+				allowed[p] = true
+			}
+		}
+		for _, p := range scope.Permissions {
+			allowed[p] = true
+		}
+	}
+	return allowed, nil
+}
+
+// restrictToScopes intersects the permissions in 'resp', which were evaluated
+// from role bindings, with the permissions that 'scopes' allow on 'resource'.
+// It's a no-op for tokens without scopes.
+func restrictToScopes(scopes []*auth.TokenScope, resource *auth.Resource, resp *auth.AuthorizeResponse) error {
+	if len(scopes) == 0 {
+		return nil
+	}
+	allowed, err := scopePermissions(scopes, resource)
+	if err != nil {
+		return err
+	}
+	satisfied := make([]auth.Permission, 0, len(resp.Satisfied))
+	for _, p := range resp.Satisfied {
+		if allowed[p] {
+			satisfied = append(satisfied, p)
+		} else {
+			resp.Missing = append(resp.Missing, p)
+		}
+	}
+	resp.Satisfied = satisfied
+	resp.Authorized = len(resp.Missing) == 0
+	return nil
+}
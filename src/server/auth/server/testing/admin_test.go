@@ -811,6 +811,41 @@ func TestGroupRoleBinding(t *testing.T) {
 	require.NoError(t, aliceClient.FinishCommit(repo, commit.Branch.Name, commit.ID))
 }
 
+// TestNestedGroupRoleBinding tests that a role binding for a group confers
+// access to members of groups nested inside it
+func TestNestedGroupRoleBinding(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	alice := robot(tu.UniqueString("alice"))
+	outer, inner := group(tu.UniqueString("outer")), group(tu.UniqueString("inner"))
+	aliceClient, rootClient := tu.GetAuthenticatedPachClient(t, alice), tu.GetAuthenticatedPachClient(t, auth.RootUser)
+
+	repo := tu.UniqueString("TestNestedGroupRoleBinding")
+	require.NoError(t, rootClient.CreateRepo(repo))
+	require.NoError(t, rootClient.ModifyRepoRoleBinding(repo, outer, []string{auth.RepoWriterRole}))
+
+	// alice is a member of 'inner', which is a member of 'outer'
+	_, err := rootClient.ModifyMembers(rootClient.Ctx(), &auth.ModifyMembersRequest{
+		Group: outer,
+		Add:   []string{inner},
+	})
+	require.NoError(t, err)
+	_, err = rootClient.ModifyMembers(rootClient.Ctx(), &auth.ModifyMembersRequest{
+		Group: inner,
+		Add:   []string{alice},
+	})
+	require.NoError(t, err)
+
+	groups, err := rootClient.GetGroupsForPrincipal(rootClient.Ctx(), &auth.GetGroupsForPrincipalRequest{Principal: alice})
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{inner, outer}, groups.Groups)
+
+	commit, err := aliceClient.StartCommit(repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, aliceClient.FinishCommit(repo, commit.Branch.Name, commit.ID))
+}
+
 // TestRobotUserAdmin tests that robot users can
 // 1) become admins
 // 2) mint tokens for robot and non-robot users
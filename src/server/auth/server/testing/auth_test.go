@@ -1130,6 +1130,8 @@ func TestListAndInspectRepo(t *testing.T) {
 			auth.Permission_REPO_CREATE_BRANCH,
 			auth.Permission_REPO_LIST_BRANCH,
 			auth.Permission_REPO_DELETE_BRANCH,
+			auth.Permission_REPO_CREATE_TAG,
+			auth.Permission_REPO_DELETE_TAG,
 			auth.Permission_REPO_LIST_FILE,
 			auth.Permission_REPO_ADD_PIPELINE_READER,
 			auth.Permission_REPO_REMOVE_PIPELINE_READER,
@@ -1146,6 +1148,7 @@ func TestListAndInspectRepo(t *testing.T) {
 			auth.Permission_REPO_CREATE_BRANCH,
 			auth.Permission_REPO_LIST_BRANCH,
 			auth.Permission_REPO_DELETE_BRANCH,
+			auth.Permission_REPO_CREATE_TAG,
 			auth.Permission_REPO_LIST_FILE,
 			auth.Permission_REPO_ADD_PIPELINE_READER,
 			auth.Permission_REPO_REMOVE_PIPELINE_READER,
@@ -2524,6 +2527,199 @@ func TestExtractAuthToken(t *testing.T) {
 	require.NoError(t, containsToken(tokenRespTwo.Token, "robot:otherTwo", false))
 }
 
+// TestListAndRevokeAuthTokens tests that admins can list, inspect and revoke
+// issued tokens by their hash
+func TestListAndRevokeAuthTokens(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, adminClient := tu.GetAuthenticatedPachClient(t, alice), tu.GetAuthenticatedPachClient(t, auth.RootUser)
+
+	// alice can't list or revoke tokens because she's not an admin
+	_, err := aliceClient.ListAuthTokens(aliceClient.Ctx(), &auth.ListAuthTokensRequest{})
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+	_, err = aliceClient.RevokeAuthTokenByHash(aliceClient.Ctx(), &auth.RevokeAuthTokenByHashRequest{HashedToken: auth.HashToken(aliceClient.AuthToken())})
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+
+	tokenResp, err := adminClient.GetRobotToken(adminClient.Ctx(), &auth.GetRobotTokenRequest{Robot: strings.TrimPrefix(bob, auth.RobotPrefix)})
+	require.NoError(t, err)
+	hash := auth.HashToken(tokenResp.Token)
+
+	// the new token is listed, but hasn't been used
+	resp, err := adminClient.ListAuthTokens(adminClient.Ctx(), &auth.ListAuthTokensRequest{Subject: bob})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Tokens))
+	require.Equal(t, hash, resp.Tokens[0].HashedToken)
+	require.Equal(t, bob, resp.Tokens[0].Subject)
+	require.NotNil(t, resp.Tokens[0].CreatedAt)
+	require.Nil(t, resp.Tokens[0].LastUsed)
+
+	// after bob uses the token, its last-used time is set
+	bobClient := tu.GetUnauthenticatedPachClient(t)
+	bobClient.SetAuthToken(tokenResp.Token)
+	_, err = bobClient.WhoAmI(bobClient.Ctx(), &auth.WhoAmIRequest{})
+	require.NoError(t, err)
+	inspectResp, err := adminClient.InspectAuthToken(adminClient.Ctx(), &auth.InspectAuthTokenRequest{HashedToken: hash})
+	require.NoError(t, err)
+	require.NotNil(t, inspectResp.Token.LastUsed)
+
+	// the root token can't be revoked by hash
+	_, err = adminClient.RevokeAuthTokenByHash(adminClient.Ctx(), &auth.RevokeAuthTokenByHashRequest{HashedToken: auth.HashToken(adminClient.AuthToken())})
+	require.YesError(t, err)
+
+	// once bob's token is revoked by hash, it no longer works
+	_, err = adminClient.RevokeAuthTokenByHash(adminClient.Ctx(), &auth.RevokeAuthTokenByHashRequest{HashedToken: hash})
+	require.NoError(t, err)
+	_, err = bobClient.WhoAmI(bobClient.Ctx(), &auth.WhoAmIRequest{})
+	require.YesError(t, err)
+	resp, err = adminClient.ListAuthTokens(adminClient.Ctx(), &auth.ListAuthTokensRequest{Subject: bob})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(resp.Tokens))
+}
+
+// getScopedClient returns a client for 'robot' whose token is restricted to
+// 'scopes'.
+func getScopedClient(t *testing.T, robot string, scopes ...*auth.TokenScope) *client.APIClient {
+	t.Helper()
+	adminClient := tu.GetAuthenticatedPachClient(t, auth.RootUser)
+	resp, err := adminClient.GetRobotToken(adminClient.Ctx(), &auth.GetRobotTokenRequest{
+		Robot:  strings.TrimPrefix(robot, auth.RobotPrefix),
+		Scopes: scopes,
+	})
+	require.NoError(t, err)
+	scopedClient := tu.GetUnauthenticatedPachClient(t)
+	scopedClient.SetAuthToken(resp.Token)
+	return scopedClient
+}
+
+func repoScope(repo string, roles ...string) *auth.TokenScope {
+	return &auth.TokenScope{
+		Resource: &auth.Resource{Type: auth.ResourceType_REPO, Name: repo},
+		Roles:    roles,
+	}
+}
+
+// TestScopedTokenRepo tests that a token scoped to a repo can use its scope on
+// that repo, and can't act on other repos, even ones its robot owns
+func TestScopedTokenRepo(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+
+	alice := robot(tu.UniqueString("alice"))
+	aliceClient := tu.GetAuthenticatedPachClient(t, alice)
+	repoA, repoB := tu.UniqueString("TestScopedTokenRepoA"), tu.UniqueString("TestScopedTokenRepoB")
+	require.NoError(t, aliceClient.CreateRepo(repoA))
+	require.NoError(t, aliceClient.CreateRepo(repoB))
+	scopedClient := getScopedClient(t, alice, repoScope(repoA, auth.RepoWriterRole))
+
+	// the scoped token can write to repoA
+	require.NoError(t, scopedClient.PutFile(client.NewCommit(repoA, "master", ""), "/file", strings.NewReader("1")))
+	var buf bytes.Buffer
+	require.NoError(t, scopedClient.GetFile(client.NewCommit(repoA, "master", ""), "/file", &buf))
+	require.Equal(t, "1", buf.String())
+	// but writer doesn't include deleting the repo, even though alice owns it
+	err := scopedClient.DeleteRepo(repoA, false)
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// the scoped token can't read or write repoB, though alice can
+	err = scopedClient.PutFile(client.NewCommit(repoB, "master", ""), "/file", strings.NewReader("1"))
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	_, err = scopedClient.ListCommitByRepo(client.NewRepo(repoB))
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	require.NoError(t, aliceClient.PutFile(client.NewCommit(repoB, "master", ""), "/file", strings.NewReader("1")))
+}
+
+// TestScopedTokenCannotEscalate tests that a token's scopes can't grant more
+// than its robot's own role bindings
+func TestScopedTokenCannotEscalate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient := tu.GetAuthenticatedPachClient(t, alice)
+	repo := tu.UniqueString("TestScopedTokenCannotEscalate")
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	require.NoError(t, aliceClient.PutFile(client.NewCommit(repo, "master", ""), "/file", strings.NewReader("1")))
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(repo, bob, []string{auth.RepoReaderRole}))
+
+	// bob's token is scoped to owner, but bob is only a reader
+	scopedClient := getScopedClient(t, bob, repoScope(repo, auth.RepoOwnerRole))
+	var buf bytes.Buffer
+	require.NoError(t, scopedClient.GetFile(client.NewCommit(repo, "master", ""), "/file", &buf))
+	require.Equal(t, "1", buf.String())
+	err := scopedClient.PutFile(client.NewCommit(repo, "master", ""), "/file", strings.NewReader("2"))
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	err = scopedClient.ModifyRepoRoleBinding(repo, bob, []string{auth.RepoOwnerRole})
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// a cluster scope doesn't grant anything beyond bob's bindings either
+	scopedClient = getScopedClient(t, bob, &auth.TokenScope{
+		Resource: &auth.Resource{Type: auth.ResourceType_CLUSTER},
+		Roles:    []string{auth.ClusterAdminRole},
+	})
+	_, err = scopedClient.ListRepo()
+	require.NoError(t, err)
+	err = scopedClient.PutFile(client.NewCommit(repo, "master", ""), "/file", strings.NewReader("2"))
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// malformed scopes are rejected
+	adminClient := tu.GetAuthenticatedPachClient(t, auth.RootUser)
+	_, err = adminClient.GetRobotToken(adminClient.Ctx(), &auth.GetRobotTokenRequest{
+		Robot:  strings.TrimPrefix(bob, auth.RobotPrefix),
+		Scopes: []*auth.TokenScope{repoScope(repo)},
+	})
+	require.YesError(t, err)
+	_, err = adminClient.GetRobotToken(adminClient.Ctx(), &auth.GetRobotTokenRequest{
+		Robot:  strings.TrimPrefix(bob, auth.RobotPrefix),
+		Scopes: []*auth.TokenScope{repoScope("", auth.RepoReaderRole)},
+	})
+	require.YesError(t, err)
+}
+
+// TestScopedTokenWhoAmI tests that WhoAmI reports a token's scopes
+func TestScopedTokenWhoAmI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+
+	alice := robot(tu.UniqueString("alice"))
+	aliceClient := tu.GetAuthenticatedPachClient(t, alice)
+	resp, err := aliceClient.WhoAmI(aliceClient.Ctx(), &auth.WhoAmIRequest{})
+	require.NoError(t, err)
+	require.Equal(t, alice, resp.Username)
+	require.Equal(t, 0, len(resp.Scopes))
+
+	scope := repoScope(tu.UniqueString("TestScopedTokenWhoAmI"), auth.RepoReaderRole)
+	scopedClient := getScopedClient(t, alice, scope)
+	resp, err = scopedClient.WhoAmI(scopedClient.Ctx(), &auth.WhoAmIRequest{})
+	require.NoError(t, err)
+	require.Equal(t, alice, resp.Username)
+	require.Equal(t, 1, len(resp.Scopes))
+	require.Equal(t, auth.ResourceType_REPO, resp.Scopes[0].Resource.Type)
+	require.Equal(t, scope.Resource.Name, resp.Scopes[0].Resource.Name)
+	require.ElementsEqual(t, scope.Roles, resp.Scopes[0].Roles)
+}
+
 // TestRestoreAuthToken tests that admins can restore hashed auth tokens that have been extracted
 func TestRestoreAuthToken(t *testing.T) {
 	if testing.Short() {
@@ -2824,3 +3020,146 @@ func TestExpiredClusterLocksOutUsers(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, len(repoInfo))
 }
+
+// TestTagPermissions checks that creating tags requires write access to the
+// repo, and that only its owners can delete them.
+func TestTagPermissions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.GetAuthenticatedPachClient(t, alice), tu.GetAuthenticatedPachClient(t, bob)
+
+	dataRepo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(dataRepo))
+	master := client.NewCommit(dataRepo, "master", "")
+	require.NoError(t, aliceClient.PutFile(master, "/file", strings.NewReader("1")))
+	require.NoError(t, aliceClient.CreateTag(dataRepo, "v1", "master", ""))
+
+	// bob can't create or delete tags
+	err := bobClient.CreateTag(dataRepo, "v2", "master", "")
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	err = bobClient.DeleteTag(dataRepo, "v1")
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// alice makes bob a writer, so bob can create tags, but still can't delete
+	// them
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(dataRepo, bob, []string{auth.RepoWriterRole}))
+	require.NoError(t, bobClient.CreateTag(dataRepo, "v2", "master", ""))
+	err = bobClient.DeleteTag(dataRepo, "v1")
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// alice owns the repo and can delete its tags
+	require.NoError(t, aliceClient.DeleteTag(dataRepo, "v1"))
+}
+
+// TestForkRepoPermissions checks that forking a repo requires read access to
+// it.
+func TestForkRepoPermissions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.GetAuthenticatedPachClient(t, alice), tu.GetAuthenticatedPachClient(t, bob)
+
+	dataRepo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(dataRepo))
+	master := client.NewCommit(dataRepo, "master", "")
+	require.NoError(t, aliceClient.PutFile(master, "/file", strings.NewReader("1")))
+
+	// bob can't fork the repo
+	fork := tu.UniqueString("fork")
+	_, err := bobClient.ForkRepo(master, fork, "")
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	_, err = aliceClient.InspectRepo(fork)
+	require.YesError(t, err)
+
+	// alice makes bob a reader, so bob can fork the repo
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(dataRepo, bob, []string{auth.RepoReaderRole}))
+	_, err = bobClient.ForkRepo(master, fork, "")
+	require.NoError(t, err)
+	buf := &bytes.Buffer{}
+	require.NoError(t, bobClient.GetFile(client.NewCommit(fork, "master", ""), "/file", buf))
+	require.Equal(t, "1", buf.String())
+}
+
+// TestMergeCommitPermissions checks that merging commits requires write access
+// to the repo.
+func TestMergeCommitPermissions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.GetAuthenticatedPachClient(t, alice), tu.GetAuthenticatedPachClient(t, bob)
+
+	// alice creates a repo with a master and a feature branch
+	dataRepo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(dataRepo))
+	master := client.NewCommit(dataRepo, "master", "")
+	require.NoError(t, aliceClient.PutFile(master, "/file", strings.NewReader("1")))
+	require.NoError(t, aliceClient.CreateBranch(dataRepo, "feature", "master", "", nil))
+	feature := client.NewCommit(dataRepo, "feature", "")
+	require.NoError(t, aliceClient.PutFile(feature, "/other", strings.NewReader("2")))
+
+	// bob can't merge without access, or as a reader
+	_, err := bobClient.MergeCommit(master, feature, pfs.MergeStrategy_FAIL)
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(dataRepo, bob, []string{auth.RepoReaderRole}))
+	_, err = bobClient.MergeCommit(master, feature, pfs.MergeStrategy_FAIL)
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// alice makes bob a writer, so bob can merge
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(dataRepo, bob, []string{auth.RepoWriterRole}))
+	resp, err := bobClient.MergeCommit(master, feature, pfs.MergeStrategy_FAIL)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(resp.Conflicts))
+	buf := &bytes.Buffer{}
+	require.NoError(t, bobClient.GetFile(master, "/other", buf))
+	require.Equal(t, "2", buf.String())
+}
+
+// TestRevertCommitPermissions checks that reverting a commit requires write
+// access to the repo.
+func TestRevertCommitPermissions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.GetAuthenticatedPachClient(t, alice), tu.GetAuthenticatedPachClient(t, bob)
+
+	dataRepo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(dataRepo))
+	master := client.NewCommit(dataRepo, "master", "")
+	require.NoError(t, aliceClient.PutFile(master, "/file", strings.NewReader("1")))
+	require.NoError(t, aliceClient.PutFile(master, "/other", strings.NewReader("2")))
+
+	// bob can't revert without access, or as a reader
+	_, err := bobClient.RevertCommit(dataRepo, "master", "")
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(dataRepo, bob, []string{auth.RepoReaderRole}))
+	_, err = bobClient.RevertCommit(dataRepo, "master", "")
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// alice makes bob a writer, so bob can revert
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(dataRepo, bob, []string{auth.RepoWriterRole}))
+	_, err = bobClient.RevertCommit(dataRepo, "master", "")
+	require.NoError(t, err)
+	_, err = bobClient.InspectFile(master, "/other")
+	require.YesError(t, err)
+}
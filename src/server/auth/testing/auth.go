@@ -179,6 +179,21 @@ func (a *InactiveAPIServer) DeleteExpiredAuthTokens(context.Context, *auth.Delet
 	return nil, auth.ErrNotActivated
 }
 
+// ListAuthTokens implements the ListAuthTokens RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ListAuthTokens(context.Context, *auth.ListAuthTokensRequest) (*auth.ListAuthTokensResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// InspectAuthToken implements the InspectAuthToken RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) InspectAuthToken(context.Context, *auth.InspectAuthTokenRequest) (*auth.InspectAuthTokenResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// RevokeAuthTokenByHash implements the RevokeAuthTokenByHash RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) RevokeAuthTokenByHash(context.Context, *auth.RevokeAuthTokenByHashRequest) (*auth.RevokeAuthTokenByHashResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
 // RotateRootToken implements the RotateRootToken RPC, but just returns NotActivatedError
 func (a *InactiveAPIServer) RotateRootToken(context.Context, *auth.RotateRootTokenRequest) (*auth.RotateRootTokenResponse, error) {
 	return nil, auth.ErrNotActivated
@@ -22,6 +22,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/metrics"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
 	admincmds "github.com/pachyderm/pachyderm/v2/src/server/admin/cmds"
+	applycmds "github.com/pachyderm/pachyderm/v2/src/server/apply/cmds"
 	authcmds "github.com/pachyderm/pachyderm/v2/src/server/auth/cmds"
 	"github.com/pachyderm/pachyderm/v2/src/server/cmd/pachctl/shell"
 	configcmds "github.com/pachyderm/pachyderm/v2/src/server/config"
@@ -822,6 +823,18 @@ This resets the cluster to its initial state.`,
 	}
 	subcommands = append(subcommands, cmdutil.CreateAlias(copyDocs, "copy"))
 
+	forkDocs := &cobra.Command{
+		Short: "Create a Pachyderm resource that shares the data of another.",
+		Long:  "Create a Pachyderm resource that shares the data of another.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(forkDocs, "fork"))
+
+	revertDocs := &cobra.Command{
+		Short: "Undo the changes made to a Pachyderm resource.",
+		Long:  "Undo the changes made to a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(revertDocs, "revert"))
+
 	getDocs := &cobra.Command{
 		Short: "Get the raw data represented by a Pachyderm resource.",
 		Long:  "Get the raw data represented by a Pachyderm resource.",
@@ -881,6 +894,7 @@ This resets the cluster to its initial state.`,
 	subcommands = append(subcommands, debugcmds.Cmds()...)
 	subcommands = append(subcommands, txncmds.Cmds()...)
 	subcommands = append(subcommands, configcmds.Cmds()...)
+	subcommands = append(subcommands, applycmds.Cmds()...)
 
 	cmdutil.MergeCommands(rootCmd, subcommands)
 
@@ -924,12 +938,15 @@ func applyRootUsageFunc(rootCmd *cobra.Command) {
 			"edit",
 			"finish",
 			"flush",
+			"fork",
 			"get",
 			"glob",
 			"inspect",
 			"list",
+			"merge",
 			"put",
 			"restart",
+			"revert",
 			"start",
 			"stop",
 			"subscribe",
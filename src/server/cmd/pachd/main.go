@@ -61,10 +61,12 @@ import (
 
 var mode string
 var readiness bool
+var migrationsDryRun bool
 
 func init() {
 	flag.StringVar(&mode, "mode", "full", "Pachd currently supports three modes: full, enterprise and sidecar. full includes everything you need in a full pachd node. Enterprise runs the Enterprise Server. Sidecar runs only PFS, the Auth service, and a stripped-down version of PPS.")
 	flag.BoolVar(&readiness, "readiness", false, "Run readiness check.")
+	flag.BoolVar(&migrationsDryRun, "migrations-dry-run", false, "Apply any pending database migrations in a transaction that is rolled back, report the result and exit.")
 	flag.Parse()
 }
 
@@ -75,6 +77,8 @@ func main() {
 	switch {
 	case readiness:
 		cmdutil.Main(doReadinessCheck, &serviceenv.PachdFullConfiguration{})
+	case migrationsDryRun:
+		cmdutil.Main(doMigrationsDryRun, &serviceenv.PachdFullConfiguration{})
 	case mode == "full":
 		cmdutil.Main(doFullMode, &serviceenv.PachdFullConfiguration{})
 	case mode == "enterprise":
@@ -91,6 +95,27 @@ func doReadinessCheck(config interface{}) error {
 	return env.GetPachClient(context.Background()).Health()
 }
 
+// doMigrationsDryRun previews the migrations that this version of pachd would
+// apply at startup, without modifying the database.
+func doMigrationsDryRun(config interface{}) error {
+	env := serviceenv.InitServiceEnv(serviceenv.NewConfiguration(config))
+	ctx := context.Background()
+	applied, err := migrations.ListApplied(ctx, env.GetDBClient())
+	if err != nil {
+		return err
+	}
+	for _, state := range migrations.States(clusterstate.DesiredClusterState) {
+		if state.Number() >= len(applied) {
+			log.Infof("pending migration %d %s", state.Number(), state.Name())
+		}
+	}
+	if err := migrations.DryRun(ctx, env.GetDBClient(), migrations.Env{}, clusterstate.DesiredClusterState); err != nil {
+		return err
+	}
+	log.Infof("all migrations applied successfully (dry run)")
+	return nil
+}
+
 func doEnterpriseMode(config interface{}) (retErr error) {
 	defer func() {
 		if retErr != nil {
@@ -155,6 +155,13 @@ func (a *apiServer) heartbeatToServer(ctx context.Context, licenseServer, id, se
 		clientID = config.Configuration.ClientID
 	}
 
+	// Usage is informational, so don't let a failure to collect it prevent
+	// the cluster from renewing its license
+	usage, err := a.clusterUsage(ctx)
+	if err != nil {
+		logrus.WithError(err).Warn("unable to collect cluster usage for license heartbeat")
+	}
+
 	pachClient, err := client.NewFromURI(licenseServer)
 	if err != nil {
 		return nil, err
@@ -166,6 +173,7 @@ func (a *apiServer) heartbeatToServer(ctx context.Context, licenseServer, id, se
 		Version:     versionResp,
 		AuthEnabled: authEnabled,
 		ClientId:    clientID,
+		Usage:       usage,
 	})
 }
 
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"golang.org/x/net/context"
+
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	lc "github.com/pachyderm/pachyderm/v2/src/license"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// clusterUsage summarizes this cluster's usage for the license server. It
+// reads PFS and PPS state directly rather than through the API, because
+// heartbeats aren't made on behalf of any user. It returns nil if this pachd
+// doesn't run PFS, e.g. a standalone enterprise server.
+func (a *apiServer) clusterUsage(ctx context.Context) (*lc.ClusterUsage, error) {
+	if a.env.PfsServer() == nil {
+		return nil, nil
+	}
+	usage := &lc.ClusterUsage{}
+
+	repos, err := a.env.PfsServer().ListRepoNoAuth(ctx, &pfs.ListRepoRequest{Type: pfs.UserRepoType})
+	if err != nil {
+		return nil, err
+	}
+	for _, repoInfo := range repos.RepoInfo {
+		usage.Repos++
+		usage.SizeBytes += int64(repoInfo.SizeBytes)
+	}
+
+	pipelines := ppsdb.Pipelines(a.env.GetDBClient(), a.env.GetPostgresListener())
+	pipelineInfo := &pps.StoredPipelineInfo{}
+	if err := pipelines.ReadOnly(ctx).List(pipelineInfo, col.DefaultOptions(), func(string) error {
+		usage.Pipelines++
+		if pipelineInfo.State == pps.PipelineState_PIPELINE_RUNNING {
+			usage.Workers += int64(pipelineInfo.Parallelism)
+		}
+		for state, count := range pipelineInfo.JobCounts {
+			if ppsutil.IsTerminal(pps.JobState(state)) {
+				usage.JobsFinished += int64(count)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/identity"
@@ -13,6 +14,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serde"
 
+	units "github.com/docker/go-units"
 	"github.com/spf13/cobra"
 )
 
@@ -57,7 +59,7 @@ func newClient() (*client.APIClient, error) {
 	if err != nil {
 		return nil, err
 	}
-	fmt.Printf("Using enterprise context: %v\n", c.ClientContextName())
+	fmt.Fprintf(os.Stderr, "Using enterprise context: %v\n", c.ClientContextName())
 	return c, nil
 }
 
@@ -110,6 +112,8 @@ func SetIdentityServerConfigCmd() *cobra.Command {
 
 // GetIdentityServerConfigCmd returns a cobra.Command to fetch the current ID server config
 func GetIdentityServerConfigCmd() *cobra.Command {
+	var raw bool
+	var output string
 	getConfig := &cobra.Command{
 		Short: "Get the identity server config",
 		Long:  `Get the identity server config`,
@@ -124,6 +128,13 @@ func GetIdentityServerConfigCmd() *cobra.Command {
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
 			}
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				return cmdutil.PrintAll(printer, resp.Config)
+			}
 
 			yamlStr, err := serde.EncodeYAML(resp.Config)
 			if err != nil {
@@ -133,6 +144,7 @@ func GetIdentityServerConfigCmd() *cobra.Command {
 			return nil
 		}),
 	}
+	getConfig.Flags().AddFlagSet(cmdutil.OutputFlags(&raw, &output))
 	return cmdutil.CreateAlias(getConfig, "idp get-config")
 }
 
@@ -203,6 +215,8 @@ func UpdateIDPConnectorCmd() *cobra.Command {
 
 // GetIDPConnectorCmd returns a cobra.Command to get an IDP connector configuration
 func GetIDPConnectorCmd() *cobra.Command {
+	var raw bool
+	var output string
 	getConnector := &cobra.Command{
 		Use:   "{{alias}} <connector id>",
 		Short: "Get the config for an identity provider connector.",
@@ -217,6 +231,13 @@ func GetIDPConnectorCmd() *cobra.Command {
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
 			}
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				return cmdutil.PrintAll(printer, resp.Connector)
+			}
 			config, err := newConnectorConfig(resp.Connector)
 			if err != nil {
 				return err
@@ -229,6 +250,7 @@ func GetIDPConnectorCmd() *cobra.Command {
 			return nil
 		}),
 	}
+	getConnector.Flags().AddFlagSet(cmdutil.OutputFlags(&raw, &output))
 	return cmdutil.CreateAlias(getConnector, "idp get-connector")
 }
 
@@ -253,6 +275,8 @@ func DeleteIDPConnectorCmd() *cobra.Command {
 
 // ListIDPConnectorsCmd returns a cobra.Command to list IDP integrations
 func ListIDPConnectorsCmd() *cobra.Command {
+	var raw bool
+	var output string
 	listConnectors := &cobra.Command{
 		Short: "List identity provider connectors",
 		Long:  `List identity provider connectors`,
@@ -267,6 +291,18 @@ func ListIDPConnectorsCmd() *cobra.Command {
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
 			}
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				for _, conn := range resp.Connectors {
+					if err := printer.Print(conn); err != nil {
+						return err
+					}
+				}
+				return printer.Flush()
+			}
 
 			for _, conn := range resp.Connectors {
 				fmt.Printf("%v - %v (%v)\n", conn.Id, conn.Name, conn.Type)
@@ -274,6 +310,7 @@ func ListIDPConnectorsCmd() *cobra.Command {
 			return nil
 		}),
 	}
+	listConnectors.Flags().AddFlagSet(cmdutil.OutputFlags(&raw, &output))
 	return cmdutil.CreateAlias(listConnectors, "idp list-connector")
 }
 
@@ -330,6 +367,8 @@ func DeleteOIDCClientCmd() *cobra.Command {
 
 // GetOIDCClientCmd returns a cobra.Command to get an OIDC client
 func GetOIDCClientCmd() *cobra.Command {
+	var raw bool
+	var output string
 	getClient := &cobra.Command{
 		Use:   "{{alias}} <client ID>",
 		Short: "Get an OIDC client.",
@@ -345,6 +384,13 @@ func GetOIDCClientCmd() *cobra.Command {
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
 			}
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				return cmdutil.PrintAll(printer, resp.Client)
+			}
 
 			yamlStr, err := serde.EncodeYAML(resp.Client)
 			if err != nil {
@@ -354,6 +400,7 @@ func GetOIDCClientCmd() *cobra.Command {
 			return nil
 		}),
 	}
+	getClient.Flags().AddFlagSet(cmdutil.OutputFlags(&raw, &output))
 	return cmdutil.CreateAlias(getClient, "idp get-client")
 }
 
@@ -387,6 +434,8 @@ func UpdateOIDCClientCmd() *cobra.Command {
 
 // ListOIDCClientsCmd returns a cobra.Command to list IDP integrations
 func ListOIDCClientsCmd() *cobra.Command {
+	var raw bool
+	var output string
 	listConnectors := &cobra.Command{
 		Short: "List OIDC clients.",
 		Long:  `List OIDC clients.`,
@@ -401,6 +450,18 @@ func ListOIDCClientsCmd() *cobra.Command {
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
 			}
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				for _, client := range resp.Clients {
+					if err := printer.Print(client); err != nil {
+						return err
+					}
+				}
+				return printer.Flush()
+			}
 
 			for _, client := range resp.Clients {
 				fmt.Printf("%v\n", client.Id)
@@ -408,9 +469,124 @@ func ListOIDCClientsCmd() *cobra.Command {
 			return nil
 		}),
 	}
+	listConnectors.Flags().AddFlagSet(cmdutil.OutputFlags(&raw, &output))
 	return cmdutil.CreateAlias(listConnectors, "idp list-client")
 }
 
+// ListUsersCmd returns a cobra.Command to list users who have logged in via an IDP
+func ListUsersCmd() *cobra.Command {
+	var raw bool
+	var output string
+	listUsers := &cobra.Command{
+		Short: "List users that have logged in through an identity provider.",
+		Long:  `List users that have logged in through an identity provider.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := newClient()
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+
+			resp, err := c.ListUsers(c.Ctx(), &identity.ListUsersRequest{})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				for _, user := range resp.Users {
+					if err := printer.Print(user); err != nil {
+						return err
+					}
+				}
+				return printer.Flush()
+			}
+
+			for _, user := range resp.Users {
+				fmt.Printf("%v - %v (last login %v)\n", user.Email, user.ConnectorId, lastLogin(user))
+			}
+			return nil
+		}),
+	}
+	listUsers.Flags().AddFlagSet(cmdutil.OutputFlags(&raw, &output))
+	return cmdutil.CreateAlias(listUsers, "idp list-user")
+}
+
+// GetUserCmd returns a cobra.Command to get a user and their active sessions
+func GetUserCmd() *cobra.Command {
+	var raw bool
+	var output string
+	getUser := &cobra.Command{
+		Use:   "{{alias}} <email>",
+		Short: "Get a user that has logged in through an identity provider, and their active sessions.",
+		Long:  `Get a user that has logged in through an identity provider, and their active sessions.`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := newClient()
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+
+			resp, err := c.InspectUser(c.Ctx(), &identity.InspectUserRequest{Email: args[0]})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				return cmdutil.PrintAll(printer, resp)
+			}
+
+			fmt.Printf("Email: %v\n", resp.User.Email)
+			fmt.Printf("Connector: %v\n", resp.User.ConnectorId)
+			fmt.Printf("Last login: %v\n", lastLogin(resp.User))
+			fmt.Printf("Sessions:\n")
+			for _, session := range resp.Sessions {
+				fmt.Printf("  %v via %v (created %v, last used %v)\n", session.ClientId, session.ConnectorId,
+					units.HumanDuration(time.Since(*session.CreatedAt))+" ago",
+					units.HumanDuration(time.Since(*session.LastUsed))+" ago")
+			}
+			return nil
+		}),
+	}
+	getUser.Flags().AddFlagSet(cmdutil.OutputFlags(&raw, &output))
+	return cmdutil.CreateAlias(getUser, "idp get-user")
+}
+
+// DeleteUserCmd returns a cobra.Command to delete a user and revoke their sessions
+func DeleteUserCmd() *cobra.Command {
+	deleteUser := &cobra.Command{
+		Use:   "{{alias}} <email>",
+		Short: "Delete a user, ending their sessions and revoking their Pachyderm tokens.",
+		Long: `Delete a user, ending their sessions and revoking their Pachyderm tokens.
+
+The user must log in to the identity provider again to regain access. To stop
+them from doing so, remove them from the identity provider.`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := newClient()
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+
+			_, err = c.DeleteUser(c.Ctx(), &identity.DeleteUserRequest{Email: args[0]})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	return cmdutil.CreateAlias(deleteUser, "idp delete-user")
+}
+
+func lastLogin(user *identity.User) string {
+	if user.LastAuthenticated == nil {
+		return "never"
+	}
+	return units.HumanDuration(time.Since(*user.LastAuthenticated)) + " ago"
+}
+
 // Cmds returns a list of cobra commands for authenticating and authorizing
 // users in an auth-enabled Pachyderm cluster.
 func Cmds() []*cobra.Command {
@@ -434,6 +610,9 @@ func Cmds() []*cobra.Command {
 	commands = append(commands, UpdateOIDCClientCmd())
 	commands = append(commands, DeleteOIDCClientCmd())
 	commands = append(commands, ListOIDCClientsCmd())
+	commands = append(commands, ListUsersCmd())
+	commands = append(commands, GetUserCmd())
+	commands = append(commands, DeleteUserCmd())
 
 	return commands
 }
@@ -19,6 +19,13 @@ CREATE TABLE IF NOT EXISTS identity.users (
 	return errors.EnsureStack(err)
 }
 
+// AddConnectorIDToUsersTable adds a column recording which IDP connector each
+// user most recently logged in with.
+func AddConnectorIDToUsersTable(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, `ALTER TABLE identity.users ADD COLUMN IF NOT EXISTS connector_id VARCHAR(4096);`)
+	return errors.EnsureStack(err)
+}
+
 // CreateConfigTable sets up the postgres table which stores IDP configuration.
 // Dex usually loads config from a file, but reconfiguring via RPCs makes it
 // faster for users to iterate on finding the correct values.
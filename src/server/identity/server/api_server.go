@@ -8,6 +8,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	logrus "github.com/sirupsen/logrus"
 
+	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/identity"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
@@ -259,6 +260,59 @@ func (a *apiServer) DeleteOIDCClient(ctx context.Context, req *identity.DeleteOI
 	return &identity.DeleteOIDCClientResponse{}, nil
 }
 
+func (a *apiServer) ListUsers(ctx context.Context, req *identity.ListUsersRequest) (resp *identity.ListUsersResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+
+	users, err := listUsers(ctx, a.env.GetDBClient())
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity.ListUsersResponse{Users: users}, nil
+}
+
+func (a *apiServer) InspectUser(ctx context.Context, req *identity.InspectUserRequest) (resp *identity.InspectUserResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+
+	user, err := getUser(ctx, a.env.GetDBClient(), req.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := a.api.listSessions(req.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity.InspectUserResponse{User: user, Sessions: sessions}, nil
+}
+
+// DeleteUser removes the record of a user having logged in, terminates their
+// OIDC sessions so that they must authenticate with the IDP again, and
+// revokes any Pachyderm tokens issued to them.
+func (a *apiServer) DeleteUser(ctx context.Context, req *identity.DeleteUserRequest) (resp *identity.DeleteUserResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+
+	if err := deleteUser(ctx, a.env.GetDBClient(), req.Email); err != nil {
+		return nil, err
+	}
+
+	if err := a.api.deleteSessions(req.Email); err != nil {
+		return nil, err
+	}
+
+	if _, err := a.env.AuthServer().RevokeAuthTokensForUser(ctx, &auth.RevokeAuthTokensForUserRequest{
+		Username: auth.UserPrefix + req.Email,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &identity.DeleteUserResponse{}, nil
+}
+
 func (a *apiServer) DeleteAll(ctx context.Context, req *identity.DeleteAllRequest) (resp *identity.DeleteAllResponse, retErr error) {
 	a.LogReq(req)
 	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
@@ -70,6 +70,18 @@ func TestAuthNotActivated(t *testing.T) {
 	require.YesError(t, err)
 	require.Equal(t, "rpc error: code = Unimplemented desc = the auth service is not activated", err.Error())
 
+	_, err = client.ListUsers(client.Ctx(), &identity.ListUsersRequest{})
+	require.YesError(t, err)
+	require.Equal(t, "rpc error: code = Unimplemented desc = the auth service is not activated", err.Error())
+
+	_, err = client.InspectUser(client.Ctx(), &identity.InspectUserRequest{})
+	require.YesError(t, err)
+	require.Equal(t, "rpc error: code = Unimplemented desc = the auth service is not activated", err.Error())
+
+	_, err = client.DeleteUser(client.Ctx(), &identity.DeleteUserRequest{})
+	require.YesError(t, err)
+	require.Equal(t, "rpc error: code = Unimplemented desc = the auth service is not activated", err.Error())
+
 	_, err = client.IdentityAPIClient.DeleteAll(client.Ctx(), &identity.DeleteAllRequest{})
 	require.YesError(t, err)
 	require.Equal(t, "rpc error: code = Unimplemented desc = the auth service is not activated", err.Error())
@@ -134,6 +146,18 @@ func TestUserNotAdmin(t *testing.T) {
 	require.YesError(t, err)
 	require.Matches(t, fmt.Sprintf("rpc error: code = Unknown desc = %v is not authorized to perform this operation", alice), err.Error())
 
+	_, err = aliceClient.ListUsers(aliceClient.Ctx(), &identity.ListUsersRequest{})
+	require.YesError(t, err)
+	require.Matches(t, fmt.Sprintf("rpc error: code = Unknown desc = %v is not authorized to perform this operation", alice), err.Error())
+
+	_, err = aliceClient.InspectUser(aliceClient.Ctx(), &identity.InspectUserRequest{})
+	require.YesError(t, err)
+	require.Matches(t, fmt.Sprintf("rpc error: code = Unknown desc = %v is not authorized to perform this operation", alice), err.Error())
+
+	_, err = aliceClient.DeleteUser(aliceClient.Ctx(), &identity.DeleteUserRequest{})
+	require.YesError(t, err)
+	require.Matches(t, fmt.Sprintf("rpc error: code = Unknown desc = %v is not authorized to perform this operation", alice), err.Error())
+
 	_, err = aliceClient.IdentityAPIClient.DeleteAll(aliceClient.Ctx(), &identity.DeleteAllRequest{})
 	require.YesError(t, err)
 	require.Matches(t, fmt.Sprintf("rpc error: code = Unknown desc = %v is not authorized to perform this operation", alice), err.Error())
@@ -10,14 +10,39 @@ import (
 
 func listUsers(ctx context.Context, db *sqlx.DB) ([]*identity.User, error) {
 	users := make([]*identity.User, 0)
-	err := db.SelectContext(ctx, &users, "SELECT email, last_authenticated FROM identity.users WHERE enabled=true;")
+	err := db.SelectContext(ctx, &users, "SELECT email, last_authenticated, COALESCE(connector_id, '') AS connector_id FROM identity.users WHERE enabled=true ORDER BY email;")
 	if err != nil {
 		return nil, err
 	}
 	return users, nil
 }
 
-func addUserInTx(ctx context.Context, tx *sqlx.Tx, email string) error {
-	_, err := tx.ExecContext(ctx, `INSERT INTO identity.users (email, last_authenticated, enabled) VALUES ($1, now(), true) ON CONFLICT(email) DO UPDATE SET last_authenticated=NOW()`, email)
+func getUser(ctx context.Context, db *sqlx.DB, email string) (*identity.User, error) {
+	users := make([]*identity.User, 0)
+	err := db.SelectContext(ctx, &users, "SELECT email, last_authenticated, COALESCE(connector_id, '') AS connector_id FROM identity.users WHERE enabled=true AND email=$1;", email)
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, identity.ErrUserNotFound
+	}
+	return users[0], nil
+}
+
+func addUserInTx(ctx context.Context, tx *sqlx.Tx, email, connectorID string) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO identity.users (email, last_authenticated, enabled, connector_id) VALUES ($1, now(), true, $2) ON CONFLICT(email) DO UPDATE SET last_authenticated=NOW(), connector_id=$2`, email, connectorID)
 	return err
 }
+
+func deleteUser(ctx context.Context, db *sqlx.DB, email string) error {
+	res, err := db.ExecContext(ctx, `DELETE FROM identity.users WHERE email=$1`, email)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return identity.ErrUserNotFound
+	}
+	return nil
+}
@@ -214,6 +214,54 @@ func (a *dexAPI) getClient(id string) (*identity.OIDCClient, error) {
 	return storageClientToPach(client), nil
 }
 
+// listSessions returns the refresh-token-backed sessions held by the user with
+// the given email. Dex doesn't index refresh tokens by user, so this scans
+// every token.
+func (a *dexAPI) listSessions(email string) ([]*identity.UserSession, error) {
+	tokens, err := a.storage.ListRefreshTokens()
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*identity.UserSession, 0)
+	for _, t := range tokens {
+		if t.Claims.Email != email {
+			continue
+		}
+		createdAt, lastUsed := t.CreatedAt, t.LastUsed
+		sessions = append(sessions, &identity.UserSession{
+			ClientId:    t.ClientID,
+			ConnectorId: t.ConnectorID,
+			CreatedAt:   &createdAt,
+			LastUsed:    &lastUsed,
+		})
+	}
+	return sessions, nil
+}
+
+// deleteSessions revokes every refresh token held by the user with the given
+// email, along with the offline sessions that track them, so the user must
+// log in to the IDP again.
+func (a *dexAPI) deleteSessions(email string) error {
+	tokens, err := a.storage.ListRefreshTokens()
+	if err != nil {
+		return err
+	}
+
+	for _, t := range tokens {
+		if t.Claims.Email != email {
+			continue
+		}
+		if err := a.storage.DeleteRefresh(t.ID); err != nil && !errors.Is(err, dex_storage.ErrNotFound) {
+			return err
+		}
+		if err := a.storage.DeleteOfflineSessions(t.Claims.UserID, t.ConnectorID); err != nil && !errors.Is(err, dex_storage.ErrNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
 func (a *dexAPI) validateConnector(id, connType string, jsonConfig []byte) error {
 	typeConf, ok := dex_server.ConnectorsConfig[connType]
 	if !ok {
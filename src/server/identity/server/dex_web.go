@@ -174,7 +174,7 @@ func (w *dexWeb) interceptApproval(server *dex_server.Server) func(http.Response
 			rw.WriteHeader(http.StatusInternalServerError)
 			return
 		}
-		if err := addUserInTx(r.Context(), tx, authReq.Claims.Email); err != nil {
+		if err := addUserInTx(r.Context(), tx, authReq.Claims.Email, authReq.ConnectorID); err != nil {
 			w.logger.WithError(err).Error("unable to record user identity for login")
 			rw.WriteHeader(http.StatusInternalServerError)
 			return
@@ -8,12 +8,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/identity"
 	"github.com/pachyderm/pachyderm/v2/src/internal/clusterstate"
 	"github.com/pachyderm/pachyderm/v2/src/internal/migrations"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+	auth_server "github.com/pachyderm/pachyderm/v2/src/server/auth"
 	logrus "github.com/sirupsen/logrus"
 
 	dex_storage "github.com/dexidp/dex/storage"
@@ -160,11 +162,12 @@ func TestLogApprovedUsers(t *testing.T) {
 
 	// Create an auth request that has already done the Github flow
 	require.NoError(t, env.GetDexDB().CreateAuthRequest(dex_storage.AuthRequest{
-		ID:       "testreq",
-		ClientID: "testclient",
-		Expiry:   time.Now().Add(time.Hour),
-		LoggedIn: true,
-		Claims:   dex_storage.Claims{Email: "test@example.com"},
+		ID:          "testreq",
+		ClientID:    "testclient",
+		Expiry:      time.Now().Add(time.Hour),
+		LoggedIn:    true,
+		Claims:      dex_storage.Claims{Email: "test@example.com"},
+		ConnectorID: "conn",
 	}))
 
 	// Hit the approval endpoint to be redirected
@@ -177,14 +180,16 @@ func TestLogApprovedUsers(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, len(users))
 	require.Equal(t, "test@example.com", users[0].Email)
+	require.Equal(t, "conn", users[0].ConnectorId)
 
 	// Create a second request and confirm the last-authenticated date is updated
 	require.NoError(t, env.GetDexDB().CreateAuthRequest(dex_storage.AuthRequest{
-		ID:       "testreq2",
-		ClientID: "testclient",
-		Expiry:   time.Now().Add(time.Hour),
-		LoggedIn: true,
-		Claims:   dex_storage.Claims{Email: "test@example.com"},
+		ID:          "testreq2",
+		ClientID:    "testclient",
+		Expiry:      time.Now().Add(time.Hour),
+		LoggedIn:    true,
+		Claims:      dex_storage.Claims{Email: "test@example.com"},
+		ConnectorID: "conn",
 	}))
 
 	req = httptest.NewRequest("GET", "/approval?req=testreq2", nil)
@@ -198,3 +203,63 @@ func TestLogApprovedUsers(t *testing.T) {
 	require.Equal(t, "test@example.com", newUsers[0].Email)
 	require.NotEqual(t, users[0].LastAuthenticated, newUsers[0].LastAuthenticated)
 }
+
+// revokeRecorder is an auth APIServer that records which users had their
+// tokens revoked
+type revokeRecorder struct {
+	auth_server.APIServer
+	revoked []string
+}
+
+func (r *revokeRecorder) RevokeAuthTokensForUser(ctx context.Context, req *auth.RevokeAuthTokensForUserRequest) (*auth.RevokeAuthTokensForUserResponse, error) {
+	r.revoked = append(r.revoked, req.Username)
+	return &auth.RevokeAuthTokensForUserResponse{}, nil
+}
+
+// TestInspectAndDeleteUser tests that users who have logged in can be
+// inspected, and that deleting a user ends their sessions and revokes their
+// Pachyderm tokens.
+func TestInspectAndDeleteUser(t *testing.T) {
+	env := getTestEnv(t)
+	authServer := &revokeRecorder{}
+	env.(*serviceenv.TestServiceEnv).Auth = authServer
+	api := NewIdentityServer(env, false)
+	ctx := context.Background()
+
+	tx, err := env.GetDBClient().Beginx()
+	require.NoError(t, err)
+	require.NoError(t, addUserInTx(ctx, tx, "alice@example.com", "conn"))
+	require.NoError(t, addUserInTx(ctx, tx, "bob@example.com", "conn"))
+	require.NoError(t, tx.Commit())
+	require.NoError(t, env.GetDexDB().CreateRefresh(dex_storage.RefreshToken{
+		ID:          "alice-token",
+		ClientID:    "pachd",
+		ConnectorID: "conn",
+		Claims:      dex_storage.Claims{UserID: "alice", Email: "alice@example.com"},
+		CreatedAt:   time.Now(),
+		LastUsed:    time.Now(),
+	}))
+
+	listResp, err := api.ListUsers(ctx, &identity.ListUsersRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(listResp.Users))
+	require.Equal(t, "alice@example.com", listResp.Users[0].Email)
+
+	inspectResp, err := api.InspectUser(ctx, &identity.InspectUserRequest{Email: "alice@example.com"})
+	require.NoError(t, err)
+	require.Equal(t, "conn", inspectResp.User.ConnectorId)
+	require.Equal(t, 1, len(inspectResp.Sessions))
+	require.Equal(t, "pachd", inspectResp.Sessions[0].ClientId)
+
+	_, err = api.DeleteUser(ctx, &identity.DeleteUserRequest{Email: "alice@example.com"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"user:alice@example.com"}, authServer.revoked)
+
+	_, err = api.InspectUser(ctx, &identity.InspectUserRequest{Email: "alice@example.com"})
+	require.True(t, identity.IsErrUserNotFound(err))
+	_, err = env.GetDexDB().GetRefresh("alice-token")
+	require.Equal(t, dex_storage.ErrNotFound, err)
+
+	_, err = api.DeleteUser(ctx, &identity.DeleteUserRequest{Email: "alice@example.com"})
+	require.True(t, identity.IsErrUserNotFound(err))
+}
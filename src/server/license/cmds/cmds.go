@@ -2,6 +2,8 @@ package cmds
 
 import (
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/enterprise"
@@ -9,8 +11,11 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/config"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pretty"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
 	"github.com/pachyderm/pachyderm/v2/src/license"
 
+	units "github.com/docker/go-units"
 	"github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 )
@@ -227,6 +232,84 @@ func ListClustersCmd() *cobra.Command {
 	return cmdutil.CreateAlias(listClusters, "license list-clusters")
 }
 
+// ListClusterUsageCmd returns a cobra.Command to show the usage that registered
+// clusters have reported
+func ListClusterUsageCmd() *cobra.Command {
+	var id string
+	var since, interval time.Duration
+	var raw bool
+	var output string
+	listClusterUsage := &cobra.Command{
+		Short: "Show the usage reported by clusters registered with the license server.",
+		Long: `Show the usage reported by clusters registered with the license server.
+
+Clusters report their usage with each heartbeat (hourly). Repo count, data size
+and pipeline count are as of the last report in each time range, workers are
+averaged over the range, and jobs counts the jobs that finished during it.`,
+		Example: `
+# Show usage of every cluster over the last day
+$ {{alias}}
+
+# Show one cluster's daily usage over the last week
+$ {{alias}} --id my-cluster --since 168h --interval 24h`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := newClient()
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+
+			end := time.Now()
+			req := &license.ListClusterUsageRequest{ClusterId: id}
+			if req.Start, err = types.TimestampProto(end.Add(-since)); err != nil {
+				return err
+			}
+			if req.End, err = types.TimestampProto(end); err != nil {
+				return err
+			}
+			if interval != 0 {
+				req.Interval = types.DurationProto(interval)
+			}
+			resp, err := c.License.ListClusterUsage(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				for _, usage := range resp.Usage {
+					if err := printer.Print(usage); err != nil {
+						return err
+					}
+				}
+				return printer.Flush()
+			}
+
+			writer := tabwriter.NewWriter(os.Stdout, "CLUSTER\tSTART\tREPOS\tSIZE\tPIPELINES\tWORKERS (AVG/MAX)\tJOBS\tLAST REPORT\t\n")
+			for _, usage := range resp.Usage {
+				fmt.Fprintf(writer, "%s\t%s\t%d\t%s\t%d\t%.1f/%d\t%d\t%s\t\n",
+					usage.ClusterId,
+					usage.Start.Format(time.RFC3339),
+					usage.Repos,
+					pretty.Size(uint64(usage.SizeBytes)),
+					usage.Pipelines,
+					usage.AvgWorkers, usage.MaxWorkers,
+					usage.JobsFinished,
+					units.HumanDuration(time.Since(*usage.LastReport))+" ago",
+				)
+			}
+			return writer.Flush()
+		}),
+	}
+	listClusterUsage.Flags().StringVar(&id, "id", "", `Only show usage for the cluster with this id`)
+	listClusterUsage.Flags().DurationVar(&since, "since", 24*time.Hour, `Show usage reported within this long ago`)
+	listClusterUsage.Flags().DurationVar(&interval, "interval", 0, `Split the range into intervals of this length, e.g. 1h (by default, the whole range is summarized)`)
+	listClusterUsage.Flags().AddFlagSet(cmdutil.OutputFlags(&raw, &output))
+	return cmdutil.CreateAlias(listClusterUsage, "license list-cluster-usage")
+}
+
 // DeleteAllCmd returns a cobra.Command to disable enterprise features and
 // clear the configuration of the license service.
 func DeleteAllCmd() *cobra.Command {
@@ -298,6 +381,7 @@ func Cmds() []*cobra.Command {
 	commands = append(commands, UpdateClusterCmd())
 	commands = append(commands, DeleteClusterCmd())
 	commands = append(commands, ListClustersCmd())
+	commands = append(commands, ListClusterUsageCmd())
 	commands = append(commands, DeleteAllCmd())
 	commands = append(commands, GetStateCmd())
 
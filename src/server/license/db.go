@@ -40,3 +40,22 @@ func AddClusterClientIdColumn(ctx context.Context, tx *sqlx.Tx) error {
 	;`)
 	return err
 }
+
+// CreateClusterUsageTable sets up the postgres table which stores the usage
+// reported by each cluster's heartbeats. Rows are deleted along with their
+// cluster.
+func CreateClusterUsageTable(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+CREATE TABLE IF NOT EXISTS license.cluster_usage (
+	cluster_id VARCHAR(4096) NOT NULL REFERENCES license.clusters(id) ON DELETE CASCADE,
+	time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	repos BIGINT NOT NULL,
+	size_bytes BIGINT NOT NULL,
+	pipelines BIGINT NOT NULL,
+	workers BIGINT NOT NULL,
+	jobs_finished BIGINT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS cluster_usage_cluster_time ON license.cluster_usage (cluster_id, time);
+`)
+	return errors.EnsureStack(err)
+}
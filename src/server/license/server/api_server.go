@@ -268,6 +268,13 @@ func (a *apiServer) Heartbeat(ctx context.Context, req *lc.HeartbeatRequest) (re
 		return nil, errors.Wrapf(err, "unable to update cluster in database")
 	}
 
+	if req.Usage != nil {
+		if _, err := a.env.GetDBClient().ExecContext(ctx, `INSERT INTO license.cluster_usage (cluster_id, repos, size_bytes, pipelines, workers, jobs_finished) VALUES ($1, $2, $3, $4, $5, $6)`,
+			req.Id, req.Usage.Repos, req.Usage.SizeBytes, req.Usage.Pipelines, req.Usage.Workers, req.Usage.JobsFinished); err != nil {
+			return nil, errors.Wrapf(err, "unable to record cluster usage in database")
+		}
+	}
+
 	record, ok := a.enterpriseTokenCache.Load().(*ec.LicenseRecord)
 	if !ok {
 		return nil, errors.New("unable to load current enterprise key")
@@ -390,3 +397,68 @@ func (a *apiServer) ListUserClusters(ctx context.Context, req *lc.ListUserCluste
 		Clusters: clusters,
 	}, nil
 }
+
+// ListClusterUsage implements the ListClusterUsage RPC
+func (a *apiServer) ListClusterUsage(ctx context.Context, req *lc.ListClusterUsageRequest) (resp *lc.ListClusterUsageResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.pachLogger.Log(req, resp, retErr, time.Since(start)) }(time.Now())
+
+	end := time.Now().UTC()
+	if req.End != nil {
+		var err error
+		if end, err = types.TimestampFromProto(req.End); err != nil {
+			return nil, err
+		}
+	}
+	start := end.Add(-24 * time.Hour)
+	if req.Start != nil {
+		var err error
+		if start, err = types.TimestampFromProto(req.Start); err != nil {
+			return nil, err
+		}
+	}
+	if !start.Before(end) {
+		return nil, errors.Errorf("usage range start (%v) must be before end (%v)", start, end)
+	}
+	var interval time.Duration
+	if req.Interval != nil {
+		var err error
+		if interval, err = types.DurationFromProto(req.Interval); err != nil {
+			return nil, err
+		}
+		if interval < time.Second {
+			return nil, errors.Errorf("usage interval must be at least one second, got %v", interval)
+		}
+	}
+
+	usage := make([]*lc.ClusterUsageSummary, 0)
+	if err := a.env.GetDBClient().SelectContext(ctx, &usage, `
+SELECT cluster_id,
+	-- without an interval, every report falls in one bucket at the start of the range
+	$1::timestamp + CASE WHEN $4::float8 > 0
+		THEN floor(extract(epoch FROM time - $1::timestamp) / $4::float8) * $4::float8
+		ELSE 0 END * interval '1 second' AS start,
+	COUNT(*) AS reports,
+	MAX(time) AS last_report,
+	(array_agg(repos ORDER BY time DESC))[1] AS repos,
+	(array_agg(size_bytes ORDER BY time DESC))[1] AS size_bytes,
+	(array_agg(pipelines ORDER BY time DESC))[1] AS pipelines,
+	MAX(workers) AS max_workers,
+	AVG(workers)::float8 AS avg_workers,
+	SUM(jobs_delta)::bigint AS jobs_finished
+FROM (
+	SELECT *,
+		-- the jobs that finished since the cluster's previous report, which may
+		-- be before the range. The count drops when pipelines are deleted, so a
+		-- drop counts as no jobs.
+		GREATEST(jobs_finished - LAG(jobs_finished) OVER (PARTITION BY cluster_id ORDER BY time), 0) AS jobs_delta
+	FROM license.cluster_usage
+	WHERE time < $2 AND ($3 = '' OR cluster_id = $3)
+) AS deltas
+WHERE time >= $1
+GROUP BY cluster_id, start
+ORDER BY cluster_id, start`, start, end, req.ClusterId, interval.Seconds()); err != nil {
+		return nil, errors.Wrapf(err, "unable to aggregate cluster usage")
+	}
+	return &lc.ListClusterUsageResponse{Usage: usage}, nil
+}
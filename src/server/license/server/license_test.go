@@ -389,6 +389,60 @@ func TestHeartbeat(t *testing.T) {
 	require.True(t, newClusters.Clusters[0].LastHeartbeat.After(*clusters.Clusters[0].LastHeartbeat))
 }
 
+// TestHeartbeatUsage tests that usage sent with heartbeats is recorded and
+// aggregated by ListClusterUsage
+func TestHeartbeatUsage(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	rootClient := tu.GetAuthenticatedPachClient(t, auth.RootUser)
+
+	pachClient := tu.GetUnauthenticatedPachClient(t)
+	for _, usage := range []*license.ClusterUsage{
+		{Repos: 2, SizeBytes: 100, Pipelines: 1, Workers: 1, JobsFinished: 10},
+		{Repos: 3, SizeBytes: 200, Pipelines: 2, Workers: 3, JobsFinished: 15},
+		// deleting a pipeline drops the count
+		{Repos: 3, SizeBytes: 200, Pipelines: 1, Workers: 2, JobsFinished: 12},
+		{Repos: 3, SizeBytes: 200, Pipelines: 2, Workers: 2, JobsFinished: 20},
+	} {
+		_, err := pachClient.License.Heartbeat(pachClient.Ctx(), &license.HeartbeatRequest{
+			Id:      "localhost",
+			Secret:  "localhost",
+			Version: "some weird version",
+			Usage:   usage,
+		})
+		require.NoError(t, err)
+	}
+
+	resp, err := rootClient.License.ListClusterUsage(rootClient.Ctx(), &license.ListClusterUsageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Usage))
+	usage := resp.Usage[0]
+	require.Equal(t, "localhost", usage.ClusterId)
+	require.True(t, usage.Reports >= 4)
+	require.Equal(t, int64(3), usage.Repos)
+	require.Equal(t, int64(200), usage.SizeBytes)
+	require.Equal(t, int64(2), usage.Pipelines)
+	require.Equal(t, int64(3), usage.MaxWorkers)
+	// 5 jobs finished between the first two reports, and 8 after the drop
+	require.True(t, usage.JobsFinished >= 13)
+
+	// Reports from before the requested range are excluded
+	start, err := types.TimestampProto(time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	end, err := types.TimestampProto(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	resp, err = rootClient.License.ListClusterUsage(rootClient.Ctx(), &license.ListClusterUsageRequest{
+		Start: start,
+		End:   end,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(resp.Usage))
+}
+
 // TestHeartbeatWrongSecret tests that Heartbeat doesn't update the record if the shared secret is incorrect
 func TestHeartbeatWrongSecret(t *testing.T) {
 	if testing.Short() {
@@ -15,6 +15,7 @@ import (
 
 	prompt "github.com/c-bata/go-prompt"
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
 	"github.com/mattn/go-isatty"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
@@ -44,9 +45,9 @@ const (
 func Cmds() []*cobra.Command {
 	var commands []*cobra.Command
 
-	raw := false
-	rawFlags := pflag.NewFlagSet("", pflag.ContinueOnError)
-	rawFlags.BoolVar(&raw, "raw", false, "disable pretty printing, print raw json")
+	var raw bool
+	var output string
+	outputFlags := cmdutil.OutputFlags(&raw, &output)
 
 	fullTimestamps := false
 	fullTimestampsFlags := pflag.NewFlagSet("", pflag.ContinueOnError)
@@ -124,16 +125,57 @@ or type (e.g. csv, binary, images, etc).`,
 	shell.RegisterCompletionFunc(updateRepo, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(updateRepo, "update repo"))
 
+	var storage, refresh bool
+	var storageBranch, storageCommit string
 	inspectRepo := &cobra.Command{
 		Use:   "{{alias}} <repo>",
 		Short: "Return info about a repo.",
-		Long:  "Return info about a repo.",
+		Long: `Return info about a repo.
+
+With --storage, report the object storage used by the repo after chunk deduplication instead. Unique bytes would be freed by deleting the repo; shared bytes are also referenced from elsewhere. Use --branch or --commit to scope the report to a branch's history or a single commit.`,
+		Example: `
+# show the deduplicated storage used by repo "foo"
+$ {{alias}} foo --storage
+
+# show the deduplicated storage used by the history of branch "master" of repo "foo"
+$ {{alias}} foo --storage --branch master`,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			if !storage && (storageBranch != "" || storageCommit != "" || refresh) {
+				return errors.Errorf("--branch, --commit and --refresh require --storage")
+			}
+			if storageBranch != "" && storageCommit != "" {
+				return errors.Errorf("cannot set both --branch and --commit")
+			}
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return err
 			}
 			defer c.Close()
+			if storage {
+				var usage *pfsclient.StorageUsage
+				switch {
+				case storageCommit != "":
+					usage, err = c.InspectCommitStorage(args[0], "", storageCommit, refresh)
+				case storageBranch != "":
+					usage, err = c.InspectBranchStorage(args[0], storageBranch, refresh)
+				default:
+					usage, err = c.InspectRepoStorage(args[0], refresh)
+				}
+				if err != nil {
+					return err
+				}
+				printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+				if err != nil {
+					return err
+				}
+				if printer != nil {
+					return cmdutil.PrintAll(printer, usage)
+				}
+				return pretty.PrintDetailedStorageUsage(&pretty.PrintableStorageUsage{
+					StorageUsage:   usage,
+					FullTimestamps: fullTimestamps,
+				})
+			}
 			repoInfo, err := c.InspectRepo(args[0])
 			if err != nil {
 				return err
@@ -141,8 +183,12 @@ or type (e.g. csv, binary, images, etc).`,
 			if repoInfo == nil {
 				return errors.Errorf("repo %s not found", args[0])
 			}
-			if raw {
-				return marshaller.Marshal(os.Stdout, repoInfo)
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				return cmdutil.PrintAll(printer, repoInfo)
 			}
 			ri := &pretty.PrintableRepoInfo{
 				RepoInfo:       repoInfo,
@@ -151,8 +197,12 @@ or type (e.g. csv, binary, images, etc).`,
 			return pretty.PrintDetailedRepoInfo(ri)
 		}),
 	}
-	inspectRepo.Flags().AddFlagSet(rawFlags)
+	inspectRepo.Flags().AddFlagSet(outputFlags)
 	inspectRepo.Flags().AddFlagSet(fullTimestampsFlags)
+	inspectRepo.Flags().BoolVar(&storage, "storage", false, "Report the deduplicated object storage used by the repo.")
+	inspectRepo.Flags().StringVar(&storageBranch, "branch", "", "With --storage, only report on the history of this branch.")
+	inspectRepo.Flags().StringVar(&storageCommit, "commit", "", "With --storage, only report on this commit.")
+	inspectRepo.Flags().BoolVar(&refresh, "refresh", false, "With --storage, recompute the report instead of using a cached one.")
 	shell.RegisterCompletionFunc(inspectRepo, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(inspectRepo, "inspect repo"))
 
@@ -178,13 +228,17 @@ or type (e.g. csv, binary, images, etc).`,
 			if err != nil {
 				return err
 			}
-			if raw {
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
 				for _, repoInfo := range repoInfos {
-					if err := marshaller.Marshal(os.Stdout, repoInfo); err != nil {
+					if err := printer.Print(repoInfo); err != nil {
 						return err
 					}
 				}
-				return nil
+				return printer.Flush()
 			}
 
 			header := pretty.RepoHeader
@@ -198,7 +252,7 @@ or type (e.g. csv, binary, images, etc).`,
 			return writer.Flush()
 		}),
 	}
-	listRepo.Flags().AddFlagSet(rawFlags)
+	listRepo.Flags().AddFlagSet(outputFlags)
 	listRepo.Flags().AddFlagSet(fullTimestampsFlags)
 	listRepo.Flags().BoolVar(&all, "all", false, "include system repos of all types")
 	listRepo.Flags().StringVar(&repoType, "type", "", "only include repos of the given type")
@@ -241,6 +295,48 @@ or type (e.g. csv, binary, images, etc).`,
 	shell.RegisterCompletionFunc(deleteRepo, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(deleteRepo, "delete repo"))
 
+	var forkBranch string
+	forkRepo := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch-or-commit> <new-repo>",
+		Short: "Create a new repo from a commit, without copying data.",
+		Long:  "Create a new repo whose initial commit has the same contents as the given commit. No data is copied, and the new repo is independent of the original afterwards.",
+		Example: `
+# fork the head of branch "master" in repo "foo" into a new repo "bar"
+$ {{alias}} foo@master bar
+
+# fork a commit into branch "experiment" of a new repo "bar"
+$ {{alias}} foo@XXX bar --branch experiment`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			source, err := cmdutil.ParseCommit(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			commit, err := c.PfsAPIClient.ForkRepo(
+				c.Ctx(),
+				&pfsclient.ForkRepoRequest{
+					Source:      source,
+					Repo:        client.NewRepo(args[1]),
+					Branch:      forkBranch,
+					Description: description,
+				},
+			)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			fmt.Println(commit.ID)
+			return nil
+		}),
+	}
+	forkRepo.Flags().StringVarP(&forkBranch, "branch", "b", "", "The branch of the new repo to create. Defaults to the branch of the source commit.")
+	forkRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the new repo.")
+	shell.RegisterCompletionFunc(forkRepo, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(forkRepo, "fork repo"))
+
 	commitDocs := &cobra.Command{
 		Short: "Docs for commits.",
 		Long: `Commits are atomic transactions on the content of a repo.
@@ -380,8 +476,12 @@ $ {{alias}} test -p XXX`,
 			if commitInfo == nil {
 				return errors.Errorf("commit %s not found", commit.ID)
 			}
-			if raw {
-				return marshaller.Marshal(os.Stdout, commitInfo)
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				return cmdutil.PrintAll(printer, commitInfo)
 			}
 			ci := &pretty.PrintableCommitInfo{
 				CommitInfo:     commitInfo,
@@ -390,7 +490,7 @@ $ {{alias}} test -p XXX`,
 			return pretty.PrintDetailedCommitInfo(os.Stdout, ci)
 		}),
 	}
-	inspectCommit.Flags().AddFlagSet(rawFlags)
+	inspectCommit.Flags().AddFlagSet(outputFlags)
 	inspectCommit.Flags().AddFlagSet(fullTimestampsFlags)
 	shell.RegisterCompletionFunc(inspectCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(inspectCommit, "inspect commit"))
@@ -430,10 +530,17 @@ $ {{alias}} foo@master --from XXX`,
 				fromCommit = branch.Repo.NewCommit("", from)
 			}
 
-			if raw {
-				return c.ListCommitF(branch.Repo, nil, fromCommit, uint64(number), false, func(ci *pfsclient.CommitInfo) error {
-					return marshaller.Marshal(os.Stdout, ci)
-				})
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				if err := c.ListCommitF(branch.Repo, nil, fromCommit, uint64(number), false, func(ci *pfsclient.CommitInfo) error {
+					return printer.Print(ci)
+				}); err != nil {
+					return err
+				}
+				return printer.Flush()
 			}
 			writer := tabwriter.NewWriter(os.Stdout, pretty.CommitHeader)
 			if err := c.ListCommitF(branch.Repo, nil, fromCommit, uint64(number), false, func(ci *pfsclient.CommitInfo) error {
@@ -448,7 +555,7 @@ $ {{alias}} foo@master --from XXX`,
 	listCommit.Flags().StringVarP(&from, "from", "f", "", "list all commits since this commit")
 	listCommit.Flags().IntVarP(&number, "number", "n", 0, "list only this many commits; if set to zero, list all commits")
 	listCommit.MarkFlagCustom("from", "__pachctl_get_commit $(__parse_repo ${nouns[0]})")
-	listCommit.Flags().AddFlagSet(rawFlags)
+	listCommit.Flags().AddFlagSet(outputFlags)
 	listCommit.Flags().AddFlagSet(fullTimestampsFlags)
 	shell.RegisterCompletionFunc(listCommit, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(listCommit, "list commit"))
@@ -481,6 +588,19 @@ $ {{alias}} foo@XXX -r bar -r baz`,
 				toRepos = append(toRepos, client.NewRepo(repoName))
 			}
 
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				if err := c.FlushCommit(commits, toRepos, func(ci *pfsclient.CommitInfo) error {
+					return printer.Print(ci)
+				}); err != nil {
+					return err
+				}
+				return printer.Flush()
+			}
+
 			w := tabwriter.NewWriter(os.Stdout, pretty.CommitHeader)
 			defer func() {
 				if err := w.Flush(); retErr == nil {
@@ -488,9 +608,6 @@ $ {{alias}} foo@XXX -r bar -r baz`,
 				}
 			}()
 			return c.FlushCommit(commits, toRepos, func(ci *pfsclient.CommitInfo) error {
-				if raw {
-					return marshaller.Marshal(os.Stdout, ci)
-				}
 				pretty.PrintCommitInfo(w, ci, fullTimestamps)
 				return nil
 			})
@@ -498,7 +615,7 @@ $ {{alias}} foo@XXX -r bar -r baz`,
 	}
 	flushCommit.Flags().VarP(&repos, "repos", "r", "Wait only for commits leading to a specific set of repos")
 	flushCommit.MarkFlagCustom("repos", "__pachctl_get_repo")
-	flushCommit.Flags().AddFlagSet(rawFlags)
+	flushCommit.Flags().AddFlagSet(outputFlags)
 	flushCommit.Flags().AddFlagSet(fullTimestampsFlags)
 	shell.RegisterCompletionFunc(flushCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(flushCommit, "flush commit"))
@@ -546,6 +663,19 @@ $ {{alias}} test@master --new`,
 				prov = pipelineInfo.SpecCommit.NewProvenance()
 			}
 
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				if err := c.SubscribeCommit(branch.Repo, branch.Name, prov, from, pfsclient.CommitState_STARTED, func(ci *pfsclient.CommitInfo) error {
+					return printer.Print(ci)
+				}); err != nil {
+					return err
+				}
+				return printer.Flush()
+			}
+
 			w := tabwriter.NewWriter(os.Stdout, pretty.CommitHeader)
 			defer func() {
 				if err := w.Flush(); retErr == nil {
@@ -553,9 +683,6 @@ $ {{alias}} test@master --new`,
 				}
 			}()
 			return c.SubscribeCommit(branch.Repo, branch.Name, prov, from, pfsclient.CommitState_STARTED, func(ci *pfsclient.CommitInfo) error {
-				if raw {
-					return marshaller.Marshal(os.Stdout, ci)
-				}
 				pretty.PrintCommitInfo(w, ci, fullTimestamps)
 				return nil
 			})
@@ -565,7 +692,7 @@ $ {{alias}} test@master --new`,
 	subscribeCommit.Flags().StringVar(&pipeline, "pipeline", "", "subscribe to all commits created by this pipeline")
 	subscribeCommit.MarkFlagCustom("from", "__pachctl_get_commit $(__parse_repo ${nouns[0]})")
 	subscribeCommit.Flags().BoolVar(&newCommits, "new", false, "subscribe to only new commits created from now on")
-	subscribeCommit.Flags().AddFlagSet(rawFlags)
+	subscribeCommit.Flags().AddFlagSet(outputFlags)
 	subscribeCommit.Flags().AddFlagSet(fullTimestampsFlags)
 	shell.RegisterCompletionFunc(subscribeCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(subscribeCommit, "subscribe commit"))
@@ -598,6 +725,105 @@ $ {{alias}} test@master --new`,
 	shell.RegisterCompletionFunc(deleteCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(deleteCommit, "delete commit"))
 
+	var mergeStrategy string
+	merge := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch> <repo>@<branch-or-commit>",
+		Short: "Merge the changes from one commit into a branch.",
+		Long: `Merge the changes from one commit into a branch.
+
+The changes made in the second commit since its common ancestor with the first are applied in a new commit on the first commit's branch. Paths that were changed differently on both sides are conflicts. With the default "fail" strategy, conflicts are printed and no commit is created; "ours" keeps the branch's version of conflicting paths, and "theirs" takes the other commit's version.`,
+		Example: `
+# merge the changes made on branch "feature" into branch "master" of repo "foo"
+$ {{alias}} foo@master foo@feature
+
+# merge, taking feature's version of any paths that conflict
+$ {{alias}} foo@master foo@feature --strategy theirs`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			ours, err := cmdutil.ParseCommit(args[0])
+			if err != nil {
+				return err
+			}
+			theirs, err := cmdutil.ParseCommit(args[1])
+			if err != nil {
+				return err
+			}
+			strategy, ok := pfs.MergeStrategy_value[strings.ToUpper(mergeStrategy)]
+			if !ok {
+				return errors.Errorf("unrecognized merge strategy %q, must be one of fail, ours or theirs", mergeStrategy)
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			resp, err := c.PfsAPIClient.MergeCommit(
+				c.Ctx(),
+				&pfs.MergeCommitRequest{
+					Ours:        ours,
+					Theirs:      theirs,
+					Strategy:    pfs.MergeStrategy(strategy),
+					Description: description,
+				},
+			)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			for _, conflict := range resp.Conflicts {
+				fmt.Fprintf(os.Stderr, "conflict: %s\n", conflict)
+			}
+			if resp.Commit == nil {
+				return errors.Errorf("merge has %d conflicts, no commit was created", len(resp.Conflicts))
+			}
+			fmt.Println(resp.Commit.ID)
+			return nil
+		}),
+	}
+	merge.Flags().StringVar(&mergeStrategy, "strategy", "fail", "How to resolve paths that were changed differently on both sides: fail, ours or theirs.")
+	merge.Flags().StringVarP(&description, "message", "m", "", "A description of the merge commit.")
+	shell.RegisterCompletionFunc(merge, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(merge, "merge"))
+
+	var revertBranch string
+	revertCommit := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch-or-commit>",
+		Short: "Undo the changes made by a commit.",
+		Long:  "Undo the changes made by a commit, in a new commit on the commit's branch. Files that the commit modified or deleted are restored from its parent, and files that it added are deleted. The reverted commit is kept, so downstream pipelines process the revert like any other commit.",
+		Example: `
+# undo the changes made by the head commit of branch "master" in repo "foo"
+$ {{alias}} foo@master
+
+# undo the changes made by commit XXX, in a new commit on branch "fix"
+$ {{alias}} foo@XXX --branch fix`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			commit, err := cmdutil.ParseCommit(args[0])
+			if err != nil {
+				return err
+			}
+			request := &pfs.RevertCommitRequest{
+				Commit:      commit,
+				Description: description,
+			}
+			if revertBranch != "" {
+				request.Branch = client.NewBranch(commit.Branch.Repo.Name, revertBranch)
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			revert, err := c.PfsAPIClient.RevertCommit(c.Ctx(), request)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			fmt.Println(revert.ID)
+			return nil
+		}),
+	}
+	revertCommit.Flags().StringVarP(&revertBranch, "branch", "b", "", "The branch to create the revert commit on. Defaults to the commit's branch.")
+	revertCommit.Flags().StringVarP(&description, "message", "m", "", "A description of the revert commit.")
+	shell.RegisterCompletionFunc(revertCommit, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(revertCommit, "revert commit"))
+
 	branchDocs := &cobra.Command{
 		Short: "Docs for branches.",
 		Long: `A branch in Pachyderm is an alias for a Commit ID.
@@ -612,6 +838,7 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 
 	var branchProvenance cmdutil.RepeatedStringArg
 	var head string
+	var ifHead string
 	trigger := &pfsclient.Trigger{}
 	createBranch := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>",
@@ -640,6 +867,10 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 			if head != "" {
 				headCommit = branch.Repo.NewCommit("", head)
 			}
+			var expectedHead *pfs.Commit
+			if ifHead != "" {
+				expectedHead = branch.Repo.NewCommit("", ifHead)
+			}
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return err
@@ -650,10 +881,11 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 				_, err := c.PfsAPIClient.CreateBranch(
 					c.Ctx(),
 					&pfsclient.CreateBranchRequest{
-						Head:       headCommit,
-						Branch:     branch,
-						Provenance: provenance,
-						Trigger:    trigger,
+						Head:         headCommit,
+						Branch:       branch,
+						Provenance:   provenance,
+						Trigger:      trigger,
+						ExpectedHead: expectedHead,
 					})
 				return grpcutil.ScrubGRPC(err)
 			})
@@ -663,6 +895,8 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 	createBranch.MarkFlagCustom("provenance", "__pachctl_get_repo_commit")
 	createBranch.Flags().StringVarP(&head, "head", "", "", "The head of the newly created branch.")
 	createBranch.MarkFlagCustom("head", "__pachctl_get_commit $(__parse_repo ${nouns[0]})")
+	createBranch.Flags().StringVar(&ifHead, "if-head", "", "Only update the branch if its current head is this commit, failing otherwise.")
+	createBranch.MarkFlagCustom("if-head", "__pachctl_get_commit $(__parse_repo ${nouns[0]})")
 	createBranch.Flags().StringVarP(&trigger.Branch, "trigger", "t", "", "The branch to trigger this branch on.")
 	createBranch.Flags().StringVar(&trigger.CronSpec, "trigger-cron", "", "The cron spec to use in triggering.")
 	createBranch.Flags().StringVar(&trigger.Size_, "trigger-size", "", "The data size to use in triggering.")
@@ -692,14 +926,18 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 			if branchInfo == nil {
 				return errors.Errorf("branch %s not found", args[0])
 			}
-			if raw {
-				return marshaller.Marshal(os.Stdout, branchInfo)
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				return cmdutil.PrintAll(printer, branchInfo)
 			}
 
 			return pretty.PrintDetailedBranchInfo(branchInfo)
 		}),
 	}
-	inspectBranch.Flags().AddFlagSet(rawFlags)
+	inspectBranch.Flags().AddFlagSet(outputFlags)
 	inspectBranch.Flags().AddFlagSet(fullTimestampsFlags)
 	shell.RegisterCompletionFunc(inspectBranch, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(inspectBranch, "inspect branch"))
@@ -718,13 +956,17 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 			if err != nil {
 				return err
 			}
-			if raw {
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
 				for _, branch := range branches {
-					if err := marshaller.Marshal(os.Stdout, branch); err != nil {
+					if err := printer.Print(branch); err != nil {
 						return err
 					}
 				}
-				return nil
+				return printer.Flush()
 			}
 			writer := tabwriter.NewWriter(os.Stdout, pretty.BranchHeader)
 			for _, branch := range branches {
@@ -733,7 +975,7 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 			return writer.Flush()
 		}),
 	}
-	listBranch.Flags().AddFlagSet(rawFlags)
+	listBranch.Flags().AddFlagSet(outputFlags)
 	shell.RegisterCompletionFunc(listBranch, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(listBranch, "list branch"))
 
@@ -762,6 +1004,144 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 	shell.RegisterCompletionFunc(deleteBranch, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(deleteBranch, "delete branch"))
 
+	tagDocs := &cobra.Command{
+		Short: "Docs for tags.",
+		Long: `A tag in Pachyderm is a permanent name for a commit.
+
+Unlike a branch, a tag can't be moved once it's created, and the commit it
+refers to can't be squashed while the tag exists. Deleting a tag requires
+owner access to the repo.
+
+Any pachctl command that can take a branch name, can take a tag name instead.`,
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(tagDocs, "tag", " tag$"))
+
+	var tagDescription string
+	createTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<tag> <branch-or-commit>",
+		Short: "Create a tag for a commit.",
+		Long:  "Create a tag for a finished commit. Tags can't be moved, so this fails if the tag already exists.",
+		Example: `
+# tag the head commit of branch "master" in repo "foo" as "v1"
+$ {{alias}} foo@v1 master
+
+# tag commit XXX in repo "foo" as "v1"
+$ {{alias}} foo@v1 XXX`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			tag, err := cmdutil.ParseTag(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			_, err = c.PfsAPIClient.CreateTag(
+				c.Ctx(),
+				&pfs.CreateTagRequest{
+					Tag:         tag,
+					Commit:      tag.Repo.NewCommit("", args[1]),
+					Description: tagDescription,
+				})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	createTag.Flags().StringVarP(&tagDescription, "description", "d", "", "A description of the tag.")
+	shell.RegisterCompletionFunc(createTag, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(createTag, "create tag"))
+
+	inspectTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<tag>",
+		Short: "Return info about a tag.",
+		Long:  "Return info about a tag.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			tag, err := cmdutil.ParseTag(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			tagInfo, err := c.PfsAPIClient.InspectTag(c.Ctx(), &pfs.InspectTagRequest{Tag: tag})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				return cmdutil.PrintAll(printer, tagInfo)
+			}
+			return pretty.PrintDetailedTagInfo(tagInfo)
+		}),
+	}
+	inspectTag.Flags().AddFlagSet(outputFlags)
+	shell.RegisterCompletionFunc(inspectTag, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(inspectTag, "inspect tag"))
+
+	listTag := &cobra.Command{
+		Use:   "{{alias}} <repo>",
+		Short: "Return all tags on a repo.",
+		Long:  "Return all tags on a repo.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			tags, err := c.ListTag(args[0])
+			if err != nil {
+				return err
+			}
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				for _, tag := range tags {
+					if err := printer.Print(tag); err != nil {
+						return err
+					}
+				}
+				return printer.Flush()
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.TagHeader)
+			for _, tag := range tags {
+				pretty.PrintTag(writer, tag, fullTimestamps)
+			}
+			return writer.Flush()
+		}),
+	}
+	listTag.Flags().AddFlagSet(outputFlags)
+	listTag.Flags().AddFlagSet(fullTimestampsFlags)
+	shell.RegisterCompletionFunc(listTag, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(listTag, "list tag"))
+
+	deleteTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<tag>",
+		Short: "Delete a tag.",
+		Long:  "Delete a tag, while leaving the commit intact. This requires owner access to the repo.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			tag, err := cmdutil.ParseTag(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			_, err = c.PfsAPIClient.DeleteTag(c.Ctx(), &pfs.DeleteTagRequest{Tag: tag})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	shell.RegisterCompletionFunc(deleteTag, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(deleteTag, "delete tag"))
+
 	fileDocs := &cobra.Command{
 		Short: "Docs for files.",
 		Long: `Files are the lowest level data objects in Pachyderm.
@@ -885,32 +1265,34 @@ $ {{alias}} repo@branch -i http://host/path`,
 				sources = filePaths
 			}
 
-			return c.WithModifyFileClient(file.Commit, func(mf client.ModifyFile) error {
-				for _, source := range sources {
-					source := source
-					if file.Path == "" {
-						// The user has not specified a path so we use source as path.
-						if source == "-" {
-							return errors.Errorf("must specify filename when reading data from stdin")
-						}
-						if err := putFileHelper(mf, joinPaths("", source), source, recursive, appendFile); err != nil {
-							return err
-						}
-					} else if len(sources) == 1 {
-						// We have a single source and the user has specified a path,
-						// we use the path and ignore source (in terms of naming the file).
-						if err := putFileHelper(mf, file.Path, source, recursive, appendFile); err != nil {
-							return err
-						}
-					} else {
-						// We have multiple sources and the user has specified a path,
-						// we use that path as a prefix for the filepaths.
-						if err := putFileHelper(mf, joinPaths(file.Path, source), source, recursive, appendFile); err != nil {
-							return err
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				return c.WithModifyFileClient(file.Commit, func(mf client.ModifyFile) error {
+					for _, source := range sources {
+						source := source
+						if file.Path == "" {
+							// The user has not specified a path so we use source as path.
+							if source == "-" {
+								return errors.Errorf("must specify filename when reading data from stdin")
+							}
+							if err := putFileHelper(mf, joinPaths("", source), source, recursive, appendFile); err != nil {
+								return err
+							}
+						} else if len(sources) == 1 {
+							// We have a single source and the user has specified a path,
+							// we use the path and ignore source (in terms of naming the file).
+							if err := putFileHelper(mf, file.Path, source, recursive, appendFile); err != nil {
+								return err
+							}
+						} else {
+							// We have multiple sources and the user has specified a path,
+							// we use that path as a prefix for the filepaths.
+							if err := putFileHelper(mf, joinPaths(file.Path, source), source, recursive, appendFile); err != nil {
+								return err
+							}
 						}
 					}
-				}
-				return nil
+					return nil
+				})
 			})
 		}),
 	}
@@ -1050,13 +1432,17 @@ $ {{alias}} 'foo@master:/test\[\].txt'`,
 			if fileInfo == nil {
 				return errors.Errorf("file %s not found", file.Path)
 			}
-			if raw {
-				return marshaller.Marshal(os.Stdout, fileInfo)
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				return cmdutil.PrintAll(printer, fileInfo)
 			}
 			return pretty.PrintDetailedFileInfo(fileInfo)
 		}),
 	}
-	inspectFile.Flags().AddFlagSet(rawFlags)
+	inspectFile.Flags().AddFlagSet(outputFlags)
 	shell.RegisterCompletionFunc(inspectFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(inspectFile, "inspect file"))
 
@@ -1103,10 +1489,17 @@ $ {{alias}} 'foo@master:dir\[1\]'`,
 				return err
 			}
 			defer c.Close()
-			if raw {
-				return c.ListFile(file.Commit, file.Path, func(fi *pfsclient.FileInfo) error {
-					return marshaller.Marshal(os.Stdout, fi)
-				})
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				if err := c.ListFile(file.Commit, file.Path, func(fi *pfsclient.FileInfo) error {
+					return printer.Print(fi)
+				}); err != nil {
+					return err
+				}
+				return printer.Flush()
 			}
 			header := pretty.FileHeader
 			if history != 0 {
@@ -1122,7 +1515,7 @@ $ {{alias}} 'foo@master:dir\[1\]'`,
 			return writer.Flush()
 		}),
 	}
-	listFile.Flags().AddFlagSet(rawFlags)
+	listFile.Flags().AddFlagSet(outputFlags)
 	listFile.Flags().AddFlagSet(fullTimestampsFlags)
 	listFile.Flags().StringVar(&history, "history", "none", "Return revision history for files.")
 	shell.RegisterCompletionFunc(listFile, shell.FileCompletion)
@@ -1154,13 +1547,17 @@ $ {{alias}} "foo@master:data/*"`,
 			if err != nil {
 				return err
 			}
-			if raw {
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
 				for _, fileInfo := range fileInfos {
-					if err := marshaller.Marshal(os.Stdout, fileInfo); err != nil {
+					if err := printer.Print(fileInfo); err != nil {
 						return err
 					}
 				}
-				return nil
+				return printer.Flush()
 			}
 			writer := tabwriter.NewWriter(os.Stdout, pretty.FileHeader)
 			for _, fileInfo := range fileInfos {
@@ -1169,7 +1566,7 @@ $ {{alias}} "foo@master:data/*"`,
 			return writer.Flush()
 		}),
 	}
-	globFile.Flags().AddFlagSet(rawFlags)
+	globFile.Flags().AddFlagSet(outputFlags)
 	globFile.Flags().AddFlagSet(fullTimestampsFlags)
 	shell.RegisterCompletionFunc(globFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(globFile, "glob file"))
@@ -1293,7 +1690,9 @@ $ {{alias}} foo@master:path1 bar@master:path2`,
 			}
 			defer c.Close()
 
-			return c.DeleteFile(file.Commit, file.Path)
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				return c.DeleteFile(file.Commit, file.Path)
+			})
 		}),
 	}
 	shell.RegisterCompletionFunc(deleteFile, shell.FileCompletion)
@@ -1308,26 +1707,59 @@ Objects are a low-level resource and should not be accessed directly by most use
 	commands = append(commands, cmdutil.CreateDocsAlias(objectDocs, "object", " object$"))
 
 	var fix bool
+	var deep bool
+	var fsckCommits []string
 	fsck := &cobra.Command{
 		Use:   "{{alias}}",
 		Short: "Run a file system consistency check on pfs.",
-		Long:  "Run a file system consistency check on the pachyderm file system, ensuring the correct provenance relationships are satisfied.",
+		Long: `Run a file system consistency check on the pachyderm file system, ensuring the correct provenance relationships are satisfied.
+
+With --deep, every chunk referenced by each commit's files is also read from object storage and checked against its hash.`,
+		Example: `
+# check the provenance relationships in pfs
+$ {{alias}}
+
+# also verify the chunks of every commit
+$ {{alias}} --deep
+
+# only verify the chunks of commit XXX in repo foo
+$ {{alias}} --deep --commit foo@XXX`,
 		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			if len(fsckCommits) > 0 && !deep {
+				return errors.Errorf("--commit can only be used with --deep")
+			}
+			commits, err := cmdutil.ParseCommits(fsckCommits)
+			if err != nil {
+				return err
+			}
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return err
 			}
 			defer c.Close()
 			errors := false
-			if err = c.Fsck(fix, func(resp *pfsclient.FsckResponse) error {
-				if resp.Error != "" {
+			onResponse := func(resp *pfsclient.FsckResponse) error {
+				switch {
+				case resp.Error != "" && resp.Commit != nil && resp.File != "":
+					errors = true
+					fmt.Printf("Error: %s:%s: %s\n", pretty.CompactPrintCommit(resp.Commit), resp.File, resp.Error)
+				case resp.Error != "" && resp.Commit != nil:
+					errors = true
+					fmt.Printf("Error: %s: %s\n", pretty.CompactPrintCommit(resp.Commit), resp.Error)
+				case resp.Error != "":
 					errors = true
 					fmt.Printf("Error: %s\n", resp.Error)
-				} else {
+				default:
 					fmt.Printf("Fix applied: %v", resp.Fix)
 				}
 				return nil
-			}); err != nil {
+			}
+			if deep {
+				err = c.FsckDeep(fix, commits, onResponse)
+			} else {
+				err = c.Fsck(fix, onResponse)
+			}
+			if err != nil {
 				return err
 			}
 			if !errors {
@@ -1337,13 +1769,68 @@ Objects are a low-level resource and should not be accessed directly by most use
 		}),
 	}
 	fsck.Flags().BoolVarP(&fix, "fix", "f", false, "Attempt to fix as many issues as possible.")
+	fsck.Flags().BoolVar(&deep, "deep", false, "Verify that the chunks referenced by each commit exist in object storage and match their hashes.")
+	fsck.Flags().StringSliceVar(&fsckCommits, "commit", nil, "Restrict the deep check to the given commits (repo@commit). May be repeated.")
 	commands = append(commands, cmdutil.CreateAlias(fsck, "fsck"))
 
+	var checkObjects, replicaFix bool
+	verifyReplica := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "Check that the replica object store is complete.",
+		Long: `Check that the replica object store is complete.
+
+Chunks are copied to the replica object store asynchronously. This reports how many chunks have not been copied yet and the age of the oldest one. With --objects, it also checks that every copied chunk is in the replica.`,
+		Example: `
+# report replication progress
+$ {{alias}}
+
+# check the replica for missing chunks and copy them again
+$ {{alias}} --objects --fix`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			if replicaFix && !checkObjects {
+				return errors.Errorf("--fix requires --objects")
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			resp, err := c.VerifyReplica(checkObjects, replicaFix)
+			if err != nil {
+				return err
+			}
+			lag, err := types.DurationFromProto(resp.Lag)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Chunks: %d\n", resp.Chunks)
+			fmt.Printf("Pending: %d\n", resp.Pending)
+			if resp.Pending > 0 {
+				fmt.Printf("Lag: %v\n", lag)
+			}
+			for _, missing := range resp.Missing {
+				fmt.Printf("Missing: %s\n", missing)
+			}
+			if len(resp.Missing) > 0 {
+				if replicaFix {
+					fmt.Printf("%d missing chunks will be replicated again.\n", len(resp.Missing))
+					return nil
+				}
+				return errors.Errorf("%d chunks are missing from the replica", len(resp.Missing))
+			}
+			return nil
+		}),
+	}
+	verifyReplica.Flags().BoolVar(&checkObjects, "objects", false, "Check that every replicated chunk exists in the replica object store.")
+	verifyReplica.Flags().BoolVarP(&replicaFix, "fix", "f", false, "Replicate chunks that are missing from the replica again. Requires --objects.")
+	commands = append(commands, cmdutil.CreateAlias(verifyReplica, "verify-replica"))
+
 	var seed int64
+	var scenario string
 	runLoadTest := &cobra.Command{
 		Use:     "{{alias}} <spec>",
 		Short:   "Run a PFS load test.",
-		Long:    "Run a PFS load test.",
+		Long:    "Run a PFS load test. Each scenario in the spec runs on its own branch of the load_test repo, and the latency and throughput of each type of operation in the scenario is reported.",
 		Example: pfsload.LoadSpecification,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
 			c, err := client.NewOnUserMachine("user")
@@ -1359,7 +1846,7 @@ Objects are a low-level resource and should not be accessed directly by most use
 			if err != nil {
 				return err
 			}
-			resp, err := c.RunPFSLoadTest(spec, seed)
+			resp, err := c.RunPFSLoadTestScenario(spec, scenario, seed)
 			if err != nil {
 				return err
 			}
@@ -1367,6 +1854,7 @@ Objects are a low-level resource and should not be accessed directly by most use
 		}),
 	}
 	runLoadTest.Flags().Int64VarP(&seed, "seed", "s", 0, "The seed to use for generating the load.")
+	runLoadTest.Flags().StringVar(&scenario, "scenario", "", "Only run the scenario with this name.")
 	commands = append(commands, cmdutil.CreateAlias(runLoadTest, "run pfs-load-test"))
 
 	// Add the mount commands (which aren't available on Windows, so they're in
@@ -1,6 +1,8 @@
 package pfs
 
 import (
+	"context"
+
 	"github.com/jmoiron/sqlx"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
@@ -19,6 +21,7 @@ type APIServer interface {
 	CreateRepoInTransaction(*txncontext.TransactionContext, *pfs_client.CreateRepoRequest) error
 	InspectRepoInTransaction(*txncontext.TransactionContext, *pfs_client.InspectRepoRequest) (*pfs_client.RepoInfo, error)
 	DeleteRepoInTransaction(*txncontext.TransactionContext, *pfs_client.DeleteRepoRequest) error
+	ListRepoNoAuth(context.Context, *pfs_client.ListRepoRequest) (*pfs_client.ListRepoResponse, error)
 
 	StartCommitInTransaction(*txncontext.TransactionContext, *pfs_client.StartCommitRequest, *pfs_client.Commit) (*pfs_client.Commit, error)
 	FinishCommitInTransaction(*txncontext.TransactionContext, *pfs_client.FinishCommitRequest) error
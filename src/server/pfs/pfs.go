@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"regexp"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/server/pfs/pretty"
@@ -73,6 +76,15 @@ type ErrCommitNotFinished struct {
 	Commit *pfs.Commit
 }
 
+// ErrBranchHeadMoved represents an error where a conditional branch update
+// failed because the head of the branch was not the expected commit.
+type ErrBranchHeadMoved struct {
+	Branch   *pfs.Branch
+	Expected *pfs.Commit
+	// Actual is nil if the branch has no head
+	Actual *pfs.Commit
+}
+
 func (e ErrFileNotFound) Error() string {
 	return fmt.Sprintf("file %v not found in repo %v at commit %v", e.File.Path, pretty.CompactPrintRepo(e.File.Commit.Branch.Repo), e.File.Commit.ID)
 }
@@ -122,6 +134,20 @@ func (e ErrCommitNotFinished) Error() string {
 	return fmt.Sprintf("commit %v not finished", e.Commit.ID)
 }
 
+func (e ErrBranchHeadMoved) Error() string {
+	actual := "no head"
+	if e.Actual != nil {
+		actual = fmt.Sprintf("head %v", e.Actual.ID)
+	}
+	return fmt.Sprintf("branch %v has %v, expected head %v", pretty.CompactPrintBranch(e.Branch), actual, e.Expected.ID)
+}
+
+// GRPCStatus returns a FailedPrecondition status, so that clients can
+// distinguish a lost compare-and-swap race from other failures.
+func (e ErrBranchHeadMoved) GRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
 var (
 	commitNotFoundRe          = regexp.MustCompile("commit [^ ]+ not found in repo [^ ]+")
 	commitDeletedRe           = regexp.MustCompile("commit [^ ]+ was deleted")
@@ -133,6 +159,7 @@ var (
 	hasNoHeadRe               = regexp.MustCompile(`the branch .+ has no head \(create one with 'start commit'\)`)
 	outputCommitNotFinishedRe = regexp.MustCompile("output commit .+ not finished")
 	commitNotFinishedRe       = regexp.MustCompile("commit .+ not finished")
+	branchHeadMovedRe         = regexp.MustCompile(`branch [^ ]+ has (no head|head [^ ]+), expected head [^ ]+`)
 )
 
 // IsCommitNotFoundErr returns true if 'err' has an error message that matches
@@ -224,3 +251,12 @@ func IsCommitNotFinishedErr(err error) bool {
 	}
 	return commitNotFinishedRe.MatchString(err.Error())
 }
+
+// IsBranchHeadMovedErr returns true if the err is due to a conditional branch
+// update whose expected head did not match the head of the branch.
+func IsBranchHeadMovedErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return branchHeadMovedRe.MatchString(grpcutil.ScrubGRPC(err).Error())
+}
@@ -22,6 +22,8 @@ const (
 	CommitHeader = "REPO\tBRANCH\tCOMMIT\tFINISHED\tSIZE\tPROGRESS\tDESCRIPTION\n"
 	// BranchHeader is the header for branches.
 	BranchHeader = "BRANCH\tHEAD\tTRIGGER\t\n"
+	// TagHeader is the header for tags.
+	TagHeader = "TAG\tCOMMIT\tCREATED\tDESCRIPTION\t\n"
 	// FileHeader is the header for files.
 	FileHeader = "NAME\tTYPE\tSIZE\t\n"
 	// FileHeaderWithCommit is the header for files that includes a commit field.
@@ -80,6 +82,28 @@ Access level: {{ .AuthInfo.AccessLevel.String }}{{end}}
 	return nil
 }
 
+// PrintableStorageUsage is a wrapper around StorageUsage containing any
+// formatting options used within the template to conditionally print
+// information.
+type PrintableStorageUsage struct {
+	*pfs.StorageUsage
+	FullTimestamps bool
+}
+
+// PrintDetailedStorageUsage pretty-prints deduplicated storage usage.
+func PrintDetailedStorageUsage(usage *PrintableStorageUsage) error {
+	template, err := template.New("StorageUsage").Funcs(funcMap).Parse(
+		`Unique storage: {{prettySize .UniqueBytes}} in {{.UniqueChunks}} chunks
+Shared storage: {{prettySize .SharedBytes}} in {{.SharedChunks}} chunks{{if .FullTimestamps}}
+Computed: {{.Computed}}{{else}}
+Computed: {{prettyAgo .Computed}}{{end}}
+`)
+	if err != nil {
+		return err
+	}
+	return template.Execute(os.Stdout, usage)
+}
+
 func printTrigger(trigger *pfs.Trigger) string {
 	var conds []string
 	if trigger.CronSpec != "" {
@@ -134,6 +158,33 @@ Trigger: {{printTrigger .Trigger}} {{end}}
 	return nil
 }
 
+// PrintTag pretty-prints a Tag.
+func PrintTag(w io.Writer, tagInfo *pfs.TagInfo, fullTimestamps bool) {
+	fmt.Fprintf(w, "%s\t", tagInfo.Tag.Name)
+	fmt.Fprintf(w, "%s\t", tagInfo.Commit.ID)
+	if fullTimestamps {
+		fmt.Fprintf(w, "%s\t", tagInfo.Created.String())
+	} else {
+		fmt.Fprintf(w, "%s\t", pretty.Ago(tagInfo.Created))
+	}
+	fmt.Fprintf(w, "%s\t", tagInfo.Description)
+	fmt.Fprintln(w)
+}
+
+// PrintDetailedTagInfo pretty-prints detailed tag info.
+func PrintDetailedTagInfo(tagInfo *pfs.TagInfo) error {
+	template, err := template.New("TagInfo").Funcs(funcMap).Parse(
+		`Name: {{.Tag.Repo.Name}}@{{.Tag.Name}}
+Commit: {{.Commit.Branch.Repo.Name}}@{{.Commit.ID}}
+Created: {{prettyAgo .Created}}{{if .Description}}
+Description: {{.Description}}{{end}}
+`)
+	if err != nil {
+		return err
+	}
+	return template.Execute(os.Stdout, tagInfo)
+}
+
 // PrintCommitInfo pretty-prints commit info.
 func PrintCommitInfo(w io.Writer, commitInfo *pfs.CommitInfo, fullTimestamps bool) {
 	fmt.Fprintf(w, "%s\t", CompactPrintRepo(commitInfo.Commit.Branch.Repo))
@@ -186,7 +237,8 @@ func PrintDetailedCommitInfo(w io.Writer, commitInfo *PrintableCommitInfo) error
 		`Commit: {{.Commit.Branch.Repo.Name}}@{{.Commit.ID}}
 Original Branch: {{.Commit.Branch.Name}}{{if .Description}}
 Description: {{.Description}}{{end}}{{if .ParentCommit}}
-Parent: {{.ParentCommit.ID}}{{end}}{{if .FullTimestamps}}
+Parent: {{.ParentCommit.ID}}{{end}}{{if .MergeParent}}
+Merge Parent: {{.MergeParent.Branch.Name}}@{{.MergeParent.ID}}{{end}}{{if .FullTimestamps}}
 Started: {{.Started}}{{else}}
 Started: {{prettyAgo .Started}}{{end}}{{if .Finished}}{{if .FullTimestamps}}
 Finished: {{.Finished}}{{else}}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
@@ -27,7 +28,6 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
-	"gopkg.in/yaml.v2"
 
 	"golang.org/x/net/context"
 )
@@ -128,6 +128,13 @@ func (a *apiServer) ListRepo(ctx context.Context, request *pfs.ListRepoRequest)
 	return repoInfos, err
 }
 
+// ListRepoNoAuth is identical to ListRepo except that it doesn't look up the
+// caller's permissions on each repo, so pachd can call it without a user's
+// credentials.  This is not an RPC.
+func (a *apiServer) ListRepoNoAuth(ctx context.Context, request *pfs.ListRepoRequest) (*pfs.ListRepoResponse, error) {
+	return a.driver.listRepo(ctx, false, request.Type)
+}
+
 // DeleteRepoInTransaction is identical to DeleteRepo except that it can run
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) DeleteRepoInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.DeleteRepoRequest) error {
@@ -149,12 +156,32 @@ func (a *apiServer) DeleteRepo(ctx context.Context, request *pfs.DeleteRepoReque
 	return &types.Empty{}, nil
 }
 
+// ForkRepo implements the protobuf pfs.ForkRepo RPC
+func (a *apiServer) ForkRepo(ctx context.Context, request *pfs.ForkRepoRequest) (response *pfs.Commit, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.forkRepo(ctx, request)
+}
+
 // StartCommitInTransaction is identical to StartCommit except that it can run
 // inside an existing postgres transaction.  This is not an RPC.  The target
 // commit can be specified but is optional.  This is so that the transaction can
 // report the commit ID back to the client before the transaction has finished
 // and it can be used in future commands inside the same transaction.
 func (a *apiServer) StartCommitInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.StartCommitRequest, commit *pfs.Commit) (*pfs.Commit, error) {
+	if request.ExpectedHead != nil {
+		// Check access before the head, so that callers without access can't
+		// learn the branch's head from the error.
+		if request.Branch == nil || request.Branch.Repo == nil {
+			return nil, errors.Errorf("branch must be specified")
+		}
+		if err := a.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, request.Branch.Repo.Name, auth.Permission_REPO_WRITE); err != nil {
+			return nil, err
+		}
+		if err := a.driver.checkBranchHead(txnCtx, request.Branch, request.ExpectedHead); err != nil {
+			return nil, err
+		}
+	}
 	id := ""
 	if commit != nil {
 		id = commit.ID
@@ -265,9 +292,36 @@ func (a *apiServer) ClearCommit(ctx context.Context, request *pfs.ClearCommitReq
 	return &types.Empty{}, a.driver.clearCommit(ctx, request.Commit)
 }
 
+// MergeCommit implements the protobuf pfs.MergeCommit RPC
+func (a *apiServer) MergeCommit(ctx context.Context, request *pfs.MergeCommitRequest) (response *pfs.MergeCommitResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.mergeCommit(ctx, request)
+}
+
+// RevertCommit implements the protobuf pfs.RevertCommit RPC
+func (a *apiServer) RevertCommit(ctx context.Context, request *pfs.RevertCommitRequest) (response *pfs.Commit, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.revertCommit(ctx, request)
+}
+
 // CreateBranchInTransaction is identical to CreateBranch except that it can run
 // inside an existing postgres transaction.  This is not an RPC.
 func (a *apiServer) CreateBranchInTransaction(txnCtx *txncontext.TransactionContext, request *pfs.CreateBranchRequest) error {
+	if request.ExpectedHead != nil {
+		// Check access before the head, so that callers without access can't
+		// learn the branch's head from the error.
+		if request.Branch == nil || request.Branch.Repo == nil {
+			return errors.Errorf("branch must be specified")
+		}
+		if err := a.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, request.Branch.Repo.Name, auth.Permission_REPO_CREATE_BRANCH); err != nil {
+			return err
+		}
+		if err := a.driver.checkBranchHead(txnCtx, request.Branch, request.ExpectedHead); err != nil {
+			return err
+		}
+	}
 	return a.driver.createBranch(txnCtx, request.Branch, request.Head, request.Provenance, request.Trigger)
 }
 
@@ -331,6 +385,44 @@ func (a *apiServer) DeleteBranch(ctx context.Context, request *pfs.DeleteBranchR
 	return &types.Empty{}, nil
 }
 
+// CreateTag implements the protobuf pfs.CreateTag RPC
+func (a *apiServer) CreateTag(ctx context.Context, request *pfs.CreateTagRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.driver.createTag(ctx, request.Tag, request.Commit, request.Description); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// InspectTag implements the protobuf pfs.InspectTag RPC
+func (a *apiServer) InspectTag(ctx context.Context, request *pfs.InspectTagRequest) (response *pfs.TagInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.inspectTag(ctx, request.Tag)
+}
+
+// ListTag implements the protobuf pfs.ListTag RPC
+func (a *apiServer) ListTag(ctx context.Context, request *pfs.ListTagRequest) (response *pfs.TagInfos, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	tags, err := a.driver.listTag(ctx, request.Repo)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.TagInfos{TagInfo: tags}, nil
+}
+
+// DeleteTag implements the protobuf pfs.DeleteTag RPC
+func (a *apiServer) DeleteTag(ctx context.Context, request *pfs.DeleteTagRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.driver.deleteTag(ctx, request.Tag); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
 func (a *apiServer) ModifyFile(server pfs.API_ModifyFileServer) (retErr error) {
 	request, err := server.Recv()
 	func() { a.Log(request, nil, nil, 0) }()
@@ -339,6 +431,17 @@ func (a *apiServer) ModifyFile(server pfs.API_ModifyFileServer) (retErr error) {
 		if err != nil {
 			return 0, err
 		}
+		activeTxn, err := client.GetTransaction(server.Context())
+		if err != nil {
+			return 0, err
+		}
+		if activeTxn != nil {
+			bytesRead, err := a.modifyFileInTransaction(server, request)
+			if err != nil {
+				return bytesRead, err
+			}
+			return bytesRead, server.SendAndClose(&types.Empty{})
+		}
 		var bytesRead int64
 		if err := a.driver.modifyFile(server.Context(), request.Commit, func(uw *fileset.UnorderedWriter) error {
 			var err error
@@ -351,6 +454,34 @@ func (a *apiServer) ModifyFile(server pfs.API_ModifyFileServer) (retErr error) {
 	})
 }
 
+// modifyFileInTransaction writes the modifications in a ModifyFile stream to a
+// new fileset, and appends a request that adds the fileset to the target commit
+// to the active transaction. The fileset is written outside of the
+// transaction, so the transaction must be finished before the fileset expires.
+func (a *apiServer) modifyFileInTransaction(server pfs.API_ModifyFileServer, request *pfs.ModifyFileRequest) (int64, error) {
+	ctx := server.Context()
+	var bytesRead int64
+	fsID, err := a.driver.createFileset(ctx, func(uw *fileset.UnorderedWriter) error {
+		var err error
+		bytesRead, err = a.modifyFile(ctx, uw, server, request)
+		return err
+	})
+	if err != nil {
+		return bytesRead, err
+	}
+	// Keep the fileset around for as long as possible, as the transaction may
+	// not be finished for a while
+	if err := a.driver.renewFileset(ctx, *fsID, maxTTL); err != nil {
+		return bytesRead, err
+	}
+	return bytesRead, a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return txn.AddFileset(&pfs.AddFilesetRequest{
+			Commit:    request.Commit,
+			FilesetId: fsID.HexString(),
+		})
+	})
+}
+
 type modifyFileSource interface {
 	Recv() (*pfs.ModifyFileRequest, error)
 }
@@ -702,15 +833,33 @@ func (a *apiServer) Fsck(request *pfs.FsckRequest, fsckServer pfs.API_FsckServer
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("stream containing %d messages", sent), retErr, time.Since(start))
 	}(time.Now())
-	if err := a.driver.fsck(fsckServer.Context(), request.Fix, func(resp *pfs.FsckResponse) error {
+	cb := func(resp *pfs.FsckResponse) error {
 		sent++
 		return fsckServer.Send(resp)
-	}); err != nil {
+	}
+	if err := a.driver.fsck(fsckServer.Context(), request.Fix, cb); err != nil {
 		return err
 	}
+	if request.Deep {
+		return a.driver.fsckDeep(fsckServer.Context(), request.Commits, cb)
+	}
 	return nil
 }
 
+// InspectStorage implements the protobuf pfs.InspectStorage RPC
+func (a *apiServer) InspectStorage(ctx context.Context, request *pfs.InspectStorageRequest) (response *pfs.StorageUsage, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.inspectStorage(ctx, request)
+}
+
+// VerifyReplica implements the protobuf pfs.VerifyReplica RPC
+func (a *apiServer) VerifyReplica(ctx context.Context, request *pfs.VerifyReplicaRequest) (response *pfs.VerifyReplicaResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.verifyReplica(ctx, request)
+}
+
 // CreateFileset implements the pfs.CreateFileset RPC
 func (a *apiServer) CreateFileset(server pfs.API_CreateFilesetServer) (retErr error) {
 	request, err := server.Recv()
@@ -742,8 +891,8 @@ func (a *apiServer) GetFileset(ctx context.Context, req *pfs.GetFilesetRequest)
 }
 
 func (a *apiServer) AddFileset(ctx context.Context, req *pfs.AddFilesetRequest) (*types.Empty, error) {
-	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		return a.AddFilesetInTransaction(txnCtx, req)
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return txn.AddFileset(req)
 	}); err != nil {
 		return nil, err
 	}
@@ -782,28 +931,60 @@ func (a *apiServer) RunLoadTest(ctx context.Context, req *pfs.RunLoadTestRequest
 	if err := pachClient.CreateRepo(repo); err != nil && !pfsserver.IsRepoExistsErr(err) {
 		return nil, err
 	}
-	branch := uuid.New()
-	if err := pachClient.CreateBranch(repo, branch, "", "", nil); err != nil {
-		return nil, err
-	}
 	seed := time.Now().UTC().UnixNano()
 	if req.Seed > 0 {
 		seed = req.Seed
 	}
-	resp := &pfs.RunLoadTestResponse{
-		Branch: client.NewBranch(repo, branch),
-		Seed:   seed,
-	}
-	if err := a.runLoadTest(pachClient, resp.Branch, req.Spec, seed); err != nil {
+	resp := &pfs.RunLoadTestResponse{Seed: seed}
+	if err := a.runLoadTest(pachClient, repo, req, resp); err != nil {
 		resp.Error = err.Error()
 	}
 	return resp, nil
 }
 
-func (a *apiServer) runLoadTest(pachClient *client.APIClient, branch *pfs.Branch, specBytes []byte, seed int64) error {
-	spec := &pfsload.CommitsSpec{}
-	if err := yaml.UnmarshalStrict(specBytes, spec); err != nil {
+// runLoadTest runs each scenario in the request's spec on a new branch, and
+// adds the scenario's results to resp. It stops at the first scenario that
+// fails.
+func (a *apiServer) runLoadTest(pachClient *client.APIClient, repo string, req *pfs.RunLoadTestRequest, resp *pfs.RunLoadTestResponse) error {
+	spec, err := pfsload.ParseLoadSpec(req.Spec)
+	if err != nil {
+		return err
+	}
+	scenarios, err := spec.Scenarios(req.Scenario)
+	if err != nil {
 		return err
 	}
-	return pfsload.Commits(pachClient, branch.Repo.Name, branch.Name, spec, seed)
+	for _, scenario := range scenarios {
+		branch := uuid.New()
+		if err := pachClient.CreateBranch(repo, branch, "", "", nil); err != nil {
+			return err
+		}
+		seed := resp.Seed
+		if scenario.Seed != 0 {
+			seed = scenario.Seed
+		}
+		result := &pfs.LoadTestScenarioResult{
+			Name:   scenario.Name,
+			Branch: client.NewBranch(repo, branch),
+			Seed:   seed,
+		}
+		resp.Scenarios = append(resp.Scenarios, result)
+		if resp.Branch == nil {
+			resp.Branch = result.Branch
+		}
+		start := time.Now()
+		stats, err := pfsload.Scenario(pachClient, repo, branch, scenario, seed)
+		duration := time.Since(start)
+		result.Duration = types.DurationProto(duration)
+		if stats != nil {
+			result.Operations = stats.Operations(duration)
+		}
+		if err != nil {
+			if scenario.Name != "" {
+				return errors.Wrapf(err, "scenario %s", scenario.Name)
+			}
+			return err
+		}
+	}
+	return nil
 }
@@ -32,6 +32,8 @@ type commitStore interface {
 	DropFilesets(ctx context.Context, commit *pfs.Commit) error
 	// DropFilesetsTx is identical to DropFilesets except it runs in the provided transaction.
 	DropFilesetsTx(tx *sqlx.Tx, commit *pfs.Commit) error
+	// TrackerIDs returns the IDs of the tracker objects that reference the commit's filesets.
+	TrackerIDs(ctx context.Context, commit *pfs.Commit) ([]string, error)
 }
 
 var _ commitStore = &postgresCommitStore{}
@@ -137,6 +139,32 @@ func (cs *postgresCommitStore) DropFilesetsTx(tx *sqlx.Tx, commit *pfs.Commit) e
 	return cs.dropDiff(tx, commit)
 }
 
+func (cs *postgresCommitStore) TrackerIDs(ctx context.Context, commit *pfs.Commit) ([]string, error) {
+	var ids []string
+	if err := dbutil.WithTx(ctx, cs.db, func(tx *sqlx.Tx) error {
+		ids = nil
+		diffIDs, err := getDiff(tx, commit)
+		if err != nil {
+			return err
+		}
+		for _, diffID := range diffIDs {
+			ids = append(ids, commitDiffTrackerID(commit, diffID))
+		}
+		totalID, err := getTotal(tx, commit)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil
+			}
+			return err
+		}
+		ids = append(ids, commitTotalTrackerID(commit, *totalID))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 func (cs *postgresCommitStore) dropDiff(tx *sqlx.Tx, commit *pfs.Commit) error {
 	diffIDs, err := getDiff(tx, commit)
 	if err != nil {
@@ -3,11 +3,11 @@ package server
 import (
 	"time"
 
-	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 	"github.com/pachyderm/pachyderm/v2/src/internal/work"
@@ -25,15 +25,24 @@ type compactor struct {
 	worker          *work.Worker
 }
 
-func newCompactor(ctx context.Context, storage *fileset.Storage, etcdClient *etcd.Client, etcdPrefix string, maxFanIn int) (*compactor, error) {
+func newCompactor(ctx context.Context, storage *fileset.Storage, env serviceenv.ServiceEnv, etcdPrefix string, maxFanIn int) (*compactor, error) {
 	if maxFanIn < 2 {
 		panic(maxFanIn)
 	}
-	compactionQueue, err := work.NewTaskQueue(ctx, etcdClient, etcdPrefix, storageTaskNamespace)
+	var compactionQueue *work.TaskQueue
+	var worker *work.Worker
+	var err error
+	switch env.Config().WorkBackend {
+	case work.PostgresBackend:
+		compactionQueue, err = work.NewPostgresTaskQueue(ctx, env.GetDBClient(), env.GetPostgresListener(), storageTaskNamespace)
+		worker = work.NewPostgresWorker(env.GetDBClient(), env.GetPostgresListener(), storageTaskNamespace)
+	default:
+		compactionQueue, err = work.NewTaskQueue(ctx, env.GetEtcdClient(), etcdPrefix, storageTaskNamespace)
+		worker = work.NewWorker(env.GetEtcdClient(), etcdPrefix, storageTaskNamespace)
+	}
 	if err != nil {
 		return nil, err
 	}
-	worker := work.NewWorker(etcdClient, etcdPrefix, storageTaskNamespace)
 	c := &compactor{
 		storage:         storage,
 		maxFanIn:        maxFanIn,
@@ -102,6 +111,9 @@ func (c *compactor) Compact(ctx context.Context, ids []fileset.ID, ttl time.Dura
 func (c *compactor) compactionWorker(ctx context.Context) error {
 	return backoff.RetryUntilCancel(ctx, func() error {
 		return c.worker.Run(ctx, func(ctx context.Context, subtask *work.Task) (*types.Any, error) {
+			if types.Is(subtask.Data, &FsckTask{}) {
+				return c.fsckWorker(ctx, subtask)
+			}
 			task, err := deserializeCompactionTask(subtask.Data)
 			if err != nil {
 				return nil, err
@@ -80,10 +80,15 @@ type driver struct {
 	branches    col.PostgresCollection
 	openCommits col.PostgresCollection
 	commitsets  col.PostgresCollection
+	tags        col.PostgresCollection
 
 	storage     *fileset.Storage
 	commitStore commitStore
 	compactor   *compactor
+	// replicator is nil unless a replica object store is configured.
+	replicator *chunk.Replicator
+	// tierer is nil unless a cold tier object store is configured.
+	tierer *chunk.Tierer
 }
 
 // TODO: use pfsdb.CommitKey instead once branches are in the primary key (part of global IDs)
@@ -103,6 +108,7 @@ func newDriver(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, etcdPre
 	branches := pfsdb.Branches(env.GetDBClient(), env.GetPostgresListener())
 	openCommits := pfsdb.OpenCommits(env.GetDBClient(), env.GetPostgresListener())
 	commitsets := pfsdb.Commitsets(env.GetDBClient(), env.GetPostgresListener())
+	tags := pfsdb.Tags(env.GetDBClient(), env.GetPostgresListener())
 
 	// Setup driver struct.
 	d := &driver{
@@ -115,6 +121,7 @@ func newDriver(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, etcdPre
 		branches:    branches,
 		openCommits: openCommits,
 		commitsets:  commitsets,
+		tags:        tags,
 		// TODO: set maxFanIn based on downward API.
 	}
 	// Setup tracker and chunk / fileset storage.
@@ -132,8 +139,17 @@ func newDriver(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, etcdPre
 	chunkStorageOpts = append(chunkStorageOpts, chunk.WithSecret(secret))
 	chunkStorage := chunk.NewStorage(objClient, memCache, env.GetDBClient(), tracker, chunkStorageOpts...)
 	d.storage = fileset.NewStorage(fileset.NewPostgresStore(env.GetDBClient()), tracker, chunkStorage, fileset.StorageOptions(env.Config())...)
+	if env.Config().StorageReplicaURL != "" {
+		d.replicator, err = newReplicator(env.Config(), chunkStorage)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if env.Config().StorageColdTierURL != "" {
+		d.tierer = chunk.NewTierer(chunkStorage)
+	}
 	// Setup compaction queue and worker.
-	d.compactor, err = newCompactor(env.Context(), d.storage, etcdClient, etcdPrefix, env.Config().StorageCompactionMaxFanIn)
+	d.compactor, err = newCompactor(env.Context(), d.storage, env, etcdPrefix, env.Config().StorageCompactionMaxFanIn)
 	if err != nil {
 		return nil, err
 	}
@@ -457,6 +473,9 @@ func (d *driver) deleteRepo(txnCtx *txncontext.TransactionContext, repo *pfs.Rep
 	if err := d.branches.ReadWrite(txnCtx.SqlTx).DeleteByIndex(pfsdb.BranchesRepoIndex, pfsdb.RepoKey(repo)); err != nil {
 		return err
 	}
+	if err := d.tags.ReadWrite(txnCtx.SqlTx).DeleteByIndex(pfsdb.TagsRepoIndex, pfsdb.RepoKey(repo)); err != nil {
+		return err
+	}
 	// Similarly with commits
 	if err := d.commits.ReadWrite(txnCtx.SqlTx).DeleteByIndex(pfsdb.CommitsRepoIndex, pfsdb.RepoKey(repo)); err != nil {
 		return err
@@ -1223,16 +1242,28 @@ func (d *driver) resolveCommit(sqlTx *sqlx.Tx, userCommit *pfs.Commit) (*pfs.Com
 		commit.ID = ""
 	}
 
-	// If commit.ID is unspecified, get it from the branch head
+	// If commit.ID is unspecified, get it from the branch head, or from the
+	// tag if there is no branch with that name
 	if commit.ID == "" {
 		branchInfo := &pfs.BranchInfo{}
 		if err := d.branches.ReadWrite(sqlTx).Get(pfsdb.BranchKey(commit.Branch), branchInfo); err != nil {
-			return nil, err
-		}
-		if branchInfo.Head == nil {
-			return nil, pfsserver.ErrNoHead{Branch: branchInfo.Branch}
+			if !col.IsErrNotFound(err) {
+				return nil, err
+			}
+			tagInfo := &pfs.TagInfo{}
+			if tagErr := d.tags.ReadWrite(sqlTx).Get(pfsdb.TagKey(branchTag(commit.Branch)), tagInfo); tagErr != nil {
+				if col.IsErrNotFound(tagErr) {
+					return nil, err
+				}
+				return nil, tagErr
+			}
+			commit.ID = tagInfo.Commit.ID
+		} else {
+			if branchInfo.Head == nil {
+				return nil, pfsserver.ErrNoHead{Branch: branchInfo.Branch}
+			}
+			commit.ID = branchInfo.Head.ID
 		}
-		commit.ID = branchInfo.Head.ID
 	}
 
 	// Traverse commits' parents until you've reached the right ancestor
@@ -1503,6 +1534,14 @@ func (d *driver) squashCommit(txnCtx *txncontext.TransactionContext, userCommit
 		deleteCommit(subv.Lower, subv.Upper)
 	}
 
+	// Tagged commits can't be deleted. Returning an error here rolls back the
+	// deletions above.
+	for _, deletedInfo := range deleted {
+		if err := d.checkNotTagged(txnCtx.SqlTx, deletedInfo.Commit); err != nil {
+			return err
+		}
+	}
+
 	// 5) Remove the commits in 'deleted' from all remaining upstream commits'
 	// subvenance.
 	// While 'commit' is required to be an input commit (no provenance),
@@ -1891,6 +1930,34 @@ func (d *driver) clearCommit(ctx context.Context, commit *pfs.Commit) error {
 	return d.commitStore.DropFilesets(ctx, commit)
 }
 
+// checkBranchHead returns ErrBranchHeadMoved if 'expected' does not resolve to
+// the current head of 'branch'. It's used to implement conditional branch
+// updates, and must be called in the same transaction as the update.
+func (d *driver) checkBranchHead(txnCtx *txncontext.TransactionContext, branch *pfs.Branch, expected *pfs.Commit) error {
+	if branch == nil {
+		return errors.New("cannot check the head of a nil branch")
+	}
+	if expected.Branch == nil {
+		expected = branch.Repo.NewCommit("", expected.ID)
+	}
+	expectedInfo, err := d.resolveCommit(txnCtx.SqlTx, expected)
+	if err != nil {
+		return errors.Wrapf(err, "unable to resolve expected head %s", pfsdb.CommitKey(expected))
+	}
+	branchInfo := &pfs.BranchInfo{}
+	if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(pfsdb.BranchKey(branch), branchInfo); err != nil && !col.IsErrNotFound(err) {
+		return err
+	}
+	if branchInfo.Head == nil || branchInfo.Head.ID != expectedInfo.Commit.ID {
+		return pfsserver.ErrBranchHeadMoved{
+			Branch:   branch,
+			Expected: expectedInfo.Commit,
+			Actual:   branchInfo.Head,
+		}
+	}
+	return nil
+}
+
 // createBranch creates a new branch or updates an existing branch (must be one
 // or the other). Most importantly, it sets 'branch.DirectProvenance' to
 // 'provenance' and then for all (downstream) branches, restores the invariant:
@@ -1918,6 +1985,12 @@ func (d *driver) createBranch(txnCtx *txncontext.TransactionContext, branch *pfs
 	if err := ancestry.ValidateName(branch.Name); err != nil {
 		return err
 	}
+	// A branch would shadow a tag with the same name, which would move it
+	if err := d.tags.ReadWrite(txnCtx.SqlTx).Get(pfsdb.TagKey(branchTag(branch)), &pfs.TagInfo{}); err == nil {
+		return errors.Errorf("cannot create branch %s because a tag with the same name exists", pretty.CompactPrintBranch(branch))
+	} else if !col.IsErrNotFound(err) {
+		return err
+	}
 
 	var ci *pfs.CommitInfo
 	// resolve the given commit
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/server/pfs/pretty"
+)
+
+// forkRepo creates a new repo whose initial commit shares the filesets of the
+// source commit. No chunks are copied: the new commit's diff references a
+// clone of the source commit's total fileset, which is tracked like any other
+// commit fileset, so the fork is unaffected by later changes to (or the
+// deletion of) the source repo.
+func (d *driver) forkRepo(ctx context.Context, request *pfs.ForkRepoRequest) (*pfs.Commit, error) {
+	if request.Source == nil {
+		return nil, errors.Errorf("source commit must be specified")
+	}
+	if request.Repo == nil {
+		return nil, errors.Errorf("repo must be specified")
+	}
+	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, request.Source.Branch.Repo.Name, auth.Permission_REPO_READ); err != nil {
+		return nil, err
+	}
+	sourceInfo, err := d.inspectCommit(ctx, request.Source, pfs.CommitState_FINISHED)
+	if err != nil {
+		return nil, err
+	}
+	filesetID, err := d.getFileset(ctx, sourceInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	branchName := request.Branch
+	if branchName == "" {
+		branchName = sourceInfo.Commit.Branch.Name
+	}
+	description := request.Description
+	if description == "" {
+		description = "fork of " + pretty.CompactPrintCommit(sourceInfo.Commit)
+	}
+	var commit *pfs.Commit
+	if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		if err := d.createRepo(txnCtx, request.Repo, description, false); err != nil {
+			return err
+		}
+		branch := &pfs.Branch{Repo: request.Repo, Name: branchName}
+		var err error
+		commit, err = d.startCommit(txnCtx, "", nil, branch, nil, description)
+		if err != nil {
+			return err
+		}
+		if err := d.commitStore.AddFilesetTx(txnCtx.SqlTx, commit, *filesetID); err != nil {
+			return err
+		}
+		return d.finishCommit(txnCtx, commit, "")
+	}); err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
@@ -7,10 +7,15 @@ import (
 	"strings"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
 	"github.com/jmoiron/sqlx"
 
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
+	"github.com/pachyderm/pachyderm/v2/src/internal/work"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/server/pfs/pretty"
 )
@@ -565,3 +570,162 @@ func (d *driver) fsck(ctx context.Context, fix bool, cb func(*pfs.FsckResponse)
 	}
 	return nil
 }
+
+// fsckDeep verifies that every chunk referenced by the filesets of the given
+// commits exists in object storage and matches its hash. If commits is empty,
+// every commit in pfs is checked. Each commit is checked by a separate task
+// in the storage work queue, and any corrupted or missing files are reported
+// through cb.
+func (d *driver) fsckDeep(ctx context.Context, commits []*pfs.Commit, cb func(*pfs.FsckResponse) error) error {
+	if len(commits) == 0 {
+		repoInfo := &pfs.RepoInfo{}
+		if err := d.repos.ReadOnly(ctx).List(repoInfo, col.DefaultOptions(), func(string) error {
+			commitInfo := &pfs.CommitInfo{}
+			return d.commits.ReadOnly(ctx).GetByIndex(pfsdb.CommitsRepoIndex, pfsdb.RepoKey(repoInfo.Repo), commitInfo, col.DefaultOptions(), func(string) error {
+				commits = append(commits, proto.Clone(commitInfo.Commit).(*pfs.Commit))
+				return nil
+			})
+		}); err != nil {
+			return err
+		}
+	}
+	return d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *renew.StringSet) error {
+		var tasks []*work.Task
+		var taskCommits []*pfs.Commit
+		for _, commit := range commits {
+			id, err := d.getFileset(ctx, commit)
+			if err != nil {
+				if err := cb(&pfs.FsckResponse{Commit: commit, Error: err.Error()}); err != nil {
+					return err
+				}
+				continue
+			}
+			renewer.Add(id.HexString())
+			any, err := serializeFsckTask(&FsckTask{
+				Index: int64(len(tasks)),
+				Id:    id.HexString(),
+			})
+			if err != nil {
+				return err
+			}
+			tasks = append(tasks, &work.Task{Data: any})
+			taskCommits = append(taskCommits, commit)
+		}
+		if len(tasks) == 0 {
+			return nil
+		}
+		return d.compactor.compactionQueue.RunTaskBlock(ctx, func(master *work.Master) error {
+			return master.RunSubtasks(tasks, func(_ context.Context, taskInfo *work.TaskInfo) error {
+				if taskInfo.State == work.State_FAILURE {
+					return errors.Errorf("fsck task failed: %s", taskInfo.Reason)
+				}
+				if taskInfo.Result == nil {
+					return errors.Errorf("no result set for fsck work.TaskInfo")
+				}
+				res, err := deserializeFsckResult(taskInfo.Result)
+				if err != nil {
+					return err
+				}
+				commit := taskCommits[int(res.Index)]
+				for _, fileErr := range res.Errors {
+					if err := cb(&pfs.FsckResponse{
+						Commit: commit,
+						File:   fileErr.Path,
+						Error:  fileErr.Error,
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		})
+	})
+}
+
+// fsckWorker checks the chunks referenced by the fileset in an fsck task.
+// Each chunk is only checked once per task, but every file that references a
+// bad chunk is reported. A fileset that cannot be read is reported as an error
+// with an empty path.
+func (c *compactor) fsckWorker(ctx context.Context, subtask *work.Task) (*types.Any, error) {
+	task, err := deserializeFsckTask(subtask.Data)
+	if err != nil {
+		return nil, err
+	}
+	res := &FsckTaskResult{Index: task.Index}
+	onError := func(p string, err error) {
+		res.Errors = append(res.Errors, &FsckFileError{Path: p, Error: err.Error()})
+	}
+	id, err := fileset.ParseID(task.Id)
+	if err != nil {
+		return nil, err
+	}
+	fs, err := c.storage.Open(ctx, []fileset.ID{*id})
+	if err != nil {
+		onError("", err)
+		return serializeFsckResult(res)
+	}
+	checked := make(map[string]error)
+	if err := fs.Iterate(ctx, func(f fileset.File) error {
+		idx := f.Index()
+		for _, dataRef := range idx.File.DataRefs {
+			key := string(dataRef.Ref.Id)
+			chunkErr, ok := checked[key]
+			if !ok {
+				chunkErr = c.storage.ChunkStorage().Check(ctx, dataRef.Ref, true)
+				if chunkErr != nil && ctx.Err() != nil {
+					return ctx.Err()
+				}
+				checked[key] = chunkErr
+			}
+			if chunkErr != nil {
+				onError(idx.Path, chunkErr)
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		onError("", err)
+	}
+	return serializeFsckResult(res)
+}
+
+func serializeFsckTask(task *FsckTask) (*types.Any, error) {
+	data, err := proto.Marshal(task)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Any{
+		TypeUrl: "/" + proto.MessageName(task),
+		Value:   data,
+	}, nil
+}
+
+func deserializeFsckTask(taskAny *types.Any) (*FsckTask, error) {
+	task := &FsckTask{}
+	if err := types.UnmarshalAny(taskAny, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+func serializeFsckResult(res *FsckTaskResult) (*types.Any, error) {
+	data, err := proto.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Any{
+		TypeUrl: "/" + proto.MessageName(res),
+		Value:   data,
+	}, nil
+}
+
+func deserializeFsckResult(any *types.Any) (*FsckTaskResult, error) {
+	res := &FsckTaskResult{}
+	if err := types.UnmarshalAny(any, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,246 @@
+package server
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/server/pfs/pretty"
+)
+
+// mergeCommit creates a commit on top of ours that also contains the changes
+// made in theirs since the common ancestor of the two commits. A path that
+// was changed differently on both sides is a conflict, which is resolved by
+// the request's strategy.
+func (d *driver) mergeCommit(ctx context.Context, request *pfs.MergeCommitRequest) (*pfs.MergeCommitResponse, error) {
+	if request.Ours == nil || request.Theirs == nil {
+		return nil, errors.Errorf("both commits must be specified")
+	}
+	oursInfo, err := d.inspectCommit(ctx, request.Ours, pfs.CommitState_FINISHED)
+	if err != nil {
+		return nil, err
+	}
+	theirsInfo, err := d.inspectCommit(ctx, request.Theirs, pfs.CommitState_FINISHED)
+	if err != nil {
+		return nil, err
+	}
+	if oursInfo.Commit.Branch.Repo.Name != theirsInfo.Commit.Branch.Repo.Name {
+		return nil, errors.Errorf("cannot merge commits from different repos")
+	}
+	baseInfo, err := d.mergeBase(ctx, oursInfo, theirsInfo)
+	if err != nil {
+		return nil, err
+	}
+	if baseInfo != nil && baseInfo.Commit.ID == theirsInfo.Commit.ID {
+		// theirs is already part of ours' history.
+		return &pfs.MergeCommitResponse{Commit: oursInfo.Commit}, nil
+	}
+	base, err := d.mergeSource(ctx, baseInfo)
+	if err != nil {
+		return nil, err
+	}
+	ours, err := d.mergeSource(ctx, oursInfo)
+	if err != nil {
+		return nil, err
+	}
+	theirs, err := d.mergeSource(ctx, theirsInfo)
+	if err != nil {
+		return nil, err
+	}
+	oursChanges, err := mergeChanges(ctx, base, ours)
+	if err != nil {
+		return nil, err
+	}
+	theirsChanges, err := mergeChanges(ctx, base, theirs)
+	if err != nil {
+		return nil, err
+	}
+	// take holds the paths to copy from theirs, and remove the paths to delete.
+	take := make(map[string]bool)
+	var remove, conflicts []string
+	for p, theirsFi := range theirsChanges {
+		if oursFi, ok := oursChanges[p]; ok {
+			if sameChange(oursFi, theirsFi) {
+				continue
+			}
+			conflicts = append(conflicts, p)
+			if request.Strategy != pfs.MergeStrategy_THEIRS {
+				continue
+			}
+		}
+		if theirsFi == nil {
+			remove = append(remove, p)
+		} else {
+			take[p] = true
+		}
+	}
+	sort.Strings(conflicts)
+	if len(conflicts) > 0 && request.Strategy == pfs.MergeStrategy_FAIL {
+		return &pfs.MergeCommitResponse{Conflicts: conflicts}, nil
+	}
+	description := request.Description
+	if description == "" {
+		description = "merge " + pretty.CompactPrintCommit(theirsInfo.Commit) + " into " + pretty.CompactPrintCommit(oursInfo.Commit)
+	}
+	oursFilesetID, err := d.getFileset(ctx, oursInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	filesetID, err := d.createFileset(ctx, func(uw *fileset.UnorderedWriter) error {
+		for _, p := range remove {
+			if err := uw.Delete(p, ""); err != nil {
+				return err
+			}
+		}
+		if len(take) == 0 {
+			return nil
+		}
+		_, fs, err := d.openCommit(ctx, theirsInfo.Commit)
+		if err != nil {
+			return err
+		}
+		fs = fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
+			return take[idx.Path]
+		})
+		return uw.Copy(ctx, fs, "", false)
+	}, fileset.WithParentID(oursFilesetID))
+	if err != nil {
+		return nil, err
+	}
+	var commit *pfs.Commit
+	if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		// The merge is only valid on top of ours, so fail rather than drop
+		// commits made to the branch since ours was read.
+		if err := d.checkBranchHead(txnCtx, oursInfo.Commit.Branch, oursInfo.Commit); err != nil {
+			return err
+		}
+		var err error
+		commit, err = d.startCommit(txnCtx, "", oursInfo.Commit, oursInfo.Commit.Branch, nil, description)
+		if err != nil {
+			return err
+		}
+		// Record theirs, so that later merges from the same branch only merge
+		// the changes made since this one.
+		commitInfo := &pfs.CommitInfo{}
+		if err := d.commits.ReadWrite(txnCtx.SqlTx).Update(pfsdb.CommitKey(commit), commitInfo, func() error {
+			commitInfo.MergeParent = theirsInfo.Commit
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := d.commitStore.AddFilesetTx(txnCtx.SqlTx, commit, *filesetID); err != nil {
+			return err
+		}
+		return d.finishCommit(txnCtx, commit, "")
+	}); err != nil {
+		return nil, err
+	}
+	return &pfs.MergeCommitResponse{
+		Commit:    commit,
+		Conflicts: conflicts,
+	}, nil
+}
+
+// mergeBase returns the most recent commit in the history of both a and b,
+// or nil if they have no common history. A commit's history includes the
+// history of its merge parent, as well as its parent's.
+func (d *driver) mergeBase(ctx context.Context, a, b *pfs.CommitInfo) (*pfs.CommitInfo, error) {
+	ancestors := make(map[string]bool)
+	if err := d.walkMergeHistory(ctx, a, func(ci *pfs.CommitInfo) error {
+		ancestors[ci.Commit.ID] = true
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	var base *pfs.CommitInfo
+	if err := d.walkMergeHistory(ctx, b, func(ci *pfs.CommitInfo) error {
+		if ancestors[ci.Commit.ID] {
+			base = ci
+			return errutil.ErrBreak
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return base, nil
+}
+
+// walkMergeHistory calls cb with 'commitInfo' and each of its ancestors,
+// following both parents and merge parents, nearest first.
+func (d *driver) walkMergeHistory(ctx context.Context, commitInfo *pfs.CommitInfo, cb func(*pfs.CommitInfo) error) error {
+	seen := map[string]bool{commitInfo.Commit.ID: true}
+	queue := []*pfs.CommitInfo{commitInfo}
+	for len(queue) > 0 {
+		ci := queue[0]
+		queue = queue[1:]
+		if err := cb(ci); err != nil {
+			if errors.Is(err, errutil.ErrBreak) {
+				return nil
+			}
+			return err
+		}
+		for _, parent := range []*pfs.Commit{ci.ParentCommit, ci.MergeParent} {
+			if parent == nil || seen[parent.ID] {
+				continue
+			}
+			seen[parent.ID] = true
+			parentInfo, err := d.getCommit(ctx, parent)
+			if err != nil {
+				// A squashed merge parent leaves no history to follow.
+				if parent == ci.MergeParent && pfsserver.IsCommitNotFoundErr(err) {
+					continue
+				}
+				return err
+			}
+			queue = append(queue, parentInfo)
+		}
+	}
+	return nil
+}
+
+func (d *driver) mergeSource(ctx context.Context, commitInfo *pfs.CommitInfo) (Source, error) {
+	if commitInfo == nil {
+		return emptySource{}, nil
+	}
+	commitInfo, fs, err := d.openCommit(ctx, commitInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	return NewSource(d.storage, commitInfo, fs, WithFull()), nil
+}
+
+// mergeChanges returns the files that differ between base and side, mapped to
+// their info in side, or nil if side deleted them. Directories are skipped,
+// since their changes are the changes to the files they contain.
+func mergeChanges(ctx context.Context, base, side Source) (map[string]*pfs.FileInfo, error) {
+	changes := make(map[string]*pfs.FileInfo)
+	if err := NewDiffer(base, side).Iterate(ctx, func(baseFi, sideFi *pfs.FileInfo) error {
+		fi := sideFi
+		if fi == nil {
+			fi = baseFi
+		}
+		if fi.FileType == pfs.FileType_DIR {
+			return nil
+		}
+		changes[fi.File.Path] = sideFi
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// sameChange returns true if both sides changed a file in the same way.
+func sameChange(a, b *pfs.FileInfo) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return equalFileInfos(a, b)
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// newReplicator returns a replicator for the replica object store configured
+// by STORAGE_REPLICA_URL. The replica uses the same credentials as the
+// primary object store.
+func newReplicator(config *serviceenv.Configuration, chunkStorage *chunk.Storage) (*chunk.Replicator, error) {
+	url, err := obj.ParseURL(config.StorageReplicaURL)
+	if err != nil {
+		return nil, err
+	}
+	replica, err := obj.NewClientFromURLAndSecret(url)
+	if err != nil {
+		return nil, err
+	}
+	gracePeriod, err := time.ParseDuration(config.StorageReplicaGracePeriod)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse replica grace period")
+	}
+	return chunk.NewReplicator(chunkStorage, replica, gracePeriod), nil
+}
+
+func (d *driver) verifyReplica(ctx context.Context, request *pfs.VerifyReplicaRequest) (*pfs.VerifyReplicaResponse, error) {
+	if d.replicator == nil {
+		return nil, errors.Errorf("no replica object store is configured")
+	}
+	if request.Fix && !request.CheckObjects {
+		return nil, errors.Errorf("fix requires check objects")
+	}
+	status, err := d.replicator.Verify(ctx, request.CheckObjects, request.Fix)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.VerifyReplicaResponse{
+		Chunks:  status.Chunks,
+		Pending: status.Pending,
+		Lag:     types.DurationProto(status.Lag),
+		Missing: status.Missing,
+	}, nil
+}
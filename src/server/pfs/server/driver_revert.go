@@ -0,0 +1,122 @@
+package server
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/server/pfs/pretty"
+)
+
+// revertCommit creates a commit on top of the branch head that undoes the
+// changes the commit made relative to its parent: files that the commit
+// modified or deleted are restored from the parent, and files that it added
+// are deleted. Later changes to other paths are kept.
+func (d *driver) revertCommit(ctx context.Context, request *pfs.RevertCommitRequest) (*pfs.Commit, error) {
+	if request.Commit == nil {
+		return nil, errors.Errorf("commit must be specified")
+	}
+	commitInfo, err := d.inspectCommit(ctx, request.Commit, pfs.CommitState_FINISHED)
+	if err != nil {
+		return nil, err
+	}
+	var parentInfo *pfs.CommitInfo
+	if commitInfo.ParentCommit != nil {
+		parentInfo, err = d.getCommit(ctx, commitInfo.ParentCommit)
+		if err != nil {
+			return nil, err
+		}
+	}
+	branch := request.Branch
+	if branch == nil {
+		branch = commitInfo.Commit.Branch
+	}
+	if branch.Repo == nil {
+		return nil, errors.Errorf("branch repo must be specified")
+	}
+	if branch.Repo.Name != commitInfo.Commit.Branch.Repo.Name {
+		return nil, errors.Errorf("cannot revert a commit onto a branch in a different repo")
+	}
+	headInfo, err := d.inspectCommit(ctx, &pfs.Commit{Branch: branch}, pfs.CommitState_FINISHED)
+	if err != nil {
+		return nil, err
+	}
+	parent, err := d.mergeSource(ctx, parentInfo)
+	if err != nil {
+		return nil, err
+	}
+	commitSource, err := d.mergeSource(ctx, commitInfo)
+	if err != nil {
+		return nil, err
+	}
+	// restore holds the paths to copy from the parent, and remove the paths
+	// that the commit added.
+	restore := make(map[string]bool)
+	var remove []string
+	if err := NewDiffer(parent, commitSource).Iterate(ctx, func(parentFi, commitFi *pfs.FileInfo) error {
+		if parentFi == nil {
+			if commitFi.FileType != pfs.FileType_DIR {
+				remove = append(remove, commitFi.File.Path)
+			}
+			return nil
+		}
+		if parentFi.FileType != pfs.FileType_DIR {
+			restore[parentFi.File.Path] = true
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	description := request.Description
+	if description == "" {
+		description = "revert " + pretty.CompactPrintCommit(commitInfo.Commit)
+	}
+	headFilesetID, err := d.getFileset(ctx, headInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	filesetID, err := d.createFileset(ctx, func(uw *fileset.UnorderedWriter) error {
+		for _, p := range remove {
+			if err := uw.Delete(p, ""); err != nil {
+				return err
+			}
+		}
+		if len(restore) == 0 {
+			return nil
+		}
+		_, fs, err := d.openCommit(ctx, parentInfo.Commit)
+		if err != nil {
+			return err
+		}
+		fs = fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
+			return restore[idx.Path]
+		})
+		return uw.Copy(ctx, fs, "", false)
+	}, fileset.WithParentID(headFilesetID))
+	if err != nil {
+		return nil, err
+	}
+	var commit *pfs.Commit
+	if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		// The revert is only valid on top of the head it was computed
+		// against, so fail rather than drop commits made to the branch since.
+		if err := d.checkBranchHead(txnCtx, branch, headInfo.Commit); err != nil {
+			return err
+		}
+		var err error
+		commit, err = d.startCommit(txnCtx, "", headInfo.Commit, branch, nil, description)
+		if err != nil {
+			return err
+		}
+		if err := d.commitStore.AddFilesetTx(txnCtx.SqlTx, commit, *filesetID); err != nil {
+			return err
+		}
+		return d.finishCommit(txnCtx, commit, "")
+	}); err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
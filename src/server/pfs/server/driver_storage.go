@@ -0,0 +1,176 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// storageUsageRow is a cached storage usage summary. The fingerprint is a
+// hash of the tracker IDs for the filesets of every commit in the scope, and
+// of the chunk storage's usage generation. Whether a chunk is shared depends on
+// every other repo, so a cached summary is only reused until anything in the
+// cluster gains or loses a fileset.
+type storageUsageRow struct {
+	Fingerprint  []byte    `db:"fingerprint"`
+	UniqueBytes  int64     `db:"unique_bytes"`
+	SharedBytes  int64     `db:"shared_bytes"`
+	UniqueChunks int64     `db:"unique_chunks"`
+	SharedChunks int64     `db:"shared_chunks"`
+	ComputedAt   time.Time `db:"computed_at"`
+}
+
+// inspectStorage computes the deduplicated object storage used by the commits
+// in a repo, a branch's history or a single commit.
+func (d *driver) inspectStorage(ctx context.Context, request *pfs.InspectStorageRequest) (*pfs.StorageUsage, error) {
+	scope, commits, err := d.storageScope(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	var trackerIDs []string
+	for _, commit := range commits {
+		ids, err := d.commitStore.TrackerIDs(ctx, commit)
+		if err != nil {
+			return nil, err
+		}
+		trackerIDs = append(trackerIDs, ids...)
+	}
+	sort.Strings(trackerIDs)
+	// Read the generation before computing the usage, so a concurrent change
+	// leaves a fingerprint that won't match the next request.
+	generation, err := d.storage.ChunkStorage().UsageGeneration(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fingerprint := pachhash.Sum([]byte(strings.Join(append(trackerIDs, generation), "\n")))
+	if !request.Refresh {
+		row := &storageUsageRow{}
+		err := d.env.GetDBClient().GetContext(ctx, row, `
+			SELECT fingerprint, unique_bytes, shared_bytes, unique_chunks, shared_chunks, computed_at
+			FROM pfs.storage_usage
+			WHERE scope = $1 AND fingerprint = $2
+		`, scope, fingerprint[:])
+		if err == nil {
+			return storageUsageFromRow(row)
+		}
+		if err != sql.ErrNoRows {
+			return nil, err
+		}
+	}
+	usage, err := d.storage.ChunkStorage().Usage(ctx, trackerIDs)
+	if err != nil {
+		return nil, err
+	}
+	row := &storageUsageRow{
+		Fingerprint:  fingerprint[:],
+		UniqueBytes:  usage.UniqueBytes,
+		SharedBytes:  usage.SharedBytes,
+		UniqueChunks: usage.UniqueChunks,
+		SharedChunks: usage.SharedChunks,
+	}
+	if err := d.env.GetDBClient().GetContext(ctx, &row.ComputedAt, `
+		INSERT INTO pfs.storage_usage (scope, fingerprint, unique_bytes, shared_bytes, unique_chunks, shared_chunks)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (scope) DO UPDATE
+		SET fingerprint = $2, unique_bytes = $3, shared_bytes = $4, unique_chunks = $5, shared_chunks = $6,
+			computed_at = CURRENT_TIMESTAMP
+		RETURNING computed_at
+	`, scope, row.Fingerprint, row.UniqueBytes, row.SharedBytes, row.UniqueChunks, row.SharedChunks); err != nil {
+		return nil, err
+	}
+	return storageUsageFromRow(row)
+}
+
+// storageScope returns the cache key and the commits for an InspectStorage
+// request.
+func (d *driver) storageScope(ctx context.Context, request *pfs.InspectStorageRequest) (string, []*pfs.Commit, error) {
+	switch {
+	case request.Commit != nil:
+		commitInfo, err := d.inspectCommit(ctx, request.Commit, pfs.CommitState_STARTED)
+		if err != nil {
+			return "", nil, err
+		}
+		return "commit/" + pfsdb.CommitKey(commitInfo.Commit), []*pfs.Commit{commitInfo.Commit}, nil
+	case request.Branch != nil:
+		if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, request.Branch.Repo.Name, auth.Permission_REPO_READ); err != nil {
+			return "", nil, err
+		}
+		branchInfo := &pfs.BranchInfo{}
+		if err := d.branches.ReadOnly(ctx).Get(pfsdb.BranchKey(request.Branch), branchInfo); err != nil {
+			return "", nil, err
+		}
+		var commits []*pfs.Commit
+		for commit := branchInfo.Head; commit != nil; {
+			commitInfo, err := d.getCommit(ctx, commit)
+			if err != nil {
+				return "", nil, err
+			}
+			commits = append(commits, commitInfo.Commit)
+			commit = commitInfo.ParentCommit
+		}
+		return "branch/" + pfsdb.BranchKey(branchInfo.Branch), commits, nil
+	case request.Repo != nil:
+		if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, request.Repo.Name, auth.Permission_REPO_READ); err != nil {
+			return "", nil, err
+		}
+		repoInfo := &pfs.RepoInfo{}
+		if err := d.repos.ReadOnly(ctx).Get(pfsdb.RepoKey(request.Repo), repoInfo); err != nil {
+			return "", nil, err
+		}
+		var commits []*pfs.Commit
+		commitInfo := &pfs.CommitInfo{}
+		if err := d.commits.ReadOnly(ctx).GetByIndex(pfsdb.CommitsRepoIndex, pfsdb.RepoKey(repoInfo.Repo), commitInfo, col.DefaultOptions(), func(string) error {
+			commits = append(commits, commitInfo.Commit)
+			commitInfo = &pfs.CommitInfo{}
+			return nil
+		}); err != nil {
+			return "", nil, err
+		}
+		return "repo/" + pfsdb.RepoKey(repoInfo.Repo), commits, nil
+	default:
+		return "", nil, errors.Errorf("one of repo, branch or commit must be set")
+	}
+}
+
+func storageUsageFromRow(row *storageUsageRow) (*pfs.StorageUsage, error) {
+	computed, err := types.TimestampProto(row.ComputedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.StorageUsage{
+		UniqueBytes:  uint64(row.UniqueBytes),
+		SharedBytes:  uint64(row.SharedBytes),
+		UniqueChunks: uint64(row.UniqueChunks),
+		SharedChunks: uint64(row.SharedChunks),
+		Computed:     computed,
+	}, nil
+}
+
+// SetupPostgresStorageUsageV0 runs SQL to setup the storage usage cache.
+func SetupPostgresStorageUsageV0(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE pfs.storage_usage (
+			scope VARCHAR(4096) NOT NULL,
+			fingerprint BYTEA NOT NULL,
+			unique_bytes INT8 NOT NULL,
+			shared_bytes INT8 NOT NULL,
+			unique_chunks INT8 NOT NULL,
+			shared_chunks INT8 NOT NULL,
+			computed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY(scope)
+		);
+	`)
+	return errors.EnsureStack(err)
+}
@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/jmoiron/sqlx"
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsdb"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/server/pfs/pretty"
+)
+
+// createTag creates a tag pointing at a finished commit. Tags can't be
+// moved, and resolve like branches anywhere a commit is specified, so a tag
+// can't share its name with a branch in the same repo. Tagged commits can't
+// be squashed, which keeps their filesets from being garbage collected.
+func (d *driver) createTag(ctx context.Context, tag *pfs.Tag, commit *pfs.Commit, description string) error {
+	// Validate arguments
+	if tag == nil {
+		return errors.New("tag cannot be nil")
+	}
+	if tag.Repo == nil {
+		return errors.New("tag repo cannot be nil")
+	}
+	if commit == nil {
+		return errors.New("commit cannot be nil")
+	}
+	if err := ancestry.ValidateName(tag.Name); err != nil {
+		return err
+	}
+	return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, tag.Repo.Name, auth.Permission_REPO_CREATE_TAG); err != nil {
+			return err
+		}
+		commitInfo, err := d.resolveCommit(txnCtx.SqlTx, commit)
+		if err != nil {
+			return err
+		}
+		if pfsdb.RepoKey(commitInfo.Commit.Branch.Repo) != pfsdb.RepoKey(tag.Repo) {
+			return errors.Errorf("cannot tag commit %s in a different repo", pretty.CompactPrintCommit(commitInfo.Commit))
+		}
+		if commitInfo.Finished == nil {
+			return pfsserver.ErrCommitNotFinished{Commit: commitInfo.Commit}
+		}
+		branch := &pfs.Branch{Repo: tag.Repo, Name: tag.Name}
+		if err := d.branches.ReadWrite(txnCtx.SqlTx).Get(pfsdb.BranchKey(branch), &pfs.BranchInfo{}); err == nil {
+			return errors.Errorf("cannot create tag %s because a branch with the same name exists", tag.Name)
+		} else if !col.IsErrNotFound(err) {
+			return err
+		}
+		if err := d.tags.ReadWrite(txnCtx.SqlTx).Create(pfsdb.TagKey(tag), &pfs.TagInfo{
+			Tag:         tag,
+			Commit:      commitInfo.Commit,
+			Created:     types.TimestampNow(),
+			Description: description,
+		}); err != nil {
+			if col.IsErrExists(err) {
+				return errors.Errorf("tag %s already exists and can't be moved", tag.Name)
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+func (d *driver) inspectTag(ctx context.Context, tag *pfs.Tag) (*pfs.TagInfo, error) {
+	// Validate arguments
+	if tag == nil {
+		return nil, errors.New("tag cannot be nil")
+	}
+	if tag.Repo == nil {
+		return nil, errors.New("tag repo cannot be nil")
+	}
+	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, tag.Repo.Name, auth.Permission_REPO_INSPECT_COMMIT); err != nil {
+		return nil, err
+	}
+	tagInfo := &pfs.TagInfo{}
+	if err := d.tags.ReadOnly(ctx).Get(pfsdb.TagKey(tag), tagInfo); err != nil {
+		return nil, err
+	}
+	return tagInfo, nil
+}
+
+func (d *driver) listTag(ctx context.Context, repo *pfs.Repo) ([]*pfs.TagInfo, error) {
+	// Validate arguments
+	if repo == nil {
+		return nil, errors.New("repo cannot be nil")
+	}
+	if err := d.env.AuthServer().CheckRepoIsAuthorized(ctx, repo.Name, auth.Permission_REPO_LIST_COMMIT); err != nil {
+		return nil, err
+	}
+	var result []*pfs.TagInfo
+	tagInfo := &pfs.TagInfo{}
+	opts := &col.Options{Target: col.SortByCreateRevision, Order: col.SortDescend}
+	if err := d.tags.ReadOnly(ctx).GetByIndex(pfsdb.TagsRepoIndex, pfsdb.RepoKey(repo), tagInfo, opts, func(string) error {
+		result = append(result, proto.Clone(tagInfo).(*pfs.TagInfo))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// deleteTag deletes a tag. Unlike the other tag operations, deleting a tag
+// requires REPO_DELETE_TAG, which writers don't have.
+func (d *driver) deleteTag(ctx context.Context, tag *pfs.Tag) error {
+	// Validate arguments
+	if tag == nil {
+		return errors.New("tag cannot be nil")
+	}
+	if tag.Repo == nil {
+		return errors.New("tag repo cannot be nil")
+	}
+	return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		if err := d.env.AuthServer().CheckRepoIsAuthorizedInTransaction(txnCtx, tag.Repo.Name, auth.Permission_REPO_DELETE_TAG); err != nil {
+			return err
+		}
+		return d.tags.ReadWrite(txnCtx.SqlTx).Delete(pfsdb.TagKey(tag))
+	})
+}
+
+// checkNotTagged returns an error if any tag points at commit.
+func (d *driver) checkNotTagged(sqlTx *sqlx.Tx, commit *pfs.Commit) error {
+	tagInfo := &pfs.TagInfo{}
+	var tags []string
+	if err := d.tags.ReadWrite(sqlTx).GetByIndex(pfsdb.TagsCommitIndex, pfsdb.CommitKey(commit), tagInfo, col.DefaultOptions(), func(string) error {
+		tags = append(tags, tagInfo.Tag.Name)
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(tags) > 0 {
+		return errors.Errorf("cannot delete commit %s because it is tagged %v", pretty.CompactPrintCommit(commit), tags)
+	}
+	return nil
+}
+
+// branchTag returns the tag with the same name as branch.
+func branchTag(branch *pfs.Branch) *pfs.Tag {
+	return &pfs.Tag{Repo: branch.Repo, Name: branch.Name}
+}
@@ -34,6 +34,16 @@ func (d *driver) master(ctx context.Context) {
 			gc := chunk.NewGC(d.storage.ChunkStorage())
 			return gc.RunForever(ctx)
 		})
+		if d.replicator != nil {
+			eg.Go(func() error {
+				return d.replicator.RunForever(ctx)
+			})
+		}
+		if d.tierer != nil {
+			eg.Go(func() error {
+				return d.tierer.RunForever(ctx)
+			})
+		}
 		return eg.Wait()
 	}, backoff.NewInfiniteBackOff(), func(err error, _ time.Duration) error {
 		log.Errorf("error in pfs master: %v", err)
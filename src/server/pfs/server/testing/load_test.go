@@ -22,6 +22,102 @@ func TestLoad(t *testing.T) {
 	}
 }
 
+func TestLoadScenarios(t *testing.T) {
+	env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+	resp, err := env.PachClient.RunPFSLoadTest([]byte(scenarioLoad))
+	require.NoError(t, err)
+	require.Equal(t, "", resp.Error, fmt.Sprint("seed: ", resp.Seed))
+	require.Equal(t, 2, len(resp.Scenarios))
+	require.Equal(t, resp.Scenarios[0].Branch, resp.Branch)
+	require.Equal(t, int64(1), resp.Scenarios[0].Seed)
+	require.Equal(t, resp.Seed, resp.Scenarios[1].Seed)
+	counts := make(map[string]int64)
+	for _, operation := range resp.Scenarios[0].Operations {
+		var histogramCount int64
+		for _, bucket := range operation.Histogram {
+			histogramCount += bucket.Count
+		}
+		require.Equal(t, operation.Count, histogramCount)
+		counts[operation.Operation] = operation.Count
+	}
+	require.Equal(t, int64(3), counts["startCommit"])
+	require.Equal(t, int64(3), counts["finishCommit"])
+	require.Equal(t, int64(9), counts["putFile"])
+	var reads int64
+	for _, operation := range []string{"getFile", "rangedRead", "listFile", "globFile"} {
+		reads += counts[operation]
+	}
+	require.Equal(t, int64(30), reads)
+
+	resp, err = env.PachClient.RunPFSLoadTestScenario([]byte(scenarioLoad), "writes", 0)
+	require.NoError(t, err)
+	require.Equal(t, "", resp.Error, fmt.Sprint("seed: ", resp.Seed))
+	require.Equal(t, 1, len(resp.Scenarios))
+	require.Equal(t, "writes", resp.Scenarios[0].Name)
+}
+
+var scenarioLoad = `
+scenarios:
+  - name: "reads"
+    seed: 1
+    count: 3
+    operations:
+      - count: 3
+        operation:
+          - putFile:
+              files:
+                count: 10
+                file:
+                  - source: "random"
+                    prob: 100
+            prob: 100
+    fileSources:
+      - name: "random"
+        random:
+          directory:
+            depth: 2
+            run: 5
+          size:
+            - min: 100
+              max: 10000
+              prob: 100
+    reads:
+      count: 10
+      read:
+        - getFile: {}
+          prob: 40
+        - rangedRead:
+            size:
+              - min: 10
+                max: 1000
+                prob: 100
+          prob: 30
+        - listFile: {}
+          prob: 20
+        - globFile: {}
+          prob: 10
+  - name: "writes"
+    count: 2
+    operations:
+      - count: 2
+        operation:
+          - putFile:
+              files:
+                count: 5
+                file:
+                  - source: "random"
+                    prob: 100
+            prob: 100
+    validator: {}
+    fileSources:
+      - name: "random"
+        random:
+          size:
+            - min: 1000
+              max: 10000
+              prob: 100
+`
+
 var loads = []string{`
 count: 5
 operations:
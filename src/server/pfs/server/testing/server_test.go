@@ -39,6 +39,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/testutil/random"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/sync/errgroup"
 )
@@ -201,6 +202,43 @@ func TestPFS(suite *testing.T) {
 		require.NotNil(t, commitInfo.ParentCommit)
 	})
 
+	suite.Run("ConditionalBranchUpdate", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "repo"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		first, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.FinishCommit(repo, "master", ""))
+		second, err := env.PachClient.StartCommitIfHead(repo, "master", first.ID)
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.FinishCommit(repo, "master", ""))
+
+		// master has moved past 'first', so conditional updates against it fail
+		_, err = env.PachClient.StartCommitIfHead(repo, "master", first.ID)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsBranchHeadMovedErr(err))
+		err = env.PachClient.CreateBranchIfHead(repo, "production", "", first.ID, nil, first.ID)
+		require.True(t, pfsserver.IsBranchHeadMovedErr(err))
+
+		require.NoError(t, env.PachClient.CreateBranch(repo, "production", "", first.ID, nil))
+		require.NoError(t, env.PachClient.CreateBranchIfHead(repo, "production", "", second.ID, nil, first.ID))
+		err = env.PachClient.CreateBranchIfHead(repo, "production", "", first.ID, nil, first.ID)
+		require.True(t, pfsserver.IsBranchHeadMovedErr(err))
+		branchInfo, err := env.PachClient.InspectBranch(repo, "production")
+		require.NoError(t, err)
+		require.Equal(t, second.ID, branchInfo.Head.ID)
+
+		// The precondition is checked again when the transaction is finished
+		txn, err := env.PachClient.StartTransaction()
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.WithTransaction(txn).CreateBranchIfHead(repo, "production", "", first.ID, nil, second.ID))
+		require.NoError(t, env.PachClient.CreateBranch(repo, "production", "", first.ID, nil))
+		_, err = env.PachClient.FinishTransaction(txn)
+		require.True(t, pfsserver.IsBranchHeadMovedErr(err))
+	})
+
 	suite.Run("ToggleBranchProvenance", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
@@ -5068,6 +5106,302 @@ func TestPFS(suite *testing.T) {
 		require.NoError(t, env.PachClient.DeleteRepo(output1, false))
 	})
 
+	suite.Run("FsckDeep", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "repo"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		for i := 0; i < 5; i++ {
+			require.NoError(t, env.PachClient.PutFile(client.NewCommit(repo, "master", ""), fmt.Sprintf("file%d", i), strings.NewReader(fmt.Sprintf("%d", i))))
+		}
+		commitInfo, err := env.PachClient.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		noErrors := func(resp *pfs.FsckResponse) error {
+			if resp.Error != "" {
+				return errors.Errorf("unexpected fsck error: %s", resp.Error)
+			}
+			return nil
+		}
+		require.NoError(t, env.PachClient.FsckDeep(false, nil, noErrors))
+		require.NoError(t, env.PachClient.FsckDeep(false, []*pfs.Commit{commitInfo.Commit}, noErrors))
+	})
+
+	suite.Run("InspectStorage", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		data := random.String(10 * units.MB)
+		require.NoError(t, env.PachClient.CreateRepo("a"))
+		require.NoError(t, env.PachClient.PutFile(client.NewCommit("a", "master", ""), "shared", strings.NewReader(data)))
+		_, err := env.PachClient.InspectCommit("a", "master", "")
+		require.NoError(t, err)
+		usage, err := env.PachClient.InspectRepoStorage("a", false)
+		require.NoError(t, err)
+		require.True(t, usage.UniqueBytes+usage.SharedBytes > 0)
+		// The summary is cached until the repo changes.
+		cached, err := env.PachClient.InspectRepoStorage("a", false)
+		require.NoError(t, err)
+		require.Equal(t, usage.Computed, cached.Computed)
+		// Identical content in another repo is deduplicated, so it is shared.
+		require.NoError(t, env.PachClient.CreateRepo("b"))
+		require.NoError(t, env.PachClient.PutFile(client.NewCommit("b", "master", ""), "shared", strings.NewReader(data)))
+		_, err = env.PachClient.InspectCommit("b", "master", "")
+		require.NoError(t, err)
+		usage, err = env.PachClient.InspectRepoStorage("a", true)
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), usage.UniqueBytes)
+		require.True(t, usage.SharedBytes > 0)
+		branchUsage, err := env.PachClient.InspectBranchStorage("a", "master", false)
+		require.NoError(t, err)
+		require.Equal(t, usage.SharedBytes, branchUsage.SharedBytes)
+		commitUsage, err := env.PachClient.InspectCommitStorage("a", "master", "", false)
+		require.NoError(t, err)
+		require.Equal(t, usage.SharedBytes, commitUsage.SharedBytes)
+	})
+
+	suite.Run("InspectStorageOtherRepoChanges", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		c := env.PachClient
+		require.NoError(t, c.CreateRepo("a"))
+		require.NoError(t, c.PutFile(client.NewCommit("a", "master", ""), "file", strings.NewReader(random.String(units.MB))))
+		_, err := c.InspectCommit("a", "master", "")
+		require.NoError(t, err)
+		usage, err := c.InspectRepoStorage("a", false)
+		require.NoError(t, err)
+		require.True(t, usage.UniqueBytes > 0)
+		require.Equal(t, uint64(0), usage.SharedBytes)
+		uniqueBytes := usage.UniqueBytes
+		_, err = c.InspectBranchStorage("a", "master", false)
+		require.NoError(t, err)
+		// Forking the repo shares its chunks, which cached summaries must
+		// reflect even though the repo itself didn't change.
+		_, err = c.ForkRepo(client.NewCommit("a", "master", ""), "fork", "")
+		require.NoError(t, err)
+		usage, err = c.InspectRepoStorage("a", false)
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), usage.UniqueBytes)
+		require.Equal(t, uniqueBytes, usage.SharedBytes)
+		branchUsage, err := c.InspectBranchStorage("a", "master", false)
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), branchUsage.UniqueBytes)
+		require.Equal(t, uniqueBytes, branchUsage.SharedBytes)
+	})
+
+	suite.Run("ForkRepo", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		c := env.PachClient
+		require.NoError(t, c.CreateRepo("source"))
+		require.NoError(t, c.PutFile(client.NewCommit("source", "master", ""), "foo", strings.NewReader("foo")))
+		require.NoError(t, c.PutFile(client.NewCommit("source", "master", ""), "bar", strings.NewReader("bar")))
+		commit, err := c.ForkRepo(client.NewCommit("source", "master", ""), "fork", "")
+		require.NoError(t, err)
+		require.Equal(t, "master", commit.Branch.Name)
+		_, err = c.ForkRepo(client.NewCommit("source", "master", ""), "fork", "")
+		require.YesError(t, err)
+		checkFiles := func() {
+			var buf bytes.Buffer
+			require.NoError(t, c.GetFile(client.NewCommit("fork", "master", ""), "foo", &buf))
+			require.Equal(t, "foo", buf.String())
+			buf.Reset()
+			require.NoError(t, c.GetFile(client.NewCommit("fork", "master", ""), "bar", &buf))
+			require.Equal(t, "bar", buf.String())
+		}
+		checkFiles()
+		// The fork is independent of the source.
+		require.NoError(t, c.DeleteFile(client.NewCommit("source", "master", ""), "foo"))
+		require.NoError(t, c.PutFile(client.NewCommit("fork", "master", ""), "baz", strings.NewReader("baz")))
+		_, err = c.InspectFile(client.NewCommit("source", "master", ""), "baz")
+		require.YesError(t, err)
+		checkFiles()
+		require.NoError(t, c.DeleteRepo("source", false))
+		checkFiles()
+		require.NoError(t, c.FsckFastExit())
+	})
+
+	suite.Run("MergeCommit", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		c := env.PachClient
+		repo := "test"
+		master := client.NewCommit(repo, "master", "")
+		feature := client.NewCommit(repo, "feature", "")
+		require.NoError(t, c.CreateRepo(repo))
+		require.NoError(t, c.PutFile(master, "a", strings.NewReader("a")))
+		require.NoError(t, c.PutFile(master, "b", strings.NewReader("b")))
+		require.NoError(t, c.PutFile(master, "c", strings.NewReader("c")))
+		require.NoError(t, c.CreateBranch(repo, "feature", "master", "", nil))
+		require.NoError(t, c.PutFile(master, "a", strings.NewReader("a-ours")))
+		require.NoError(t, c.PutFile(master, "d", strings.NewReader("d")))
+		// other starts out the same as master, to merge into with THEIRS.
+		other := client.NewCommit(repo, "other", "")
+		require.NoError(t, c.CreateBranch(repo, "other", "master", "", nil))
+		require.NoError(t, c.PutFile(feature, "a", strings.NewReader("a-theirs")))
+		require.NoError(t, c.PutFile(feature, "b", strings.NewReader("b-theirs")))
+		require.NoError(t, c.DeleteFile(feature, "c"))
+		checkBranchFile := func(commit *pfs.Commit, path, expected string) {
+			var buf bytes.Buffer
+			require.NoError(t, c.GetFile(commit, path, &buf))
+			require.Equal(t, expected, buf.String())
+		}
+		checkFile := func(path, expected string) {
+			checkBranchFile(master, path, expected)
+		}
+
+		resp, err := c.MergeCommit(master, feature, pfs.MergeStrategy_FAIL)
+		require.NoError(t, err)
+		require.Nil(t, resp.Commit)
+		require.Equal(t, []string{"/a"}, resp.Conflicts)
+		checkFile("a", "a-ours")
+
+		resp, err = c.MergeCommit(master, feature, pfs.MergeStrategy_OURS)
+		require.NoError(t, err)
+		require.NotNil(t, resp.Commit)
+		require.Equal(t, []string{"/a"}, resp.Conflicts)
+		checkFile("a", "a-ours")
+		checkFile("b", "b-theirs")
+		checkFile("d", "d")
+		_, err = c.InspectFile(master, "c")
+		require.YesError(t, err)
+
+		featureInfo, err := c.InspectCommit(repo, "feature", "")
+		require.NoError(t, err)
+		mergeInfo, err := c.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.Equal(t, featureInfo.Commit.ID, mergeInfo.MergeParent.ID)
+
+		resp, err = c.MergeCommit(other, feature, pfs.MergeStrategy_THEIRS)
+		require.NoError(t, err)
+		require.NotNil(t, resp.Commit)
+		checkBranchFile(other, "a", "a-theirs")
+		checkBranchFile(other, "b", "b-theirs")
+
+		// Merging again only merges the changes made since the last merge, so
+		// the conflict on a that was resolved isn't a conflict anymore.
+		require.NoError(t, c.PutFile(feature, "b", strings.NewReader("b-theirs-2")))
+		require.NoError(t, c.PutFile(feature, "e", strings.NewReader("e")))
+		resp, err = c.MergeCommit(master, feature, pfs.MergeStrategy_FAIL)
+		require.NoError(t, err)
+		require.NotNil(t, resp.Commit)
+		require.Equal(t, 0, len(resp.Conflicts))
+		checkFile("a", "a-ours")
+		checkFile("b", "b-theirs-2")
+		checkFile("e", "e")
+		// With nothing new to merge, merging again doesn't create a commit.
+		resp, err = c.MergeCommit(master, feature, pfs.MergeStrategy_FAIL)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(resp.Conflicts))
+		headInfo, err := c.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.Equal(t, headInfo.Commit.ID, resp.Commit.ID)
+
+		// Merging an ancestor doesn't create a commit.
+		head, err := c.InspectCommit(repo, "feature", "")
+		require.NoError(t, err)
+		resp, err = c.MergeCommit(feature, client.NewCommit(repo, "", "feature^"), pfs.MergeStrategy_FAIL)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(resp.Conflicts))
+		require.Equal(t, head.Commit.ID, resp.Commit.ID)
+
+		// Merging onto a commit that isn't its branch's head fails rather than
+		// rewinding the branch.
+		_, err = c.MergeCommit(client.NewCommit(repo, "", "master^"), feature, pfs.MergeStrategy_FAIL)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsBranchHeadMovedErr(err))
+		checkHead, err := c.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.Equal(t, headInfo.Commit.ID, checkHead.Commit.ID)
+	})
+
+	suite.Run("RevertCommit", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		c := env.PachClient
+		repo := "test"
+		master := client.NewCommit(repo, "master", "")
+		require.NoError(t, c.CreateRepo(repo))
+		mfc, err := c.NewModifyFileClient(master)
+		require.NoError(t, err)
+		require.NoError(t, mfc.PutFile("a", strings.NewReader("a")))
+		require.NoError(t, mfc.PutFile("b", strings.NewReader("b")))
+		require.NoError(t, mfc.Close())
+		mfc, err = c.NewModifyFileClient(master)
+		require.NoError(t, err)
+		require.NoError(t, mfc.PutFile("a", strings.NewReader("a-changed")))
+		require.NoError(t, mfc.DeleteFile("b"))
+		require.NoError(t, mfc.PutFile("c", strings.NewReader("c")))
+		require.NoError(t, mfc.Close())
+		reverted, err := c.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.NoError(t, c.PutFile(master, "d", strings.NewReader("d")))
+		checkFile := func(path, expected string) {
+			var buf bytes.Buffer
+			require.NoError(t, c.GetFile(master, path, &buf))
+			require.Equal(t, expected, buf.String())
+		}
+
+		commit, err := c.RevertCommit(repo, "", reverted.Commit.ID)
+		require.NoError(t, err)
+		head, err := c.InspectCommit(repo, "master", "")
+		require.NoError(t, err)
+		require.Equal(t, commit.ID, head.Commit.ID)
+		checkFile("a", "a")
+		checkFile("b", "b")
+		checkFile("d", "d")
+		_, err = c.InspectFile(master, "c")
+		require.YesError(t, err)
+	})
+
+	suite.Run("Tags", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		c := env.PachClient
+		repo := "test"
+		master := client.NewCommit(repo, "master", "")
+		require.NoError(t, c.CreateRepo(repo))
+		require.NoError(t, c.PutFile(master, "a", strings.NewReader("1")))
+		require.NoError(t, c.CreateTag(repo, "v1", "master", ""))
+		require.NoError(t, c.PutFile(master, "a", strings.NewReader("2")))
+
+		// The tag resolves to the commit it was created on.
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile(client.NewCommit(repo, "v1", ""), "a", &buf))
+		require.Equal(t, "1", buf.String())
+		tagInfo, err := c.InspectTag(repo, "v1")
+		require.NoError(t, err)
+		commitInfo, err := c.InspectCommit(repo, "v1", "")
+		require.NoError(t, err)
+		require.Equal(t, tagInfo.Commit.ID, commitInfo.Commit.ID)
+		tagInfos, err := c.ListTag(repo)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(tagInfos))
+
+		// Tags can't be moved, or shadowed by a branch.
+		require.YesError(t, c.CreateTag(repo, "v1", "master", ""))
+		require.YesError(t, c.CreateBranch(repo, "v1", "master", "", nil))
+		require.YesError(t, c.PutFile(client.NewCommit(repo, "v1", ""), "a", strings.NewReader("3")))
+		require.YesError(t, c.CreateTag(repo, "master", "master", ""))
+
+		// Tagged commits can't be squashed.
+		require.YesError(t, c.SquashCommit(repo, "", tagInfo.Commit.ID))
+		_, err = c.InspectCommit(repo, "", tagInfo.Commit.ID)
+		require.NoError(t, err)
+
+		require.NoError(t, c.DeleteTag(repo, "v1"))
+		_, err = c.InspectTag(repo, "v1")
+		require.YesError(t, err)
+		tagInfos, err = c.ListTag(repo)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(tagInfos))
+	})
+
 	suite.Run("PutFileAtomic", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
@@ -21,8 +21,8 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pager"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	prettyutil "github.com/pachyderm/pachyderm/v2/src/internal/pretty"
 	"github.com/pachyderm/pachyderm/v2/src/internal/progress"
-	"github.com/pachyderm/pachyderm/v2/src/internal/serde"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tracing/extended"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
@@ -35,6 +35,7 @@ import (
 
 	prompt "github.com/c-bata/go-prompt"
 	docker "github.com/fsouza/go-dockerclient"
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/itchyny/gojq"
 	glob "github.com/pachyderm/ohmyglob"
@@ -44,46 +45,13 @@ import (
 	"golang.org/x/net/context"
 )
 
-// encoder creates an encoder that writes data structures to w[0] (or os.Stdout
-// if no 'w' is passed) in the serialization format 'format'. If more than one
-// writer is passed, all writers after the first are silently ignored (rather
-// than returning an error), and if the 'format' passed is unrecognized
-// (currently, 'format' must be 'json' or 'yaml') then pachctl exits
-// immediately. Ignoring errors or crashing simplifies the type signature of
-// 'encoder' and allows it to be used inline.
-func encoder(format string, w ...io.Writer) serde.Encoder {
-	format = strings.ToLower(format)
-	if format == "" {
-		format = "json"
-	}
-	var output io.Writer = os.Stdout
-	if len(w) > 0 {
-		output = w[0]
-	}
-	e, err := serde.GetEncoder(format, output,
-		serde.WithIndent(2),
-		serde.WithOrigName(true),
-	)
-	if err != nil {
-		cmdutil.ErrorAndExit(err.Error())
-	}
-	return e
-}
-
 // Cmds returns a slice containing pps commands.
 func Cmds() []*cobra.Command {
 	var commands []*cobra.Command
 
-	raw := false
+	var raw bool
 	var output string
-	outputFlags := pflag.NewFlagSet("", pflag.ExitOnError)
-	outputFlags.BoolVar(&raw, "raw", false, "Disable pretty printing; serialize data structures to an encoding such as json or yaml")
-	// --output is empty by default, so that we can print an error if a user
-	// explicitly sets --output without --raw, but the effective default is set in
-	// encode(), which assumes "json" if 'format' is empty.
-	// Note: because of how spf13/flags works, no other StringVarP that sets
-	// 'output' can have a default value either
-	outputFlags.StringVarP(&output, "output", "o", "", "Output format when --raw is set: \"json\" or \"yaml\" (default \"json\")")
+	outputFlags := cmdutil.OutputFlags(&raw, &output)
 
 	fullTimestamps := false
 	fullTimestampsFlags := pflag.NewFlagSet("", pflag.ContinueOnError)
@@ -124,10 +92,12 @@ If the job fails, the output commit will not be populated with data.`,
 			if jobInfo == nil {
 				cmdutil.ErrorAndExit("job %s not found.", args[0])
 			}
-			if raw {
-				return encoder(output).EncodeProto(jobInfo)
-			} else if output != "" {
-				cmdutil.ErrorAndExit("cannot set --output (-o) without --raw")
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				return cmdutil.PrintAll(printer, jobInfo)
 			}
 			pji := &pretty.PrintableJobInfo{
 				JobInfo:        jobInfo,
@@ -174,6 +144,11 @@ $ {{alias}} -p foo -i bar@YYY`,
 			if err != nil {
 				return errors.Wrapf(err, "error parsing history flag")
 			}
+			// Likewise, --output used to take an output commit
+			if outputCommitStr == "" && strings.Contains(output, "@") && !strings.Contains(output, "=") {
+				fmt.Fprintln(os.Stderr, "WARNING: filtering jobs with '--output <repo>@<branch-or-commit>' is deprecated, use '--output-commit' instead")
+				outputCommitStr, output = output, ""
+			}
 			var outputCommit *pfs.Commit
 			if outputCommitStr != "" {
 				outputCommit, err = cmdutil.ParseCommit(outputCommitStr)
@@ -195,15 +170,19 @@ $ {{alias}} -p foo -i bar@YYY`,
 			}
 			defer client.Close()
 
-			return pager.Page(noPager, os.Stdout, func(w io.Writer) error {
-				if raw {
-					e := encoder(output)
-					return client.ListJobFilterF(pipelineName, commits, outputCommit, history, true, filter, func(ji *ppsclient.JobInfo) error {
-						return e.EncodeProto(ji)
-					})
-				} else if output != "" {
-					cmdutil.ErrorAndExit("cannot set --output (-o) without --raw")
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				if err := client.ListJobFilterF(pipelineName, commits, outputCommit, history, true, filter, func(ji *ppsclient.JobInfo) error {
+					return printer.Print(ji)
+				}); err != nil {
+					return err
 				}
+				return printer.Flush()
+			}
+			return pager.Page(noPager, os.Stdout, func(w io.Writer) error {
 				writer := tabwriter.NewWriter(w, pretty.JobHeader)
 				if err := client.ListJobFilterF(pipelineName, commits, outputCommit, history, false, filter, func(ji *ppsclient.JobInfo) error {
 					pretty.PrintJobInfo(writer, ji, fullTimestamps)
@@ -217,11 +196,13 @@ $ {{alias}} -p foo -i bar@YYY`,
 	}
 	listJob.Flags().StringVarP(&pipelineName, "pipeline", "p", "", "Limit to jobs made by pipeline.")
 	listJob.MarkFlagCustom("pipeline", "__pachctl_get_pipeline")
-	listJob.Flags().StringVarP(&outputCommitStr, "output", "o", "", "List jobs with a specific output commit. format: <repo>@<branch-or-commit>")
-	listJob.MarkFlagCustom("output", "__pachctl_get_repo_commit")
+	listJob.Flags().StringVarP(&outputCommitStr, "output-commit", "o", "", "List jobs with a specific output commit. format: <repo>@<branch-or-commit>")
+	listJob.MarkFlagCustom("output-commit", "__pachctl_get_repo_commit")
 	listJob.Flags().StringSliceVarP(&inputCommitStrs, "input", "i", []string{}, "List jobs with a specific set of input commits. format: <repo>@<branch-or-commit>")
 	listJob.MarkFlagCustom("input", "__pachctl_get_repo_commit")
-	listJob.Flags().AddFlagSet(outputFlags)
+	// -o filtered by output commit before --output set the output format, so
+	// it still does here
+	listJob.Flags().AddFlagSet(cmdutil.OutputFlagsWithoutShorthand(&raw, &output))
 	listJob.Flags().AddFlagSet(fullTimestampsFlags)
 	listJob.Flags().AddFlagSet(noPagerFlags)
 	listJob.Flags().StringVar(&history, "history", "none", "Return jobs from historical versions of pipelines.")
@@ -248,8 +229,9 @@ $ {{alias}} foo@XXX bar@YYY
 # Return jobs caused by foo@XXX leading to pipelines bar and baz.
 $ {{alias}} foo@XXX -p bar -p baz`,
 		Run: cmdutil.Run(func(args []string) error {
-			if output != "" && !raw {
-				cmdutil.ErrorAndExit("cannot set --output (-o) without --raw")
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
 			}
 			commits, err := cmdutil.ParseCommits(args)
 			if err != nil {
@@ -262,26 +244,22 @@ $ {{alias}} foo@XXX -p bar -p baz`,
 			}
 			defer c.Close()
 			var writer *tabwriter.Writer
-			if !raw {
+			if printer == nil {
 				writer = tabwriter.NewWriter(os.Stdout, pretty.JobHeader)
 			}
-			e := encoder(output)
 			if err := c.FlushJob(commits, pipelines, func(ji *ppsclient.JobInfo) error {
-				if raw {
-					if err := e.EncodeProto(ji); err != nil {
-						return err
-					}
-					return nil
+				if printer != nil {
+					return printer.Print(ji)
 				}
 				pretty.PrintJobInfo(writer, ji, fullTimestamps)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if !raw {
-				return writer.Flush()
+			if printer != nil {
+				return printer.Flush()
 			}
-			return nil
+			return writer.Flush()
 		}),
 	}
 	flushJob.Flags().VarP(&pipelines, "pipeline", "p", "Wait only for jobs leading to a specific set of pipelines")
@@ -386,11 +364,12 @@ each datum.`,
 				return err
 			}
 			defer client.Close()
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
 			var printF func(*ppsclient.DatumInfo) error
-			if !raw {
-				if output != "" {
-					cmdutil.ErrorAndExit("cannot set --output (-o) without --raw")
-				}
+			if printer == nil {
 				writer := tabwriter.NewWriter(os.Stdout, pretty.DatumHeader)
 				printF = func(di *ppsclient.DatumInfo) error {
 					pretty.PrintDatumInfo(writer, di)
@@ -402,10 +381,14 @@ each datum.`,
 					}
 				}()
 			} else {
-				e := encoder(output)
 				printF = func(di *ppsclient.DatumInfo) error {
-					return e.EncodeProto(di)
+					return printer.Print(di)
 				}
+				defer func() {
+					if err := printer.Flush(); retErr == nil {
+						retErr = err
+					}
+				}()
 			}
 			if len(args) != 1 {
 				return errors.Errorf("must specify one job")
@@ -431,10 +414,12 @@ each datum.`,
 			if err != nil {
 				return err
 			}
-			if raw {
-				return encoder(output).EncodeProto(datumInfo)
-			} else if output != "" {
-				cmdutil.ErrorAndExit("cannot set --output (-o) without --raw")
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				return cmdutil.PrintAll(printer, datumInfo)
 			}
 			pretty.PrintDetailedDatumInfo(os.Stdout, datumInfo)
 			return nil
@@ -589,11 +574,12 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	var registry string
 	var username string
 	var pipelinePath string
+	var dryRun bool
 	createPipeline := &cobra.Command{
 		Short: "Create a new pipeline.",
 		Long:  "Create a new pipeline from a pipeline specification. For details on the format, see http://docs.pachyderm.io/en/latest/reference/pipeline_spec.html.",
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			return pipelineHelper(false, build, pushImages, registry, username, pipelinePath, false)
+			return pipelineHelper(false, build, pushImages, registry, username, pipelinePath, false, dryRun)
 		}),
 	}
 	createPipeline.Flags().StringVarP(&pipelinePath, "file", "f", "-", "The JSON file containing the pipeline, it can be a url or local file. - reads from stdin.")
@@ -601,6 +587,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	createPipeline.Flags().BoolVarP(&pushImages, "push-images", "p", false, "If true, push local docker images into the docker registry.")
 	createPipeline.Flags().StringVarP(&registry, "registry", "r", "index.docker.io", "The registry to push images to.")
 	createPipeline.Flags().StringVarP(&username, "username", "u", "", "The username to push images as.")
+	createPipeline.Flags().BoolVar(&dryRun, "dry-run", false, "If true, report the datums the pipeline would process without creating it.")
 	commands = append(commands, cmdutil.CreateAlias(createPipeline, "create pipeline"))
 
 	var reprocess bool
@@ -608,7 +595,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 		Short: "Update an existing Pachyderm pipeline.",
 		Long:  "Update a Pachyderm pipeline with a new pipeline specification. For details on the format, see http://docs.pachyderm.io/en/latest/reference/pipeline_spec.html.",
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			return pipelineHelper(reprocess, build, pushImages, registry, username, pipelinePath, true)
+			return pipelineHelper(reprocess, build, pushImages, registry, username, pipelinePath, true, dryRun)
 		}),
 	}
 	updatePipeline.Flags().StringVarP(&pipelinePath, "file", "f", "-", "The JSON file containing the pipeline, it can be a url or local file. - reads from stdin.")
@@ -617,6 +604,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	updatePipeline.Flags().StringVarP(&registry, "registry", "r", "index.docker.io", "The registry to push images to.")
 	updatePipeline.Flags().StringVarP(&username, "username", "u", "", "The username to push images as.")
 	updatePipeline.Flags().BoolVar(&reprocess, "reprocess", false, "If true, reprocess datums that were already processed by previous version of the pipeline.")
+	updatePipeline.Flags().BoolVar(&dryRun, "dry-run", false, "If true, report the datums the updated pipeline would process without updating it.")
 	commands = append(commands, cmdutil.CreateAlias(updatePipeline, "update pipeline"))
 
 	runPipeline := &cobra.Command{
@@ -698,10 +686,12 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			if pipelineInfo == nil {
 				return errors.Errorf("pipeline %s not found", args[0])
 			}
-			if raw {
-				return encoder(output).EncodeProto(pipelineInfo)
-			} else if output != "" {
-				cmdutil.ErrorAndExit("cannot set --output (-o) without --raw")
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				return cmdutil.PrintAll(printer, pipelineInfo)
 			}
 			pi := &pretty.PrintablePipelineInfo{
 				PipelineInfo:   pipelineInfo,
@@ -723,8 +713,11 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			// validate flags
 			if raw && spec {
 				return errors.Errorf("cannot set both --raw and --spec")
-			} else if !raw && !spec && output != "" {
-				cmdutil.ErrorAndExit("cannot set --output (-o) without --raw or --spec")
+			}
+			// --spec prints specs as json by default
+			printer, err := cmdutil.NewPrinter(raw || spec, output, os.Stdout)
+			if err != nil {
+				return err
 			}
 			history, err := cmdutil.ParseHistory(history)
 			if err != nil {
@@ -756,22 +749,17 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				return grpcutil.ScrubGRPC(err)
 			}
 			pipelineInfos := response.PipelineInfo
-			if raw {
-				e := encoder(output)
+			if printer != nil {
 				for _, pipelineInfo := range pipelineInfos {
-					if err := e.EncodeProto(pipelineInfo); err != nil {
-						return err
+					var msg proto.Message = pipelineInfo
+					if spec {
+						msg = ppsutil.PipelineReqFromInfo(pipelineInfo)
 					}
-				}
-				return nil
-			} else if spec {
-				e := encoder(output)
-				for _, pipelineInfo := range pipelineInfos {
-					if err := e.EncodeProto(ppsutil.PipelineReqFromInfo(pipelineInfo)); err != nil {
+					if err := printer.Print(msg); err != nil {
 						return err
 					}
 				}
-				return nil
+				return printer.Flush()
 			}
 			for _, pi := range pipelineInfos {
 				if ppsutil.ErrorState(pi.State) {
@@ -822,10 +810,10 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			if len(args) > 0 {
 				req.Pipeline = pachdclient.NewPipeline(args[0])
 			}
-			if _, err = client.PpsAPIClient.DeletePipeline(client.Ctx(), req); err != nil {
+			return txncmds.WithActiveTransaction(client, func(txClient *pachdclient.APIClient) error {
+				_, err := txClient.PpsAPIClient.DeletePipeline(txClient.Ctx(), req)
 				return grpcutil.ScrubGRPC(err)
-			}
-			return nil
+			})
 		}),
 	}
 	deletePipeline.Flags().BoolVar(&all, "all", false, "delete all pipelines")
@@ -843,7 +831,9 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				return err
 			}
 			defer client.Close()
-			if err := client.StartPipeline(args[0]); err != nil {
+			if err := txncmds.WithActiveTransaction(client, func(txClient *pachdclient.APIClient) error {
+				return txClient.StartPipeline(args[0])
+			}); err != nil {
 				cmdutil.ErrorAndExit("error from StartPipeline: %s", err.Error())
 			}
 			return nil
@@ -861,7 +851,9 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				return err
 			}
 			defer client.Close()
-			if err := client.StopPipeline(args[0]); err != nil {
+			if err := txncmds.WithActiveTransaction(client, func(txClient *pachdclient.APIClient) error {
+				return txClient.StopPipeline(args[0])
+			}); err != nil {
 				cmdutil.ErrorAndExit("error from StopPipeline: %s", err.Error())
 			}
 			return nil
@@ -946,11 +938,19 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
 			}
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				return cmdutil.PrintAll(printer, secretInfo)
+			}
 			writer := tabwriter.NewWriter(os.Stdout, pretty.SecretHeader)
 			pretty.PrintSecretInfo(writer, secretInfo)
 			return writer.Flush()
 		}),
 	}
+	inspectSecret.Flags().AddFlagSet(outputFlags)
 	commands = append(commands, cmdutil.CreateAlias(inspectSecret, "inspect secret"))
 
 	listSecret := &cobra.Command{
@@ -971,6 +971,18 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
 			}
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				for _, si := range secretInfos.GetSecretInfo() {
+					if err := printer.Print(si); err != nil {
+						return err
+					}
+				}
+				return printer.Flush()
+			}
 			writer := tabwriter.NewWriter(os.Stdout, pretty.SecretHeader)
 			for _, si := range secretInfos.GetSecretInfo() {
 				pretty.PrintSecretInfo(writer, si)
@@ -978,15 +990,19 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			return writer.Flush()
 		}),
 	}
+	listSecret.Flags().AddFlagSet(outputFlags)
 	commands = append(commands, cmdutil.CreateAlias(listSecret, "list secret"))
 
 	return commands
 }
 
-func pipelineHelper(reprocess bool, build bool, pushImages bool, registry, username, pipelinePath string, update bool) error {
+func pipelineHelper(reprocess bool, build bool, pushImages bool, registry, username, pipelinePath string, update bool, dryRun bool) error {
 	if build && pushImages {
 		logrus.Warning("`--push-images` is redundant, as it's already enabled with `--build`")
 	}
+	if dryRun && (build || pushImages) {
+		return errors.New("cannot build or push images during a dry run")
+	}
 
 	pipelineReader, err := ppsutil.NewPipelineManifestReader(pipelinePath)
 	if err != nil {
@@ -1033,6 +1049,9 @@ func pipelineHelper(reprocess bool, build bool, pushImages bool, registry, usern
 		}
 
 		if request.Transform != nil && request.Transform.Build != nil {
+			if dryRun {
+				return errors.New("cannot dry run build step-enabled pipelines")
+			}
 			if !isLocal {
 				return errors.Errorf("cannot use build step-enabled pipelines that aren't local")
 			}
@@ -1089,6 +1108,17 @@ func pipelineHelper(reprocess bool, build bool, pushImages bool, registry, usern
 						"'bash:latest' to 'bash:5'. This improves reproducibility of your pipelines.\n")
 			}
 		}
+		if dryRun {
+			request.DryRun = true
+			response, err := pc.PpsAPIClient.CreatePipeline(pc.Ctx(), request)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			fmt.Printf("%s: %d new datums, %d skipped datums, %d removed datums, %s of input\n",
+				request.Pipeline.Name, response.DryRun.NewDatums, response.DryRun.SkippedDatums,
+				response.DryRun.RemovedDatums, prettyutil.Size(uint64(response.DryRun.InputSizeBytes)))
+			continue
+		}
 		if err = txncmds.WithActiveTransaction(pc, func(txClient *pachdclient.APIClient) error {
 			_, err := txClient.PpsAPIClient.CreatePipeline(
 				txClient.Ctx(),
@@ -421,6 +421,47 @@ func TestListPipelineFilter(t *testing.T) {
 	).Run())
 }
 
+// TestListJobOutputCommit tests that list job still filters by output commit
+// with -o and --output, which predate --output setting the output format
+func TestListJobOutputCommit(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	pipeline := tu.UniqueString("pipeline")
+	require.NoError(t, tu.BashCmd(`
+		yes | pachctl delete all
+		pachctl create repo input
+		pachctl create pipeline -f - <<EOF
+		{
+		"pipeline": {
+		  "name": "{{.pipeline}}"
+		},
+		"input": {
+		  "pfs": {
+		    "glob": "/*",
+		    "repo": "input"
+		  }
+		},
+		"transform": {
+		  "cmd": [ "/bin/bash" ],
+		  "stdin": [
+		    "cp /pfs/input/* /pfs/out"
+		  ]
+		}
+		}
+		EOF
+
+		echo foo | pachctl put file input@master:/foo
+		pachctl flush commit input@master
+		pachctl list job --output-commit {{.pipeline}}@master --output json | match {{.pipeline}}
+		pachctl list job -o {{.pipeline}}@master --raw | match {{.pipeline}}
+		pachctl list job --output {{.pipeline}}@master | match {{.pipeline}}
+		pachctl list job --output {{.pipeline}}@master 2>&1 >/dev/null | match deprecated
+		pachctl list job --output yaml | match {{.pipeline}}
+	`, "pipeline", pipeline,
+	).Run())
+}
+
 // TestYAMLError tests that when creating pipelines using a YAML spec with an
 // error, you get an error indicating the problem in the YAML, rather than an
 // error complaining about multiple documents.
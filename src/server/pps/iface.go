@@ -15,4 +15,7 @@ type APIServer interface {
 	StopJobInTransaction(*txncontext.TransactionContext, *pps_client.StopJobRequest) error
 	UpdateJobStateInTransaction(*txncontext.TransactionContext, *pps_client.UpdateJobStateRequest) error
 	CreatePipelineInTransaction(*txncontext.TransactionContext, *pps_client.CreatePipelineRequest, *string, **pfs_client.Commit) error
+	DeletePipelineInTransaction(*txncontext.TransactionContext, *pps_client.DeletePipelineRequest) error
+	StartPipelineInTransaction(*txncontext.TransactionContext, *pps_client.StartPipelineRequest, *string, **pfs_client.Commit) error
+	StopPipelineInTransaction(*txncontext.TransactionContext, *pps_client.StopPipelineRequest, *string, **pfs_client.Commit) error
 }
@@ -149,6 +149,11 @@ Data Uploaded: {{prettySize .Stats.UploadBytes}}
 Download Time: {{prettyDuration .Stats.DownloadTime}}
 Process Time: {{prettyDuration .Stats.ProcessTime}}
 Upload Time: {{prettyDuration .Stats.UploadTime}}
+CPU Time: {{prettyDuration .Stats.CPUTime}}
+Peak Memory: {{prettySize .Stats.PeakMemoryBytes}}
+Disk Read: {{prettySize .Stats.DiskReadBytes}}
+Disk Written: {{prettySize .Stats.DiskWriteBytes}}
+Peak Scratch Space: {{prettySize .Stats.ScratchBytes}}
 Datum Timeout: {{.DatumTimeout}}
 Job Timeout: {{.JobTimeout}}
 Worker Status:
@@ -303,6 +308,19 @@ func PrintDetailedDatumInfo(w io.Writer, datumInfo *ppsclient.DatumInfo) {
 	}
 	fmt.Fprintf(w, "Upload Time\t%s\n", uploadTime)
 
+	var cpuTime string
+	cpu, err := types.DurationFromProto(datumInfo.Stats.CPUTime)
+	if err != nil {
+		cpuTime = err.Error()
+	} else {
+		cpuTime = cpu.String()
+	}
+	fmt.Fprintf(w, "CPU Time\t%s\n", cpuTime)
+	fmt.Fprintf(w, "Peak Memory\t%s\n", pretty.Size(datumInfo.Stats.PeakMemoryBytes))
+	fmt.Fprintf(w, "Disk Read\t%s\n", pretty.Size(datumInfo.Stats.DiskReadBytes))
+	fmt.Fprintf(w, "Disk Written\t%s\n", pretty.Size(datumInfo.Stats.DiskWriteBytes))
+	fmt.Fprintf(w, "Scratch Space\t%s\n", pretty.Size(datumInfo.Stats.ScratchBytes))
+
 	fmt.Fprintf(w, "PFS State:\n")
 	tw := ansiterm.NewTabWriter(w, 10, 1, 3, ' ', 0)
 	PrintFileHeader(tw)
@@ -7,7 +7,7 @@ import (
 	"fmt"
 	"math"
 	"path"
-	"sort"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -20,6 +20,7 @@ import (
 	"github.com/itchyny/gojq"
 	"github.com/jmoiron/sqlx"
 	opentracing "github.com/opentracing/opentracing-go"
+	glob "github.com/pachyderm/ohmyglob"
 	"github.com/robfig/cron"
 	logrus "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
@@ -27,7 +28,6 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	kube "k8s.io/client-go/kubernetes"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/client"
@@ -114,6 +114,8 @@ type apiServer struct {
 	httpPort              uint16
 	peerPort              uint16
 	gcPercent             int
+	// workers creates, scales and deletes the workers of each pipeline
+	workers workerBackend
 	// collections
 	pipelines col.PostgresCollection
 	jobs      col.PostgresCollection
@@ -210,6 +212,22 @@ func (a *apiServer) validateInput(pipelineName string, input *pps.Input) error {
 				return errors.Errorf("input cannot specify both 's3' and " +
 					"'empty_files', as 's3' requires input data to be accessed via " +
 					"Pachyderm's S3 gateway rather than the file system")
+			case input.Pfs.MinSizeBytes < 0 || input.Pfs.MaxSizeBytes < 0:
+				return errors.Errorf("input cannot specify a negative 'min_size_bytes' " +
+					"or 'max_size_bytes'")
+			case input.Pfs.MaxSizeBytes > 0 && input.Pfs.MinSizeBytes > input.Pfs.MaxSizeBytes:
+				return errors.Errorf("input 'min_size_bytes' cannot be larger than " +
+					"'max_size_bytes'")
+			}
+			if input.Pfs.ExcludeGlob != "" {
+				if _, err := glob.Compile(input.Pfs.ExcludeGlob, '/'); err != nil {
+					return errors.Wrapf(err, "invalid 'exclude_glob'")
+				}
+			}
+			if input.Pfs.PathRegex != "" {
+				if _, err := regexp.Compile(input.Pfs.PathRegex); err != nil {
+					return errors.Wrapf(err, "invalid 'path_regex'")
+				}
 			}
 		}
 		if input.Cross != nil {
@@ -1034,9 +1052,21 @@ func (a *apiServer) ListDatum(request *pps.ListDatumRequest, server pps.API_List
 
 func (a *apiServer) listDatumInput(ctx context.Context, input *pps.Input, cb func(*datum.Meta) error) error {
 	setInputDefaults("", input)
+	di, err := a.newInputIterator(ctx, input)
+	if err != nil {
+		return err
+	}
+	return di.Iterate(func(meta *datum.Meta) error {
+		return cb(meta)
+	})
+}
+
+// newInputIterator returns a datum iterator over the current head commits of
+// the branches in 'input'.
+func (a *apiServer) newInputIterator(ctx context.Context, input *pps.Input) (datum.Iterator, error) {
+	pachClient := a.env.GetPachClient(ctx)
 	if visitErr := pps.VisitInput(input, func(input *pps.Input) error {
 		if input.Pfs != nil {
-			pachClient := a.env.GetPachClient(ctx)
 			ci, err := pachClient.InspectCommit(input.Pfs.Repo, input.Pfs.Branch, "")
 			if err != nil {
 				return err
@@ -1048,16 +1078,9 @@ func (a *apiServer) listDatumInput(ctx context.Context, input *pps.Input, cb fun
 		}
 		return nil
 	}); visitErr != nil {
-		return visitErr
+		return nil, visitErr
 	}
-	pachClient := a.env.GetPachClient(ctx)
-	di, err := datum.NewIterator(pachClient, input)
-	if err != nil {
-		return err
-	}
-	return di.Iterate(func(meta *datum.Meta) error {
-		return cb(meta)
-	})
+	return datum.NewIterator(pachClient, input)
 }
 
 func convertDatumMetaToInfo(meta *datum.Meta) *pps.DatumInfo {
@@ -1172,12 +1195,13 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 		}
 	}
 
-	// Get pods managed by the RC we're scraping (either pipeline or pachd)
-	pods, err := a.rcPods(rcName)
+	// Get workers (pods) managed by the RC we're scraping (either pipeline or
+	// pachd)
+	workers, err := a.workers.ListWorkers(apiGetLogsServer.Context(), rcName)
 	if err != nil {
 		return errors.Wrapf(err, "could not get pods in rc \"%s\" containing logs", rcName)
 	}
-	if len(pods) == 0 {
+	if len(workers) == 0 {
 		return errors.Errorf("no pods belonging to the rc \"%s\" were found", rcName)
 	}
 	// Convert request.From to a usable timestamp.
@@ -1189,14 +1213,14 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 
 	// Spawn one goroutine per pod. Each goro writes its pod's logs to a channel
 	// and channels are read into the output server in a stable order.
-	// (sort the pods to make sure that the order of log lines is stable)
-	sort.Sort(podSlice(pods))
+	// (ListWorkers sorts the pods to make sure that the order of log lines is
+	// stable)
 	logCh := make(chan *pps.LogMessage)
 	var eg errgroup.Group
 	var mu sync.Mutex
 	eg.Go(func() error {
-		for _, pod := range pods {
-			pod := pod
+		for _, worker := range workers {
+			worker := worker
 			if !request.Follow {
 				mu.Lock()
 			}
@@ -1209,13 +1233,13 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 					tailLines = nil
 				}
 				// Get full set of logs from pod i
-				stream, err := a.env.GetKubeClient().CoreV1().Pods(a.namespace).GetLogs(
-					pod.ObjectMeta.Name, &v1.PodLogOptions{
+				stream, err := a.workers.WorkerLogs(apiGetLogsServer.Context(), worker,
+					&v1.PodLogOptions{
 						Container:    containerName,
 						Follow:       request.Follow,
 						TailLines:    tailLines,
 						SinceSeconds: &sinceSeconds,
-					}).Timeout(10 * time.Second).Stream()
+					})
 				if err != nil {
 					return err
 				}
@@ -1553,32 +1577,6 @@ func branchProvenance(input *pps.Input) []*pfs.Branch {
 	return result
 }
 
-// writePipelineInfo is a helper for CreatePipeline that creates a commit with
-// 'pipelineInfo' in SpecRepo (in PFS). It's called in both the case where a
-// user is updating a pipeline and the case where a user is creating a new
-// pipeline.
-// pipelineInfo.SpecCommit is used to ensure that (in the absence of
-// transactionality) the new pipelineInfo is only applied on top of the previous
-// pipelineInfo - if the head of the spec branch has changed, this operation
-// will error out.
-func (a *apiServer) writePipelineInfo(ctx context.Context, pipelineInfo *pps.PipelineInfo) (*pfs.Commit, error) {
-	filesetID, err := a.writePipelineInfoToFileset(ctx, pipelineInfo)
-	if err != nil {
-		return nil, err
-	}
-
-	var commit *pfs.Commit
-	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		var err error
-		commit, err = a.commitPipelineInfoFromFileset(txnCtx, pipelineInfo.Pipeline.Name, filesetID, pipelineInfo.SpecCommit)
-		return err
-	}); err != nil {
-		return nil, err
-	}
-
-	return commit, nil
-}
-
 func (a *apiServer) writePipelineInfoToFileset(ctx context.Context, pipelineInfo *pps.PipelineInfo) (string, error) {
 	data, err := pipelineInfo.Marshal()
 	if err != nil {
@@ -1723,7 +1721,7 @@ func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txncontext.Tra
 // getExpectedNumWorkers is a helper function for CreatePipeline that transforms
 // the parallelism spec in CreatePipelineRequest.Parallelism into a constant
 // that can be stored in StoredPipelineInfo.Parallelism
-func getExpectedNumWorkers(kc *kube.Clientset, pipelineInfo *pps.PipelineInfo) (int, error) {
+func getExpectedNumWorkers(numNodes func() (int, error), pipelineInfo *pps.PipelineInfo) (int, error) {
 	switch pspec := pipelineInfo.ParallelismSpec; {
 	case pspec == nil, pspec.Constant == 0 && pspec.Coefficient == 0:
 		return 1, nil
@@ -1731,16 +1729,15 @@ func getExpectedNumWorkers(kc *kube.Clientset, pipelineInfo *pps.PipelineInfo) (
 		return int(pspec.Constant), nil
 	case pspec.Constant == 0 && pspec.Coefficient > 0:
 		// Start ('coefficient' * 'nodes') workers. Determine number of workers
-		nodeList, err := kc.CoreV1().Nodes().List(metav1.ListOptions{})
+		nodes, err := numNodes()
 		if err != nil {
-			return 0, errors.Wrapf(err, "unable to retrieve node list from k8s to determine parallelism")
+			return 0, errors.Wrapf(err, "unable to determine parallelism")
 		}
-		if len(nodeList.Items) == 0 {
-			return 0, errors.Errorf("unable to determine parallelism for %q: no k8s nodes found",
+		if nodes == 0 {
+			return 0, errors.Errorf("unable to determine parallelism for %q: no nodes found",
 				pipelineInfo.Pipeline.Name)
 		}
-		numNodes := len(nodeList.Items)
-		floatParallelism := math.Floor(pspec.Coefficient * float64(numNodes))
+		floatParallelism := math.Floor(pspec.Coefficient * float64(nodes))
 		return int(math.Max(floatParallelism, 1)), nil
 	default:
 		return 0, errors.Errorf("unable to interpret ParallelismSpec %+v", pspec)
@@ -1768,7 +1765,7 @@ func getExpectedNumWorkers(kc *kube.Clientset, pipelineInfo *pps.PipelineInfo) (
 //   request.Reprocess == true).
 // - Rather than try to enumerate every case where we can't create a spec
 //   commit without stopping the pipeline, we just always stop the pipeline
-func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipelineRequest) (response *types.Empty, retErr error) {
+func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipelineRequest) (response *pps.CreatePipelineResponse, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "CreatePipeline")
@@ -1778,6 +1775,14 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 		return nil, errors.New("request.Pipeline cannot be nil")
 	}
 
+	if request.DryRun {
+		dryRun, err := a.dryRunPipeline(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		return &pps.CreatePipelineResponse{DryRun: dryRun}, nil
+	}
+
 	// Annotate current span with pipeline & persist any extended trace to etcd
 	span := opentracing.SpanFromContext(ctx)
 	tracing.TagAnySpan(span, "pipeline", request.Pipeline.Name)
@@ -1794,7 +1799,7 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 	}); err != nil {
 		return nil, err
 	}
-	return &types.Empty{}, nil
+	return &pps.CreatePipelineResponse{}, nil
 }
 
 func (a *apiServer) initializePipelineInfo(request *pps.CreatePipelineRequest, oldPipelineInfo *pps.PipelineInfo) (*pps.PipelineInfo, error) {
@@ -1906,18 +1911,17 @@ func (a *apiServer) pipelineInfosForUpdate(txnCtx *txncontext.TransactionContext
 	return oldPipelineInfo, newPipelineInfo, nil
 }
 
-func (a *apiServer) CreatePipelineInTransaction(
+// ensurePipelineSpecFileset makes sure that 'specFilesetID' refers to a live
+// fileset containing 'newPipelineInfo', which must be written outside of the
+// transaction. If a new fileset has to be written, this returns a transaction
+// conflict so that the transaction is retried and can see the new fileset.
+func (a *apiServer) ensurePipelineSpecFileset(
 	txnCtx *txncontext.TransactionContext,
-	request *pps.CreatePipelineRequest,
+	oldPipelineInfo *pps.PipelineInfo,
+	newPipelineInfo *pps.PipelineInfo,
 	specFilesetID *string,
 	prevSpecCommit **pfs.Commit,
 ) error {
-	oldPipelineInfo, newPipelineInfo, err := a.pipelineInfosForUpdate(txnCtx, request)
-	if err != nil {
-		return err
-	}
-	pipelineName := request.Pipeline.Name
-
 	if *specFilesetID != "" {
 		// If we already have a fileset, try to renew it - if that fails, invalidate it
 		if err := a.env.GetPachClient(txnCtx.ClientContext).RenewFileSet(*specFilesetID, 600*time.Second); err != nil {
@@ -1937,6 +1941,7 @@ func (a *apiServer) CreatePipelineInTransaction(
 	if staleFileset || *specFilesetID == "" {
 		// No existing fileset or the old one expired, create a new fileset - the
 		// pipeline spec to be written into a fileset outside of the transaction.
+		var err error
 		*specFilesetID, err = a.writePipelineInfoToFileset(txnCtx.ClientContext, newPipelineInfo)
 		if err != nil {
 			return err
@@ -1948,6 +1953,27 @@ func (a *apiServer) CreatePipelineInTransaction(
 		// The transaction cannot continue because it cannot see the fileset - abort and retry
 		return &col.ErrTransactionConflict{}
 	}
+	return nil
+}
+
+func (a *apiServer) CreatePipelineInTransaction(
+	txnCtx *txncontext.TransactionContext,
+	request *pps.CreatePipelineRequest,
+	specFilesetID *string,
+	prevSpecCommit **pfs.Commit,
+) error {
+	if request.DryRun {
+		return errors.New("pipeline dry runs are not supported in transactions")
+	}
+	oldPipelineInfo, newPipelineInfo, err := a.pipelineInfosForUpdate(txnCtx, request)
+	if err != nil {
+		return err
+	}
+	pipelineName := request.Pipeline.Name
+
+	if err := a.ensurePipelineSpecFileset(txnCtx, oldPipelineInfo, newPipelineInfo, specFilesetID, prevSpecCommit); err != nil {
+		return err
+	}
 
 	// Verify that all input repos exist (create cron and git repos if necessary)
 	if visitErr := pps.VisitInput(newPipelineInfo.Input, func(input *pps.Input) error {
@@ -2002,7 +2028,7 @@ func (a *apiServer) CreatePipelineInTransaction(
 	)
 
 	// Get the expected number of workers for this pipeline
-	parallelism, err := getExpectedNumWorkers(a.env.GetKubeClient(), newPipelineInfo)
+	parallelism, err := getExpectedNumWorkers(a.workers.NumNodes, newPipelineInfo)
 	if err != nil {
 		return err
 	}
@@ -2333,7 +2359,6 @@ func (a *apiServer) inspectPipeline(ctx context.Context, name string) (*pps.Pipe
 }
 
 func (a *apiServer) inspectPipelineInTransaction(txnCtx *txncontext.TransactionContext, name string) (*pps.PipelineInfo, error) {
-	kubeClient := a.env.GetKubeClient()
 	name, ancestors, err := ancestry.Parse(name)
 	if err != nil {
 		return nil, err
@@ -2357,13 +2382,13 @@ func (a *apiServer) inspectPipelineInTransaction(txnCtx *txncontext.TransactionC
 		if err != nil {
 			return nil, err
 		}
-		service, err := kubeClient.CoreV1().Services(a.namespace).Get(fmt.Sprintf("%s-user", rcName), metav1.GetOptions{})
+		serviceIP, err := a.workers.ServiceIP(txnCtx.ClientContext, rcName)
 		if err != nil {
 			if !isNotFoundErr(err) {
 				return nil, err
 			}
 		} else {
-			pipelineInfo.Service.IP = service.Spec.ClusterIP
+			pipelineInfo.Service.IP = serviceIP
 		}
 	}
 
@@ -2556,77 +2581,79 @@ func (a *apiServer) listPipelinePtr(ctx context.Context,
 func (a *apiServer) DeletePipeline(ctx context.Context, request *pps.DeletePipelineRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
-	// Possibly list pipelines in etcd (skip PFS read--don't need it) and delete them
-	if request.All {
-		request.Pipeline = &pps.Pipeline{}
-		pipelinePtr := &pps.StoredPipelineInfo{}
-		if err := a.pipelines.ReadOnly(ctx).List(pipelinePtr, col.DefaultOptions(), func(string) error {
-			request.Pipeline.Name = pipelinePtr.Pipeline.Name
-			_, err := a.deletePipeline(ctx, request)
-			return err
-		}); err != nil {
-			return nil, err
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return txn.DeletePipeline(request)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// DeletePipelineInTransaction is identical to DeletePipeline except that it can
+// run inside an existing postgres transaction.  This is not an RPC.
+func (a *apiServer) DeletePipelineInTransaction(txnCtx *txncontext.TransactionContext, request *pps.DeletePipelineRequest) error {
+	if !request.All {
+		if request.Pipeline == nil {
+			return errors.New("request.Pipeline cannot be nil")
 		}
-		return &types.Empty{}, nil
+		return a.deletePipeline(txnCtx, request)
 	}
 
-	// Otherwise delete single pipeline from request
-	return a.deletePipeline(ctx, request)
+	// List pipelines in postgres (skip PFS read--don't need it) and delete them
+	var names []string
+	pipelinePtr := &pps.StoredPipelineInfo{}
+	if err := a.pipelines.ReadOnly(txnCtx.ClientContext).List(pipelinePtr, col.DefaultOptions(), func(string) error {
+		names = append(names, pipelinePtr.Pipeline.Name)
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, name := range names {
+		req := proto.Clone(request).(*pps.DeletePipelineRequest)
+		req.All = false
+		req.Pipeline = &pps.Pipeline{Name: name}
+		if err := a.deletePipeline(txnCtx, req); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipelineRequest) (response *types.Empty, retErr error) {
+func (a *apiServer) deletePipeline(txnCtx *txncontext.TransactionContext, request *pps.DeletePipelineRequest) error {
+	pipelineName := request.Pipeline.Name
 	// Check if there's an StoredPipelineInfo for this pipeline. If not, we can't
 	// authorize, and must return something here
 	pipelinePtr := pps.StoredPipelineInfo{}
-	if err := a.pipelines.ReadOnly(ctx).Get(request.Pipeline.Name, &pipelinePtr); err != nil {
+	if err := a.pipelines.ReadWrite(txnCtx.SqlTx).Get(pipelineName, &pipelinePtr); err != nil {
 		if col.IsErrNotFound(err) {
-			return &types.Empty{}, nil
+			return nil
 		}
-		return nil, err
+		return err
 	}
 
-	// Get current pipeline info from:
-	// - etcdPipelineInfo
-	// - spec commit in etcdPipelineInfo (which may not be the HEAD of the
-	//   pipeline's spec branch)
-	// - kubernetes services (for service pipelines, etc)
-	pipelineInfo, err := a.inspectPipeline(ctx, request.Pipeline.Name)
+	// Get current pipeline info from the spec commit in the StoredPipelineInfo
+	// (which may not be the HEAD of the pipeline's spec branch). The spec commit
+	// is read outside of the transaction, so if the pipeline was created earlier
+	// in this transaction we fall back to a minimal PipelineInfo.
+	pipelineInfo, err := ppsutil.GetPipelineInfo(a.env.GetPachClient(txnCtx.ClientContext), &pipelinePtr)
 	if err != nil {
 		logrus.Errorf("error inspecting pipeline: %v", err)
 		pipelineInfo = &pps.PipelineInfo{Pipeline: request.Pipeline, OutputBranch: "master"}
 	}
 
-	pachClient := a.env.GetPachClient(ctx)
-
 	// check if the output repo exists--if not, the pipeline is non-functional and
 	// the rest of the delete operation continues without any auth checks
-	if _, err := pachClient.InspectRepo(request.Pipeline.Name); err != nil && !isNotFoundErr(err) && !auth.IsErrNoRoleBinding(err) {
-		return nil, err
+	outputRepoExists := false
+	if _, err := a.env.PfsServer().InspectRepoInTransaction(txnCtx, &pfs.InspectRepoRequest{
+		Repo: client.NewRepo(pipelineName),
+	}); err != nil && !isNotFoundErr(err) && !auth.IsErrNoRoleBinding(err) {
+		return err
 	} else if err == nil {
-		// Check if the caller is authorized to delete this pipeline. This must be
-		// done after cleaning up the spec branch HEAD commit, because the
-		// authorization condition depends on the pipeline's PipelineInfo
-		if err := a.authorizePipelineOp(ctx, pipelineOpDelete, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
-			return nil, err
-		}
-		if request.KeepRepo {
-			// Remove branch provenance (pass branch twice so that it continues to point
-			// at the same commit, but also pass empty provenance slice)
-			if err := pachClient.CreateBranch(
-				request.Pipeline.Name,
-				pipelineInfo.OutputBranch,
-				pipelineInfo.OutputBranch,
-				"",
-				nil,
-			); err != nil {
-				return nil, err
-			}
-		} else {
-			// delete the pipeline's output repo
-			if err := pachClient.DeleteRepo(request.Pipeline.Name, request.Force); err != nil {
-				return nil, err
-			}
+		// Check if the caller is authorized to delete this pipeline
+		if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpDelete, pipelineInfo.Input, pipelineName); err != nil {
+			return err
 		}
+		outputRepoExists = true
 	}
 
 	// If necessary, revoke the pipeline's auth token and remove it from its
@@ -2634,164 +2661,178 @@ func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipel
 	if pipelinePtr.AuthToken != "" {
 		// If auth was deactivated after the pipeline was created, don't bother
 		// revoking
-		if _, err := pachClient.WhoAmI(pachClient.Ctx(), &auth.WhoAmIRequest{}); err == nil {
+		if _, err := a.env.AuthServer().WhoAmI(txnCtx.ClientContext, &auth.WhoAmIRequest{}); err == nil {
 			// 'pipelineInfo' == nil => remove pipeline from all input repos
-			if err := a.fixPipelineInputRepoACLs(ctx, nil, pipelineInfo); err != nil {
-				return nil, grpcutil.ScrubGRPC(err)
+			if err := a.fixPipelineInputRepoACLsInTransaction(txnCtx, nil, pipelineInfo); err != nil {
+				return grpcutil.ScrubGRPC(err)
 			}
-			if _, err := pachClient.RevokeAuthToken(pachClient.Ctx(),
+			if _, err := a.env.AuthServer().RevokeAuthTokenInTransaction(txnCtx,
 				&auth.RevokeAuthTokenRequest{
 					Token: pipelinePtr.AuthToken,
 				}); err != nil {
-
-				return nil, grpcutil.ScrubGRPC(err)
+				return grpcutil.ScrubGRPC(err)
 			}
 		}
 	}
 
-	// Kill or delete all of the pipeline's jobs
+	// Kill and delete all of the pipeline's jobs. This is done before the output
+	// repo is deleted, as killing a job finishes its output commit.
 	// TODO(msteffen): a job may be created by the worker master after this step
 	// but before the pipeline RC is deleted. Check for orphaned jobs in
 	// pollPipelines.
-	var eg errgroup.Group
-	pipelinJobPtr := &pps.StoredJobInfo{}
-	if err := a.jobs.ReadOnly(ctx).GetByIndex(ppsdb.JobsPipelineIndex, request.Pipeline.Name, pipelinJobPtr, col.DefaultOptions(), func(jobID string) error {
-		eg.Go(func() error {
-			_, err := a.DeleteJob(ctx, &pps.DeleteJobRequest{Job: client.NewJob(jobID)})
-			if isNotFoundErr(err) || auth.IsErrNoRoleBinding(err) {
-				return nil
-			}
-			return err
-		})
+	jobs := a.jobs.ReadWrite(txnCtx.SqlTx)
+	var jobIDs []string
+	jobPtr := &pps.StoredJobInfo{}
+	if err := jobs.GetByIndex(ppsdb.JobsPipelineIndex, pipelineName, jobPtr, col.DefaultOptions(), func(jobID string) error {
+		jobIDs = append(jobIDs, jobID)
 		return nil
 	}); err != nil {
-		return nil, err
+		return err
 	}
-	if err := eg.Wait(); err != nil {
-		return nil, err
+	for _, jobID := range jobIDs {
+		if err := a.stopJob(txnCtx, client.NewJob(jobID), nil, "job deleted"); err != nil && !isNotFoundErr(err) {
+			return err
+		}
+		if err := jobs.Delete(jobID); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+	}
+
+	if outputRepoExists {
+		if request.KeepRepo {
+			// Remove branch provenance (pass branch as its own head so that it
+			// continues to point at the same commit, but with no provenance)
+			if err := a.env.PfsServer().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+				Branch: client.NewBranch(pipelineName, pipelineInfo.OutputBranch),
+				Head:   client.NewCommit(pipelineName, pipelineInfo.OutputBranch, ""),
+			}); err != nil {
+				return err
+			}
+		} else {
+			// delete the pipeline's output repo
+			if err := a.env.PfsServer().DeleteRepoInTransaction(txnCtx, &pfs.DeleteRepoRequest{
+				Repo:  client.NewRepo(pipelineName),
+				Force: request.Force,
+			}); err != nil {
+				return err
+			}
+		}
 	}
 
-	eg = errgroup.Group{}
 	// Delete cron input repos
 	if !request.KeepRepo {
-		pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) error {
+		if err := pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) error {
 			if input.Cron != nil {
-				eg.Go(func() error {
-					return pachClient.DeleteRepo(input.Cron.Repo, request.Force)
+				return a.env.PfsServer().DeleteRepoInTransaction(txnCtx, &pfs.DeleteRepoRequest{
+					Repo:  client.NewRepo(input.Cron.Repo),
+					Force: request.Force,
 				})
 			}
 			return nil
-		})
-	}
-	// Delete StoredPipelineInfo
-	eg.Go(func() error {
-		if err := col.NewSQLTx(ctx, a.env.GetDBClient(), func(sqlTx *sqlx.Tx) error {
-			return a.pipelines.ReadWrite(sqlTx).Delete(request.Pipeline.Name)
 		}); err != nil {
-			return errors.Wrapf(err, "collection.Delete")
+			return err
 		}
-		return nil
-	})
-	if err := eg.Wait(); err != nil {
-		return nil, err
 	}
-	return &types.Empty{}, nil
+
+	// Delete StoredPipelineInfo
+	if err := a.pipelines.ReadWrite(txnCtx.SqlTx).Delete(pipelineName); err != nil {
+		return errors.Wrapf(err, "collection.Delete")
+	}
+	return nil
 }
 
 // StartPipeline implements the protobuf pps.StartPipeline RPC
 func (a *apiServer) StartPipeline(ctx context.Context, request *pps.StartPipelineRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
-	if request.Pipeline == nil {
-		return nil, errors.New("request.Pipeline cannot be nil")
-	}
-
-	// Get request.Pipeline's info
-	var pipelineInfo *pps.PipelineInfo
-	if err := a.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		var err error
-		pipelineInfo, err = a.latestPipelineInfo(txnCtx, request.Pipeline.Name)
-		return err
+	// Don't provide a fileset and the transaction env will generate it
+	filesetID := ""
+	var prevSpecCommit *pfs.Commit
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return txn.StartPipeline(request, &filesetID, &prevSpecCommit)
 	}); err != nil {
 		return nil, err
 	}
+	return &types.Empty{}, nil
+}
 
-	// check if the caller is authorized to update this pipeline
-	if err := a.authorizePipelineOp(ctx, pipelineOpUpdate, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
-		return nil, err
-	}
-
-	// Remove 'Stopped' from the pipeline spec
-	pipelineInfo.Stopped = false
-	commit, err := a.writePipelineInfo(ctx, pipelineInfo)
-	if err != nil {
-		return nil, err
-	}
-	if a.updatePipelineSpecCommit(ctx, request.Pipeline.Name, commit); err != nil {
-		return nil, err
-	}
-
-	pachClient := a.env.GetPachClient(ctx)
-	// Replace missing branch provenance (removed by StopPipeline)
-	provenance := append(branchProvenance(pipelineInfo.Input),
-		client.NewSystemRepo(pipelineInfo.Pipeline.Name, pfs.SpecRepoType).NewBranch("master"))
-	if err := pachClient.CreateBranch(
-		request.Pipeline.Name,
-		pipelineInfo.OutputBranch,
-		pipelineInfo.OutputBranch,
-		"",
-		provenance,
-	); err != nil {
-		return nil, err
+// StartPipelineInTransaction is identical to StartPipeline except that it can
+// run inside an existing postgres transaction.  This is not an RPC.
+func (a *apiServer) StartPipelineInTransaction(txnCtx *txncontext.TransactionContext, request *pps.StartPipelineRequest, specFilesetID *string, prevSpecCommit **pfs.Commit) error {
+	if request.Pipeline == nil {
+		return errors.New("request.Pipeline cannot be nil")
 	}
-	return &types.Empty{}, nil
+	return a.setPipelineStoppedInTransaction(txnCtx, request.Pipeline.Name, false, specFilesetID, prevSpecCommit)
 }
 
 // StopPipeline implements the protobuf pps.StopPipeline RPC
 func (a *apiServer) StopPipeline(ctx context.Context, request *pps.StopPipelineRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	// Don't provide a fileset and the transaction env will generate it
+	filesetID := ""
+	var prevSpecCommit *pfs.Commit
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return txn.StopPipeline(request, &filesetID, &prevSpecCommit)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// StopPipelineInTransaction is identical to StopPipeline except that it can
+// run inside an existing postgres transaction.  This is not an RPC.
+func (a *apiServer) StopPipelineInTransaction(txnCtx *txncontext.TransactionContext, request *pps.StopPipelineRequest, specFilesetID *string, prevSpecCommit **pfs.Commit) error {
 	if request.Pipeline == nil {
-		return nil, errors.New("request.Pipeline cannot be nil")
+		return errors.New("request.Pipeline cannot be nil")
 	}
+	return a.setPipelineStoppedInTransaction(txnCtx, request.Pipeline.Name, true, specFilesetID, prevSpecCommit)
+}
 
-	var pipelineInfo *pps.PipelineInfo
-	if err := a.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
-		var err error
-		pipelineInfo, err = a.latestPipelineInfo(txnCtx, request.Pipeline.Name)
+// setPipelineStoppedInTransaction writes a new spec commit for the pipeline
+// with 'Stopped' set to 'stopped', and updates the provenance of its output
+// branch accordingly: a stopped pipeline's output branch has no provenance, so
+// that no new output commits (and therefore jobs) are created.
+func (a *apiServer) setPipelineStoppedInTransaction(txnCtx *txncontext.TransactionContext, pipelineName string, stopped bool, specFilesetID *string, prevSpecCommit **pfs.Commit) error {
+	oldPipelineInfo, err := a.latestPipelineInfo(txnCtx, pipelineName)
+	if err != nil {
 		return err
-	}); err != nil {
-		return nil, err
+	}
+	if oldPipelineInfo == nil {
+		return newErrPipelineNotFound(pipelineName)
 	}
 
 	// check if the caller is authorized to update this pipeline
-	if err := a.authorizePipelineOp(ctx, pipelineOpUpdate, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
-		return nil, err
+	if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpUpdate, oldPipelineInfo.Input, pipelineName); err != nil {
+		return err
 	}
 
-	pachClient := a.env.GetPachClient(ctx)
-	// Remove branch provenance (pass branch twice so that it continues to point
-	// at the same commit, but also pass empty provenance slice)
-	if err := pachClient.CreateBranch(
-		request.Pipeline.Name,
-		pipelineInfo.OutputBranch,
-		pipelineInfo.OutputBranch,
-		"",
-		nil,
-	); err != nil {
-		return nil, err
+	newPipelineInfo := proto.Clone(oldPipelineInfo).(*pps.PipelineInfo)
+	newPipelineInfo.Stopped = stopped
+	if err := a.ensurePipelineSpecFileset(txnCtx, oldPipelineInfo, newPipelineInfo, specFilesetID, prevSpecCommit); err != nil {
+		return err
 	}
-
-	// Update PipelineInfo with new state
-	pipelineInfo.Stopped = true
-	commit, err := a.writePipelineInfo(ctx, pipelineInfo)
+	specCommit, err := a.commitPipelineInfoFromFileset(txnCtx, pipelineName, *specFilesetID, *prevSpecCommit)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	if a.updatePipelineSpecCommit(ctx, request.Pipeline.Name, commit); err != nil {
-		return nil, err
+	if err := a.updatePipelineSpecCommit(txnCtx, pipelineName, specCommit); err != nil {
+		return err
 	}
-	return &types.Empty{}, nil
+
+	var provenance []*pfs.Branch
+	if !stopped {
+		// Replace missing branch provenance (removed by StopPipeline)
+		provenance = append(branchProvenance(newPipelineInfo.Input),
+			client.NewSystemRepo(pipelineName, pfs.SpecRepoType).NewBranch("master"))
+	}
+	// Pass the branch as its own head so that it continues to point at the
+	// same commit
+	return a.env.PfsServer().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+		Branch:     client.NewBranch(pipelineName, newPipelineInfo.OutputBranch),
+		Head:       client.NewCommit(pipelineName, newPipelineInfo.OutputBranch, ""),
+		Provenance: provenance,
+	})
 }
 
 func (a *apiServer) RunPipeline(ctx context.Context, request *pps.RunPipelineRequest) (response *types.Empty, retErr error) {
@@ -3183,15 +3224,11 @@ func isNotFoundErr(err error) bool {
 	return err != nil && strings.Contains(err.Error(), "not found")
 }
 
-func (a *apiServer) updatePipelineSpecCommit(ctx context.Context, pipelineName string, commit *pfs.Commit) error {
-	err := col.NewSQLTx(ctx, a.env.GetDBClient(), func(sqlTx *sqlx.Tx) error {
-		pipelines := a.pipelines.ReadWrite(sqlTx)
-		pipelinePtr := &pps.StoredPipelineInfo{}
-		if err := pipelines.Get(pipelineName, pipelinePtr); err != nil {
-			return err
-		}
+func (a *apiServer) updatePipelineSpecCommit(txnCtx *txncontext.TransactionContext, pipelineName string, commit *pfs.Commit) error {
+	pipelinePtr := &pps.StoredPipelineInfo{}
+	err := a.pipelines.ReadWrite(txnCtx.SqlTx).Update(pipelineName, pipelinePtr, func() error {
 		pipelinePtr.SpecCommit = commit
-		return pipelines.Put(pipelineName, pipelinePtr)
+		return nil
 	})
 	if isNotFoundErr(err) {
 		return newErrPipelineNotFound(pipelineName)
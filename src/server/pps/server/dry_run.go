@@ -0,0 +1,122 @@
+package server
+
+import (
+	"context"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/transactionenv/txncontext"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/pipeline/transform/chain"
+)
+
+// pipelineHasher hashes datums the same way the pipeline's workers do, so that
+// a dry run skips exactly the datums that a job would skip.
+type pipelineHasher struct {
+	name string
+	salt string
+}
+
+func (h *pipelineHasher) Hash(inputs []*common.Input) string {
+	return common.HashDatum(h.name, h.salt, inputs)
+}
+
+// sizeIterator wraps a datum iterator and totals the size of the input files
+// in the datums that it yields.
+type sizeIterator struct {
+	datum.Iterator
+	size *int64
+}
+
+func (si *sizeIterator) Iterate(cb func(*datum.Meta) error) error {
+	*si.size = 0
+	return si.Iterator.Iterate(func(meta *datum.Meta) error {
+		for _, input := range meta.Inputs {
+			*si.size += int64(input.FileInfo.SizeBytes)
+		}
+		return cb(meta)
+	})
+}
+
+// dryRunPipeline validates 'request' and computes the datums that a job of the
+// pipeline it describes would process against the current heads of the
+// pipeline's inputs, without creating or updating the pipeline.
+func (a *apiServer) dryRunPipeline(ctx context.Context, request *pps.CreatePipelineRequest) (*pps.PipelineDryRun, error) {
+	var oldPipelineInfo, newPipelineInfo *pps.PipelineInfo
+	if err := a.txnEnv.WithReadContext(ctx, func(txnCtx *txncontext.TransactionContext) error {
+		var err error
+		oldPipelineInfo, newPipelineInfo, err = a.pipelineInfosForUpdate(txnCtx, request)
+		if err != nil {
+			return err
+		}
+		operation := pipelineOpCreate
+		if request.Update {
+			operation = pipelineOpUpdate
+		}
+		return a.authorizePipelineOpInTransaction(txnCtx, operation, newPipelineInfo.Input, newPipelineInfo.Pipeline.Name)
+	}); err != nil {
+		return nil, err
+	}
+	if oldPipelineInfo != nil && !request.Update {
+		return nil, newErrPipelineExists(request.Pipeline.Name)
+	}
+	pachClient := a.env.GetPachClient(ctx)
+	dit, err := a.newInputIterator(ctx, newPipelineInfo.Input)
+	if err != nil {
+		return nil, err
+	}
+	result := &pps.PipelineDryRun{}
+	hasher := &pipelineHasher{
+		name: newPipelineInfo.Pipeline.Name,
+		salt: newPipelineInfo.Salt,
+	}
+	var opts []chain.JobChainOption
+	if newPipelineInfo.ReprocessSpec == client.ReprocessSpecEveryJob || newPipelineInfo.S3Out {
+		opts = append(opts, chain.WithNoSkip())
+	}
+	if oldPipelineInfo != nil {
+		// Compare against the datums of the most recent job that finished.
+		metaCommit, err := latestFinishedCommit(pachClient, client.NewSystemRepo(request.Pipeline.Name, pfs.MetaRepoType).NewCommit("master", ""))
+		if err != nil {
+			return nil, err
+		}
+		if metaCommit != nil {
+			opts = append(opts, chain.WithBase(datum.NewCommitIterator(pachClient, metaCommit)))
+		}
+	}
+	jdi := chain.NewJobChain(pachClient, hasher, opts...).CreateJob(ctx, uuid.NewWithoutDashes(), &sizeIterator{
+		Iterator: dit,
+		size:     &result.InputSizeBytes,
+	}, nil)
+	jdi.SetDeleter(func(_ *datum.Meta) error {
+		result.RemovedDatums++
+		return nil
+	})
+	if err := jdi.Iterate(func(_ *datum.Meta) error {
+		result.NewDatums++
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	result.SkippedDatums = jdi.Stats().Skipped
+	return result, nil
+}
+
+// latestFinishedCommit returns 'commit', or its most recent ancestor that is
+// finished. It returns nil if there is no such commit.
+func latestFinishedCommit(pachClient *client.APIClient, commit *pfs.Commit) (*pfs.Commit, error) {
+	for commit != nil {
+		ci, err := pachClient.InspectCommit(commit.Branch.Repo.Name, commit.Branch.Name, commit.ID)
+		if err != nil {
+			return nil, err
+		}
+		if ci.Finished != nil {
+			return ci.Commit, nil
+		}
+		commit = ci.ParentCommit
+	}
+	return nil, nil
+}
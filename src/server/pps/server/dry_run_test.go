@@ -0,0 +1,104 @@
+package server
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func putFiles(t *testing.T, c *client.APIClient, repo string, files ...string) *pfs.Commit {
+	commit, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	for _, file := range files {
+		require.NoError(t, c.PutFile(commit, file, bytes.NewBufferString(file)))
+	}
+	require.NoError(t, c.FinishCommit(repo, "master", commit.ID))
+	return commit
+}
+
+func runDryRun(t *testing.T, c *client.APIClient, pipeline string, stdin []string, input *pps.Input) *pps.PipelineDryRun {
+	resp, err := c.PpsAPIClient.CreatePipeline(c.Ctx(), &pps.CreatePipelineRequest{
+		Pipeline:  client.NewPipeline(pipeline),
+		Transform: &pps.Transform{Cmd: []string{"bash"}, Stdin: stdin},
+		Input:     input,
+		Update:    true,
+		DryRun:    true,
+	})
+	require.NoError(t, err)
+	return resp.DryRun
+}
+
+func requireDryRun(t *testing.T, dryRun *pps.PipelineDryRun, newDatums, skippedDatums, removedDatums int64) {
+	t.Helper()
+	require.Equal(t, newDatums, dryRun.NewDatums)
+	require.Equal(t, skippedDatums, dryRun.SkippedDatums)
+	require.Equal(t, removedDatums, dryRun.RemovedDatums)
+}
+
+func TestDryRunPipelineUpdate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := newLocalPPSEnv(t).PachClient
+
+	repo := tu.UniqueString("TestDryRunPipelineUpdate_data")
+	other := tu.UniqueString("TestDryRunPipelineUpdate_other")
+	pipeline := tu.UniqueString("TestDryRunPipelineUpdate")
+	require.NoError(t, c.CreateRepo(repo))
+	require.NoError(t, c.CreateRepo(other))
+	commit := putFiles(t, c, repo, "a", "b", "c")
+	putFiles(t, c, other, "d", "e")
+
+	stdin := []string{"cp -r pfs/" + repo + "/. pfs/out/"}
+	require.NoError(t, c.CreatePipeline(pipeline, "", []string{"bash"}, stdin,
+		&pps.ParallelismSpec{Constant: 1}, client.NewPFSInput(repo, "/*"), "", false))
+	_, err := c.FlushCommitAll([]*pfs.Commit{commit}, []*pfs.Repo{client.NewRepo(pipeline)})
+	require.NoError(t, err)
+
+	// An unchanged spec reuses every datum of the last job
+	dryRun := runDryRun(t, c, pipeline, stdin, client.NewPFSInput(repo, "/*"))
+	requireDryRun(t, dryRun, 0, 3, 0)
+	require.Equal(t, int64(3), dryRun.InputSizeBytes)
+
+	// A new file only adds its own datum
+	putFiles(t, c, repo, "f")
+	requireDryRun(t, runDryRun(t, c, pipeline, stdin, client.NewPFSInput(repo, "/*")), 1, 3, 0)
+
+	// A new glob replaces every datum
+	requireDryRun(t, runDryRun(t, c, pipeline, stdin, client.NewPFSInput(repo, "/")), 1, 0, 3)
+
+	// So does a new input
+	requireDryRun(t, runDryRun(t, c, pipeline, stdin, client.NewPFSInput(other, "/*")), 2, 0, 3)
+
+	// Dry runs don't modify the pipeline
+	pipelineInfo, err := c.InspectPipeline(pipeline)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), pipelineInfo.Version)
+	require.Equal(t, "/*", pipelineInfo.Input.Pfs.Glob)
+	require.Equal(t, repo, pipelineInfo.Input.Pfs.Repo)
+}
+
+func TestDryRunPipelineNoFinishedCommit(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := newLocalPPSEnv(t).PachClient
+
+	repo := tu.UniqueString("TestDryRunPipelineNoFinishedCommit_data")
+	pipeline := tu.UniqueString("TestDryRunPipelineNoFinishedCommit")
+	require.NoError(t, c.CreateRepo(repo))
+	putFiles(t, c, repo, "a", "b", "c")
+
+	// The pipeline's first job never finishes, so there's nothing to compare
+	// against
+	stdin := []string{"sleep 600"}
+	require.NoError(t, c.CreatePipeline(pipeline, "", []string{"bash"}, stdin,
+		&pps.ParallelismSpec{Constant: 1}, client.NewPFSInput(repo, "/*"), "", false))
+	requireDryRun(t, runDryRun(t, c, pipeline, stdin, client.NewPFSInput(repo, "/*")), 3, 0, 0)
+	require.NoError(t, c.DeletePipeline(pipeline, true))
+}
@@ -0,0 +1,210 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	kube_err "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_watch "k8s.io/apimachinery/pkg/watch"
+	kube "k8s.io/client-go/kubernetes"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// kubeWorkers is the default workerBackend, which runs each pipeline's workers
+// as pods managed by a kubernetes ReplicationController.
+type kubeWorkers struct {
+	a *apiServer
+}
+
+func (k *kubeWorkers) Validate() {
+	k.a.validateKube()
+}
+
+func (k *kubeWorkers) CreateWorkers(ctx context.Context, ptr *pps.StoredPipelineInfo, pipelineInfo *pps.PipelineInfo) error {
+	return k.a.createWorkerSvcAndRc(ctx, ptr, pipelineInfo)
+}
+
+func (k *kubeWorkers) DeleteWorkers(ctx context.Context, pipeline string) error {
+	kubeClient := k.a.env.GetKubeClient()
+	namespace := k.a.namespace
+
+	// Delete any services associated with op.pipeline
+	selector := fmt.Sprintf("%s=%s", pipelineNameLabel, pipeline)
+	opts := &metav1.DeleteOptions{
+		OrphanDependents: &falseVal,
+	}
+	services, err := kubeClient.CoreV1().Services(namespace).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return errors.Wrapf(err, "could not list services")
+	}
+	for _, service := range services.Items {
+		if err := kubeClient.CoreV1().Services(namespace).Delete(service.Name, opts); err != nil {
+			if !isNotFoundErr(err) {
+				return errors.Wrapf(err, "could not delete service %q", service.Name)
+			}
+		}
+	}
+
+	// Delete any secrets associated with op.pipeline
+	secrets, err := kubeClient.CoreV1().Secrets(namespace).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return errors.Wrapf(err, "could not list secrets")
+	}
+	for _, secret := range secrets.Items {
+		if err := kubeClient.CoreV1().Secrets(namespace).Delete(secret.Name, opts); err != nil {
+			if !isNotFoundErr(err) {
+				return errors.Wrapf(err, "could not delete secret %q", secret.Name)
+			}
+		}
+	}
+
+	// Finally, delete op.pipeline's RC, which will cause pollPipelines to stop
+	// polling it.
+	rcs, err := kubeClient.CoreV1().ReplicationControllers(namespace).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return errors.Wrapf(err, "could not list RCs")
+	}
+	for _, rc := range rcs.Items {
+		if err := kubeClient.CoreV1().ReplicationControllers(namespace).Delete(rc.Name, opts); err != nil {
+			if !isNotFoundErr(err) {
+				return errors.Wrapf(err, "could not delete RC %q", rc.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func (k *kubeWorkers) ListWorkerRCs(ctx context.Context, pipeline string) ([]v1.ReplicationController, error) {
+	selector := "suite=pachyderm," + pipelineNameLabel
+	if pipeline != "" {
+		selector = fmt.Sprintf("%s=%s", pipelineNameLabel, pipeline)
+	}
+	rcs, err := k.a.env.GetKubeClient().CoreV1().ReplicationControllers(k.a.namespace).List(
+		metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		if isNotFoundErr(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return rcs.Items, nil
+}
+
+func (k *kubeWorkers) UpdateWorkerRC(ctx context.Context, rc *v1.ReplicationController) error {
+	_, err := k.a.env.GetKubeClient().CoreV1().ReplicationControllers(k.a.namespace).Update(rc)
+	return err
+}
+
+// WatchWorkerFailures creates a kubernetes watch, and for each event:
+//   1) Checks if the event concerns a Pod
+//   2) Checks if the Pod belongs to a pipeline (pipelineName annotation is set)
+//   3) Checks if the Pod is failing
+// If all three conditions are met, then 'onFailure' is called with the
+// pipeline in 'pipelineName'
+func (k *kubeWorkers) WatchWorkerFailures(ctx context.Context, onFailure func(pipeline, reason string) error) error {
+	kubePipelineWatch, err := k.a.env.GetKubeClient().CoreV1().Pods(k.a.namespace).Watch(
+		metav1.ListOptions{
+			LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(
+				map[string]string{
+					"component": "worker",
+				})),
+			Watch: true,
+		})
+	if err != nil {
+		return errors.Wrap(err, "failed to watch kubernetes pods")
+	}
+	defer kubePipelineWatch.Stop()
+	for {
+		var event kube_watch.Event
+		var ok bool
+		select {
+		case event, ok = <-kubePipelineWatch.ResultChan():
+			if !ok {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		// if we get an error we restart the watch
+		if event.Type == kube_watch.Error {
+			return errors.Wrap(kube_err.FromObject(event.Object), "error while watching kubernetes pods")
+		} else if event.Type == "" {
+			// k8s watches seem to sometimes get stuck in a loop returning events
+			// with Type = "". We treat these as errors as otherwise we get an
+			// endless stream of them and can't do anything.
+			return errors.New("error while watching kubernetes pods: empty event type")
+		}
+		pod, ok := event.Object.(*v1.Pod)
+		if !ok {
+			continue // irrelevant event
+		}
+		if pod.Status.Phase == v1.PodFailed {
+			log.Errorf("pod failed because: %s", pod.Status.Message)
+		}
+		pipelineName := pod.ObjectMeta.Annotations["pipelineName"]
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.State.Waiting != nil && failures[status.State.Waiting.Reason] {
+				if err := onFailure(pipelineName, status.State.Waiting.Message); err != nil {
+					return err
+				}
+			}
+		}
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == v1.PodScheduled &&
+				condition.Status != v1.ConditionTrue && failures[condition.Reason] {
+				if err := onFailure(pipelineName, condition.Message); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func (k *kubeWorkers) ListWorkers(ctx context.Context, rcName string) ([]string, error) {
+	pods, err := k.a.rcPods(rcName)
+	if err != nil {
+		return nil, err
+	}
+	// sort the pods to make sure that the order of log lines is stable
+	sort.Sort(podSlice(pods))
+	var names []string
+	for _, pod := range pods {
+		names = append(names, pod.ObjectMeta.Name)
+	}
+	return names, nil
+}
+
+func (k *kubeWorkers) WorkerLogs(ctx context.Context, worker string, opts *v1.PodLogOptions) (io.ReadCloser, error) {
+	return k.a.env.GetKubeClient().CoreV1().Pods(k.a.namespace).GetLogs(worker, opts).
+		Timeout(10 * time.Second).Stream()
+}
+
+func (k *kubeWorkers) ServiceIP(ctx context.Context, rcName string) (string, error) {
+	service, err := k.a.env.GetKubeClient().CoreV1().Services(k.a.namespace).Get(
+		fmt.Sprintf("%s-user", rcName), metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return service.Spec.ClusterIP, nil
+}
+
+func (k *kubeWorkers) NumNodes() (int, error) {
+	return kubeNumNodes(k.a.env.GetKubeClient())
+}
+
+// kubeNumNodes returns the number of nodes in the kubernetes cluster
+func kubeNumNodes(kc *kube.Clientset) (int, error) {
+	nodeList, err := kc.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return 0, errors.Wrapf(err, "unable to retrieve node list from k8s")
+	}
+	return len(nodeList.Items), nil
+}
@@ -0,0 +1,443 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// localLogPollInterval is how often a followed log file is checked for new
+// lines once its end has been reached
+const localLogPollInterval = 500 * time.Millisecond
+
+// localWorkers is a workerBackend that runs each pipeline worker as a process
+// on the pachd host, rather than as a pod in kubernetes. It's meant for
+// development and testing, where no kubernetes cluster is available:
+//   - User code runs directly on the host, so a pipeline's transform.cmd must
+//     be runnable there (the pipeline's image is ignored).
+//   - Each worker gets its own scratch directory (in place of the worker
+//     container's filesystem root) and log file under the configured root.
+//   - Workers only live as long as the pachd that spawned them. After a
+//     restart, the PPS master finds no RCs and recreates every pipeline's
+//     workers.
+type localWorkers struct {
+	a      *apiServer
+	binary string // path to the worker binary
+	root   string // directory holding each worker's scratch dir and log file
+
+	mu  sync.Mutex
+	rcs map[string]*localRC // rc name -> RC (protected by mu)
+
+	failures chan localWorkerFailure
+}
+
+// localRC is the local stand-in for a pipeline's ReplicationController. The PPS
+// master reads and updates 'rc' exactly as it would a kubernetes RC, and
+// localWorkers starts or stops worker processes to match its replica count.
+type localRC struct {
+	rc      v1.ReplicationController
+	env     []string // environment shared by all of the RC's workers
+	workers []*localWorker
+}
+
+type localWorker struct {
+	name    string
+	logPath string
+	cancel  func()
+	done    chan struct{} // closed once the worker process has exited for good
+}
+
+type localWorkerFailure struct {
+	pipeline string
+	reason   string
+}
+
+func newLocalWorkers(a *apiServer) *localWorkers {
+	return &localWorkers{
+		a:        a,
+		binary:   a.env.Config().PPSLocalWorkerBinary,
+		root:     a.env.Config().PPSLocalWorkerRoot,
+		rcs:      make(map[string]*localRC),
+		failures: make(chan localWorkerFailure, 100),
+	}
+}
+
+func (l *localWorkers) Validate() {
+	errors := false
+	if _, err := exec.LookPath(l.binary); err != nil {
+		errors = true
+		log.Errorf("unable to find the worker binary %q, Pachyderm will not be able to run pipelines until this is fixed. error: %v", l.binary, err)
+	}
+	if err := os.MkdirAll(l.root, 0755); err != nil {
+		errors = true
+		log.Errorf("unable to create the local worker root %q, Pachyderm will not be able to run pipelines until this is fixed. error: %v", l.root, err)
+	}
+	if !errors {
+		log.Infof("validating local worker backend returned no errors")
+	}
+}
+
+func (l *localWorkers) CreateWorkers(ctx context.Context, ptr *pps.StoredPipelineInfo, pipelineInfo *pps.PipelineInfo) error {
+	log.Infof("PPS master: upserting local workers for %q", pipelineInfo.Pipeline.Name)
+	options, err := l.a.getWorkerOptions(ptr, pipelineInfo)
+	if err != nil {
+		return noValidOptionsErr{err}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.rcs[options.rcName]; ok {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Join(l.root, options.rcName), 0755); err != nil {
+		return errors.EnsureStack(err)
+	}
+	replicas := options.parallelism
+	l.rcs[options.rcName] = &localRC{
+		rc: v1.ReplicationController{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ReplicationController",
+				APIVersion: "v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        options.rcName,
+				Labels:      options.labels,
+				Annotations: options.annotations,
+			},
+			Spec: v1.ReplicationControllerSpec{
+				Selector: options.labels,
+				Replicas: &replicas,
+			},
+		},
+		env: l.workerEnv(options),
+	}
+	return nil
+}
+
+// workerEnv returns the environment shared by all local workers created from
+// 'options'. It starts from pachd's own environment, so that user code can
+// find the same binaries that pachd can.
+func (l *localWorkers) workerEnv(options *workerOptions) []string {
+	config := l.a.env.Config()
+	env := os.Environ()
+	for _, e := range options.workerEnv {
+		if e.ValueFrom != nil {
+			continue // secrets and downward API fields only exist in kubernetes
+		}
+		env = append(env, e.Name+"="+e.Value)
+	}
+	env = append(env,
+		"PACH_IN_WORKER=true",
+		"PACH_NAMESPACE="+l.a.namespace,
+		"PACH_ROOT="+l.a.storageRoot,
+		"ETCD_SERVICE_HOST="+config.EtcdHost,
+		"ETCD_SERVICE_PORT="+config.EtcdPort,
+		"POSTGRES_SERVICE_HOST="+config.PostgresServiceHost,
+		"POSTGRES_SERVICE_PORT="+strconv.Itoa(config.PostgresServicePort),
+		"POSTGRES_SERVICE_SSL="+config.PostgresServiceSSL,
+		"POSTGRES_DATABASE_NAME="+config.PostgresDBName,
+		// Local workers talk to this pachd directly, rather than to a sidecar
+		client.PeerPortEnv+"="+strconv.FormatUint(uint64(l.a.peerPort), 10),
+		client.PPSEtcdPrefixEnv+"="+l.a.etcdPrefix,
+		client.PPSSpecCommitEnv+"="+options.specCommit,
+		"METRICS="+strconv.FormatBool(config.Metrics),
+		"WORK_BACKEND="+config.WorkBackend,
+	)
+	if config.DisableCommitProgressCounter {
+		env = append(env, "DISABLE_COMMIT_PROGRESS_COUNTER=true")
+	}
+	if config.LokiLogging {
+		env = append(env, "LOKI_LOGGING=true")
+	}
+	return env
+}
+
+func (l *localWorkers) DeleteWorkers(ctx context.Context, pipeline string) error {
+	l.mu.Lock()
+	var rcNames []string
+	var workers []*localWorker
+	for name, lrc := range l.rcs {
+		if lrc.rc.Labels[pipelineNameLabel] == pipeline {
+			rcNames = append(rcNames, name)
+			workers = append(workers, lrc.workers...)
+			l.scaleTo(lrc, 0)
+			delete(l.rcs, name)
+		}
+	}
+	l.mu.Unlock()
+	// wait for the workers to exit before removing their scratch dirs and logs
+	for _, w := range workers {
+		<-w.done
+	}
+	for _, name := range rcNames {
+		if err := os.RemoveAll(filepath.Join(l.root, name)); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	return nil
+}
+
+func (l *localWorkers) ListWorkerRCs(ctx context.Context, pipeline string) ([]v1.ReplicationController, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var result []v1.ReplicationController
+	for _, lrc := range l.rcs {
+		if pipeline == "" || lrc.rc.Labels[pipelineNameLabel] == pipeline {
+			result = append(result, *lrc.rc.DeepCopy())
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+func (l *localWorkers) UpdateWorkerRC(ctx context.Context, rc *v1.ReplicationController) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	lrc, ok := l.rcs[rc.Name]
+	if !ok {
+		return errors.Errorf("RC %q not found", rc.Name)
+	}
+	lrc.rc = *rc.DeepCopy()
+	replicas := 1
+	if rc.Spec.Replicas != nil {
+		replicas = int(*rc.Spec.Replicas)
+	}
+	l.scaleTo(lrc, replicas)
+	return nil
+}
+
+// scaleTo starts or stops workers until 'lrc' has 'n' of them. l.mu must be
+// held by the caller.
+func (l *localWorkers) scaleTo(lrc *localRC, n int) {
+	for len(lrc.workers) < n {
+		lrc.workers = append(lrc.workers, l.startWorker(lrc))
+	}
+	for len(lrc.workers) > n {
+		last := lrc.workers[len(lrc.workers)-1]
+		last.cancel()
+		lrc.workers = lrc.workers[:len(lrc.workers)-1]
+	}
+}
+
+// startWorker launches a new worker process for 'lrc', which is restarted
+// (with backoff) whenever it exits until the worker is cancelled, as a pod's
+// container would be. l.mu must be held by the caller.
+func (l *localWorkers) startWorker(lrc *localRC) *localWorker {
+	name := fmt.Sprintf("%s-%s", lrc.rc.Name, uuid.NewWithoutDashes()[:5])
+	pipeline := lrc.rc.Labels[pipelineNameLabel]
+	ctx, cancel := context.WithCancel(l.a.env.Context())
+	w := &localWorker{
+		name:    name,
+		logPath: filepath.Join(l.root, lrc.rc.Name, name+".log"),
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	env := lrc.env
+	go func() {
+		defer close(w.done)
+		backoff.RetryUntilCancel(ctx, func() error {
+			err := l.runWorker(ctx, w, env)
+			if ctx.Err() != nil {
+				return nil // worker was scaled down or deleted
+			}
+			if err == nil {
+				err = errors.New("exited unexpectedly")
+			}
+			reason := fmt.Sprintf("worker %q failed: %v", w.name, err)
+			select {
+			case l.failures <- localWorkerFailure{pipeline: pipeline, reason: reason}:
+			default:
+				// the master isn't keeping up; it'll learn about the crash from the
+				// next failure
+			}
+			return errors.New(reason)
+		}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+			log.Errorf("PPS master: %v; restarting in %v", err, d)
+			return nil
+		})
+	}()
+	return w
+}
+
+// runWorker runs a single instance of 'w's process, until it exits or 'ctx'
+// is cancelled
+func (l *localWorkers) runWorker(ctx context.Context, w *localWorker, env []string) error {
+	scratch := filepath.Join(filepath.Dir(w.logPath), w.name)
+	if err := os.MkdirAll(scratch, 0755); err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer os.RemoveAll(scratch)
+	logFile, err := os.OpenFile(w.logPath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer logFile.Close()
+	port, err := freePort()
+	if err != nil {
+		return err
+	}
+	cmd := exec.CommandContext(ctx, l.binary)
+	cmd.Dir = scratch
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	// Workers normally register their pod IP, and are reached on the
+	// cluster-wide worker port. Local workers share a host, so each one listens
+	// on its own port and registers its full address instead.
+	cmd.Env = append(env,
+		client.PPSPodNameEnv+"="+w.name,
+		client.PPSWorkerIPEnv+"="+net.JoinHostPort("127.0.0.1", strconv.Itoa(port)),
+		client.PPSWorkerPortEnv+"="+strconv.Itoa(port),
+		"PPS_WORKER_ROOT="+scratch,
+	)
+	return errors.EnsureStack(cmd.Run())
+}
+
+// freePort returns a port on the loopback interface that's currently unused
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
+
+func (l *localWorkers) WatchWorkerFailures(ctx context.Context, onFailure func(pipeline, reason string) error) error {
+	for {
+		select {
+		case f := <-l.failures:
+			if err := onFailure(f.pipeline, f.reason); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (l *localWorkers) ListWorkers(ctx context.Context, rcName string) ([]string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	lrc, ok := l.rcs[rcName]
+	if !ok {
+		return nil, nil
+	}
+	var names []string
+	for _, w := range lrc.workers {
+		names = append(names, w.name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// WorkerLogs returns the contents of 'worker's log file. Local workers have a
+// single process, so opts.Container is ignored, as is opts.SinceSeconds.
+func (l *localWorkers) WorkerLogs(ctx context.Context, worker string, opts *v1.PodLogOptions) (io.ReadCloser, error) {
+	i := strings.LastIndex(worker, "-")
+	if i < 0 {
+		return nil, errors.Errorf("invalid local worker name %q", worker)
+	}
+	rcName := worker[:i]
+	f, err := os.Open(filepath.Join(l.root, rcName, worker+".log"))
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	if opts.TailLines != nil {
+		if err := seekToTail(f, *opts.TailLines); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+	if !opts.Follow {
+		return f, nil
+	}
+	return newFollowReader(ctx, f), nil
+}
+
+// seekToTail positions 'f' at the start of its last 'n' lines
+func seekToTail(f *os.File, n int64) error {
+	var offsets []int64 // start offsets of the last n+1 lines seen
+	var offset int64
+	r := bufio.NewReader(f)
+	for {
+		line, err := r.ReadBytes('\n')
+		if len(line) > 0 {
+			offsets = append(offsets, offset)
+			if int64(len(offsets)) > n {
+				offsets = offsets[1:]
+			}
+			offset += int64(len(line))
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	start := offset
+	if len(offsets) > 0 {
+		start = offsets[0]
+	}
+	_, err := f.Seek(start, io.SeekStart)
+	return errors.EnsureStack(err)
+}
+
+// followReader reads a file that's still being written, like 'tail -f'. Once
+// it reaches the end of the file it waits for more data, until its context is
+// cancelled.
+type followReader struct {
+	ctx context.Context
+	f   *os.File
+}
+
+func newFollowReader(ctx context.Context, f *os.File) *followReader {
+	return &followReader{ctx: ctx, f: f}
+}
+
+func (r *followReader) Read(p []byte) (int, error) {
+	for {
+		n, err := r.f.Read(p)
+		if n > 0 || !errors.Is(err, io.EOF) {
+			return n, err
+		}
+		select {
+		case <-r.ctx.Done():
+			return 0, io.EOF
+		case <-time.After(localLogPollInterval):
+		}
+	}
+}
+
+func (r *followReader) Close() error {
+	return r.f.Close()
+}
+
+// ServiceIP returns the loopback address, as local workers' user code runs on
+// the pachd host.
+func (l *localWorkers) ServiceIP(ctx context.Context, rcName string) (string, error) {
+	return "127.0.0.1", nil
+}
+
+// NumNodes always returns 1, as all local workers run on the pachd host.
+func (l *localWorkers) NumNodes() (int, error) {
+	return 1, nil
+}
@@ -0,0 +1,193 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfsload"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
+	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	ppsiface "github.com/pachyderm/pachyderm/v2/src/server/pps"
+	v1 "k8s.io/api/core/v1"
+)
+
+func writeLog(t testing.TB, content string) *os.File {
+	path := filepath.Join(t.TempDir(), "worker.log")
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { f.Close() })
+	return f
+}
+
+func TestSeekToTail(t *testing.T) {
+	for _, c := range []struct {
+		content  string
+		n        int64
+		expected string
+	}{
+		{"a\nb\nc\n", 2, "b\nc\n"},
+		{"a\nb\nc\n", 5, "a\nb\nc\n"},
+		{"a\nb\nc", 1, "c"},
+		{"a\nb\nc\n", 0, ""},
+		{"", 3, ""},
+	} {
+		f := writeLog(t, c.content)
+		require.NoError(t, seekToTail(f, c.n))
+		rest, err := ioutil.ReadAll(f)
+		require.NoError(t, err)
+		require.Equal(t, c.expected, string(rest))
+	}
+}
+
+func TestFollowReader(t *testing.T) {
+	f := writeLog(t, "first\n")
+	w, err := os.OpenFile(f.Name(), os.O_APPEND|os.O_WRONLY, 0644)
+	require.NoError(t, err)
+	defer w.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(2 * localLogPollInterval)
+		w.Write([]byte("second\n"))
+		time.Sleep(2 * localLogPollInterval)
+		cancel()
+	}()
+	content, err := ioutil.ReadAll(newFollowReader(ctx, f))
+	require.NoError(t, err)
+	require.Equal(t, "first\nsecond\n", string(content))
+}
+
+func TestWorkerLogsInvalidName(t *testing.T) {
+	l := &localWorkers{root: t.TempDir()}
+	_, err := l.WorkerLogs(context.Background(), "nodash", &v1.PodLogOptions{})
+	require.YesError(t, err)
+}
+
+// newLocalPPSEnv returns a real env whose PPS server runs pipeline workers as
+// local processes.
+func newLocalPPSEnv(t *testing.T) *testpachd.RealEnv {
+	binary := testpachd.BuildWorkerBinary(t)
+	return testpachd.NewRealEnvWithPPS(t, func(senv serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv) (ppsiface.APIServer, error) {
+		return NewAPIServer(senv, txnEnv, nil)
+	}, tu.NewTestDBConfig(t), func(config *serviceenv.Configuration) {
+		config.PPSLocalWorkerBinary = binary
+	})
+}
+
+func TestLocalWorkersPipeline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	env := newLocalPPSEnv(t)
+	c := env.PachClient
+
+	repo := tu.UniqueString("TestLocalWorkersPipeline_data")
+	pipeline := tu.UniqueString("TestLocalWorkersPipeline")
+	require.NoError(t, c.CreateRepo(repo))
+	// User code runs in the worker's scratch directory, which holds its pfs
+	// directory
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{"cp -r pfs/" + repo + "/. pfs/out/"},
+		&pps.ParallelismSpec{Constant: 1},
+		client.NewPFSInput(repo, "/*"),
+		"",
+		false,
+	))
+
+	commit, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit, "foo", bytes.NewBufferString("foo\n")))
+	require.NoError(t, c.PutFile(commit, "bar", bytes.NewBufferString("bar\n")))
+	require.NoError(t, c.FinishCommit(repo, "master", commit.ID))
+
+	commitInfos, err := c.FlushCommitAll([]*pfs.Commit{commit}, []*pfs.Repo{client.NewRepo(pipeline)})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(commitInfos))
+	for _, name := range []string{"foo", "bar"} {
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile(commitInfos[0].Commit, name, &buf))
+		require.Equal(t, name+"\n", buf.String())
+	}
+
+	jobInfos, err := c.ListJob(pipeline, nil, nil, -1, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobInfos))
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfos[0].State)
+
+	// Deleting the pipeline stops its workers
+	require.NoError(t, c.DeletePipeline(pipeline, false))
+	require.NoErrorWithinTRetry(t, time.Minute, func() error {
+		rcs, err := env.PPSServer.(*apiServer).workers.ListWorkerRCs(c.Ctx(), pipeline)
+		if err != nil {
+			return err
+		}
+		if len(rcs) > 0 {
+			return errors.Errorf("pipeline still has %d worker RCs", len(rcs))
+		}
+		return nil
+	})
+}
+
+func TestLocalWorkersPipelineScenario(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	env := newLocalPPSEnv(t)
+	c := env.PachClient
+
+	repo := tu.UniqueString("TestLocalWorkersPipelineScenario")
+	require.NoError(t, c.CreateRepo(repo))
+	loadSpec, err := pfsload.ParseLoadSpec([]byte(`
+count: 2
+operations:
+  - count: 2
+    operation:
+      - putFile:
+          files:
+            count: 5
+            file:
+              - source: "random"
+                prob: 100
+        prob: 100
+fileSources:
+  - name: "random"
+    random:
+      size:
+        - min: 100
+          max: 1000
+          prob: 100
+pipeline:
+  stdin:
+    - "cp -r pfs/` + repo + `/. pfs/out/"
+`))
+	require.NoError(t, err)
+	start := time.Now()
+	stats, err := pfsload.Scenario(c, repo, "master", &loadSpec.ScenarioSpec, 1)
+	require.NoError(t, err)
+	counts := make(map[string]int64)
+	for _, operation := range stats.Operations(time.Since(start)) {
+		counts[operation.Operation] = operation.Count
+	}
+	require.Equal(t, int64(2), counts["job"])
+
+	// The scenario deletes its pipeline once it's done
+	pipelineInfos, err := c.ListPipeline()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(pipelineInfos))
+}
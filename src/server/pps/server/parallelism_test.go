@@ -20,21 +20,22 @@ func wrap(t testing.TB, ps *pps.ParallelismSpec) *pps.PipelineInfo {
 
 func TestGetExpectedNumWorkers(t *testing.T) {
 	kubeClient := tu.GetKubeClient(t)
+	countNodes := func() (int, error) { return kubeNumNodes(kubeClient) }
 
 	// An empty parallelism spec should default to 1 worker
-	workers, err := getExpectedNumWorkers(kubeClient, wrap(t,
+	workers, err := getExpectedNumWorkers(countNodes, wrap(t,
 		&pps.ParallelismSpec{}))
 	require.NoError(t, err)
 	require.Equal(t, 1, workers)
 
 	// A constant should literally be returned
-	workers, err = getExpectedNumWorkers(kubeClient, wrap(t,
+	workers, err = getExpectedNumWorkers(countNodes, wrap(t,
 		&pps.ParallelismSpec{
 			Constant: 1,
 		}))
 	require.NoError(t, err)
 	require.Equal(t, 1, workers)
-	workers, err = getExpectedNumWorkers(kubeClient, wrap(t,
+	workers, err = getExpectedNumWorkers(countNodes, wrap(t,
 		&pps.ParallelismSpec{
 			Constant: 3,
 		}))
@@ -42,7 +43,7 @@ func TestGetExpectedNumWorkers(t *testing.T) {
 	require.Equal(t, 3, workers)
 
 	// Constant and Coefficient cannot both be non-zero
-	_, err = getExpectedNumWorkers(kubeClient, wrap(t,
+	_, err = getExpectedNumWorkers(countNodes, wrap(t,
 		&pps.ParallelismSpec{
 			Constant:    3,
 			Coefficient: 0.5,
@@ -50,7 +51,7 @@ func TestGetExpectedNumWorkers(t *testing.T) {
 	require.YesError(t, err)
 
 	// No parallelism spec should default to 1 worker
-	workers, err = getExpectedNumWorkers(kubeClient, wrap(t, nil))
+	workers, err = getExpectedNumWorkers(countNodes, wrap(t, nil))
 	require.NoError(t, err)
 	require.Equal(t, 1, workers)
 
@@ -59,7 +60,7 @@ func TestGetExpectedNumWorkers(t *testing.T) {
 	numNodes := len(nodes.Items)
 
 	// Coefficient == 1
-	parellelism, err := getExpectedNumWorkers(kubeClient, wrap(t,
+	parellelism, err := getExpectedNumWorkers(countNodes, wrap(t,
 		&pps.ParallelismSpec{
 			Coefficient: 1,
 		}))
@@ -67,7 +68,7 @@ func TestGetExpectedNumWorkers(t *testing.T) {
 	require.Equal(t, numNodes, parellelism)
 
 	// Coefficient > 1
-	parellelism, err = getExpectedNumWorkers(kubeClient, wrap(t,
+	parellelism, err = getExpectedNumWorkers(countNodes, wrap(t,
 		&pps.ParallelismSpec{
 			Coefficient: 2,
 		}))
@@ -75,7 +76,7 @@ func TestGetExpectedNumWorkers(t *testing.T) {
 	require.Equal(t, 2*numNodes, parellelism)
 
 	// Make sure we start at least one worker
-	parellelism, err = getExpectedNumWorkers(kubeClient, wrap(t,
+	parellelism, err = getExpectedNumWorkers(countNodes, wrap(t,
 		&pps.ParallelismSpec{
 			Coefficient: 0.01,
 		}))
@@ -19,7 +19,6 @@ import (
 	opentracing "github.com/opentracing/opentracing-go"
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type rcExpectation byte
@@ -250,7 +249,7 @@ func (op *pipelineOp) getPipelineInfo() error {
 // exist--if set to 'rcExpected', getRC will restart the pipeline if no RC is
 // found after three retries. If set to 'noRCExpected', then getRC will return
 // after the first "not found" error. If set to noExpectation, then getRC will
-// retry the kubeclient.List() RPC, but will not restart the pipeline if no RC
+// retry the workers.ListWorkerRCs() call, but will not restart the pipeline if no RC
 // is found
 //
 // Unlike other functions in this file, getRC takes responsibility for restarting
@@ -265,32 +264,27 @@ func (op *pipelineOp) getRC(expectation rcExpectation) (retErr error) {
 		tracing.FinishAnySpan(span)
 	}(span)
 
-	kubeClient := op.m.a.env.GetKubeClient()
-	namespace := op.m.a.namespace
-	selector := fmt.Sprintf("%s=%s", pipelineNameLabel, op.ptr.Pipeline.Name)
-
 	// count error types separately, so that this only errors if the pipeline is
 	// stuck and not changing
 	var notFoundErrCount, unexpectedErrCount, staleErrCount, tooManyErrCount,
 		otherErrCount int
 	return backoff.RetryNotify(func() error {
 		// List all RCs, so stale RCs from old pipelines are noticed and deleted
-		rcs, err := kubeClient.CoreV1().ReplicationControllers(namespace).List(
-			metav1.ListOptions{LabelSelector: selector})
+		rcs, err := op.m.a.workers.ListWorkerRCs(op.ctx, op.ptr.Pipeline.Name)
 		if err != nil && !isNotFoundErr(err) {
 			return err
 		}
-		if len(rcs.Items) == 0 {
+		if len(rcs) == 0 {
 			op.rc = nil
 			return errRCNotFound
 		}
 
-		op.rc = &rcs.Items[0]
+		op.rc = &rcs[0]
 		switch {
-		case len(rcs.Items) > 1:
+		case len(rcs) > 1:
 			// select stale RC if possible, so that we delete it in restartPipeline
-			for i := range rcs.Items {
-				op.rc = &rcs.Items[i]
+			for i := range rcs {
+				op.rc = &rcs[i]
 				if !op.rcIsFresh() {
 					break
 				}
@@ -390,7 +384,7 @@ func (op *pipelineOp) setPipelineState(state pps.PipelineState, reason string) e
 // createPipelineResources creates the RC and any services for op's pipeline.
 func (op *pipelineOp) createPipelineResources() error {
 	log.Infof("PPS master: creating resources for pipeline %q", op.ptr.Pipeline.Name)
-	if err := op.m.a.createWorkerSvcAndRc(op.ctx, op.ptr, op.pipelineInfo); err != nil {
+	if err := op.m.a.workers.CreateWorkers(op.ctx, op.ptr, op.pipelineInfo); err != nil {
 		if errors.As(err, &noValidOptionsErr{}) {
 			// these errors indicate invalid pipelineInfo, don't retry
 			return stepError{
@@ -471,21 +465,17 @@ func (op *pipelineOp) deletePipelineResources() error {
 }
 
 // updateRC is a helper for {scaleUp,scaleDown}Pipeline. It includes all of the
-// logic for writing an updated RC spec to kubernetes, and updating/retrying if
-// k8s rejects the write. It presents a strange API, since the the RC being
+// logic for writing an updated RC spec to the worker backend, and
+// updating/retrying if the backend rejects the write. It presents a strange API, since the the RC being
 // updated is already available to the caller in op.rc, but update() may be
 // called muliple times if the k8s write fails. It may be helpful to think of
 // the rc passed to update() as mutable, while op.rc is immutable.
 func (op *pipelineOp) updateRC(update func(rc *v1.ReplicationController)) error {
-	kubeClient := op.m.a.env.GetKubeClient()
-	namespace := op.m.a.namespace
-	rc := kubeClient.CoreV1().ReplicationControllers(namespace)
-
 	newRC := *op.rc
 	// Apply op's update to rc
 	update(&newRC)
-	// write updated RC to k8s
-	if _, err := rc.Update(&newRC); err != nil {
+	// write updated RC to the worker backend
+	if err := op.m.a.workers.UpdateWorkerRC(op.ctx, &newRC); err != nil {
 		return newRetriableError(err, "error updating RC")
 	}
 	return nil
@@ -5,10 +5,6 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
-	v1 "k8s.io/api/core/v1"
-	kube_err "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	kube_watch "k8s.io/apimachinery/pkg/watch"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -74,8 +70,8 @@ func (m *ppsMaster) cancelPipelineEtcdPoller() {
 //////////////////////////////////////////////////////////////////////////////
 
 // pollPipelines generates regular updateEv and deleteEv events for each
-// pipeline and sends them to ppsMaster.Run(). By scanning etcd and k8s
-// regularly and generating events for them, it prevents pipelines from
+// pipeline and sends them to ppsMaster.Run(). By scanning etcd and the worker
+// backend regularly and generating events for them, it prevents pipelines from
 // getting orphaned.
 func (m *ppsMaster) pollPipelines(ctx context.Context) {
 	etcdPipelines := map[string]bool{}
@@ -84,15 +80,12 @@ func (m *ppsMaster) pollPipelines(ctx context.Context) {
 			// 1. Get the current set of pipeline RCs.
 			//
 			// We'll delete any RCs that don't correspond to a live pipeline after
-			// querying etcd to determine the set of live pipelines, but we query k8s
-			// first to avoid a race (if we were to query etcd first, and
-			// CreatePipeline(foo) were to run between querying etcd and querying k8s,
+			// querying etcd to determine the set of live pipelines, but we query the
+			// worker backend first to avoid a race (if we were to query etcd first, and
+			// CreatePipeline(foo) were to run between querying etcd and the backend,
 			// then we might delete the RC for brand-new pipeline 'foo'). Even if we
 			// do delete a live pipeline's RC, it'll be fixed in the next cycle)
-			kc := m.a.env.GetKubeClient().CoreV1().ReplicationControllers(m.a.env.Config().Namespace)
-			rcs, err := kc.List(metav1.ListOptions{
-				LabelSelector: "suite=pachyderm,pipelineName",
-			})
+			rcs, err := m.a.workers.ListWorkerRCs(ctx, "")
 			if err != nil {
 				// No sensible error recovery here (e.g .if we can't reach k8s). We'll
 				// keep going, and just won't delete any RCs this round.
@@ -114,7 +107,7 @@ func (m *ppsMaster) pollPipelines(ctx context.Context) {
 
 			// 3. Generate a delete event for orphaned RCs
 			if rcs != nil {
-				for _, rc := range rcs.Items {
+				for _, rc := range rcs {
 					pipeline, ok := rc.Labels["pipelineName"]
 					if !ok {
 						return errors.New("'pipelineName' label missing from rc " + rc.Name)
@@ -164,59 +157,18 @@ func (m *ppsMaster) pollPipelines(ctx context.Context) {
 	}
 }
 
-// pollPipelinePods creates a kubernetes watch, and for each event:
-//   1) Checks if the event concerns a Pod
-//   2) Checks if the Pod belongs to a pipeline (pipelineName annotation is set)
-//   3) Checks if the Pod is failing
-// If all three conditions are met, then the pipline (in 'pipelineName') is set
-// to CRASHING
+// pollPipelinePods watches the worker backend for failing workers (in
+// kubernetes, pods that can't pull their image or be scheduled), and sets the
+// pipeline that each failing worker belongs to to CRASHING
 func (m *ppsMaster) pollPipelinePods(ctx context.Context) {
 	if err := backoff.RetryUntilCancel(ctx, backoff.MustLoop(func() error {
-		kubePipelineWatch, err := m.a.env.GetKubeClient().CoreV1().Pods(m.a.namespace).Watch(
-			metav1.ListOptions{
-				LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(
-					map[string]string{
-						"component": "worker",
-					})),
-				Watch: true,
-			})
-		if err != nil {
-			return errors.Wrap(err, "failed to watch kubernetes pods")
-		}
-		defer kubePipelineWatch.Stop()
-		for event := range kubePipelineWatch.ResultChan() {
-			// if we get an error we restart the watch
-			if event.Type == kube_watch.Error {
-				return errors.Wrap(kube_err.FromObject(event.Object), "error while watching kubernetes pods")
-			} else if event.Type == "" {
-				// k8s watches seem to sometimes get stuck in a loop returning events
-				// with Type = "". We treat these as errors as otherwise we get an
-				// endless stream of them and can't do anything.
-				return errors.New("error while watching kubernetes pods: empty event type")
-			}
-			pod, ok := event.Object.(*v1.Pod)
-			if !ok {
-				continue // irrelevant event
-			}
-			if pod.Status.Phase == v1.PodFailed {
-				log.Errorf("pod failed because: %s", pod.Status.Message)
-			}
-			pipelineName := pod.ObjectMeta.Annotations["pipelineName"]
-			for _, status := range pod.Status.ContainerStatuses {
-				if status.State.Waiting != nil && failures[status.State.Waiting.Reason] {
-					if err := m.a.setPipelineCrashing(ctx, pipelineName, status.State.Waiting.Message); err != nil {
-						return errors.Wrap(err, "error moving pipeline to CRASHING")
-					}
-				}
-			}
-			for _, condition := range pod.Status.Conditions {
-				if condition.Type == v1.PodScheduled &&
-					condition.Status != v1.ConditionTrue && failures[condition.Reason] {
-					if err := m.a.setPipelineCrashing(ctx, pipelineName, condition.Message); err != nil {
-						return errors.Wrap(err, "error moving pipeline to CRASHING")
-					}
-				}
+		if err := m.a.workers.WatchWorkerFailures(ctx, func(pipeline, reason string) error {
+			if err := m.a.setPipelineCrashing(ctx, pipeline, reason); err != nil {
+				return errors.Wrap(err, "error moving pipeline to CRASHING")
 			}
+			return nil
+		}); err != nil {
+			return err
 		}
 		return backoff.ErrContinue // keep polling until cancelled (RetryUntilCancel)
 	}), backoff.NewInfiniteBackOff(), backoff.NotifyContinue("pollPipelinePods"),
@@ -43,7 +43,12 @@ func NewAPIServer(
 		peerPort:              env.Config().PeerPort,
 		gcPercent:             env.Config().GCPercent,
 	}
-	apiServer.validateKube()
+	workers, err := newWorkerBackend(apiServer)
+	if err != nil {
+		return nil, err
+	}
+	apiServer.workers = workers
+	apiServer.workers.Validate()
 	go apiServer.master()
 	return apiServer, nil
 }
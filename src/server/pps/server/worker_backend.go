@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"io"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+const (
+	// kubeWorkerBackend runs pipeline workers as pods managed by a kubernetes
+	// ReplicationController (the default)
+	kubeWorkerBackend = "kubernetes"
+	// localWorkerBackend runs pipeline workers as processes on the pachd host
+	localWorkerBackend = "local"
+)
+
+// workerBackend is the interface through which the PPS master creates, scales,
+// monitors and deletes the workers belonging to a pipeline.
+//
+// Workers are always described by a ReplicationController, even if the
+// backend isn't kubernetes: the pipeline controller stores the pipeline
+// version, spec commit and auth token hash in the RC's annotations and
+// compares them against the pipeline's current state, and the number of
+// running workers is the RC's replica count. Backends that don't run on
+// kubernetes synthesize equivalent RCs for their workers.
+type workerBackend interface {
+	// Validate checks that the backend is usable, logging any problems it
+	// finds. It doesn't return an error, as pachd can still serve some requests
+	// if e.g. it can't create workers.
+	Validate()
+
+	// CreateWorkers creates the RC (initially scaled to zero) and any services
+	// used by the workers of 'pipelineInfo'. It's not an error if they already
+	// exist.
+	CreateWorkers(ctx context.Context, ptr *pps.StoredPipelineInfo, pipelineInfo *pps.PipelineInfo) error
+
+	// DeleteWorkers deletes all RCs, workers, services and secrets belonging to
+	// 'pipeline'. It's not an error if they don't exist.
+	DeleteWorkers(ctx context.Context, pipeline string) error
+
+	// ListWorkerRCs returns the RCs belonging to 'pipeline', or the RCs of all
+	// pipelines if 'pipeline' is empty.
+	ListWorkerRCs(ctx context.Context, pipeline string) ([]v1.ReplicationController, error)
+
+	// UpdateWorkerRC writes 'rc', which must have been returned by
+	// ListWorkerRCs, back to the backend. This is how pipelines are scaled.
+	UpdateWorkerRC(ctx context.Context, rc *v1.ReplicationController) error
+
+	// WatchWorkerFailures calls 'onFailure' each time a pipeline's workers fail
+	// in a way that should move the pipeline to CRASHING. It blocks until 'ctx'
+	// is cancelled or the watch fails, and should be called in a retry loop.
+	WatchWorkerFailures(ctx context.Context, onFailure func(pipeline, reason string) error) error
+
+	// ListWorkers returns the names of the workers (pods, in kubernetes)
+	// managed by the RC 'rcName', sorted by name.
+	ListWorkers(ctx context.Context, rcName string) ([]string, error)
+
+	// WorkerLogs returns a stream of the logs written by opts.Container in the
+	// worker 'worker', which must have been returned by ListWorkers.
+	WorkerLogs(ctx context.Context, worker string, opts *v1.PodLogOptions) (io.ReadCloser, error)
+
+	// ServiceIP returns the IP at which the user service of a service
+	// pipeline, whose workers are managed by the RC 'rcName', can be reached.
+	ServiceIP(ctx context.Context, rcName string) (string, error)
+
+	// NumNodes returns the number of nodes workers can be scheduled on, which
+	// is used to compute the number of workers for COEFFICIENT parallelism.
+	NumNodes() (int, error)
+}
+
+// newWorkerBackend returns the workerBackend selected by pachd's
+// PPS_WORKER_BACKEND setting.
+func newWorkerBackend(a *apiServer) (workerBackend, error) {
+	switch backend := a.env.Config().PPSWorkerBackend; backend {
+	case kubeWorkerBackend, "":
+		return &kubeWorkers{a: a}, nil
+	case localWorkerBackend:
+		return newLocalWorkers(a), nil
+	default:
+		return nil, errors.Errorf("unrecognized worker backend %q (must be %q or %q)",
+			backend, kubeWorkerBackend, localWorkerBackend)
+	}
+}
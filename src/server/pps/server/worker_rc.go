@@ -123,6 +123,9 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 	}, {
 		Name:  "METRICS",
 		Value: strconv.FormatBool(a.env.Config().Metrics),
+	}, {
+		Name:  "WORK_BACKEND",
+		Value: a.env.Config().WorkBackend,
 	}}
 	sidecarEnv = append(sidecarEnv, assets.GetSecretEnvVars(a.storageBackend)...)
 	sidecarEnv = append(sidecarEnv, a.getStorageEnvVars(pipelineInfo)...)
@@ -184,6 +187,10 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 			Name:  "METRICS",
 			Value: strconv.FormatBool(a.env.Config().Metrics),
 		},
+		{
+			Name:  "WORK_BACKEND",
+			Value: a.env.Config().WorkBackend,
+		},
 	}...)
 	workerEnv = append(workerEnv, assets.GetSecretEnvVars(a.storageBackend)...)
 
@@ -245,6 +252,12 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 		sidecarVolumeMounts = append(sidecarVolumeMounts, emptyDirVolumeMount)
 		userVolumeMounts = append(userVolumeMounts, emptyDirVolumeMount)
 	}
+	// Share the node's on-disk chunk cache with the sidecar.
+	if hostPath := a.env.Config().StorageChunkCacheHostPath; hostPath != "" {
+		chunkCacheVolume, chunkCacheMount := assets.GetChunkCacheVolumeAndMount(hostPath)
+		options.volumes = append(options.volumes, chunkCacheVolume)
+		sidecarVolumeMounts = append(sidecarVolumeMounts, chunkCacheMount)
+	}
 	secretVolume, secretMount := assets.GetBackendSecretVolumeAndMount(a.storageBackend)
 	options.volumes = append(options.volumes, secretVolume)
 	sidecarVolumeMounts = append(sidecarVolumeMounts, secretMount)
@@ -433,6 +446,13 @@ func (a *apiServer) getStorageEnvVars(pipelineInfo *pps.PipelineInfo) []v1.EnvVa
 		{Name: assets.UploadConcurrencyLimitEnvVar, Value: strconv.Itoa(a.env.Config().StorageUploadConcurrencyLimit)},
 		{Name: client.PPSPipelineNameEnv, Value: pipelineInfo.Pipeline.Name},
 	}
+	if a.env.Config().StorageChunkCacheHostPath != "" {
+		vars = append(vars, assets.ChunkCacheEnvVars(assets.ChunkCacheMountPath, a.env.Config().StorageChunkCacheBytes)...)
+	}
+	if a.env.Config().StorageColdTierURL != "" {
+		// Workers read chunks, so they need to know where cold chunks are.
+		vars = append(vars, assets.ColdTierEnvVars(a.env.Config().StorageColdTierURL, a.env.Config().StorageColdTierAge, a.env.Config().StorageColdTierPromote)...)
+	}
 	return vars
 }
 
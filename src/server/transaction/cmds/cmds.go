@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/gogo/protobuf/jsonpb"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -21,11 +20,9 @@ import (
 func Cmds() []*cobra.Command {
 	var commands []*cobra.Command
 
-	marshaller := &jsonpb.Marshaler{Indent: "  "}
-
-	raw := false
-	rawFlags := pflag.NewFlagSet("", pflag.ContinueOnError)
-	rawFlags.BoolVar(&raw, "raw", false, "disable pretty printing, print raw json")
+	var raw bool
+	var output string
+	outputFlags := cmdutil.OutputFlags(&raw, &output)
 
 	fullTimestamps := false
 	fullTimestampsFlags := pflag.NewFlagSet("", pflag.ContinueOnError)
@@ -43,8 +40,20 @@ The following pachctl commands are supported in transactions:
   delete commit
   create branch
   delete branch
+  put file
+  delete file
   create pipeline
   update pipeline
+  delete pipeline
+  start pipeline
+  stop pipeline
+  auth set repo
+  auth set cluster
+
+Files written with 'put file' inside a transaction are uploaded immediately
+but only become visible in the commit when the transaction is finished. The
+uploaded data is discarded if the transaction is not finished before it
+expires.
 
 A transaction can be started with 'start transaction', after which the above
 commands will be stored in the transaction rather than immediately executed.
@@ -66,13 +75,17 @@ transaction' or cancelled with 'delete transaction'.`,
 			if err != nil {
 				return err
 			}
-			if raw {
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
 				for _, transaction := range transactions {
-					if err := marshaller.Marshal(os.Stdout, transaction); err != nil {
+					if err := printer.Print(transaction); err != nil {
 						return err
 					}
 				}
-				return nil
+				return printer.Flush()
 			}
 			writer := tabwriter.NewWriter(os.Stdout, pretty.TransactionHeader)
 			for _, transaction := range transactions {
@@ -81,7 +94,7 @@ transaction' or cancelled with 'delete transaction'.`,
 			return writer.Flush()
 		}),
 	}
-	listTransaction.Flags().AddFlagSet(rawFlags)
+	listTransaction.Flags().AddFlagSet(outputFlags)
 	listTransaction.Flags().AddFlagSet(fullTimestampsFlags)
 	commands = append(commands, cmdutil.CreateAlias(listTransaction, "list transaction"))
 
@@ -253,8 +266,12 @@ transaction' or cancelled with 'delete transaction'.`,
 			if info == nil {
 				return errors.Errorf("transaction %s not found", txn.ID)
 			}
-			if raw {
-				return marshaller.Marshal(os.Stdout, info)
+			printer, err := cmdutil.NewPrinter(raw, output, os.Stdout)
+			if err != nil {
+				return err
+			}
+			if printer != nil {
+				return cmdutil.PrintAll(printer, info)
 			}
 			return pretty.PrintDetailedTransactionInfo(&pretty.PrintableTransactionInfo{
 				TransactionInfo: info,
@@ -262,7 +279,7 @@ transaction' or cancelled with 'delete transaction'.`,
 			})
 		}),
 	}
-	inspectTransaction.Flags().AddFlagSet(rawFlags)
+	inspectTransaction.Flags().AddFlagSet(outputFlags)
 	inspectTransaction.Flags().AddFlagSet(fullTimestampsFlags)
 	commands = append(commands, cmdutil.CreateAlias(inspectTransaction, "inspect transaction"))
 
@@ -7,6 +7,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pretty"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/pps"
@@ -107,6 +108,10 @@ func sprintDeleteBranch(request *pfs.DeleteBranchRequest) string {
 	return fmt.Sprintf("delete branch %s%s", pfspretty.CompactPrintBranch(request.Branch), force)
 }
 
+func sprintAddFileset(request *pfs.AddFilesetRequest) string {
+	return fmt.Sprintf("put file %s (%s)", pfspretty.CompactPrintCommit(request.Commit), request.FilesetId)
+}
+
 func sprintUpdateJobState(request *pps.UpdateJobStateRequest) string {
 	state := func() string {
 		switch request.State {
@@ -138,6 +143,31 @@ func sprintCreatePipeline(request *pps.CreatePipelineRequest) string {
 	return fmt.Sprintf("%s pipeline %s", verb, request.Pipeline.Name)
 }
 
+func sprintDeletePipeline(request *pps.DeletePipelineRequest) string {
+	force := ""
+	if request.Force {
+		force = " --force"
+	}
+	if request.KeepRepo {
+		force += " --keep-repo"
+	}
+	if request.All {
+		return fmt.Sprintf("delete pipeline --all%s", force)
+	}
+	return fmt.Sprintf("delete pipeline %s%s", request.Pipeline.Name, force)
+}
+
+func sprintModifyRoleBinding(request *auth.ModifyRoleBindingRequest) string {
+	roles := "none"
+	if len(request.Roles) > 0 {
+		roles = strings.Join(request.Roles, ",")
+	}
+	if request.Resource.Type == auth.ResourceType_CLUSTER {
+		return fmt.Sprintf("auth set cluster %s %s", roles, request.Principal)
+	}
+	return fmt.Sprintf("auth set repo %s %s %s", request.Resource.Name, roles, request.Principal)
+}
+
 func transactionRequests(
 	requests []*transaction.TransactionRequest,
 	responses []*transaction.TransactionResponse,
@@ -167,10 +197,20 @@ func transactionRequests(
 			line = sprintCreateBranch(request.CreateBranch)
 		} else if request.DeleteBranch != nil {
 			line = sprintDeleteBranch(request.DeleteBranch)
+		} else if request.AddFileset != nil {
+			line = sprintAddFileset(request.AddFileset)
 		} else if request.UpdateJobState != nil {
 			line = sprintUpdateJobState(request.UpdateJobState)
 		} else if request.CreatePipeline != nil {
 			line = sprintCreatePipeline(request.CreatePipeline)
+		} else if request.DeletePipeline != nil {
+			line = sprintDeletePipeline(request.DeletePipeline)
+		} else if request.StartPipeline != nil {
+			line = fmt.Sprintf("start pipeline %s", request.StartPipeline.Pipeline.Name)
+		} else if request.StopPipeline != nil {
+			line = fmt.Sprintf("stop pipeline %s", request.StopPipeline.Pipeline.Name)
+		} else if request.ModifyRoleBinding != nil {
+			line = sprintModifyRoleBinding(request.ModifyRoleBinding)
 		} else {
 			line = "ERROR (unknown request type)"
 		}
@@ -164,6 +164,8 @@ func (d *driver) runTransaction(txnCtx *txncontext.TransactionContext, info *tra
 			err = directTxn.CreateBranch(request.CreateBranch)
 		} else if request.DeleteBranch != nil {
 			err = directTxn.DeleteBranch(request.DeleteBranch)
+		} else if request.AddFileset != nil {
+			err = directTxn.AddFileset(request.AddFileset)
 		} else if request.UpdateJobState != nil {
 			err = directTxn.UpdateJobState(request.UpdateJobState)
 		} else if request.DeleteAll != nil {
@@ -184,6 +186,23 @@ func (d *driver) runTransaction(txnCtx *txncontext.TransactionContext, info *tra
 			// need to save them into the response so they can be seen the next time
 			// the transaction is attempted.
 			err = directTxn.CreatePipeline(request.CreatePipeline, filesetID, prevSpecCommit)
+		} else if request.DeletePipeline != nil {
+			err = directTxn.DeletePipeline(request.DeletePipeline)
+		} else if request.StartPipeline != nil || request.StopPipeline != nil {
+			// Like CreatePipeline, starting and stopping a pipeline writes a new
+			// spec commit from a fileset that's created outside of the transaction.
+			if response.CreatePipelineResponse == nil {
+				response.CreatePipelineResponse = &transaction.CreatePipelineTransactionResponse{}
+			}
+			filesetID := &response.CreatePipelineResponse.FilesetId
+			prevSpecCommit := &response.CreatePipelineResponse.PrevSpecCommit
+			if request.StartPipeline != nil {
+				err = directTxn.StartPipeline(request.StartPipeline, filesetID, prevSpecCommit)
+			} else {
+				err = directTxn.StopPipeline(request.StopPipeline, filesetID, prevSpecCommit)
+			}
+		} else if request.ModifyRoleBinding != nil {
+			_, err = directTxn.ModifyRoleBinding(request.ModifyRoleBinding)
 		} else {
 			err = errors.New("unrecognized transaction request type")
 		}
@@ -2,10 +2,13 @@ package testing
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
@@ -576,3 +579,149 @@ func TestCreatePipelineTransaction(t *testing.T) {
 	require.NoError(t, c.GetFile(commitInfos[0].Commit, "foo", &buf))
 	require.Equal(t, "bar", buf.String())
 }
+
+// appendPipelineSetup appends the requests to create a repo with a file in it,
+// give reader access on the repo to reader, and create a pipeline over the
+// repo to txnClient's transaction.
+func appendPipelineSetup(t *testing.T, txnClient *client.APIClient, repo, pipeline, reader string) {
+	require.NoError(t, txnClient.CreateRepo(repo))
+	commit, err := txnClient.StartCommit(repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, txnClient.PutFile(commit, "foo", strings.NewReader("bar")))
+	require.NoError(t, txnClient.FinishCommit(repo, "master", commit.ID))
+	require.NoError(t, txnClient.ModifyRepoRoleBinding(repo, reader, []string{auth.RepoReaderRole}))
+	require.NoError(t, txnClient.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out", repo)},
+		&pps.ParallelismSpec{Constant: 1},
+		client.NewPFSInput(repo, "/"),
+		"master",
+		false,
+	))
+}
+
+func TestPipelineSetupTransaction(t *testing.T) {
+	testutil.DeleteAll(t)
+	defer testutil.DeleteAll(t)
+	rootClient := testutil.GetAuthenticatedPachClient(t, auth.RootUser)
+	bob := auth.RobotPrefix + testutil.UniqueString("bob")
+	bobClient := testutil.GetAuthenticatedPachClient(t, bob)
+	repo := testutil.UniqueString("in")
+	pipeline := testutil.UniqueString("pipeline")
+
+	txn, err := rootClient.StartTransaction()
+	require.NoError(t, err)
+	appendPipelineSetup(t, rootClient.WithTransaction(txn), repo, pipeline, bob)
+	// Nothing is applied until the transaction is finished.
+	_, err = rootClient.InspectRepo(repo)
+	require.YesError(t, err)
+	_, err = rootClient.InspectPipeline(pipeline)
+	require.YesError(t, err)
+
+	_, err = rootClient.FinishTransaction(txn)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, bobClient.GetFile(client.NewCommit(repo, "master", ""), "foo", &buf))
+	require.Equal(t, "bar", buf.String())
+	commitInfos, err := rootClient.FlushCommitAll([]*pfs.Commit{client.NewCommit(repo, "master", "")}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfos))
+	buf.Reset()
+	require.NoError(t, rootClient.GetFile(client.NewCommit(pipeline, "master", ""), "foo", &buf))
+	require.Equal(t, "bar", buf.String())
+}
+
+func TestPipelineSetupTransactionRollback(t *testing.T) {
+	testutil.DeleteAll(t)
+	defer testutil.DeleteAll(t)
+	rootClient := testutil.GetAuthenticatedPachClient(t, auth.RootUser)
+	bob := auth.RobotPrefix + testutil.UniqueString("bob")
+	repo := testutil.UniqueString("in")
+	pipeline := testutil.UniqueString("pipeline")
+
+	txn, err := rootClient.StartTransaction()
+	require.NoError(t, err)
+	appendPipelineSetup(t, rootClient.WithTransaction(txn), repo, pipeline, bob)
+	// Create the repo outside of the transaction, so it can't run
+	require.NoError(t, rootClient.CreateRepo(repo))
+	_, err = rootClient.FinishTransaction(txn)
+	require.YesError(t, err)
+
+	// None of the transaction's requests were applied.
+	_, err = rootClient.InspectCommit(repo, "master", "")
+	require.YesError(t, err)
+	roleBinding, err := rootClient.GetRepoRoleBinding(repo)
+	require.NoError(t, err)
+	_, ok := roleBinding.Entries[bob]
+	require.False(t, ok)
+	_, err = rootClient.InspectPipeline(pipeline)
+	require.YesError(t, err)
+}
+
+// TestDeletePipelineOutsideTransaction checks that deleting a pipeline without
+// a transaction deletes the pipeline and its output repo, so that the
+// pipeline can be created again.
+func TestDeletePipelineOutsideTransaction(t *testing.T) {
+	c := testutil.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	repo := testutil.UniqueString("in")
+	pipeline := testutil.UniqueString("pipeline")
+	require.NoError(t, c.CreateRepo(repo))
+	createPipeline := func() {
+		require.NoError(t, c.CreatePipeline(
+			pipeline,
+			"",
+			[]string{"bash"},
+			[]string{fmt.Sprintf("cp /pfs/%s/* /pfs/out", repo)},
+			&pps.ParallelismSpec{Constant: 1},
+			client.NewPFSInput(repo, "/"),
+			"master",
+			false,
+		))
+	}
+	createPipeline()
+	commit := client.NewCommit(repo, "master", "")
+	require.NoError(t, c.PutFile(commit, "foo", strings.NewReader("bar")))
+	_, err := c.FlushCommitAll([]*pfs.Commit{commit}, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, c.DeletePipeline(pipeline, false))
+	_, err = c.InspectPipeline(pipeline)
+	require.YesError(t, err)
+	_, err = c.InspectRepo(pipeline)
+	require.YesError(t, err)
+
+	createPipeline()
+	commitInfos, err := c.FlushCommitAll([]*pfs.Commit{commit}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfos))
+}
+
+// TestModifyClusterRoleBindingTransaction checks that modifying a cluster role
+// binding in a transaction returns without waiting for the binding to be
+// applied, and that it's applied when the transaction finishes.
+func TestModifyClusterRoleBindingTransaction(t *testing.T) {
+	testutil.DeleteAll(t)
+	defer testutil.DeleteAll(t)
+	rootClient := testutil.GetAuthenticatedPachClient(t, auth.RootUser)
+	bob := auth.RobotPrefix + testutil.UniqueString("bob")
+
+	txn, err := rootClient.StartTransaction()
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(rootClient.Ctx(), time.Minute)
+	defer cancel()
+	require.NoError(t, rootClient.WithCtx(ctx).WithTransaction(txn).ModifyClusterRoleBinding(bob, []string{auth.RepoReaderRole}))
+	// Nothing is applied until the transaction is finished.
+	roleBinding, err := rootClient.GetClusterRoleBinding()
+	require.NoError(t, err)
+	_, ok := roleBinding.Entries[bob]
+	require.False(t, ok)
+
+	_, err = rootClient.FinishTransaction(txn)
+	require.NoError(t, err)
+	roleBinding, err = rootClient.GetClusterRoleBinding()
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{auth.RepoReaderRole: true}, roleBinding.Entries[bob].Roles)
+}
@@ -287,11 +287,17 @@ func (d *Datum) downloadData(downloader pfssync.Downloader) error {
 	return nil
 }
 
+// Stats returns the process stats for the datum.
+func (d *Datum) Stats() *pps.ProcessStats {
+	return d.meta.Stats
+}
+
 // Run provides a scoped environment for the processing of a datum.
 func (d *Datum) Run(ctx context.Context, cb func(ctx context.Context) error) error {
 	start := time.Now()
 	defer func() {
 		d.meta.Stats.ProcessTime = types.DurationProto(time.Since(start))
+		d.meta.Stats.ScratchBytes = diskUsage(d.PFSStorageRoot())
 	}()
 	if d.timeout > 0 {
 		timeoutCtx, cancel := context.WithTimeout(ctx, d.timeout)
@@ -301,6 +307,21 @@ func (d *Datum) Run(ctx context.Context, cb func(ctx context.Context) error) err
 	return d.run(ctx, cb)
 }
 
+// diskUsage returns the total size of the regular files under 'root'.
+func diskUsage(root string) uint64 {
+	var size uint64
+	filepath.Walk(root, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if info.Mode().IsRegular() {
+			size += uint64(info.Size())
+		}
+		return nil
+	})
+	return size
+}
+
 func (d *Datum) run(ctx context.Context, cb func(ctx context.Context) error) (retErr error) {
 	defer func() {
 		if retErr != nil {
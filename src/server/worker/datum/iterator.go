@@ -4,6 +4,7 @@ import (
 	"archive/tar"
 	"io"
 	"path"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -51,7 +52,14 @@ func (pi *pfsIterator) Iterate(cb func(*Meta) error) error {
 	branch := pi.input.Branch
 	commit := pi.input.Commit
 	pattern := pi.input.Glob
+	filter, err := pi.newFilter()
+	if err != nil {
+		return err
+	}
 	return pi.pachClient.GlobFile(client.NewCommit(repo, branch, commit), pattern, func(fi *pfs.FileInfo) error {
+		if !filter(fi) {
+			return nil
+		}
 		g := glob.MustCompile(pi.input.Glob, '/')
 		joinOn := g.Replace(fi.File.Path, pi.input.JoinOn)
 		groupBy := g.Replace(fi.File.Path, pi.input.GroupBy)
@@ -73,6 +81,96 @@ func (pi *pfsIterator) Iterate(cb func(*Meta) error) error {
 	})
 }
 
+// newFilter returns a function that reports whether a file matched by the
+// input's glob passes the input's filters.
+func (pi *pfsIterator) newFilter() (func(*pfs.FileInfo) bool, error) {
+	var filters []func(*pfs.FileInfo) bool
+	if pi.input.ExcludeGlob != "" {
+		g, err := glob.Compile(pi.input.ExcludeGlob, '/')
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		filters = append(filters, func(fi *pfs.FileInfo) bool {
+			return !g.Match(cleanPath(fi.File.Path))
+		})
+	}
+	if pi.input.MinSizeBytes > 0 {
+		filters = append(filters, func(fi *pfs.FileInfo) bool {
+			return int64(fi.SizeBytes) >= pi.input.MinSizeBytes
+		})
+	}
+	if pi.input.MaxSizeBytes > 0 {
+		filters = append(filters, func(fi *pfs.FileInfo) bool {
+			return int64(fi.SizeBytes) <= pi.input.MaxSizeBytes
+		})
+	}
+	if pi.input.PathRegex != "" {
+		re, err := regexp.Compile(pi.input.PathRegex)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		filters = append(filters, func(fi *pfs.FileInfo) bool {
+			return re.MatchString(cleanPath(fi.File.Path))
+		})
+	}
+	if pi.input.ChangedSinceParent {
+		changed, err := pi.changedPaths()
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, func(fi *pfs.FileInfo) bool {
+			return containsPath(changed, cleanPath(fi.File.Path))
+		})
+	}
+	return func(fi *pfs.FileInfo) bool {
+		for _, filter := range filters {
+			if !filter(fi) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// changedPaths returns the sorted paths of the files that differ between the
+// input commit and its parent.
+func (pi *pfsIterator) changedPaths() ([]string, error) {
+	commit := client.NewCommit(pi.input.Repo, pi.input.Branch, pi.input.Commit)
+	changed := make(map[string]struct{})
+	if err := pi.pachClient.DiffFile(commit, "/", nil, "", false, func(newFi, oldFi *pfs.FileInfo) error {
+		for _, fi := range []*pfs.FileInfo{newFi, oldFi} {
+			if fi != nil {
+				changed[cleanPath(fi.File.Path)] = struct{}{}
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	var paths []string
+	for p := range changed {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// containsPath reports whether 'p', or anything under it, is in the sorted
+// slice 'paths'.
+func containsPath(paths []string, p string) bool {
+	i := sort.SearchStrings(paths, p)
+	if i < len(paths) && paths[i] == p {
+		return true
+	}
+	prefix := strings.TrimSuffix(p, "/") + "/"
+	i = sort.SearchStrings(paths, prefix)
+	return i < len(paths) && strings.HasPrefix(paths[i], prefix)
+}
+
+func cleanPath(p string) string {
+	return "/" + strings.Trim(p, "/")
+}
+
 type unionIterator struct {
 	iterators []Iterator
 }
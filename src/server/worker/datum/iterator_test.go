@@ -10,6 +10,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
 	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
 )
 
 func TestIterators(t *testing.T) {
@@ -280,6 +281,90 @@ func TestIterators(t *testing.T) {
 	})
 }
 
+func TestFilters(t *testing.T) {
+	t.Parallel()
+	env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+	c := env.PachClient
+	dataRepo := tu.UniqueString(t.Name() + "_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, c.PutFile(commit1, fmt.Sprintf("/foo%v", i), strings.NewReader("input")))
+	}
+	require.NoError(t, c.PutFile(commit1, "/foo.tmp", strings.NewReader("input")))
+	require.NoError(t, c.PutFile(commit1, "/big", strings.NewReader(strings.Repeat("input", 1000))))
+	require.NoError(t, c.FinishCommit(dataRepo, commit1.Branch.Name, commit1.ID))
+	commit2, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(commit2, "/foo1", strings.NewReader("changed")))
+	require.NoError(t, c.PutFile(commit2, "/foo3", strings.NewReader("input")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit2.Branch.Name, commit2.ID))
+
+	newInput := func() *pps.Input {
+		in := client.NewPFSInput(dataRepo, "/*")
+		in.Pfs.Commit = commit2.ID
+		return in
+	}
+	t.Run("ExcludeGlob", func(t *testing.T) {
+		in := newInput()
+		in.Pfs.ExcludeGlob = "/*.tmp"
+		di, err := NewIterator(c, in)
+		require.NoError(t, err)
+		validateDI(t, di, "/big", "/foo0", "/foo1", "/foo2", "/foo3")
+	})
+	t.Run("Size", func(t *testing.T) {
+		in := newInput()
+		in.Pfs.MinSizeBytes = 1024
+		di, err := NewIterator(c, in)
+		require.NoError(t, err)
+		validateDI(t, di, "/big")
+		in = newInput()
+		in.Pfs.MaxSizeBytes = 5
+		di, err = NewIterator(c, in)
+		require.NoError(t, err)
+		validateDI(t, di, "/foo.tmp", "/foo0", "/foo2", "/foo3")
+	})
+	t.Run("PathRegex", func(t *testing.T) {
+		in := newInput()
+		in.Pfs.PathRegex = "^/foo[0-9]$"
+		di, err := NewIterator(c, in)
+		require.NoError(t, err)
+		validateDI(t, di, "/foo0", "/foo1", "/foo2", "/foo3")
+	})
+	t.Run("ChangedSinceParent", func(t *testing.T) {
+		in := newInput()
+		in.Pfs.ChangedSinceParent = true
+		di, err := NewIterator(c, in)
+		require.NoError(t, err)
+		validateDI(t, di, "/foo1", "/foo3")
+		in.Pfs.Glob = "/"
+		di, err = NewIterator(c, in)
+		require.NoError(t, err)
+		validateDI(t, di, "/")
+	})
+	t.Run("Combined", func(t *testing.T) {
+		in := newInput()
+		in.Pfs.ChangedSinceParent = true
+		in.Pfs.MaxSizeBytes = 5
+		di, err := NewIterator(c, in)
+		require.NoError(t, err)
+		validateDI(t, di, "/foo3")
+	})
+}
+
+func TestContainsPath(t *testing.T) {
+	paths := []string{"/a-b", "/a/c", "/b"}
+	require.True(t, containsPath(paths, "/a"))
+	require.True(t, containsPath(paths, "/a/c"))
+	require.True(t, containsPath(paths, "/b"))
+	require.True(t, containsPath(paths, "/"))
+	require.False(t, containsPath(paths, "/a/d"))
+	require.False(t, containsPath(paths, "/c"))
+	require.False(t, containsPath(nil, "/"))
+}
+
 // TestJoinOnTrailingSlash tests that the same glob pattern is used for
 // extracting JoinOn and GroupBy capture groups as is used to match paths. Tests
 // the fix for https://github.com/pachyderm/pachyderm/v2/issues/5365
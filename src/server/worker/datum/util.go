@@ -36,6 +36,17 @@ func MergeProcessStats(x, y *pps.ProcessStats) error {
 	}
 	x.DownloadBytes += y.DownloadBytes
 	x.UploadBytes += y.UploadBytes
+	if x.CPUTime, err = plusDuration(x.CPUTime, y.CPUTime); err != nil {
+		return err
+	}
+	if y.PeakMemoryBytes > x.PeakMemoryBytes {
+		x.PeakMemoryBytes = y.PeakMemoryBytes
+	}
+	x.DiskReadBytes += y.DiskReadBytes
+	x.DiskWriteBytes += y.DiskWriteBytes
+	if y.ScratchBytes > x.ScratchBytes {
+		x.ScratchBytes = y.ScratchBytes
+	}
 	return nil
 }
 
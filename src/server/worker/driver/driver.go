@@ -82,7 +82,9 @@ type Driver interface {
 	// launching the configured user process.
 	UserCodeEnv(string, *pfs.Commit, []*common.Input) []string
 
-	RunUserCode(context.Context, logs.TaggedLogger, []string) error
+	// RunUserCode runs the pipeline's user code. If the stats are not nil, the
+	// resource usage of the user code is recorded in them.
+	RunUserCode(context.Context, logs.TaggedLogger, []string, *pps.ProcessStats) error
 
 	RunUserErrorHandlingCode(context.Context, logs.TaggedLogger, []string) error
 
@@ -263,10 +265,16 @@ func (d *driver) Pipelines() col.PostgresCollection {
 }
 
 func (d *driver) NewTaskWorker() *work.Worker {
+	if d.env.Config().WorkBackend == work.PostgresBackend {
+		return work.NewPostgresWorker(d.env.GetDBClient(), d.env.GetPostgresListener(), workNamespace(d.pipelineInfo))
+	}
 	return work.NewWorker(d.env.GetEtcdClient(), d.env.Config().PPSEtcdPrefix, workNamespace(d.pipelineInfo))
 }
 
 func (d *driver) NewTaskQueue() (*work.TaskQueue, error) {
+	if d.env.Config().WorkBackend == work.PostgresBackend {
+		return work.NewPostgresTaskQueue(d.ctx, d.env.GetDBClient(), d.env.GetPostgresListener(), workNamespace(d.pipelineInfo))
+	}
 	return work.NewTaskQueue(d.ctx, d.env.GetEtcdClient(), d.env.Config().PPSEtcdPrefix, workNamespace(d.pipelineInfo))
 }
 
@@ -306,6 +314,7 @@ func (d *driver) RunUserCode(
 	ctx context.Context,
 	logger logs.TaggedLogger,
 	environ []string,
+	stats *pps.ProcessStats,
 ) (retErr error) {
 	logger.Logf("beginning to run user code")
 	defer func(start time.Time) {
@@ -335,9 +344,19 @@ func (d *driver) RunUserCode(
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
+	var p *profiler
+	if stats != nil {
+		p = startProfiler(cmd.Process.Pid)
+	}
 	// A context with a deadline will successfully cancel/kill
 	// the running process (minus zombies)
 	state, err := cmd.Process.Wait()
+	if p != nil {
+		peakMemory := p.stop()
+		if err == nil {
+			recordUsage(state, peakMemory, stats)
+		}
+	}
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
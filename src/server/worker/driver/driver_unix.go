@@ -7,9 +7,13 @@ import (
 	"path/filepath"
 	"strings"
 	"syscall"
+	"time"
+
+	"github.com/gogo/protobuf/types"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
 )
 
@@ -22,6 +26,23 @@ func makeCmdCredentials(uid uint32, gid uint32) *syscall.SysProcAttr {
 	}
 }
 
+// recordUsage records the resource usage of an exited user code process in
+// 'stats'. 'peakMemory' is the peak memory sampled while it ran.
+func recordUsage(state *os.ProcessState, peakMemory uint64, stats *pps.ProcessStats) {
+	stats.PeakMemoryBytes = peakMemory
+	rusage, ok := state.SysUsage().(*syscall.Rusage)
+	if !ok {
+		return
+	}
+	stats.CPUTime = types.DurationProto(time.Duration(rusage.Utime.Nano() + rusage.Stime.Nano()))
+	// Maxrss is in kilobytes, and block counts are in 512 byte units.
+	if maxRSS := uint64(rusage.Maxrss) * 1024; maxRSS > stats.PeakMemoryBytes {
+		stats.PeakMemoryBytes = maxRSS
+	}
+	stats.DiskReadBytes = uint64(rusage.Inblock) * 512
+	stats.DiskWriteBytes = uint64(rusage.Oublock) * 512
+}
+
 // WithActiveData is implemented differently in unix vs windows because of how
 // symlinks work on windows. Here, we create symlinks to the scratch space
 // directory, then clean up before returning.
@@ -0,0 +1,128 @@
+package driver
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// profileInterval is how often the memory used by user code is sampled.
+const profileInterval = 500 * time.Millisecond
+
+// profiler samples the resident memory of a process and its descendants from
+// procfs, and tracks the peak. Processes that exit between samples are covered
+// by the rusage reported when the user code exits.
+type profiler struct {
+	pid        int
+	cancel     context.CancelFunc
+	done       chan struct{}
+	peakMemory uint64
+}
+
+func startProfiler(pid int) *profiler {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &profiler{
+		pid:    pid,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go func() {
+		defer close(p.done)
+		ticker := time.NewTicker(profileInterval)
+		defer ticker.Stop()
+		for {
+			if memory := processTreeMemory(p.pid); memory > p.peakMemory {
+				p.peakMemory = memory
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return p
+}
+
+// stop stops sampling, and returns the peak memory that was sampled.
+func (p *profiler) stop() uint64 {
+	p.cancel()
+	<-p.done
+	return p.peakMemory
+}
+
+// processTreeMemory returns the total resident memory of 'pid' and its
+// descendants, or 0 if procfs isn't available.
+func processTreeMemory(pid int) uint64 {
+	children := make(map[int][]int)
+	stats, err := filepath.Glob("/proc/[0-9]*/stat")
+	if err != nil {
+		return 0
+	}
+	for _, stat := range stats {
+		child, ppid, ok := readParent(stat)
+		if ok {
+			children[ppid] = append(children[ppid], child)
+		}
+	}
+	var total uint64
+	pageSize := uint64(os.Getpagesize())
+	queue := []int{pid}
+	for len(queue) > 0 {
+		pid, queue = queue[0], queue[1:]
+		total += residentPages(pid) * pageSize
+		queue = append(queue, children[pid]...)
+	}
+	return total
+}
+
+// readParent parses a /proc/<pid>/stat file, and returns the pid and its
+// parent's pid.
+func readParent(stat string) (int, int, bool) {
+	data, err := ioutil.ReadFile(stat)
+	if err != nil {
+		return 0, 0, false
+	}
+	// The second field is the executable name in parentheses, which may
+	// contain spaces, so parse the fields after the last ')'.
+	s := string(data)
+	i := strings.LastIndexByte(s, ')')
+	if i < 0 {
+		return 0, 0, false
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(s[:strings.IndexByte(s, '(')]))
+	if err != nil {
+		return 0, 0, false
+	}
+	fields := strings.Fields(s[i+1:])
+	if len(fields) < 2 {
+		return 0, 0, false
+	}
+	ppid, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, 0, false
+	}
+	return pid, ppid, true
+}
+
+// residentPages returns the number of resident pages of 'pid', from
+// /proc/<pid>/statm.
+func residentPages(pid int) uint64 {
+	data, err := ioutil.ReadFile(filepath.Join("/proc", strconv.Itoa(pid), "statm"))
+	if err != nil {
+		return 0
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) < 2 {
+		return 0
+	}
+	pages, err := strconv.ParseUint(fields[1], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return pages
+}
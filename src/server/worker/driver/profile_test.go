@@ -0,0 +1,28 @@
+package driver
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestProcessTreeMemory(t *testing.T) {
+	if _, err := os.Stat("/proc/self/stat"); err != nil {
+		t.Skip("procfs is not available")
+	}
+	pid, ppid, ok := readParent("/proc/self/stat")
+	require.True(t, ok)
+	require.Equal(t, os.Getpid(), pid)
+	require.Equal(t, os.Getppid(), ppid)
+
+	cmd := exec.Command("sleep", "10")
+	require.NoError(t, cmd.Start())
+	defer cmd.Process.Kill()
+	self := residentPages(os.Getpid()) * uint64(os.Getpagesize())
+	require.True(t, self > 0)
+	// The tree includes the child process.
+	require.True(t, processTreeMemory(os.Getpid()) > self)
+	require.Equal(t, uint64(0), processTreeMemory(-1))
+}
@@ -65,7 +65,7 @@ func Run(driver driver.Driver, logger logs.TaggedLogger) error {
 			return s.WithDatum(ctx, meta, func(d *datum.Datum) error {
 				return driver.WithActiveData(inputs, d.PFSStorageRoot(), func() error {
 					return d.Run(ctx, func(runCtx context.Context) error {
-						return driver.RunUserCode(runCtx, logger, env)
+						return driver.RunUserCode(runCtx, logger, env, nil)
 					})
 				})
 			})
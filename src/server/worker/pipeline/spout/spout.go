@@ -8,5 +8,5 @@ import (
 // Run will run a spout pipeline until the driver is canceled.
 func Run(driver driver.Driver, logger logs.TaggedLogger) error {
 	logger = logger.WithJob("spout")
-	return driver.RunUserCode(driver.PachClient().Ctx(), logger, nil)
+	return driver.RunUserCode(driver.PachClient().Ctx(), logger, nil, nil)
 }
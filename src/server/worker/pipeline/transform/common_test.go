@@ -101,8 +101,8 @@ func (td *testDriver) WithActiveData(inputs []*common.Input, dir string, cb func
 func (td *testDriver) UserCodeEnv(jobID string, commit *pfs.Commit, inputs []*common.Input) []string {
 	return td.inner.UserCodeEnv(jobID, commit, inputs)
 }
-func (td *testDriver) RunUserCode(ctx context.Context, logger logs.TaggedLogger, env []string) error {
-	return td.inner.RunUserCode(ctx, logger, env)
+func (td *testDriver) RunUserCode(ctx context.Context, logger logs.TaggedLogger, env []string, stats *pps.ProcessStats) error {
+	return td.inner.RunUserCode(ctx, logger, env, stats)
 }
 func (td *testDriver) RunUserErrorHandlingCode(ctx context.Context, logger logs.TaggedLogger, env []string) error {
 	return td.inner.RunUserErrorHandlingCode(ctx, logger, env)
@@ -18,6 +18,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/driver"
 	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/stats"
 )
 
 // Worker handles a transform pipeline work subtask, then returns.
@@ -113,9 +114,11 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 						defer cancel()
 						return status.withDatum(inputs, cancel, func() error {
 							return driver.WithActiveData(inputs, d.PFSStorageRoot(), func() error {
-								return d.Run(cancelCtx, func(runCtx context.Context) error {
-									return driver.RunUserCode(runCtx, logger, env)
+								err := d.Run(cancelCtx, func(runCtx context.Context) error {
+									return driver.RunUserCode(runCtx, logger, env, d.Stats())
 								})
+								stats.ReportResourceUsage(driver.PipelineInfo().Pipeline.Name, logger.JobID(), d.Stats())
+								return err
 							})
 						})
 					}, opts...)
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
 	"path"
 	"strconv"
@@ -94,7 +95,7 @@ func Conns(ctx context.Context, pipelineRcName string, etcdClient *etcd.Client,
 		}
 		ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
 		defer cancel()
-		conn, err := grpc.DialContext(ctx, fmt.Sprintf("%s:%d", wIP, workerGrpcPort),
+		conn, err := grpc.DialContext(ctx, workerAddress(wIP, workerGrpcPort),
 			append(client.DefaultDialOptions(), grpc.WithInsecure())...)
 		if err != nil {
 			return nil, err
@@ -141,10 +142,21 @@ func NewClient(address string) (Client, error) {
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
 	defer cancel()
-	conn, err := grpc.DialContext(ctx, fmt.Sprintf("%s:%d", address, port),
+	conn, err := grpc.DialContext(ctx, workerAddress(address, uint16(port)),
 		append(client.DefaultDialOptions(), grpc.WithInsecure())...)
 	if err != nil {
 		return Client{}, err
 	}
 	return newClient(conn), nil
 }
+
+// workerAddress returns the address at which the worker that registered
+// 'workerIP' can be reached. Workers usually register only their IP and listen
+// on the cluster-wide worker port, but workers that share a host register a
+// full host:port.
+func workerAddress(workerIP string, workerGrpcPort uint16) string {
+	if _, _, err := net.SplitHostPort(workerIP); err == nil {
+		return workerIP
+	}
+	return fmt.Sprintf("%s:%d", workerIP, workerGrpcPort)
+}
@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
 )
 
 const (
@@ -20,6 +22,10 @@ var (
 	bucketFactor = 2.0
 	bucketCount  = 20 // Which makes the max bucket 2^20 seconds or ~12 days in size
 
+	// Resource size buckets start at 1MiB, which makes the max bucket 32GiB
+	sizeBucketStart = 1024.0 * 1024.0
+	sizeBucketCount = 16
+
 	// DatumCount is a counter tracking the number of datums processed by a pipeline
 	DatumCount = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -180,6 +186,78 @@ var (
 			"job",
 		},
 	)
+
+	// DatumPeakMemory is a histogram tracking the peak memory used by user code for datums processed by a pipeline
+	DatumPeakMemory = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "pachyderm",
+			Subsystem: "worker",
+			Name:      "datum_peak_memory",
+			Help:      "Peak resident memory of user code",
+			Buckets:   prometheus.ExponentialBuckets(sizeBucketStart, bucketFactor, sizeBucketCount),
+		},
+		[]string{
+			"pipeline",
+			"job",
+		},
+	)
+
+	// DatumCPUSecondsCount is a counter tracking the total CPU time used by user code in a pipeline
+	DatumCPUSecondsCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "pachyderm",
+			Subsystem: "worker",
+			Name:      "datum_cpu_seconds_count",
+			Help:      "Cumulative number of CPU seconds used by user code",
+		},
+		[]string{
+			"pipeline",
+			"job",
+		},
+	)
+
+	// DatumDiskReadBytesCount is a counter tracking the total bytes read from disk by user code in a pipeline
+	DatumDiskReadBytesCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "pachyderm",
+			Subsystem: "worker",
+			Name:      "datum_disk_read_bytes_count",
+			Help:      "Cumulative number of bytes read from disk by user code",
+		},
+		[]string{
+			"pipeline",
+			"job",
+		},
+	)
+
+	// DatumDiskWriteBytesCount is a counter tracking the total bytes written to disk by user code in a pipeline
+	DatumDiskWriteBytesCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "pachyderm",
+			Subsystem: "worker",
+			Name:      "datum_disk_write_bytes_count",
+			Help:      "Cumulative number of bytes written to disk by user code",
+		},
+		[]string{
+			"pipeline",
+			"job",
+		},
+	)
+
+	// DatumScratchSize is a histogram tracking the scratch space used by datums processed by a pipeline
+	DatumScratchSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "pachyderm",
+			Subsystem: "worker",
+			Name:      "datum_scratch_size",
+			Help:      "Size of datum scratch space when user code finished",
+			Buckets:   prometheus.ExponentialBuckets(sizeBucketStart, bucketFactor, sizeBucketCount),
+		},
+		[]string{
+			"pipeline",
+			"job",
+		},
+	)
 )
 
 // InitPrometheus sets up the default datum stats collectors for use by worker
@@ -197,6 +275,11 @@ func InitPrometheus() {
 		DatumDownloadBytesCount,
 		DatumUploadSize,
 		DatumUploadBytesCount,
+		DatumPeakMemory,
+		DatumCPUSecondsCount,
+		DatumDiskReadBytesCount,
+		DatumDiskWriteBytesCount,
+		DatumScratchSize,
 	}
 	for _, metric := range metrics {
 		if err := prometheus.Register(metric); err != nil {
@@ -213,3 +296,14 @@ func InitPrometheus() {
 		}
 	}()
 }
+
+// ReportResourceUsage exports the resource usage of the user code for a datum.
+func ReportResourceUsage(pipeline, job string, stats *pps.ProcessStats) {
+	DatumPeakMemory.WithLabelValues(pipeline, job).Observe(float64(stats.PeakMemoryBytes))
+	if cpuTime, err := types.DurationFromProto(stats.CPUTime); err == nil {
+		DatumCPUSecondsCount.WithLabelValues(pipeline, job).Add(cpuTime.Seconds())
+	}
+	DatumDiskReadBytesCount.WithLabelValues(pipeline, job).Add(float64(stats.DiskReadBytes))
+	DatumDiskWriteBytesCount.WithLabelValues(pipeline, job).Add(float64(stats.DiskWriteBytes))
+	DatumScratchSize.WithLabelValues(pipeline, job).Observe(float64(stats.ScratchBytes))
+}
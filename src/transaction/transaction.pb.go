@@ -9,6 +9,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
+	auth "github.com/pachyderm/pachyderm/v2/src/auth"
 	pfs "github.com/pachyderm/pachyderm/v2/src/pfs"
 	pps "github.com/pachyderm/pachyderm/v2/src/pps"
 	grpc "google.golang.org/grpc"
@@ -71,20 +72,25 @@ var xxx_messageInfo_DeleteAllRequest proto.InternalMessageInfo
 
 type TransactionRequest struct {
 	// Exactly one of these fields should be set
-	CreateRepo           *pfs.CreateRepoRequest     `protobuf:"bytes,1,opt,name=create_repo,json=createRepo,proto3" json:"create_repo,omitempty"`
-	DeleteRepo           *pfs.DeleteRepoRequest     `protobuf:"bytes,2,opt,name=delete_repo,json=deleteRepo,proto3" json:"delete_repo,omitempty"`
-	StartCommit          *pfs.StartCommitRequest    `protobuf:"bytes,3,opt,name=start_commit,json=startCommit,proto3" json:"start_commit,omitempty"`
-	FinishCommit         *pfs.FinishCommitRequest   `protobuf:"bytes,4,opt,name=finish_commit,json=finishCommit,proto3" json:"finish_commit,omitempty"`
-	SquashCommit         *pfs.SquashCommitRequest   `protobuf:"bytes,5,opt,name=squash_commit,json=squashCommit,proto3" json:"squash_commit,omitempty"`
-	CreateBranch         *pfs.CreateBranchRequest   `protobuf:"bytes,6,opt,name=create_branch,json=createBranch,proto3" json:"create_branch,omitempty"`
-	DeleteBranch         *pfs.DeleteBranchRequest   `protobuf:"bytes,7,opt,name=delete_branch,json=deleteBranch,proto3" json:"delete_branch,omitempty"`
-	UpdateJobState       *pps.UpdateJobStateRequest `protobuf:"bytes,8,opt,name=update_job_state,json=updateJobState,proto3" json:"update_job_state,omitempty"`
-	CreatePipeline       *pps.CreatePipelineRequest `protobuf:"bytes,9,opt,name=create_pipeline,json=createPipeline,proto3" json:"create_pipeline,omitempty"`
-	StopJob              *pps.StopJobRequest        `protobuf:"bytes,10,opt,name=stop_job,json=stopJob,proto3" json:"stop_job,omitempty"`
-	DeleteAll            *DeleteAllRequest          `protobuf:"bytes,11,opt,name=delete_all,json=deleteAll,proto3" json:"delete_all,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	CreateRepo           *pfs.CreateRepoRequest         `protobuf:"bytes,1,opt,name=create_repo,json=createRepo,proto3" json:"create_repo,omitempty"`
+	DeleteRepo           *pfs.DeleteRepoRequest         `protobuf:"bytes,2,opt,name=delete_repo,json=deleteRepo,proto3" json:"delete_repo,omitempty"`
+	StartCommit          *pfs.StartCommitRequest        `protobuf:"bytes,3,opt,name=start_commit,json=startCommit,proto3" json:"start_commit,omitempty"`
+	FinishCommit         *pfs.FinishCommitRequest       `protobuf:"bytes,4,opt,name=finish_commit,json=finishCommit,proto3" json:"finish_commit,omitempty"`
+	SquashCommit         *pfs.SquashCommitRequest       `protobuf:"bytes,5,opt,name=squash_commit,json=squashCommit,proto3" json:"squash_commit,omitempty"`
+	CreateBranch         *pfs.CreateBranchRequest       `protobuf:"bytes,6,opt,name=create_branch,json=createBranch,proto3" json:"create_branch,omitempty"`
+	DeleteBranch         *pfs.DeleteBranchRequest       `protobuf:"bytes,7,opt,name=delete_branch,json=deleteBranch,proto3" json:"delete_branch,omitempty"`
+	UpdateJobState       *pps.UpdateJobStateRequest     `protobuf:"bytes,8,opt,name=update_job_state,json=updateJobState,proto3" json:"update_job_state,omitempty"`
+	CreatePipeline       *pps.CreatePipelineRequest     `protobuf:"bytes,9,opt,name=create_pipeline,json=createPipeline,proto3" json:"create_pipeline,omitempty"`
+	StopJob              *pps.StopJobRequest            `protobuf:"bytes,10,opt,name=stop_job,json=stopJob,proto3" json:"stop_job,omitempty"`
+	DeleteAll            *DeleteAllRequest              `protobuf:"bytes,11,opt,name=delete_all,json=deleteAll,proto3" json:"delete_all,omitempty"`
+	AddFileset           *pfs.AddFilesetRequest         `protobuf:"bytes,12,opt,name=add_fileset,json=addFileset,proto3" json:"add_fileset,omitempty"`
+	DeletePipeline       *pps.DeletePipelineRequest     `protobuf:"bytes,13,opt,name=delete_pipeline,json=deletePipeline,proto3" json:"delete_pipeline,omitempty"`
+	StartPipeline        *pps.StartPipelineRequest      `protobuf:"bytes,14,opt,name=start_pipeline,json=startPipeline,proto3" json:"start_pipeline,omitempty"`
+	StopPipeline         *pps.StopPipelineRequest       `protobuf:"bytes,15,opt,name=stop_pipeline,json=stopPipeline,proto3" json:"stop_pipeline,omitempty"`
+	ModifyRoleBinding    *auth.ModifyRoleBindingRequest `protobuf:"bytes,16,opt,name=modify_role_binding,json=modifyRoleBinding,proto3" json:"modify_role_binding,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
 func (m *TransactionRequest) Reset()         { *m = TransactionRequest{} }
@@ -197,6 +203,41 @@ func (m *TransactionRequest) GetDeleteAll() *DeleteAllRequest {
 	return nil
 }
 
+func (m *TransactionRequest) GetAddFileset() *pfs.AddFilesetRequest {
+	if m != nil {
+		return m.AddFileset
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetDeletePipeline() *pps.DeletePipelineRequest {
+	if m != nil {
+		return m.DeletePipeline
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetStartPipeline() *pps.StartPipelineRequest {
+	if m != nil {
+		return m.StartPipeline
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetStopPipeline() *pps.StopPipelineRequest {
+	if m != nil {
+		return m.StopPipeline
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetModifyRoleBinding() *auth.ModifyRoleBindingRequest {
+	if m != nil {
+		return m.ModifyRoleBinding
+	}
+	return nil
+}
+
 type TransactionResponse struct {
 	// At most, one of these fields should be set (most responses are empty)
 	Commit                 *pfs.Commit                        `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
@@ -758,64 +799,72 @@ func init() {
 func init() { proto.RegisterFile("transaction/transaction.proto", fileDescriptor_284c03442be38d9f) }
 
 var fileDescriptor_284c03442be38d9f = []byte{
-	// 904 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0x6e, 0x52, 0xfa, 0x93, 0x93, 0xdd, 0x36, 0x3b, 0xa0, 0xac, 0x9b, 0xaa, 0x3f, 0x0c, 0x62,
-	0x29, 0x37, 0xb6, 0x1a, 0xb8, 0x40, 0x48, 0xb0, 0xb4, 0xbb, 0xec, 0x2a, 0x15, 0x17, 0x2b, 0x67,
-	0x11, 0x6a, 0x25, 0xd6, 0xf8, 0x67, 0x9c, 0x18, 0x1c, 0xcf, 0xac, 0x67, 0x52, 0x69, 0x25, 0x1e,
-	0x00, 0x5e, 0x85, 0x27, 0xe1, 0x92, 0x4b, 0xae, 0x10, 0xea, 0x93, 0x20, 0xcf, 0x8c, 0x5d, 0xdb,
-	0x71, 0xda, 0x45, 0xdb, 0x3b, 0xfb, 0x3b, 0xe7, 0xfb, 0xe6, 0xcc, 0xf9, 0x8e, 0x4f, 0x02, 0x7b,
-	0x22, 0x75, 0x13, 0xee, 0xfa, 0x22, 0xa2, 0x89, 0x55, 0x7a, 0x36, 0x59, 0x4a, 0x05, 0x45, 0x5b,
-	0x25, 0xc8, 0xb9, 0x1c, 0x0e, 0x76, 0x27, 0x94, 0x4e, 0x62, 0x62, 0xc9, 0xa8, 0x37, 0x0f, 0x2d,
-	0x32, 0x63, 0xe2, 0x8d, 0x4a, 0x1e, 0x1c, 0xd4, 0x83, 0x22, 0x9a, 0x11, 0x2e, 0xdc, 0x19, 0xd3,
-	0x09, 0x1f, 0x4c, 0xe8, 0x84, 0xca, 0x47, 0x2b, 0x7b, 0xd2, 0xe8, 0x7d, 0x16, 0x72, 0x8b, 0x85,
-	0xbc, 0x78, 0x65, 0xdc, 0x62, 0x4c, 0xbf, 0x62, 0x04, 0xbd, 0xa7, 0x24, 0x26, 0x82, 0x9c, 0xc4,
-	0xb1, 0x4d, 0x5e, 0xcf, 0x09, 0x17, 0xf8, 0xef, 0x35, 0x40, 0x2f, 0xaf, 0x0b, 0xd3, 0x30, 0xfa,
-	0x12, 0xba, 0x7e, 0x4a, 0x5c, 0x41, 0x9c, 0x94, 0x30, 0x6a, 0xb4, 0x0e, 0x5b, 0x47, 0xdd, 0xe1,
-	0x8e, 0xc9, 0x42, 0xee, 0x5c, 0x0e, 0xcd, 0x27, 0x32, 0x64, 0x13, 0x46, 0x75, 0xbe, 0x0d, 0x7e,
-	0x01, 0x65, 0xdc, 0x40, 0x1e, 0xa3, 0xb8, 0xed, 0x2a, 0x57, 0x55, 0x50, 0xe1, 0x06, 0x05, 0x84,
-	0xbe, 0x82, 0x7b, 0x5c, 0xb8, 0xa9, 0x70, 0x7c, 0x3a, 0x9b, 0x45, 0xc2, 0x58, 0x95, 0xe4, 0x41,
-	0x4e, 0x1e, 0x67, 0xb1, 0x27, 0x32, 0x94, 0xb3, 0xbb, 0xfc, 0x1a, 0x43, 0xdf, 0xc0, 0xfd, 0x30,
-	0x4a, 0x22, 0x3e, 0xcd, 0xf9, 0xef, 0x49, 0xfe, 0x6e, 0xce, 0x7f, 0x26, 0x83, 0x55, 0x81, 0x7b,
-	0x61, 0x09, 0xcc, 0x14, 0xf8, 0xeb, 0xb9, 0x7b, 0xad, 0xb0, 0x56, 0x55, 0x18, 0xcb, 0x60, 0x4d,
-	0x81, 0x97, 0xc0, 0x4c, 0x41, 0xb7, 0xce, 0x4b, 0xdd, 0xc4, 0x9f, 0x1a, 0xeb, 0x55, 0x05, 0xd5,
-	0xbc, 0x53, 0x19, 0x2b, 0x14, 0xfc, 0x12, 0x98, 0x29, 0xe8, 0x06, 0x6a, 0x85, 0x8d, 0xaa, 0x82,
-	0x6a, 0x61, 0x4d, 0x21, 0x28, 0x81, 0xe8, 0x39, 0xf4, 0xe6, 0x2c, 0xc8, 0x6a, 0xf8, 0x99, 0x7a,
-	0x0e, 0x17, 0xae, 0x20, 0xc6, 0xa6, 0x14, 0xd9, 0x33, 0x19, 0x93, 0x22, 0xdf, 0xcb, 0xf8, 0x19,
-	0xf5, 0xc6, 0x42, 0x1a, 0xa7, 0x64, 0xb6, 0xe6, 0x15, 0x18, 0x3d, 0x83, 0x6d, 0x7d, 0x19, 0x16,
-	0x31, 0x12, 0x47, 0x09, 0x31, 0x3a, 0x55, 0x1d, 0x75, 0x9d, 0x17, 0x3a, 0x5a, 0xe8, 0xf8, 0x15,
-	0x18, 0x1d, 0xc3, 0x26, 0x17, 0x94, 0x65, 0xe5, 0x18, 0x20, 0x05, 0xfa, 0xb9, 0xc0, 0x58, 0x50,
-	0x76, 0x46, 0xbd, 0x9c, 0xb9, 0xc1, 0xd5, 0x3b, 0x7a, 0x0c, 0x7a, 0x30, 0x1c, 0x37, 0x8e, 0x8d,
-	0xae, 0x24, 0x1d, 0x9a, 0xd5, 0x8f, 0xc8, 0xac, 0xcf, 0xb3, 0xdd, 0x09, 0x72, 0x04, 0xff, 0xd1,
-	0x82, 0xf7, 0x2b, 0xa3, 0xcd, 0x19, 0x4d, 0x38, 0x41, 0x8f, 0x60, 0x5d, 0x7b, 0xab, 0xc6, 0x7a,
-	0xab, 0x70, 0x46, 0xb9, 0xaa, 0xa3, 0xe8, 0x17, 0x30, 0x6a, 0x77, 0x77, 0x52, 0xad, 0xa1, 0x87,
-	0xfa, 0xb8, 0x5e, 0x4e, 0xb5, 0x19, 0x0d, 0x87, 0xdb, 0x7d, 0xbf, 0xd6, 0x2f, 0x85, 0xe3, 0x5f,
-	0xe1, 0xc3, 0x5b, 0xc9, 0x68, 0x0f, 0x20, 0x8c, 0x62, 0xc2, 0x89, 0x70, 0xa2, 0x40, 0x56, 0xdf,
-	0xb1, 0x3b, 0x1a, 0x19, 0x05, 0xe8, 0x0b, 0xe8, 0xb1, 0x94, 0x5c, 0x3a, 0x9c, 0x11, 0x3f, 0x1f,
-	0xdf, 0x76, 0xe3, 0x15, 0xb7, 0xb2, 0xbc, 0x31, 0x23, 0xbe, 0x7a, 0xc7, 0x1f, 0x43, 0xb7, 0x74,
-	0x1e, 0xea, 0x43, 0x3b, 0xd7, 0x3f, 0x5d, 0xbf, 0xfa, 0xe7, 0xa0, 0x3d, 0x7a, 0x6a, 0xb7, 0xa3,
-	0x00, 0xff, 0xde, 0x86, 0xed, 0x52, 0xde, 0x28, 0x09, 0xb3, 0x2f, 0xb6, 0x5b, 0x6a, 0x82, 0x6e,
-	0xe9, 0x6e, 0xbd, 0x31, 0xe5, 0xdb, 0x94, 0xf3, 0xd1, 0xd7, 0xb0, 0x99, 0x2a, 0xeb, 0xb8, 0xd1,
-	0x3e, 0x5c, 0x3d, 0xea, 0x0e, 0xf1, 0x4d, 0x5c, 0xed, 0x72, 0xc1, 0x41, 0x27, 0xd0, 0xc9, 0x4d,
-	0xe1, 0xc6, 0xaa, 0x14, 0xf8, 0xe8, 0x46, 0x01, 0xed, 0xc3, 0x35, 0x0b, 0x7d, 0x0e, 0x1b, 0x72,
-	0x87, 0x90, 0x40, 0xaf, 0x8b, 0x81, 0xa9, 0xb6, 0xaf, 0x99, 0x6f, 0x5f, 0xf3, 0x65, 0xbe, 0x7d,
-	0xed, 0x3c, 0x15, 0xbf, 0x82, 0x5e, 0xad, 0x15, 0x1c, 0x9d, 0x41, 0xaf, 0x7c, 0x74, 0x94, 0x84,
-	0xd9, 0xea, 0xcc, 0x6a, 0x3a, 0xb8, 0xa1, 0xa6, 0x8c, 0x6b, 0x6f, 0x8b, 0x2a, 0x80, 0xcf, 0xe1,
-	0xe1, 0xa9, 0x2b, 0xfc, 0x69, 0xc3, 0x72, 0x2e, 0xf7, 0xac, 0xf5, 0xff, 0x7b, 0x86, 0x77, 0xe0,
-	0xa1, 0x5c, 0xa4, 0x8b, 0x49, 0xf8, 0x02, 0x76, 0x46, 0x49, 0x36, 0x3f, 0x0d, 0xc1, 0x77, 0xb4,
-	0x1a, 0x9f, 0x83, 0xa1, 0x3e, 0xd7, 0xbb, 0x97, 0x36, 0xa0, 0xff, 0x5d, 0xc4, 0x9b, 0x2e, 0x74,
-	0x0e, 0x86, 0x5a, 0xfa, 0x77, 0x7e, 0xe8, 0xf0, 0xb7, 0x35, 0x58, 0x3d, 0x79, 0x31, 0x42, 0xaf,
-	0xa0, 0x57, 0x77, 0x0a, 0x7d, 0x52, 0x57, 0x59, 0xe2, 0xe5, 0xe0, 0xb6, 0xc1, 0xc0, 0x2b, 0xe8,
-	0x02, 0x7a, 0x75, 0xbb, 0x16, 0xf5, 0x97, 0x18, 0x3a, 0xb8, 0xe9, 0x3a, 0x78, 0x05, 0x79, 0x80,
-	0x16, 0xfd, 0x46, 0x9f, 0xd6, 0x49, 0x4b, 0x67, 0xe2, 0x6d, 0xea, 0xff, 0x01, 0x1e, 0x2c, 0xf8,
-	0x8e, 0x8e, 0x9a, 0x37, 0x79, 0xc3, 0x09, 0xfd, 0x85, 0xaf, 0xf1, 0xdb, 0xec, 0x8f, 0x12, 0x5e,
-	0x41, 0x3f, 0xc2, 0x76, 0xcd, 0x75, 0xf4, 0xa8, 0x2e, 0xdb, 0x3c, 0x16, 0x83, 0xc3, 0x5b, 0xca,
-	0xe6, 0x78, 0x05, 0xfd, 0x04, 0x0f, 0x16, 0x46, 0x67, 0xb1, 0xee, 0x65, 0xd3, 0xf5, 0x36, 0x9d,
-	0x79, 0x0e, 0x9d, 0xe2, 0x07, 0x0c, 0xdd, 0xfa, 0xdb, 0xb6, 0xbc, 0x13, 0xa7, 0x8f, 0xff, 0xbc,
-	0xda, 0x6f, 0xfd, 0x75, 0xb5, 0xdf, 0xfa, 0xf7, 0x6a, 0xbf, 0x75, 0x71, 0x3c, 0x89, 0xc4, 0x74,
-	0xee, 0x99, 0x3e, 0x9d, 0x59, 0xcc, 0xf5, 0xa7, 0x6f, 0x02, 0x92, 0x96, 0x9f, 0x2e, 0x87, 0x16,
-	0x4f, 0xfd, 0xf2, 0x5f, 0x54, 0x6f, 0x5d, 0x4a, 0x7e, 0xf6, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x03, 0x7b, 0xf9, 0xd3, 0xc4, 0x0a, 0x00, 0x00,
+	// 1026 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x6e, 0x52, 0xb6, 0x6d, 0x4e, 0xda, 0x24, 0x9d, 0x45, 0x5d, 0x37, 0xa5, 0x3f, 0x6b, 0xc4,
+	0x52, 0x6e, 0x1c, 0x35, 0x70, 0x81, 0x90, 0x60, 0x69, 0xba, 0x74, 0x95, 0x0a, 0xa4, 0xc5, 0x59,
+	0x84, 0x5a, 0x89, 0x35, 0x8e, 0x3d, 0x4e, 0x0c, 0x8e, 0x67, 0xd6, 0x33, 0xa9, 0x54, 0x89, 0x07,
+	0x80, 0x57, 0xe1, 0x01, 0x78, 0x06, 0x2e, 0x79, 0x02, 0x84, 0xfa, 0x24, 0x68, 0x7e, 0xec, 0xd8,
+	0x4e, 0xd2, 0x2e, 0x62, 0x6f, 0x22, 0xfb, 0x3b, 0xe7, 0x7c, 0x73, 0x7e, 0xbe, 0x99, 0x71, 0x60,
+	0x9f, 0x27, 0x6e, 0xcc, 0x5c, 0x8f, 0x87, 0x24, 0xee, 0xe4, 0x9e, 0x2d, 0x9a, 0x10, 0x4e, 0x50,
+	0x23, 0x07, 0x39, 0xd7, 0xdd, 0xf6, 0xde, 0x88, 0x90, 0x51, 0x84, 0x3b, 0xd2, 0x3a, 0x9c, 0x06,
+	0x1d, 0x3c, 0xa1, 0xfc, 0x46, 0x39, 0xb7, 0x0f, 0xcb, 0x46, 0x1e, 0x4e, 0x30, 0xe3, 0xee, 0x84,
+	0x6a, 0x87, 0x77, 0x47, 0x64, 0x44, 0xe4, 0x63, 0x47, 0x3c, 0x69, 0xb4, 0xe9, 0x4e, 0xf9, 0xb8,
+	0x23, 0x7e, 0x34, 0xb0, 0x45, 0x03, 0xd6, 0xa1, 0x01, 0xcb, 0x5e, 0x29, 0xeb, 0x50, 0xaa, 0x5f,
+	0x4d, 0x04, 0xad, 0x67, 0x38, 0xc2, 0x1c, 0x9f, 0x46, 0x91, 0x8d, 0x5f, 0x4f, 0x31, 0xe3, 0xe6,
+	0x1f, 0x1b, 0x80, 0x5e, 0xce, 0x32, 0xd5, 0x30, 0xfa, 0x0c, 0xea, 0x5e, 0x82, 0x5d, 0x8e, 0x9d,
+	0x04, 0x53, 0x62, 0x54, 0x8e, 0x2a, 0xc7, 0xf5, 0xee, 0xae, 0x45, 0x03, 0xe6, 0x5c, 0x77, 0xad,
+	0x33, 0x69, 0xb2, 0x31, 0x25, 0xda, 0xdf, 0x06, 0x2f, 0x83, 0x44, 0xac, 0x2f, 0x97, 0x51, 0xb1,
+	0xd5, 0x62, 0xac, 0xca, 0xa0, 0x10, 0xeb, 0x67, 0x10, 0xfa, 0x1c, 0x36, 0x19, 0x77, 0x13, 0xee,
+	0x78, 0x64, 0x32, 0x09, 0xb9, 0xb1, 0x2a, 0x83, 0xdb, 0x69, 0xf0, 0x40, 0xd8, 0xce, 0xa4, 0x29,
+	0x8d, 0xae, 0xb3, 0x19, 0x86, 0xbe, 0x84, 0xad, 0x20, 0x8c, 0x43, 0x36, 0x4e, 0xe3, 0xdf, 0x91,
+	0xf1, 0x7b, 0x69, 0xfc, 0xb9, 0x34, 0x16, 0x09, 0x36, 0x83, 0x1c, 0x28, 0x18, 0xd8, 0xeb, 0xa9,
+	0x3b, 0x63, 0x78, 0x50, 0x64, 0x18, 0x48, 0x63, 0x89, 0x81, 0xe5, 0x40, 0xc1, 0xa0, 0x5b, 0x37,
+	0x4c, 0xdc, 0xd8, 0x1b, 0x1b, 0x6b, 0x45, 0x06, 0xd5, 0xbc, 0x9e, 0xb4, 0x65, 0x0c, 0x5e, 0x0e,
+	0x14, 0x0c, 0xba, 0x81, 0x9a, 0x61, 0xbd, 0xc8, 0xa0, 0x5a, 0x58, 0x62, 0xf0, 0x73, 0x20, 0x7a,
+	0x0e, 0xad, 0x29, 0xf5, 0x45, 0x0e, 0x3f, 0x91, 0xa1, 0xc3, 0xb8, 0xcb, 0xb1, 0xb1, 0x21, 0x49,
+	0xf6, 0x2d, 0x4a, 0x25, 0xc9, 0x77, 0xd2, 0x7e, 0x41, 0x86, 0x03, 0x2e, 0x07, 0xa7, 0x68, 0x1a,
+	0xd3, 0x02, 0x8c, 0xce, 0xa1, 0xa9, 0x8b, 0xa1, 0x21, 0xc5, 0x51, 0x18, 0x63, 0xa3, 0x56, 0xe4,
+	0x51, 0xe5, 0xbc, 0xd0, 0xd6, 0x8c, 0xc7, 0x2b, 0xc0, 0xe8, 0x04, 0x36, 0x18, 0x27, 0x54, 0xa4,
+	0x63, 0x80, 0x24, 0xd8, 0x49, 0x09, 0x06, 0x9c, 0xd0, 0x0b, 0x32, 0x4c, 0x23, 0xd7, 0x99, 0x7a,
+	0x47, 0x4f, 0x41, 0x0b, 0xc3, 0x71, 0xa3, 0xc8, 0xa8, 0xcb, 0xa0, 0x23, 0xab, 0xb8, 0xab, 0xac,
+	0xb2, 0x9e, 0xed, 0x9a, 0x9f, 0x22, 0x42, 0x87, 0xae, 0xef, 0x3b, 0x41, 0x18, 0x61, 0x86, 0xb9,
+	0xb1, 0x59, 0xd4, 0xe1, 0xa9, 0xef, 0x9f, 0x2b, 0x4b, 0xa6, 0x43, 0x37, 0x83, 0x44, 0xdd, 0x7a,
+	0xf1, 0xac, 0xee, 0xad, 0x62, 0xdd, 0x6a, 0xe5, 0xb9, 0xba, 0xfd, 0x02, 0x8c, 0xce, 0xa0, 0xa1,
+	0xf4, 0x9c, 0xd1, 0x34, 0x24, 0xcd, 0x7b, 0xb3, 0xea, 0xdd, 0x84, 0x97, 0x59, 0xb6, 0x58, 0x1e,
+	0x95, 0x9a, 0x14, 0xcd, 0xcb, 0x38, 0x9a, 0xa9, 0x1e, 0x66, 0x1d, 0x2c, 0x53, 0x6c, 0xb2, 0x1c,
+	0x88, 0xbe, 0x85, 0x87, 0x13, 0xe2, 0x87, 0xc1, 0x8d, 0x93, 0x90, 0x08, 0x3b, 0xc3, 0x30, 0xf6,
+	0xc3, 0x78, 0x64, 0xb4, 0x24, 0xcf, 0x63, 0x4b, 0x9c, 0x20, 0x82, 0xe8, 0x1b, 0xe9, 0x63, 0x93,
+	0x08, 0xf7, 0x94, 0x47, 0xca, 0xb6, 0x3d, 0x29, 0x5b, 0xcc, 0xdf, 0x2b, 0xf0, 0xb0, 0x70, 0x70,
+	0x30, 0x4a, 0x62, 0x86, 0xd1, 0x13, 0x58, 0xd3, 0x3b, 0x47, 0x1d, 0x1a, 0x8d, 0x4c, 0xf7, 0x12,
+	0xb5, 0xb5, 0x15, 0xfd, 0x0c, 0x46, 0x49, 0x59, 0x4e, 0xa2, 0x39, 0xf4, 0x91, 0x71, 0x52, 0x1e,
+	0x76, 0x51, 0x6a, 0x0b, 0x16, 0xb7, 0x77, 0xbc, 0x92, 0x1a, 0x15, 0x6e, 0xfe, 0x02, 0x8f, 0xef,
+	0x0d, 0x46, 0xfb, 0x00, 0x5a, 0x2b, 0x4e, 0xe8, 0xcb, 0xec, 0x6b, 0x76, 0x4d, 0x23, 0x7d, 0x1f,
+	0x7d, 0x0a, 0x2d, 0x9a, 0xe0, 0x6b, 0x87, 0x51, 0xec, 0xa5, 0x87, 0x43, 0x75, 0x61, 0x89, 0x0d,
+	0xe1, 0x37, 0xa0, 0xd8, 0x53, 0xef, 0xe6, 0x07, 0x50, 0xcf, 0xad, 0x87, 0x76, 0xa0, 0x9a, 0xf2,
+	0xf7, 0xd6, 0x6e, 0xff, 0x3e, 0xac, 0xf6, 0x9f, 0xd9, 0xd5, 0xd0, 0x37, 0x7f, 0xab, 0x42, 0x33,
+	0xe7, 0xd7, 0x8f, 0x03, 0x71, 0x1e, 0xd6, 0x73, 0x4d, 0xd0, 0x2d, 0xdd, 0x2b, 0x37, 0x26, 0x5f,
+	0x4d, 0xde, 0x1f, 0x7d, 0x01, 0x1b, 0x89, 0x1a, 0x21, 0x33, 0xaa, 0x47, 0xab, 0xc7, 0xf5, 0xae,
+	0x79, 0x57, 0xac, 0x9e, 0x76, 0x16, 0x83, 0x4e, 0xa1, 0x96, 0x0e, 0x85, 0x19, 0xab, 0x92, 0xe0,
+	0xfd, 0x3b, 0x09, 0xf4, 0x1c, 0x66, 0x51, 0xe8, 0x13, 0x58, 0x97, 0x6a, 0xc6, 0xbe, 0x3e, 0x8c,
+	0xdb, 0x96, 0xba, 0xec, 0xac, 0xf4, 0xb2, 0xb3, 0x5e, 0xa6, 0x97, 0x9d, 0x9d, 0xba, 0x9a, 0xaf,
+	0xa0, 0x55, 0x6a, 0x05, 0x43, 0x17, 0xd0, 0xca, 0x2f, 0x1d, 0xc6, 0x81, 0xb8, 0x98, 0x44, 0x4e,
+	0x87, 0x77, 0xe4, 0x24, 0x62, 0xed, 0x26, 0x2f, 0x02, 0xe6, 0x25, 0x3c, 0xea, 0xb9, 0xdc, 0x1b,
+	0x2f, 0xb8, 0xfa, 0xf2, 0x3d, 0xab, 0xfc, 0xf7, 0x9e, 0x99, 0xbb, 0xf0, 0x48, 0x6e, 0xea, 0x79,
+	0x27, 0xf3, 0x0a, 0x76, 0xfb, 0xb1, 0xd0, 0xcf, 0x02, 0xe3, 0xff, 0x1c, 0xb5, 0x79, 0x09, 0x86,
+	0x3a, 0x92, 0xde, 0x3e, 0xb5, 0x01, 0x3b, 0x5f, 0x87, 0x6c, 0x51, 0x41, 0x97, 0x60, 0xa8, 0x2b,
+	0xf5, 0xad, 0x2f, 0xda, 0xfd, 0xf5, 0x01, 0xac, 0x9e, 0xbe, 0xe8, 0xa3, 0x57, 0xd0, 0x2a, 0x4f,
+	0x0a, 0x7d, 0x58, 0x66, 0x59, 0x32, 0xcb, 0xf6, 0x7d, 0xc2, 0x30, 0x57, 0xd0, 0x15, 0xb4, 0xca,
+	0xe3, 0x9a, 0xe7, 0x5f, 0x32, 0xd0, 0xf6, 0x5d, 0xe5, 0x98, 0x2b, 0x68, 0x08, 0x68, 0x7e, 0xde,
+	0xe8, 0xa3, 0x72, 0xd0, 0x52, 0x4d, 0xbc, 0x49, 0xfe, 0xdf, 0xc3, 0xf6, 0xdc, 0xdc, 0xd1, 0xf1,
+	0xe2, 0x7b, 0x72, 0xc1, 0x0a, 0x3b, 0x73, 0xbb, 0xf1, 0x2b, 0xf1, 0x5d, 0x6a, 0xae, 0xa0, 0x1f,
+	0xa0, 0x59, 0x9a, 0x3a, 0x7a, 0x52, 0xa6, 0x5d, 0x2c, 0x8b, 0xf6, 0xd1, 0x3d, 0x69, 0x33, 0x73,
+	0x05, 0xfd, 0x08, 0xdb, 0x73, 0xd2, 0x99, 0xcf, 0x7b, 0x99, 0xba, 0xde, 0xa4, 0x33, 0xcf, 0xa1,
+	0x96, 0x7d, 0x1e, 0xa0, 0x7b, 0xbf, 0x1c, 0x96, 0x77, 0xa2, 0xf7, 0xf4, 0xcf, 0xdb, 0x83, 0xca,
+	0x5f, 0xb7, 0x07, 0x95, 0x7f, 0x6e, 0x0f, 0x2a, 0x57, 0x27, 0xa3, 0x90, 0x8f, 0xa7, 0x43, 0xcb,
+	0x23, 0x93, 0x0e, 0x75, 0xbd, 0xf1, 0x8d, 0x8f, 0x93, 0xfc, 0xd3, 0x75, 0xb7, 0xc3, 0x12, 0x2f,
+	0xff, 0x8f, 0x60, 0xb8, 0x26, 0x29, 0x3f, 0xfe, 0x77, 0x00, 0xef, 0xcf, 0x32, 0x91, 0x33, 0x0c,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1167,6 +1216,68 @@ func (m *TransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ModifyRoleBinding != nil {
+		{
+			size, err := m.ModifyRoleBinding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.StopPipeline != nil {
+		{
+			size, err := m.StopPipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.StartPipeline != nil {
+		{
+			size, err := m.StartPipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.DeletePipeline != nil {
+		{
+			size, err := m.DeletePipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.AddFileset != nil {
+		{
+			size, err := m.AddFileset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.DeleteAll != nil {
 		{
 			size, err := m.DeleteAll.MarshalToSizedBuffer(dAtA[:i])
@@ -1838,6 +1949,26 @@ func (m *TransactionRequest) Size() (n int) {
 		l = m.DeleteAll.Size()
 		n += 1 + l + sovTransaction(uint64(l))
 	}
+	if m.AddFileset != nil {
+		l = m.AddFileset.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.DeletePipeline != nil {
+		l = m.DeletePipeline.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.StartPipeline != nil {
+		l = m.StartPipeline.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.StopPipeline != nil {
+		l = m.StopPipeline.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.ModifyRoleBinding != nil {
+		l = m.ModifyRoleBinding.Size()
+		n += 2 + l + sovTransaction(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2522,6 +2653,186 @@ func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddFileset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AddFileset == nil {
+				m.AddFileset = &pfs.AddFilesetRequest{}
+			}
+			if err := m.AddFileset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletePipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletePipeline == nil {
+				m.DeletePipeline = &pps.DeletePipelineRequest{}
+			}
+			if err := m.DeletePipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartPipeline == nil {
+				m.StartPipeline = &pps.StartPipelineRequest{}
+			}
+			if err := m.StartPipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopPipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StopPipeline == nil {
+				m.StopPipeline = &pps.StopPipelineRequest{}
+			}
+			if err := m.StopPipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifyRoleBinding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ModifyRoleBinding == nil {
+				m.ModifyRoleBinding = &auth.ModifyRoleBindingRequest{}
+			}
+			if err := m.ModifyRoleBinding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])